@@ -27,6 +27,7 @@ type CourseAPIClient interface {
 	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
 	GetFocusedLessons(ctx context.Context, in *GetFocusedLessonsRequest, opts ...grpc.CallOption) (*GetFocusedLessonsResponse, error)
 	GetEnrolledCourses(ctx context.Context, in *GetEnrolledCoursesRequest, opts ...grpc.CallOption) (*GetEnrolledCoursesResponse, error)
+	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
 	EnrollUser(ctx context.Context, in *EnrollUserRequest, opts ...grpc.CallOption) (*EnrollUserResponse, error)
 	GetUserProgress(ctx context.Context, in *GetUserProgressRequest, opts ...grpc.CallOption) (*GetUserProgressResponse, error)
 	GetLessonState(ctx context.Context, in *GetLessonStateRequest, opts ...grpc.CallOption) (*GetLessonStateResponse, error)
@@ -35,6 +36,20 @@ type CourseAPIClient interface {
 	CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error)
 	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*UpdateCourseResponse, error)
 	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*UpdateLessonResponse, error)
+	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
+	RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*RestoreCourseResponse, error)
+	DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error)
+	RestoreLesson(ctx context.Context, in *RestoreLessonRequest, opts ...grpc.CallOption) (*RestoreLessonResponse, error)
+	ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error)
+	GetModule(ctx context.Context, in *GetModuleRequest, opts ...grpc.CallOption) (*GetModuleResponse, error)
+	CreateModule(ctx context.Context, in *CreateModuleRequest, opts ...grpc.CallOption) (*CreateModuleResponse, error)
+	UpdateModule(ctx context.Context, in *UpdateModuleRequest, opts ...grpc.CallOption) (*UpdateModuleResponse, error)
+	DeleteModule(ctx context.Context, in *DeleteModuleRequest, opts ...grpc.CallOption) (*DeleteModuleResponse, error)
+	RestoreModule(ctx context.Context, in *RestoreModuleRequest, opts ...grpc.CallOption) (*RestoreModuleResponse, error)
+	ReorderModules(ctx context.Context, in *ReorderModulesRequest, opts ...grpc.CallOption) (*ReorderModulesResponse, error)
+	GetCourseOutline(ctx context.Context, in *GetCourseOutlineRequest, opts ...grpc.CallOption) (*GetCourseOutlineResponse, error)
+	GetCoursePrerequisites(ctx context.Context, in *GetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*GetCoursePrerequisitesResponse, error)
+	SetCoursePrerequisites(ctx context.Context, in *SetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*SetCoursePrerequisitesResponse, error)
 	SyncState(ctx context.Context, in *SyncStateRequest, opts ...grpc.CallOption) (*SyncStateResponse, error)
 }
 
@@ -91,6 +106,15 @@ func (c *courseAPIClient) GetEnrolledCourses(ctx context.Context, in *GetEnrolle
 	return out, nil
 }
 
+func (c *courseAPIClient) ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error) {
+	out := new(ListCoursesResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/ListCourses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courseAPIClient) EnrollUser(ctx context.Context, in *EnrollUserRequest, opts ...grpc.CallOption) (*EnrollUserResponse, error) {
 	out := new(EnrollUserResponse)
 	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/EnrollUser", in, out, opts...)
@@ -163,6 +187,132 @@ func (c *courseAPIClient) UpdateLesson(ctx context.Context, in *UpdateLessonRequ
 	return out, nil
 }
 
+func (c *courseAPIClient) DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error) {
+	out := new(DeleteCourseResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/DeleteCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*RestoreCourseResponse, error) {
+	out := new(RestoreCourseResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/RestoreCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error) {
+	out := new(DeleteLessonResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/DeleteLesson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) RestoreLesson(ctx context.Context, in *RestoreLessonRequest, opts ...grpc.CallOption) (*RestoreLessonResponse, error) {
+	out := new(RestoreLessonResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/RestoreLesson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error) {
+	out := new(ReorderLessonsResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/ReorderLessons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) GetModule(ctx context.Context, in *GetModuleRequest, opts ...grpc.CallOption) (*GetModuleResponse, error) {
+	out := new(GetModuleResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/GetModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) CreateModule(ctx context.Context, in *CreateModuleRequest, opts ...grpc.CallOption) (*CreateModuleResponse, error) {
+	out := new(CreateModuleResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/CreateModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) UpdateModule(ctx context.Context, in *UpdateModuleRequest, opts ...grpc.CallOption) (*UpdateModuleResponse, error) {
+	out := new(UpdateModuleResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/UpdateModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) DeleteModule(ctx context.Context, in *DeleteModuleRequest, opts ...grpc.CallOption) (*DeleteModuleResponse, error) {
+	out := new(DeleteModuleResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/DeleteModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) RestoreModule(ctx context.Context, in *RestoreModuleRequest, opts ...grpc.CallOption) (*RestoreModuleResponse, error) {
+	out := new(RestoreModuleResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/RestoreModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) ReorderModules(ctx context.Context, in *ReorderModulesRequest, opts ...grpc.CallOption) (*ReorderModulesResponse, error) {
+	out := new(ReorderModulesResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/ReorderModules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) GetCourseOutline(ctx context.Context, in *GetCourseOutlineRequest, opts ...grpc.CallOption) (*GetCourseOutlineResponse, error) {
+	out := new(GetCourseOutlineResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/GetCourseOutline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) GetCoursePrerequisites(ctx context.Context, in *GetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*GetCoursePrerequisitesResponse, error) {
+	out := new(GetCoursePrerequisitesResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/GetCoursePrerequisites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseAPIClient) SetCoursePrerequisites(ctx context.Context, in *SetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*SetCoursePrerequisitesResponse, error) {
+	out := new(SetCoursePrerequisitesResponse)
+	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/SetCoursePrerequisites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courseAPIClient) SyncState(ctx context.Context, in *SyncStateRequest, opts ...grpc.CallOption) (*SyncStateResponse, error) {
 	out := new(SyncStateResponse)
 	err := c.cc.Invoke(ctx, "/course.v1.CourseAPI/SyncState", in, out, opts...)
@@ -181,6 +331,7 @@ type CourseAPIServer interface {
 	GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error)
 	GetFocusedLessons(context.Context, *GetFocusedLessonsRequest) (*GetFocusedLessonsResponse, error)
 	GetEnrolledCourses(context.Context, *GetEnrolledCoursesRequest) (*GetEnrolledCoursesResponse, error)
+	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
 	EnrollUser(context.Context, *EnrollUserRequest) (*EnrollUserResponse, error)
 	GetUserProgress(context.Context, *GetUserProgressRequest) (*GetUserProgressResponse, error)
 	GetLessonState(context.Context, *GetLessonStateRequest) (*GetLessonStateResponse, error)
@@ -189,6 +340,20 @@ type CourseAPIServer interface {
 	CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error)
 	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
 	UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error)
+	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	RestoreCourse(context.Context, *RestoreCourseRequest) (*RestoreCourseResponse, error)
+	DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error)
+	RestoreLesson(context.Context, *RestoreLessonRequest) (*RestoreLessonResponse, error)
+	ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error)
+	GetModule(context.Context, *GetModuleRequest) (*GetModuleResponse, error)
+	CreateModule(context.Context, *CreateModuleRequest) (*CreateModuleResponse, error)
+	UpdateModule(context.Context, *UpdateModuleRequest) (*UpdateModuleResponse, error)
+	DeleteModule(context.Context, *DeleteModuleRequest) (*DeleteModuleResponse, error)
+	RestoreModule(context.Context, *RestoreModuleRequest) (*RestoreModuleResponse, error)
+	ReorderModules(context.Context, *ReorderModulesRequest) (*ReorderModulesResponse, error)
+	GetCourseOutline(context.Context, *GetCourseOutlineRequest) (*GetCourseOutlineResponse, error)
+	GetCoursePrerequisites(context.Context, *GetCoursePrerequisitesRequest) (*GetCoursePrerequisitesResponse, error)
+	SetCoursePrerequisites(context.Context, *SetCoursePrerequisitesRequest) (*SetCoursePrerequisitesResponse, error)
 	SyncState(context.Context, *SyncStateRequest) (*SyncStateResponse, error)
 }
 
@@ -211,6 +376,9 @@ func (UnimplementedCourseAPIServer) GetFocusedLessons(context.Context, *GetFocus
 func (UnimplementedCourseAPIServer) GetEnrolledCourses(context.Context, *GetEnrolledCoursesRequest) (*GetEnrolledCoursesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnrolledCourses not implemented")
 }
+func (UnimplementedCourseAPIServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+}
 func (UnimplementedCourseAPIServer) EnrollUser(context.Context, *EnrollUserRequest) (*EnrollUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnrollUser not implemented")
 }
@@ -235,6 +403,48 @@ func (UnimplementedCourseAPIServer) UpdateCourse(context.Context, *UpdateCourseR
 func (UnimplementedCourseAPIServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
 }
+func (UnimplementedCourseAPIServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+}
+func (UnimplementedCourseAPIServer) RestoreCourse(context.Context, *RestoreCourseRequest) (*RestoreCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCourse not implemented")
+}
+func (UnimplementedCourseAPIServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
+}
+func (UnimplementedCourseAPIServer) RestoreLesson(context.Context, *RestoreLessonRequest) (*RestoreLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLesson not implemented")
+}
+func (UnimplementedCourseAPIServer) ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderLessons not implemented")
+}
+func (UnimplementedCourseAPIServer) GetModule(context.Context, *GetModuleRequest) (*GetModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModule not implemented")
+}
+func (UnimplementedCourseAPIServer) CreateModule(context.Context, *CreateModuleRequest) (*CreateModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateModule not implemented")
+}
+func (UnimplementedCourseAPIServer) UpdateModule(context.Context, *UpdateModuleRequest) (*UpdateModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModule not implemented")
+}
+func (UnimplementedCourseAPIServer) DeleteModule(context.Context, *DeleteModuleRequest) (*DeleteModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModule not implemented")
+}
+func (UnimplementedCourseAPIServer) RestoreModule(context.Context, *RestoreModuleRequest) (*RestoreModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreModule not implemented")
+}
+func (UnimplementedCourseAPIServer) ReorderModules(context.Context, *ReorderModulesRequest) (*ReorderModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderModules not implemented")
+}
+func (UnimplementedCourseAPIServer) GetCourseOutline(context.Context, *GetCourseOutlineRequest) (*GetCourseOutlineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseOutline not implemented")
+}
+func (UnimplementedCourseAPIServer) GetCoursePrerequisites(context.Context, *GetCoursePrerequisitesRequest) (*GetCoursePrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursePrerequisites not implemented")
+}
+func (UnimplementedCourseAPIServer) SetCoursePrerequisites(context.Context, *SetCoursePrerequisitesRequest) (*SetCoursePrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoursePrerequisites not implemented")
+}
 func (UnimplementedCourseAPIServer) SyncState(context.Context, *SyncStateRequest) (*SyncStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncState not implemented")
 }
@@ -340,6 +550,24 @@ func _CourseAPI_GetEnrolledCourses_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseAPI_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).ListCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/ListCourses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).ListCourses(ctx, req.(*ListCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CourseAPI_EnrollUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnrollUserRequest)
 	if err := dec(in); err != nil {
@@ -484,6 +712,258 @@ func _CourseAPI_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseAPI_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).DeleteCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/DeleteCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).DeleteCourse(ctx, req.(*DeleteCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_RestoreCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).RestoreCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/RestoreCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).RestoreCourse(ctx, req.(*RestoreCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_DeleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).DeleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/DeleteLesson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).DeleteLesson(ctx, req.(*DeleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_RestoreLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).RestoreLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/RestoreLesson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).RestoreLesson(ctx, req.(*RestoreLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_ReorderLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).ReorderLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/ReorderLessons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).ReorderLessons(ctx, req.(*ReorderLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_GetModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).GetModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/GetModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).GetModule(ctx, req.(*GetModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_CreateModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).CreateModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/CreateModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).CreateModule(ctx, req.(*CreateModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_UpdateModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).UpdateModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/UpdateModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).UpdateModule(ctx, req.(*UpdateModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_DeleteModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).DeleteModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/DeleteModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).DeleteModule(ctx, req.(*DeleteModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_RestoreModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).RestoreModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/RestoreModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).RestoreModule(ctx, req.(*RestoreModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_ReorderModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).ReorderModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/ReorderModules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).ReorderModules(ctx, req.(*ReorderModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_GetCourseOutline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseOutlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).GetCourseOutline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/GetCourseOutline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).GetCourseOutline(ctx, req.(*GetCourseOutlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_GetCoursePrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoursePrerequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).GetCoursePrerequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/GetCoursePrerequisites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).GetCoursePrerequisites(ctx, req.(*GetCoursePrerequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseAPI_SetCoursePrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoursePrerequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseAPIServer).SetCoursePrerequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/course.v1.CourseAPI/SetCoursePrerequisites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseAPIServer).SetCoursePrerequisites(ctx, req.(*SetCoursePrerequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CourseAPI_SyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncStateRequest)
 	if err := dec(in); err != nil {
@@ -529,6 +1009,10 @@ var CourseAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEnrolledCourses",
 			Handler:    _CourseAPI_GetEnrolledCourses_Handler,
 		},
+		{
+			MethodName: "ListCourses",
+			Handler:    _CourseAPI_ListCourses_Handler,
+		},
 		{
 			MethodName: "EnrollUser",
 			Handler:    _CourseAPI_EnrollUser_Handler,
@@ -561,6 +1045,62 @@ var CourseAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLesson",
 			Handler:    _CourseAPI_UpdateLesson_Handler,
 		},
+		{
+			MethodName: "DeleteCourse",
+			Handler:    _CourseAPI_DeleteCourse_Handler,
+		},
+		{
+			MethodName: "RestoreCourse",
+			Handler:    _CourseAPI_RestoreCourse_Handler,
+		},
+		{
+			MethodName: "DeleteLesson",
+			Handler:    _CourseAPI_DeleteLesson_Handler,
+		},
+		{
+			MethodName: "RestoreLesson",
+			Handler:    _CourseAPI_RestoreLesson_Handler,
+		},
+		{
+			MethodName: "ReorderLessons",
+			Handler:    _CourseAPI_ReorderLessons_Handler,
+		},
+		{
+			MethodName: "GetModule",
+			Handler:    _CourseAPI_GetModule_Handler,
+		},
+		{
+			MethodName: "CreateModule",
+			Handler:    _CourseAPI_CreateModule_Handler,
+		},
+		{
+			MethodName: "UpdateModule",
+			Handler:    _CourseAPI_UpdateModule_Handler,
+		},
+		{
+			MethodName: "DeleteModule",
+			Handler:    _CourseAPI_DeleteModule_Handler,
+		},
+		{
+			MethodName: "RestoreModule",
+			Handler:    _CourseAPI_RestoreModule_Handler,
+		},
+		{
+			MethodName: "ReorderModules",
+			Handler:    _CourseAPI_ReorderModules_Handler,
+		},
+		{
+			MethodName: "GetCourseOutline",
+			Handler:    _CourseAPI_GetCourseOutline_Handler,
+		},
+		{
+			MethodName: "GetCoursePrerequisites",
+			Handler:    _CourseAPI_GetCoursePrerequisites_Handler,
+		},
+		{
+			MethodName: "SetCoursePrerequisites",
+			Handler:    _CourseAPI_SetCoursePrerequisites_Handler,
+		},
 		{
 			MethodName: "SyncState",
 			Handler:    _CourseAPI_SyncState_Handler,
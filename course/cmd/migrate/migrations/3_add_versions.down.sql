@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE lessons DROP COLUMN IF EXISTS version;
+ALTER TABLE courses DROP COLUMN IF EXISTS version;
+
+COMMIT;
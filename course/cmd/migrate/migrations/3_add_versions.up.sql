@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Updates can ask for the version they were based on, and are rejected when
+-- someone else changed the course or lesson in between
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+COMMIT;
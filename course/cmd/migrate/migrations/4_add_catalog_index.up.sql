@@ -0,0 +1,7 @@
+BEGIN;
+
+-- The catalog pages through the courses that were not deleted, sorted by
+-- their order and then by when they were created
+CREATE INDEX IF NOT EXISTS idx_courses_catalog ON courses("order", created_at, id) WHERE deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- Lessons keep the order they were renumbered to
+DROP INDEX IF EXISTS lessons_course_id_order_key;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Lessons of a course are numbered 1, 2, 3... in their current order, so
+-- that no two of them share an order before it is enforced
+UPDATE lessons l SET "order" = ranked.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY "order", created_at, id) AS position
+    FROM lessons
+    WHERE deleted_at IS NULL
+) ranked
+WHERE l.id = ranked.id AND l."order" <> ranked.position;
+
+-- Deleted lessons give their order up, and take a free one when restored
+CREATE UNIQUE INDEX IF NOT EXISTS lessons_course_id_order_key ON lessons(course_id, "order") WHERE deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_lessons_module_id;
+ALTER TABLE lessons DROP COLUMN IF EXISTS module_id;
+DROP TABLE IF EXISTS modules;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- Modules group the lessons of a course. Lessons keep a single order across
+-- the whole course, and the ones without a module are left ungrouped.
+CREATE TABLE IF NOT EXISTS modules (
+    id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    course_id UUID NOT NULL REFERENCES courses(id),
+    "order" INT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS modules_course_id_order_key ON modules(course_id, "order") WHERE deleted_at IS NULL;
+
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS module_id UUID REFERENCES modules(id);
+
+CREATE INDEX IF NOT EXISTS idx_lessons_module_id ON lessons(module_id) WHERE module_id IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS course_prerequisites;
+ALTER TABLE courses DROP CONSTRAINT IF EXISTS courses_gating_check;
+ALTER TABLE courses DROP COLUMN IF EXISTS unlock_threshold;
+ALTER TABLE courses DROP COLUMN IF EXISTS gating_mode;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+-- Courses either leave every lesson open, open each lesson once the previous
+-- one is completed, or once unlock_threshold percent of its cards are
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS gating_mode TEXT NOT NULL DEFAULT 'free';
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS unlock_threshold INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE courses ADD CONSTRAINT courses_gating_check CHECK (
+    gating_mode IN ('free', 'sequential', 'threshold')
+    AND unlock_threshold BETWEEN 0 AND 100
+    AND (gating_mode <> 'threshold' OR unlock_threshold > 0)
+);
+
+-- Users must complete every prerequisite of a course before enrolling in it
+CREATE TABLE IF NOT EXISTS course_prerequisites (
+    course_id UUID NOT NULL REFERENCES courses(id),
+    prerequisite_id UUID NOT NULL REFERENCES courses(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (course_id, prerequisite_id),
+    CHECK (course_id <> prerequisite_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_prerequisites_prerequisite_id ON course_prerequisites(prerequisite_id);
+
+COMMIT;
@@ -9,13 +9,33 @@ import (
 	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
 )
 
+// CardAnswer is a learner's answer to a card. Depending on the card's kind
+// it holds the chosen answer, the text typed into each blank, several
+// chosen answers (ordered, for ordering cards) or matched pairs of answers.
 type CardAnswer struct {
-	CardID   uuid.UUID
-	AnswerID uuid.UUID
+	CardID      uuid.UUID
+	AnswerID    uuid.UUID
+	TypedBlanks []string
+	AnswerIDs   []uuid.UUID
+	Pairs       []AnswerPair
+}
+
+// AnswerPair is a left and a right answer matched on a matching card.
+type AnswerPair struct {
+	LeftID  uuid.UUID
+	RightID uuid.UUID
+}
+
+// CardResult is the grade of one answered card along with the card's
+// explanation, shown to the learner once they have answered.
+type CardResult struct {
+	CardID      uuid.UUID
+	IsCorrect   bool
+	Explanation string
 }
 
 type Answerer interface {
-	Answer(ctx context.Context, userID, courseID, lessonID, deckID uuid.UUID, cardAnswers []CardAnswer) error
+	Answer(ctx context.Context, userID, courseID, lessonID, deckID uuid.UUID, cardAnswers []CardAnswer) ([]CardResult, error)
 }
 
 type answerer struct {
@@ -30,44 +50,60 @@ func NewAnswerer(repo Repository, deckClient pbDeck.DecksAPIClient) Answerer {
 	}
 }
 
-// Answer processes the user's answers for a set of cards in a deck within a lesson.
-func (a *answerer) Answer(ctx context.Context, userID, courseID, lessonID, deckID uuid.UUID, cardAnswers []CardAnswer) error {
+// Answer processes the user's answers for a set of cards in a deck within a lesson
+// and returns the result of each answer, in the order of cardAnswers.
+func (a *answerer) Answer(ctx context.Context, userID, courseID, lessonID, deckID uuid.UUID, cardAnswers []CardAnswer) ([]CardResult, error) {
 	userProgress, err := a.repo.GetUserCourseProgress(ctx, userID, courseID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if userProgress.State == nil {
-		return errors.Trace(ErrUnitProgressStateNotInitialized)
+		return nil, errors.Trace(ErrUnitProgressStateNotInitialized)
+	}
+
+	c, err := a.repo.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	locked, err := isLessonLocked(ctx, a.repo, c, lessonID, userProgress.State)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if locked {
+		return nil, errors.Trace(ErrLessonLocked)
 	}
 
-	cardIDs := make([]string, 0, len(cardAnswers))
+	answers := make([]*pbDeck.CardAnswer, 0, len(cardAnswers))
 	for _, ca := range cardAnswers {
-		cardIDs = append(cardIDs, ca.CardID.String())
+		answers = append(answers, toGradeRequestAnswer(ca))
 	}
 
-	// TODO: Deck service should accept []CardAnswer and return which are correct
-	getCardsRes, err := a.deckClient.GetCards(ctx, &pbDeck.GetCardsRequest{
-		CardIds: cardIDs,
+	gradeRes, err := a.deckClient.GradeAnswers(ctx, &pbDeck.GradeAnswersRequest{
+		Answers: answers,
 	})
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
-	correctAnswers := make(map[string]struct{}, len(getCardsRes.Cards))
-	for _, card := range getCardsRes.Cards {
-		for _, answer := range card.PossibleAnswers {
-			if answer.IsCorrect {
-				correctAnswers[answer.Id] = struct{}{}
-			}
-		}
+	if len(gradeRes.Grades) != len(cardAnswers) {
+		return nil, errors.Errorf("expected %d grades, got %d", len(cardAnswers), len(gradeRes.Grades))
 	}
 
-	for _, cardAnswer := range cardAnswers {
-		_, correct := correctAnswers[cardAnswer.AnswerID.String()]
-		if err := userProgress.State.AnswerCard(lessonID.String(), deckID.String(), cardAnswer.CardID.String(), correct); err != nil {
-			return errors.Trace(err)
+	results := make([]CardResult, 0, len(cardAnswers))
+	for i, cardAnswer := range cardAnswers {
+		grade := gradeRes.Grades[i]
+		if err := userProgress.State.AnswerCard(lessonID.String(), deckID.String(), cardAnswer.CardID.String(), grade.IsCorrect); err != nil {
+			return nil, errors.Trace(err)
 		}
+
+		results = append(results, CardResult{
+			CardID:      cardAnswer.CardID,
+			IsCorrect:   grade.IsCorrect,
+			Explanation: grade.Explanation,
+		})
 	}
 
 	// If this lesson is now completed, mark current lesson as this one so clients see is_current=true
@@ -77,8 +113,35 @@ func (a *answerer) Answer(ctx context.Context, userID, courseID, lessonID, deckI
 	}
 
 	if err := a.repo.UpdateUserProgress(ctx, userProgress); err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
+	}
+
+	return results, nil
+}
+
+func toGradeRequestAnswer(ca CardAnswer) *pbDeck.CardAnswer {
+	out := &pbDeck.CardAnswer{CardId: ca.CardID.String()}
+
+	switch {
+	case len(ca.TypedBlanks) > 0:
+		out.Response = &pbDeck.CardAnswer_Typed{Typed: &pbDeck.TypedResponse{Blanks: ca.TypedBlanks}}
+	case len(ca.AnswerIDs) > 0:
+		ids := make([]string, 0, len(ca.AnswerIDs))
+		for _, id := range ca.AnswerIDs {
+			ids = append(ids, id.String())
+		}
+
+		out.Response = &pbDeck.CardAnswer_AnswerIds{AnswerIds: &pbDeck.AnswerIDs{Ids: ids}}
+	case len(ca.Pairs) > 0:
+		pairs := make([]*pbDeck.MatchedPairs_Pair, 0, len(ca.Pairs))
+		for _, p := range ca.Pairs {
+			pairs = append(pairs, &pbDeck.MatchedPairs_Pair{LeftId: p.LeftID.String(), RightId: p.RightID.String()})
+		}
+
+		out.Response = &pbDeck.CardAnswer_Pairs{Pairs: &pbDeck.MatchedPairs{Pairs: pairs}}
+	default:
+		out.Response = &pbDeck.CardAnswer_AnswerId{AnswerId: ca.AnswerID.String()}
 	}
 
-	return nil
+	return out
 }
@@ -50,36 +50,25 @@ func TestAnswerer_Answer_Success_AllCorrect(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 	mockRepo.On("UpdateUserProgress", ctx, mock.MatchedBy(func(ucp UserCourseProgress) bool {
 		return ucp.State != nil
 	})).Return(nil)
 
-	mockDecksClient.On("GetCards", ctx, mock.MatchedBy(func(req *pbDeck.GetCardsRequest) bool {
-		return len(req.CardIds) == 2
-	})).Return(&pbDeck.GetCardsResponse{
-		Cards: map[string]*pbDeck.Card{
-			card1ID.String(): {
-				Id:    card1ID.String(),
-				Title: "Question 1",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: answer1ID.String(), IsCorrect: true},
-					{Id: uuid.New().String(), IsCorrect: false},
-				},
-			},
-			card2ID.String(): {
-				Id:    card2ID.String(),
-				Title: "Question 2",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: answer2ID.String(), IsCorrect: true},
-					{Id: uuid.New().String(), IsCorrect: false},
-				},
-			},
+	mockDecksClient.On("GradeAnswers", ctx, mock.MatchedBy(func(req *pbDeck.GradeAnswersRequest) bool {
+		return len(req.Answers) == 2 &&
+			req.Answers[0].CardId == card1ID.String() && req.Answers[0].GetAnswerId() == answer1ID.String() &&
+			req.Answers[1].CardId == card2ID.String() && req.Answers[1].GetAnswerId() == answer2ID.String()
+	})).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: card1ID.String(), IsCorrect: true},
+			{CardId: card2ID.String(), IsCorrect: true},
 		},
 	}, nil)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 	require.NoError(t, err)
 
 	// Verify cards were marked as answered correctly
@@ -131,35 +120,26 @@ func TestAnswerer_Answer_Success_SomeIncorrect(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
 
-	mockDecksClient.On("GetCards", ctx, mock.MatchedBy(func(req *pbDeck.GetCardsRequest) bool {
-		return len(req.CardIds) == 2
-	})).Return(&pbDeck.GetCardsResponse{
-		Cards: map[string]*pbDeck.Card{
-			card1ID.String(): {
-				Id:    card1ID.String(),
-				Title: "Question 1",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: correctAnswerID.String(), IsCorrect: true},
-					{Id: uuid.New().String(), IsCorrect: false},
-				},
-			},
-			card2ID.String(): {
-				Id:    card2ID.String(),
-				Title: "Question 2",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: uuid.New().String(), IsCorrect: true},
-					{Id: incorrectAnswerID.String(), IsCorrect: false},
-				},
-			},
+	mockDecksClient.On("GradeAnswers", ctx, mock.MatchedBy(func(req *pbDeck.GradeAnswersRequest) bool {
+		return len(req.Answers) == 2
+	})).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: card1ID.String(), IsCorrect: true, Explanation: "Because."},
+			{CardId: card2ID.String(), IsCorrect: false, Explanation: "Not quite."},
 		},
 	}, nil)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	results, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 	require.NoError(t, err)
+	assert.Equal(t, []CardResult{
+		{CardID: card1ID, IsCorrect: true, Explanation: "Because."},
+		{CardID: card2ID, IsCorrect: false, Explanation: "Not quite."},
+	}, results)
 
 	// Verify card1 was correct and card2 was incorrect
 	assert.True(t, userProgress.State.Lessons[lessonID.String()].Decks[deckID.String()].Cards[card1ID.String()].IsCompleted)
@@ -186,7 +166,7 @@ func TestAnswerer_Answer_ErrorGettingUserProgress(t *testing.T) {
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, []CardAnswer{})
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, []CardAnswer{})
 
 	require.Error(t, err)
 	assert.Equal(t, assert.AnError, err)
@@ -211,14 +191,14 @@ func TestAnswerer_Answer_NilProgressState(t *testing.T) {
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, []CardAnswer{})
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, []CardAnswer{})
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "progress state not initialized")
 	mockRepo.AssertExpectations(t)
 }
 
-func TestAnswerer_Answer_ErrorGettingCards(t *testing.T) {
+func TestAnswerer_Answer_ErrorGradingAnswers(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
 	courseID := uuid.New()
@@ -252,12 +232,13 @@ func TestAnswerer_Answer_ErrorGettingCards(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
-	mockDecksClient.On("GetCards", ctx, mock.Anything).Return(&pbDeck.GetCardsResponse{}, assert.AnError)
+	mockDecksClient.On("GradeAnswers", ctx, mock.Anything).Return(&pbDeck.GradeAnswersResponse{}, assert.AnError)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 
 	require.Error(t, err)
 	mockRepo.AssertExpectations(t)
@@ -300,22 +281,17 @@ func TestAnswerer_Answer_ErrorAnsweringCard(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
-	mockDecksClient.On("GetCards", ctx, mock.Anything).Return(&pbDeck.GetCardsResponse{
-		Cards: map[string]*pbDeck.Card{
-			nonExistentCardID.String(): {
-				Id:    nonExistentCardID.String(),
-				Title: "Question",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: answerID.String(), IsCorrect: true},
-				},
-			},
+	mockDecksClient.On("GradeAnswers", ctx, mock.Anything).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: nonExistentCardID.String(), IsCorrect: true},
 		},
 	}, nil)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 
 	require.Error(t, err)
 	mockRepo.AssertExpectations(t)
@@ -356,24 +332,19 @@ func TestAnswerer_Answer_ErrorUpdatingUserProgress(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
 	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(assert.AnError)
 
-	mockDecksClient.On("GetCards", ctx, mock.Anything).Return(&pbDeck.GetCardsResponse{
-		Cards: map[string]*pbDeck.Card{
-			cardID.String(): {
-				Id:    cardID.String(),
-				Title: "Question",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: answerID.String(), IsCorrect: true},
-				},
-			},
+	mockDecksClient.On("GradeAnswers", ctx, mock.Anything).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: cardID.String(), IsCorrect: true},
 		},
 	}, nil)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), assert.AnError.Error())
@@ -407,17 +378,18 @@ func TestAnswerer_Answer_EmptyCardAnswers(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
 
-	mockDecksClient.On("GetCards", ctx, mock.MatchedBy(func(req *pbDeck.GetCardsRequest) bool {
-		return len(req.CardIds) == 0
-	})).Return(&pbDeck.GetCardsResponse{
-		Cards: map[string]*pbDeck.Card{},
+	mockDecksClient.On("GradeAnswers", ctx, mock.MatchedBy(func(req *pbDeck.GradeAnswersRequest) bool {
+		return len(req.Answers) == 0
+	})).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{},
 	}, nil)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, []CardAnswer{})
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, []CardAnswer{})
 	require.NoError(t, err)
 
 	mockRepo.AssertExpectations(t)
@@ -462,27 +434,22 @@ func TestAnswerer_Answer_MultipleAnswersForSameCard(t *testing.T) {
 	}
 
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
 
-	mockDecksClient.On("GetCards", ctx, mock.MatchedBy(func(req *pbDeck.GetCardsRequest) bool {
-		// Should have both card IDs (even though they're the same)
-		return len(req.CardIds) == 2
-	})).Return(&pbDeck.GetCardsResponse{
-		Cards: map[string]*pbDeck.Card{
-			cardID.String(): {
-				Id:    cardID.String(),
-				Title: "Question",
-				PossibleAnswers: []*pbDeck.Answer{
-					{Id: correctAnswerID.String(), IsCorrect: true},
-					{Id: incorrectAnswerID.String(), IsCorrect: false},
-				},
-			},
+	mockDecksClient.On("GradeAnswers", ctx, mock.MatchedBy(func(req *pbDeck.GradeAnswersRequest) bool {
+		// Should grade both answers (even though they're for the same card)
+		return len(req.Answers) == 2
+	})).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: cardID.String(), IsCorrect: false},
+			{CardId: cardID.String(), IsCorrect: true},
 		},
 	}, nil)
 
 	answerer := NewAnswerer(mockRepo, mockDecksClient)
 
-	err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 	require.NoError(t, err)
 
 	// Card should be completed after the correct answer
@@ -494,3 +461,161 @@ func TestAnswerer_Answer_MultipleAnswersForSameCard(t *testing.T) {
 	mockDecksClient.AssertExpectations(t)
 
 }
+
+func TestAnswerer_Answer_TypedBlanks(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	courseID := uuid.New()
+	lessonID := uuid.New()
+	deckID := uuid.New()
+	cardID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	state := NewProgressState()
+	state.Lessons[lessonID.String()] = &LessonProgress{
+		IsCompleted: false,
+		Decks: map[string]*DeckProgress{
+			deckID.String(): {
+				IsCompleted: false,
+				Cards: map[string]*CardProgress{
+					cardID.String(): {IsCompleted: false},
+				},
+			},
+		},
+	}
+
+	userProgress := UserCourseProgress{
+		State: state,
+	}
+
+	cardAnswers := []CardAnswer{
+		{CardID: cardID, TypedBlanks: []string{"Go", "Google"}},
+	}
+
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
+	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
+
+	mockDecksClient.On("GradeAnswers", ctx, mock.MatchedBy(func(req *pbDeck.GradeAnswersRequest) bool {
+		return len(req.Answers) == 1 &&
+			req.Answers[0].GetAnswerId() == "" &&
+			assert.ObjectsAreEqual([]string{"Go", "Google"}, req.Answers[0].GetTyped().GetBlanks())
+	})).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: cardID.String(), IsCorrect: true},
+		},
+	}, nil)
+
+	answerer := NewAnswerer(mockRepo, mockDecksClient)
+
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	require.NoError(t, err)
+
+	assert.True(t, userProgress.State.Lessons[lessonID.String()].Decks[deckID.String()].Cards[cardID.String()].IsCompleted)
+
+	mockRepo.AssertExpectations(t)
+	mockDecksClient.AssertExpectations(t)
+}
+
+func TestAnswerer_Answer_OrderedAnswersAndPairs(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	courseID := uuid.New()
+	lessonID := uuid.New()
+	deckID := uuid.New()
+	orderingCardID := uuid.New()
+	matchingCardID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	left, right := uuid.New(), uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	state := NewProgressState()
+	state.Lessons[lessonID.String()] = &LessonProgress{
+		Decks: map[string]*DeckProgress{
+			deckID.String(): {
+				Cards: map[string]*CardProgress{
+					orderingCardID.String(): {},
+					matchingCardID.String(): {},
+				},
+			},
+		},
+	}
+
+	userProgress := UserCourseProgress{
+		State: state,
+	}
+
+	cardAnswers := []CardAnswer{
+		{CardID: orderingCardID, AnswerIDs: []uuid.UUID{second, first}},
+		{CardID: matchingCardID, Pairs: []AnswerPair{{LeftID: left, RightID: right}}},
+	}
+
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
+	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
+
+	mockDecksClient.On("GradeAnswers", ctx, mock.MatchedBy(func(req *pbDeck.GradeAnswersRequest) bool {
+		return len(req.Answers) == 2 &&
+			assert.ObjectsAreEqual([]string{second.String(), first.String()}, req.Answers[0].GetAnswerIds().GetIds()) &&
+			len(req.Answers[1].GetPairs().GetPairs()) == 1 &&
+			req.Answers[1].GetPairs().GetPairs()[0].LeftId == left.String() &&
+			req.Answers[1].GetPairs().GetPairs()[0].RightId == right.String()
+	})).Return(&pbDeck.GradeAnswersResponse{
+		Grades: []*pbDeck.CardGrade{
+			{CardId: orderingCardID.String(), IsCorrect: false},
+			{CardId: matchingCardID.String(), IsCorrect: true},
+		},
+	}, nil)
+
+	answerer := NewAnswerer(mockRepo, mockDecksClient)
+
+	_, err := answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	require.NoError(t, err)
+
+	cards := userProgress.State.Lessons[lessonID.String()].Decks[deckID.String()].Cards
+	assert.False(t, cards[orderingCardID.String()].IsCompleted)
+	assert.True(t, cards[matchingCardID.String()].IsCompleted)
+
+	mockRepo.AssertExpectations(t)
+	mockDecksClient.AssertExpectations(t)
+}
+
+func TestAnswerer_Answer_LockedLesson(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	courseID := uuid.New()
+	lesson1 := Lesson{ID: uuid.New(), CourseID: courseID, Order: 1}
+	lesson2 := Lesson{ID: uuid.New(), CourseID: courseID, Order: 2}
+	deckID := uuid.New()
+	cardID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	state := NewProgressState()
+	for _, lesson := range []Lesson{lesson1, lesson2} {
+		state.Lessons[lesson.ID.String()] = &LessonProgress{
+			Decks: map[string]*DeckProgress{
+				deckID.String(): {Cards: map[string]*CardProgress{cardID.String(): {}}},
+			},
+		}
+	}
+
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(UserCourseProgress{State: state}, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingThreshold, UnlockThreshold: 50}, nil)
+	mockLessonsBefore(ctx, mockRepo, courseID, []Lesson{lesson1, lesson2})
+
+	answerer := NewAnswerer(mockRepo, mockDecksClient)
+
+	_, err := answerer.Answer(ctx, userID, courseID, lesson2.ID, deckID, []CardAnswer{{CardID: cardID, AnswerID: uuid.New()}})
+	assert.ErrorIs(t, err, ErrLessonLocked)
+
+	// Nothing was graded nor saved
+	mockRepo.AssertNotCalled(t, "UpdateUserProgress", mock.Anything, mock.Anything)
+	mockDecksClient.AssertNotCalled(t, "GradeAnswers", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
@@ -65,7 +65,23 @@ func (b *lessonsBrowser) Browse(ctx context.Context, courseID uuid.UUID, p pagin
 		return result, errors.Trace(err)
 	}
 
-	enrichedConn := b.enrichLessonsWithProgress(conn, progress)
+	c, err := b.repo.GetCourse(ctx, courseID)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	locked, err := lockedLessons(ctx, b.repo, c, progress.State)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	enrichedConn := b.enrichLessonsWithProgress(conn, progress, locked)
+
+	enrichedConn.Modules, err = b.modulesWithProgress(ctx, courseID, conn, progress)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
 	result.ProgressLessons = &enrichedConn
 
 	if opts.UserID != nil && *opts.UserID != uuid.Nil {
@@ -84,10 +100,12 @@ func (b *lessonsBrowser) Browse(ctx context.Context, courseID uuid.UUID, p pagin
 	return result, nil
 }
 
-// enrichLessonsWithProgress merges lessons with their completion status from progress state
+// enrichLessonsWithProgress merges lessons with their completion status from
+// progress state, and flags the locked ones
 func (b *lessonsBrowser) enrichLessonsWithProgress(
 	conn LessonsConnection,
 	progress UserCourseProgress,
+	locked map[uuid.UUID]bool,
 ) LessonsWithProgressConnection {
 	enriched := LessonsWithProgressConnection{
 		PageInfo: conn.PageInfo,
@@ -101,6 +119,7 @@ func (b *lessonsBrowser) enrichLessonsWithProgress(
 			Lesson:      edge.Lesson,
 			IsCompleted: isCompleted,
 			IsCurrent:   isCurrent,
+			IsLocked:    locked[edge.Lesson.ID],
 		}
 
 		enriched.Edges = append(enriched.Edges, LessonWithProgressEdge{
@@ -111,3 +130,47 @@ func (b *lessonsBrowser) enrichLessonsWithProgress(
 
 	return enriched
 }
+
+// modulesWithProgress returns the modules of the lessons in conn along with
+// their completion, as rolled up in the progress state
+func (b *lessonsBrowser) modulesWithProgress(
+	ctx context.Context,
+	courseID uuid.UUID,
+	conn LessonsConnection,
+	progress UserCourseProgress,
+) ([]ModuleWithProgress, error) {
+	inPage := make(map[uuid.UUID]bool)
+	for _, edge := range conn.Edges {
+		if edge.Lesson.ModuleID != uuid.Nil {
+			inPage[edge.Lesson.ModuleID] = true
+		}
+	}
+
+	if len(inPage) == 0 {
+		return nil, nil
+	}
+
+	modules, err := b.repo.GetModulesByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var out []ModuleWithProgress
+	for _, module := range modules {
+		if !inPage[module.ID] {
+			continue
+		}
+
+		moduleWithProgress := ModuleWithProgress{Module: module}
+		if progress.State != nil && progress.State.Modules[module.ID.String()] != nil {
+			moduleProgress := progress.State.Modules[module.ID.String()]
+			moduleWithProgress.LessonCount = moduleProgress.LessonCount
+			moduleWithProgress.CompletedLessons = moduleProgress.CompletedLessons
+			moduleWithProgress.IsCompleted = moduleProgress.IsCompleted
+		}
+
+		out = append(out, moduleWithProgress)
+	}
+
+	return out, nil
+}
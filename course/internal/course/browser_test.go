@@ -122,6 +122,7 @@ func TestBrowser_Browse_WithUserContext_Success(t *testing.T) {
 	p := pagination.Pagination{First: 10}
 	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, false).Return(lessonsConn, nil)
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
 	syncerCallDone := make(chan struct{})
 	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
@@ -267,6 +268,7 @@ func TestBrowser_Browse_WithUserContext_EmptyLessons(t *testing.T) {
 	p := pagination.Pagination{First: 10}
 	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, false).Return(lessonsConn, nil)
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
 	syncerCallDone := make(chan struct{})
 	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
@@ -336,6 +338,7 @@ func TestBrowser_Browse_WithUserContext_AllLessonsCompleted(t *testing.T) {
 	p := pagination.Pagination{First: 10}
 	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, false).Return(lessonsConn, nil)
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
 	syncerCallDone := make(chan struct{})
 	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
@@ -397,6 +400,7 @@ func TestBrowser_Browse_WithUserContext_PaginationPreserved(t *testing.T) {
 	p := pagination.Pagination{Kind: pagination.PaginationKindOldestFirst, First: 10}
 	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, false).Return(lessonsConn, nil)
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
 	syncerCallDone := make(chan struct{})
 	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
@@ -458,6 +462,7 @@ func TestBrowser_Browse_WithUserContext_CursorsPreserved(t *testing.T) {
 	p := pagination.Pagination{First: 10}
 	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, false).Return(lessonsConn, nil)
 	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
 
 	syncerCallDone := make(chan struct{})
 	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
@@ -493,3 +498,121 @@ func waitForGoroutine(t *testing.T, c <-chan struct{}) {
 		t.Fatal("timeout waiting for goroutine")
 	}
 }
+
+func TestBrowser_Browse_WithUserContext_RollsUpModules(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	userID := uuid.New()
+	lesson1ID := uuid.New()
+	lesson2ID := uuid.New()
+	lesson3ID := uuid.New()
+	module1 := Module{ID: uuid.New(), CourseID: courseID, Order: 1, Title: "Basics"}
+	module2 := Module{ID: uuid.New(), CourseID: courseID, Order: 2, Title: "Advanced"}
+	module3 := Module{ID: uuid.New(), CourseID: courseID, Order: 3, Title: "Not in page"}
+
+	mockRepo := new(RepositoryMock)
+	mockSyncer := new(StateSyncerMock)
+
+	lessonsConn := LessonsConnection{
+		Edges: []LessonEdge{
+			{Lesson: Lesson{ID: lesson1ID, ModuleID: module1.ID}, Cursor: "cursor1"},
+			{Lesson: Lesson{ID: lesson2ID, ModuleID: module2.ID}, Cursor: "cursor2"},
+			{Lesson: Lesson{ID: lesson3ID}, Cursor: "cursor3"},
+		},
+	}
+
+	state := NewProgressState()
+	state.Lessons[lesson1ID.String()] = &LessonProgress{ModuleID: module1.ID.String(), IsCompleted: true}
+	state.Lessons[lesson2ID.String()] = &LessonProgress{ModuleID: module2.ID.String()}
+	state.Lessons[lesson3ID.String()] = &LessonProgress{}
+	state.RollUpModules()
+
+	p := pagination.Pagination{First: 3}
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, true).Return(lessonsConn, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(UserCourseProgress{State: state}, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingFree}, nil)
+	mockRepo.On("GetModulesByCourseID", ctx, courseID).Return([]Module{module1, module2, module3}, nil)
+
+	syncerCallDone := make(chan struct{})
+	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
+		Run(func(args mock.Arguments) {
+			close(syncerCallDone)
+		}).
+		Return(nil)
+
+	browser := NewLessonsBrowser(mockRepo, mockSyncer)
+	result, err := browser.Browse(ctx, courseID, p, BrowseOptions{UserID: &userID, Bodyless: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ModuleWithProgress{
+		{Module: module1, LessonCount: 1, CompletedLessons: 1, IsCompleted: true},
+		{Module: module2, LessonCount: 1, CompletedLessons: 0, IsCompleted: false},
+	}, result.ProgressLessons.Modules)
+
+	mockRepo.AssertExpectations(t)
+
+	waitForGoroutine(t, syncerCallDone)
+	mockSyncer.AssertExpectations(t)
+}
+
+func TestBrowser_Browse_WithUserContext_FlagsLockedLessons(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	userID := uuid.New()
+	lesson1 := Lesson{ID: uuid.New(), CourseID: courseID, Order: 1}
+	lesson2 := Lesson{ID: uuid.New(), CourseID: courseID, Order: 2}
+	lesson3 := Lesson{ID: uuid.New(), CourseID: courseID, Order: 3}
+
+	mockRepo := new(RepositoryMock)
+	mockSyncer := new(StateSyncerMock)
+
+	// The page starts at the second lesson, whose lock depends on the first
+	p := pagination.Pagination{First: 2, After: "cursor1"}
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, p, true).Return(LessonsConnection{
+		Edges: []LessonEdge{
+			{Lesson: lesson2, Cursor: "cursor2"},
+			{Lesson: lesson3, Cursor: "cursor3"},
+		},
+	}, nil)
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.NewOldestFirstPagination(pagination.WithFirst(allLessonsPageSize)), true).
+		Return(LessonsConnection{
+			Edges: []LessonEdge{
+				{Lesson: lesson1, Cursor: "cursor1"},
+				{Lesson: lesson2, Cursor: "cursor2"},
+				{Lesson: lesson3, Cursor: "cursor3"},
+			},
+		}, nil)
+
+	state := NewProgressState()
+	state.Lessons[lesson1.ID.String()] = &LessonProgress{IsCompleted: true}
+	for _, lesson := range []Lesson{lesson2, lesson3} {
+		state.Lessons[lesson.ID.String()] = &LessonProgress{
+			Decks: map[string]*DeckProgress{
+				uuid.NewString(): {Cards: map[string]*CardProgress{uuid.NewString(): {}}},
+			},
+		}
+	}
+
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(UserCourseProgress{State: state, CurrentLessonID: lesson2.ID}, nil)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{ID: courseID, GatingMode: GatingSequential}, nil)
+
+	syncerCallDone := make(chan struct{})
+	mockSyncer.On("Sync", context.WithoutCancel(ctx), userID, courseID).
+		Run(func(args mock.Arguments) {
+			close(syncerCallDone)
+		}).
+		Return(nil)
+
+	browser := NewLessonsBrowser(mockRepo, mockSyncer)
+	result, err := browser.Browse(ctx, courseID, p, BrowseOptions{UserID: &userID, Bodyless: true})
+	require.NoError(t, err)
+
+	require.Len(t, result.ProgressLessons.Edges, 2)
+	assert.False(t, result.ProgressLessons.Edges[0].Lesson.IsLocked)
+	assert.True(t, result.ProgressLessons.Edges[1].Lesson.IsLocked)
+
+	mockRepo.AssertExpectations(t)
+
+	waitForGoroutine(t, syncerCallDone)
+	mockSyncer.AssertExpectations(t)
+}
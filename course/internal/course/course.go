@@ -11,6 +11,7 @@ import (
 var (
 	ErrCourseNotFound       = errors.New("courses: course not found")
 	ErrLessonNotFound       = errors.New("courses: lesson not found")
+	ErrModuleNotFound       = errors.New("courses: module not found")
 	ErrUserProgressNotFound = errors.New("courses: user progress not found")
 	ErrInvalidCourse        = errors.New("courses: invalid course")
 	ErrInvalidLesson        = errors.New("courses: invalid lesson")
@@ -19,6 +20,22 @@ var (
 	ErrNoDescription        = errors.New("courses: description is missing")
 	ErrNoBody               = errors.New("courses: body is required")
 	ErrNoDecksReferenced    = errors.New("courses: lesson must reference at least one deck")
+	ErrVersionConflict      = errors.New("courses: version conflict")
+	ErrInvalidCursor        = errors.New("courses: invalid cursor")
+	ErrLessonOrderTaken     = errors.New("courses: lesson order taken")
+	ErrInvalidLessonOrder   = errors.New("courses: lesson order must list every lesson of the course once")
+	ErrModuleOrderTaken     = errors.New("courses: module order taken")
+	ErrInvalidModuleOrder   = errors.New("courses: module order must list every module of the course once")
+	ErrModuleNotEmpty       = errors.New("courses: module still has lessons")
+	ErrInvalidModule        = errors.New("courses: module is not part of the lesson's course")
+	ErrInvalidGating        = errors.New("courses: invalid lesson gating")
+	ErrLessonLocked         = errors.New("courses: lesson is locked")
+	ErrPrerequisitesNotMet  = errors.New("courses: course prerequisites not met")
+	ErrPrerequisiteCycle    = errors.New("courses: prerequisites would form a cycle")
+	ErrNoUpdates            = errors.New("courses: no fields to update")
+	ErrDeckNotFound         = errors.New("courses: lesson references a deck that does not exist")
+	ErrDeckNotInLesson      = errors.New("courses: deck is not part of the lesson")
+	ErrCardNotInDeck        = errors.New("courses: card is not part of the deck")
 )
 
 var (
@@ -34,18 +51,45 @@ type Course struct {
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	// Version grows with every update of the course
+	Version int `json:"version"`
+	// GatingMode decides when the lessons of the course unlock, see
+	// LockedLessons
+	GatingMode GatingMode `json:"gating_mode"`
+	// UnlockThreshold is the percentage of the cards of a lesson to complete
+	// before the next one unlocks, with GatingThreshold
+	UnlockThreshold int `json:"unlock_threshold"`
 }
 
 type Lesson struct {
+	ID       uuid.UUID `json:"id"`
+	CourseID uuid.UUID `json:"course_id"`
+	// ModuleID is uuid.Nil for lessons that are not part of a module
+	ModuleID    uuid.UUID  `json:"module_id"`
+	Order       int        `json:"order"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Body        string     `json:"body"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	// Version grows with every update of the lesson
+	Version int `json:"version"`
+}
+
+// Module groups lessons of a course. Lessons keep their order across the
+// whole course, modules only give it sections.
+type Module struct {
 	ID          uuid.UUID  `json:"id"`
 	CourseID    uuid.UUID  `json:"course_id"`
 	Order       int        `json:"order"`
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
-	Body        string     `json:"body"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	// Version grows with every update of the module
+	Version int `json:"version"`
 }
 
 // UserCourseProgress represents a user's progress in a course
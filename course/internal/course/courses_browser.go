@@ -2,6 +2,7 @@ package course
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/juju/errors"
@@ -9,9 +10,30 @@ import (
 	"github.com/XaviFP/toshokan/common/pagination"
 )
 
-// CoursesBrowser handles fetching enrolled courses with user progress
+// CoursesBrowser handles fetching enrolled courses with user progress, and
+// the catalog of every course for those yet to enroll
 type CoursesBrowser interface {
 	BrowseEnrolled(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (CoursesWithProgressConnection, error)
+	BrowseCatalog(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error)
+	// Outline fetches the whole tree of a course, with bodyless lessons
+	Outline(ctx context.Context, courseID uuid.UUID) (CourseOutline, error)
+}
+
+// allLessonsPageSize is how many lessons are fetched at once when every
+// lesson of a course is needed
+const allLessonsPageSize = 100
+
+// CourseOutline is a course with its modules in order, each with its
+// lessons, and the lessons that are not part of any module
+type CourseOutline struct {
+	Course  Course
+	Modules []ModuleOutline
+	Lessons []Lesson
+}
+
+type ModuleOutline struct {
+	Module  Module
+	Lessons []Lesson
 }
 
 type coursesBrowser struct {
@@ -34,3 +56,84 @@ func (b *coursesBrowser) BrowseEnrolled(ctx context.Context, userID uuid.UUID, p
 
 	return conn, nil
 }
+
+// BrowseCatalog fetches the courses whose title or description contain
+// query, or every course when query is blank
+func (b *coursesBrowser) BrowseCatalog(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error) {
+	conn, err := b.repo.ListCourses(ctx, strings.TrimSpace(query), p)
+	if err != nil {
+		return CatalogConnection{}, errors.Trace(err)
+	}
+
+	return conn, nil
+}
+
+// Outline fetches a course, its modules and all of its lessons, and sorts
+// the lessons into their modules keeping their order
+func (b *coursesBrowser) Outline(ctx context.Context, courseID uuid.UUID) (CourseOutline, error) {
+	c, err := b.repo.GetCourse(ctx, courseID)
+	if err != nil {
+		return CourseOutline{}, errors.Trace(err)
+	}
+
+	modules, err := b.repo.GetModulesByCourseID(ctx, courseID)
+	if err != nil {
+		return CourseOutline{}, errors.Trace(err)
+	}
+
+	outline := CourseOutline{
+		Course:  c,
+		Modules: make([]ModuleOutline, 0, len(modules)),
+		Lessons: []Lesson{},
+	}
+
+	moduleIndex := make(map[uuid.UUID]int, len(modules))
+	for i, module := range modules {
+		moduleIndex[module.ID] = i
+		outline.Modules = append(outline.Modules, ModuleOutline{Module: module, Lessons: []Lesson{}})
+	}
+
+	lessons, err := allLessons(ctx, b.repo, courseID)
+	if err != nil {
+		return CourseOutline{}, errors.Trace(err)
+	}
+
+	for _, lesson := range lessons {
+		i, ok := moduleIndex[lesson.ModuleID]
+		if !ok {
+			outline.Lessons = append(outline.Lessons, lesson)
+			continue
+		}
+
+		outline.Modules[i].Lessons = append(outline.Modules[i].Lessons, lesson)
+	}
+
+	return outline, nil
+}
+
+// allLessons pages through every lesson of a course, in order and bodyless
+func allLessons(ctx context.Context, repo Repository, courseID uuid.UUID) ([]Lesson, error) {
+	var (
+		lessons []Lesson
+		after   pagination.Cursor
+	)
+	for {
+		conn, err := repo.GetLessonsByCourseID(ctx, courseID, pagination.NewOldestFirstPagination(
+			pagination.WithFirst(allLessonsPageSize),
+			pagination.WithAfter(after),
+		), true)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		for _, edge := range conn.Edges {
+			lessons = append(lessons, edge.Lesson)
+		}
+
+		if !conn.PageInfo.HasNextPage {
+			return lessons, nil
+		}
+
+		after = conn.PageInfo.EndCursor
+	}
+}
@@ -100,3 +100,93 @@ func TestCoursesBrowser_BrowseEnrolled_EmptyResult(t *testing.T) {
 	assert.Equal(t, 0, len(result.Edges))
 	mockRepo.AssertExpectations(t)
 }
+
+func TestCoursesBrowser_BrowseCatalog(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+
+	expectedConn := CatalogConnection{
+		Edges: []CatalogEdge{
+			{
+				Course: CatalogCourse{
+					Course: Course{
+						ID:          courseID,
+						Title:       "Go Fundamentals",
+						Description: "Learn Go basics",
+						CreatedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					LessonCount: 12,
+				},
+				Cursor: "cursor1",
+			},
+		},
+		PageInfo: pagination.PageInfo{
+			StartCursor: "cursor1",
+			EndCursor:   "cursor1",
+		},
+	}
+
+	p := pagination.Pagination{First: 10}
+	// Surrounding blanks don't count as part of the query
+	mockRepo.On("ListCourses", ctx, "go basics", p).Return(expectedConn, nil)
+
+	browser := NewCoursesBrowser(mockRepo)
+	result, err := browser.BrowseCatalog(ctx, "  go basics ", p)
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedConn, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCoursesBrowser_Outline(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	c := Course{ID: courseID, Title: "Go Fundamentals"}
+	module1 := Module{ID: uuid.New(), CourseID: courseID, Order: 1, Title: "Basics"}
+	module2 := Module{ID: uuid.New(), CourseID: courseID, Order: 2, Title: "Empty"}
+	lesson1 := Lesson{ID: uuid.New(), CourseID: courseID, ModuleID: module1.ID, Order: 1}
+	lesson2 := Lesson{ID: uuid.New(), CourseID: courseID, Order: 2}
+	lesson3 := Lesson{ID: uuid.New(), CourseID: courseID, ModuleID: module1.ID, Order: 3}
+
+	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCourse", ctx, courseID).Return(c, nil)
+	mockRepo.On("GetModulesByCourseID", ctx, courseID).Return([]Module{module1, module2}, nil)
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.NewOldestFirstPagination(pagination.WithFirst(allLessonsPageSize)), true).
+		Return(LessonsConnection{
+			Edges:    []LessonEdge{{Lesson: lesson1, Cursor: "cursor1"}, {Lesson: lesson2, Cursor: "cursor2"}},
+			PageInfo: pagination.PageInfo{HasNextPage: true, EndCursor: "cursor2"},
+		}, nil)
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.NewOldestFirstPagination(pagination.WithFirst(allLessonsPageSize), pagination.WithAfter("cursor2")), true).
+		Return(LessonsConnection{
+			Edges: []LessonEdge{{Lesson: lesson3, Cursor: "cursor3"}},
+		}, nil)
+
+	browser := NewCoursesBrowser(mockRepo)
+	outline, err := browser.Outline(ctx, courseID)
+	require.NoError(t, err)
+
+	assert.Equal(t, CourseOutline{
+		Course: c,
+		Modules: []ModuleOutline{
+			{Module: module1, Lessons: []Lesson{lesson1, lesson3}},
+			{Module: module2, Lessons: []Lesson{}},
+		},
+		Lessons: []Lesson{lesson2},
+	}, outline)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCoursesBrowser_Outline_CourseNotFound(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCourse", ctx, courseID).Return(Course{}, ErrCourseNotFound)
+
+	browser := NewCoursesBrowser(mockRepo)
+	_, err := browser.Outline(ctx, courseID)
+	assert.ErrorIs(t, err, ErrCourseNotFound)
+	mockRepo.AssertExpectations(t)
+}
@@ -2,6 +2,8 @@ package course
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/juju/errors"
@@ -30,6 +32,10 @@ func NewEnroller(clock clock.Clock, repo Repository, decksClient pbDeck.DecksAPI
 }
 
 func (e *enroller) Enroll(ctx context.Context, userID, courseID uuid.UUID) (UserCourseProgress, error) {
+	if err := e.checkPrerequisites(ctx, userID, courseID); err != nil {
+		return UserCourseProgress{}, errors.Trace(err)
+	}
+
 	// Initialize state with all lessons and decks for the course
 	state := NewProgressState()
 
@@ -52,6 +58,7 @@ func (e *enroller) Enroll(ctx context.Context, userID, courseID uuid.UUID) (User
 
 		lessonKey := lesson.ID.String()
 		state.Lessons[lessonKey] = &LessonProgress{
+			ModuleID:    stateModuleID(lesson.ModuleID),
 			Decks:       make(map[string]*DeckProgress),
 			IsCompleted: false,
 		}
@@ -86,6 +93,7 @@ func (e *enroller) Enroll(ctx context.Context, userID, courseID uuid.UUID) (User
 	}
 
 	state.CurrentLessonID = firstLessonID
+	state.RollUpModules()
 
 	err = e.repo.EnrollUserInCourse(ctx, userID, courseID, *state)
 	if err != nil {
@@ -101,3 +109,37 @@ func (e *enroller) Enroll(ctx context.Context, userID, courseID uuid.UUID) (User
 		UpdatedAt:       e.clock.Now().UTC(),
 	}, nil
 }
+
+// checkPrerequisites makes sure the user completed every prerequisite of a
+// course, naming the ones left in ErrPrerequisitesNotMet otherwise
+func (e *enroller) checkPrerequisites(ctx context.Context, userID, courseID uuid.UUID) error {
+	prerequisites, err := e.repo.GetCoursePrerequisites(ctx, courseID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var missing []string
+	for _, prerequisite := range prerequisites {
+		lessons, err := allLessons(ctx, e.repo, prerequisite.ID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		// Users who never enrolled have no progress, which only completes
+		// courses without lessons
+		progress, err := e.repo.GetUserCourseProgress(ctx, userID, prerequisite.ID)
+		if err != nil && !errors.Is(err, ErrUserProgressNotFound) {
+			return errors.Trace(err)
+		}
+
+		if !progress.State.IsCourseCompleted(lessons) {
+			missing = append(missing, fmt.Sprintf("%q", prerequisite.Title))
+		}
+	}
+
+	if len(missing) > 0 {
+		return errors.Annotatef(ErrPrerequisitesNotMet, "complete %s first", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
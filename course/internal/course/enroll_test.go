@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/juju/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/tilinna/clock"
@@ -25,6 +26,7 @@ func TestEnroller_Enroll_Success(t *testing.T) {
 	cardID := uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
 
 	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCoursePrerequisites", ctx, courseID).Return([]Course{}, nil)
 	mockDecksClient := new(MockDecksAPIClient)
 
 	lessonCursor, _ := pagination.ToCursor(LessonCursor{Order: 1})
@@ -106,6 +108,7 @@ func TestEnroller_Enroll_NoLessons(t *testing.T) {
 	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
 
 	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCoursePrerequisites", ctx, courseID).Return([]Course{}, nil)
 	mockDecksClient := new(MockDecksAPIClient)
 
 	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.Pagination{Kind: pagination.PaginationKindOldestFirst, First: 1000}, false).Return(LessonsConnection{Edges: []LessonEdge{}}, nil)
@@ -124,6 +127,7 @@ func TestEnroller_Enroll_NoDecks(t *testing.T) {
 	lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
 
 	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCoursePrerequisites", ctx, courseID).Return([]Course{}, nil)
 	mockDecksClient := new(MockDecksAPIClient)
 
 	lessonCursor, _ := pagination.ToCursor(LessonCursor{Order: 1})
@@ -159,6 +163,7 @@ func TestEnroller_Enroll_GetDecksError(t *testing.T) {
 	deckID := uuid.MustParse("60766223-ff9f-4871-a497-f765c05a0c5e")
 
 	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCoursePrerequisites", ctx, courseID).Return([]Course{}, nil)
 	mockDecksClient := new(MockDecksAPIClient)
 
 	lessonCursor, _ := pagination.ToCursor(LessonCursor{Order: 1})
@@ -199,6 +204,7 @@ func TestEnroller_Enroll_EnrollmentError(t *testing.T) {
 	cardID := uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
 
 	mockRepo := new(RepositoryMock)
+	mockRepo.On("GetCoursePrerequisites", ctx, courseID).Return([]Course{}, nil)
 	mockDecksClient := new(MockDecksAPIClient)
 
 	lessonCursor, _ := pagination.ToCursor(LessonCursor{Order: 1})
@@ -292,3 +298,225 @@ func (m *MockDecksAPIClient) UpdateAnswer(ctx context.Context, in *pbDeck.Update
 	args := m.Called(ctx, in)
 	return args.Get(0).(*pbDeck.UpdateAnswerResponse), args.Error(1)
 }
+
+func (m *MockDecksAPIClient) GradeAnswers(ctx context.Context, in *pbDeck.GradeAnswersRequest, opts ...grpc.CallOption) (*pbDeck.GradeAnswersResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GradeAnswersResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetDeckAnalytics(ctx context.Context, in *pbDeck.GetDeckAnalyticsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckAnalyticsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetDeckAnalyticsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetDeckCards(ctx context.Context, in *pbDeck.GetDeckCardsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckCardsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetDeckCardsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetDecksCards(ctx context.Context, in *pbDeck.GetDecksCardsRequest, opts ...grpc.CallOption) (*pbDeck.GetDecksCardsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetDecksCardsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) StreamDeckCards(ctx context.Context, in *pbDeck.StreamDeckCardsRequest, opts ...grpc.CallOption) (pbDeck.DecksAPI_StreamDeckCardsClient, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(pbDeck.DecksAPI_StreamDeckCardsClient), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) AddToLibrary(ctx context.Context, in *pbDeck.AddToLibraryRequest, opts ...grpc.CallOption) (*pbDeck.AddToLibraryResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.AddToLibraryResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) RemoveFromLibrary(ctx context.Context, in *pbDeck.RemoveFromLibraryRequest, opts ...grpc.CallOption) (*pbDeck.RemoveFromLibraryResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.RemoveFromLibraryResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetLibrary(ctx context.Context, in *pbDeck.GetLibraryRequest, opts ...grpc.CallOption) (*pbDeck.GetLibraryResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetLibraryResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetAuthoredDecks(ctx context.Context, in *pbDeck.GetAuthoredDecksRequest, opts ...grpc.CallOption) (*pbDeck.GetAuthoredDecksResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetAuthoredDecksResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) RateDeck(ctx context.Context, in *pbDeck.RateDeckRequest, opts ...grpc.CallOption) (*pbDeck.RateDeckResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.RateDeckResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetDeckReviews(ctx context.Context, in *pbDeck.GetDeckReviewsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckReviewsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetDeckReviewsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) ListDeckMembers(ctx context.Context, in *pbDeck.ListDeckMembersRequest, opts ...grpc.CallOption) (*pbDeck.ListDeckMembersResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.ListDeckMembersResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) InviteDeckMember(ctx context.Context, in *pbDeck.InviteDeckMemberRequest, opts ...grpc.CallOption) (*pbDeck.InviteDeckMemberResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.InviteDeckMemberResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) ChangeDeckMemberRole(ctx context.Context, in *pbDeck.ChangeDeckMemberRoleRequest, opts ...grpc.CallOption) (*pbDeck.ChangeDeckMemberRoleResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.ChangeDeckMemberRoleResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) RemoveDeckMember(ctx context.Context, in *pbDeck.RemoveDeckMemberRequest, opts ...grpc.CallOption) (*pbDeck.RemoveDeckMemberResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.RemoveDeckMemberResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetSharedDecks(ctx context.Context, in *pbDeck.GetSharedDecksRequest, opts ...grpc.CallOption) (*pbDeck.GetSharedDecksResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetSharedDecksResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) DeleteCard(ctx context.Context, in *pbDeck.DeleteCardRequest, opts ...grpc.CallOption) (*pbDeck.DeleteCardResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.DeleteCardResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) CreateAnswer(ctx context.Context, in *pbDeck.CreateAnswerRequest, opts ...grpc.CallOption) (*pbDeck.CreateAnswerResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.CreateAnswerResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) DeleteAnswer(ctx context.Context, in *pbDeck.DeleteAnswerRequest, opts ...grpc.CallOption) (*pbDeck.DeleteAnswerResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.DeleteAnswerResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) ForkDeck(ctx context.Context, in *pbDeck.ForkDeckRequest, opts ...grpc.CallOption) (*pbDeck.ForkDeckResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.ForkDeckResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetUpstreamChanges(ctx context.Context, in *pbDeck.GetUpstreamChangesRequest, opts ...grpc.CallOption) (*pbDeck.GetUpstreamChangesResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetUpstreamChangesResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) PullUpstreamChanges(ctx context.Context, in *pbDeck.PullUpstreamChangesRequest, opts ...grpc.CallOption) (*pbDeck.PullUpstreamChangesResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.PullUpstreamChangesResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) ListCardRevisions(ctx context.Context, in *pbDeck.ListCardRevisionsRequest, opts ...grpc.CallOption) (*pbDeck.ListCardRevisionsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.ListCardRevisionsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) DiffCardRevisions(ctx context.Context, in *pbDeck.DiffCardRevisionsRequest, opts ...grpc.CallOption) (*pbDeck.DiffCardRevisionsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.DiffCardRevisionsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) RestoreCardRevision(ctx context.Context, in *pbDeck.RestoreCardRevisionRequest, opts ...grpc.CallOption) (*pbDeck.RestoreCardRevisionResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.RestoreCardRevisionResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) AutocompleteTags(ctx context.Context, in *pbDeck.AutocompleteTagsRequest, opts ...grpc.CallOption) (*pbDeck.AutocompleteTagsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.AutocompleteTagsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) GetTopics(ctx context.Context, in *pbDeck.GetTopicsRequest, opts ...grpc.CallOption) (*pbDeck.GetTopicsResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.GetTopicsResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) CreateTopic(ctx context.Context, in *pbDeck.CreateTopicRequest, opts ...grpc.CallOption) (*pbDeck.CreateTopicResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.CreateTopicResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) DeleteTopic(ctx context.Context, in *pbDeck.DeleteTopicRequest, opts ...grpc.CallOption) (*pbDeck.DeleteTopicResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.DeleteTopicResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) SetTagTopic(ctx context.Context, in *pbDeck.SetTagTopicRequest, opts ...grpc.CallOption) (*pbDeck.SetTagTopicResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.SetTagTopicResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) SearchDecks(ctx context.Context, in *pbDeck.SearchDecksRequest, opts ...grpc.CallOption) (*pbDeck.SearchDecksResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.SearchDecksResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) ImportDeck(ctx context.Context, in *pbDeck.ImportDeckRequest, opts ...grpc.CallOption) (*pbDeck.ImportDeckResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.ImportDeckResponse), args.Error(1)
+}
+
+func (m *MockDecksAPIClient) ExportDeck(ctx context.Context, in *pbDeck.ExportDeckRequest, opts ...grpc.CallOption) (*pbDeck.ExportDeckResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*pbDeck.ExportDeckResponse), args.Error(1)
+}
+
+func TestEnroller_Enroll_PrerequisitesNotMet(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	courseID := uuid.New()
+	completed := Course{ID: uuid.New(), Title: "Basics"}
+	started := Course{ID: uuid.New(), Title: "Intermediate"}
+	notEnrolled := Course{ID: uuid.New(), Title: "Tooling"}
+	empty := Course{ID: uuid.New(), Title: "Coming soon"}
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	done, doneToo, pending := Lesson{ID: uuid.New()}, Lesson{ID: uuid.New()}, Lesson{ID: uuid.New()}
+
+	completedState := NewProgressState()
+	completedState.Lessons[done.ID.String()] = &LessonProgress{IsCompleted: true}
+	// Lessons deleted from the course since don't count
+	completedState.Lessons[uuid.NewString()] = &LessonProgress{}
+	startedState := NewProgressState()
+	startedState.Lessons[doneToo.ID.String()] = &LessonProgress{IsCompleted: true}
+	startedState.Lessons[pending.ID.String()] = &LessonProgress{
+		Decks: map[string]*DeckProgress{
+			uuid.NewString(): {Cards: map[string]*CardProgress{uuid.NewString(): {}}},
+		},
+	}
+
+	allPages := pagination.NewOldestFirstPagination(pagination.WithFirst(allLessonsPageSize))
+	for c, lessons := range map[uuid.UUID][]Lesson{
+		completed.ID:   {done},
+		started.ID:     {doneToo, pending},
+		notEnrolled.ID: {{ID: uuid.New()}},
+		empty.ID:       nil,
+	} {
+		var edges []LessonEdge
+		for _, lesson := range lessons {
+			edges = append(edges, LessonEdge{Lesson: lesson})
+		}
+		mockRepo.On("GetLessonsByCourseID", ctx, c, allPages, true).Return(LessonsConnection{Edges: edges}, nil)
+	}
+
+	mockRepo.On("GetCoursePrerequisites", ctx, courseID).Return([]Course{completed, started, notEnrolled, empty}, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, userID, completed.ID).Return(UserCourseProgress{State: completedState}, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, userID, started.ID).Return(UserCourseProgress{State: startedState}, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, userID, notEnrolled.ID).Return(UserCourseProgress{}, errors.Trace(ErrUserProgressNotFound))
+	mockRepo.On("GetUserCourseProgress", ctx, userID, empty.ID).Return(UserCourseProgress{}, errors.Trace(ErrUserProgressNotFound))
+
+	enroller := NewEnroller(clock.Realtime(), mockRepo, mockDecksClient)
+	_, err := enroller.Enroll(ctx, userID, courseID)
+
+	assert.ErrorIs(t, err, ErrPrerequisitesNotMet)
+	assert.Contains(t, err.Error(), `"Intermediate", "Tooling"`)
+	assert.NotContains(t, err.Error(), "Basics")
+	assert.NotContains(t, err.Error(), "Coming soon", "courses without lessons are completed")
+	mockRepo.AssertNotCalled(t, "EnrollUserInCourse", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
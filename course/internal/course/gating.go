@@ -0,0 +1,152 @@
+package course
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	"github.com/XaviFP/toshokan/common/pagination"
+)
+
+// GatingMode decides when the lessons of a course unlock for a learner.
+type GatingMode string
+
+const (
+	// GatingFree leaves every lesson unlocked.
+	GatingFree GatingMode = "free"
+	// GatingSequential unlocks a lesson once the previous one is completed.
+	GatingSequential GatingMode = "sequential"
+	// GatingThreshold unlocks a lesson once the course's UnlockThreshold
+	// percent of the cards of the previous one are completed.
+	GatingThreshold GatingMode = "threshold"
+)
+
+func (m GatingMode) IsValid() bool {
+	switch m {
+	case GatingFree, GatingSequential, GatingThreshold:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateGating checks a gating mode along with its unlock threshold, a
+// percentage only GatingThreshold makes use of.
+func ValidateGating(mode GatingMode, threshold int) error {
+	if !mode.IsValid() || threshold < 0 || threshold > 100 {
+		return ErrInvalidGating
+	}
+
+	if mode == GatingThreshold && threshold == 0 {
+		return ErrInvalidGating
+	}
+
+	return nil
+}
+
+// unlockPercent is the percentage of the cards of a lesson to complete
+// before the next one unlocks, 0 when lessons are not gated. Courses cached
+// before gating existed have no mode, and are free.
+func (c Course) unlockPercent() int {
+	switch c.GatingMode {
+	case GatingSequential:
+		return 100
+	case GatingThreshold:
+		return c.UnlockThreshold
+	default:
+		return 0
+	}
+}
+
+// LockedLessons tells which of lessons, every lesson of the course in
+// order, are locked for a learner with state. The first lesson is always
+// unlocked, and so are the lessons the learner completed already. Any other
+// lesson unlocks once the previous one is unlocked and completed as far as
+// the gating mode asks; lessons the state doesn't track yet hold nobody
+// back.
+func (c Course) LockedLessons(lessons []Lesson, state *ProgressState) map[uuid.UUID]bool {
+	locked := make(map[uuid.UUID]bool)
+
+	percent := c.unlockPercent()
+	if percent == 0 {
+		return locked
+	}
+
+	for i := 1; i < len(lessons); i++ {
+		lessonID := lessons[i].ID
+		if state.IsLessonCompleted(lessonID.String()) {
+			continue
+		}
+
+		previousID := lessons[i-1].ID
+		completed, total := state.CompletedCards(previousID.String())
+		if locked[previousID] || completed*100 < percent*total {
+			locked[lessonID] = true
+		}
+	}
+
+	return locked
+}
+
+// lockedLessons fetches the lessons of a course to tell which are locked for
+// a learner with state. Courses without gating skip the fetch.
+func lockedLessons(ctx context.Context, repo Repository, c Course, state *ProgressState) (map[uuid.UUID]bool, error) {
+	if c.unlockPercent() == 0 {
+		return map[uuid.UUID]bool{}, nil
+	}
+
+	lessons, err := allLessons(ctx, repo, c.ID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return c.LockedLessons(lessons, state), nil
+}
+
+// isLessonLocked tells whether a lesson of a course is locked for a learner
+// with state, as LockedLessons would, fetching only the lessons before it
+// that matter. Locked lessons can't be answered, so a lesson completed as
+// far as the gating mode asks was unlocked and nothing before it needs to
+// be looked at. Only lessons without cards send the lookup further back.
+func isLessonLocked(ctx context.Context, repo Repository, c Course, lessonID uuid.UUID, state *ProgressState) (bool, error) {
+	percent := c.unlockPercent()
+	if percent == 0 || state.IsLessonCompleted(lessonID.String()) {
+		return false, nil
+	}
+
+	lesson, err := repo.GetLesson(ctx, lessonID)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	if lesson.CourseID != c.ID {
+		return false, errors.Trace(ErrLessonNotFound)
+	}
+
+	for {
+		before, err := pagination.ToCursor(LessonCursor{Order: lesson.Order})
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+
+		conn, err := repo.GetLessonsByCourseID(ctx, c.ID, pagination.NewOldestFirstPagination(
+			pagination.WithLast(1),
+			pagination.WithBefore(before),
+		), true)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+
+		// The first lesson is always unlocked
+		if len(conn.Edges) == 0 {
+			return false, nil
+		}
+
+		lesson = conn.Edges[0].Lesson
+		completed, total := state.CompletedCards(lesson.ID.String())
+		if total > 0 || state.IsLessonCompleted(lesson.ID.String()) {
+			return completed*100 < percent*total, nil
+		}
+	}
+}
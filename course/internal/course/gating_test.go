@@ -0,0 +1,191 @@
+package course
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/XaviFP/toshokan/common/pagination"
+)
+
+func TestValidateGating(t *testing.T) {
+	assert.NoError(t, ValidateGating(GatingFree, 0))
+	assert.NoError(t, ValidateGating(GatingSequential, 0))
+	assert.NoError(t, ValidateGating(GatingThreshold, 50))
+	assert.NoError(t, ValidateGating(GatingThreshold, 100))
+
+	assert.ErrorIs(t, ValidateGating(GatingThreshold, 0), ErrInvalidGating)
+	assert.ErrorIs(t, ValidateGating(GatingThreshold, 101), ErrInvalidGating)
+	assert.ErrorIs(t, ValidateGating(GatingFree, -1), ErrInvalidGating)
+	assert.ErrorIs(t, ValidateGating("whenever", 0), ErrInvalidGating)
+	assert.ErrorIs(t, ValidateGating("", 0), ErrInvalidGating)
+}
+
+// gatingState returns lessons in order and a state where each of them has
+// one deck with four cards, of which completed[i] are completed
+func gatingState(completed ...int) ([]Lesson, *ProgressState) {
+	state := NewProgressState()
+	lessons := make([]Lesson, len(completed))
+	for i, n := range completed {
+		lessons[i] = Lesson{ID: uuid.New(), Order: i + 1}
+
+		cards := make(map[string]*CardProgress)
+		for j := 0; j < 4; j++ {
+			cards[uuid.NewString()] = &CardProgress{IsCompleted: j < n}
+		}
+
+		state.Lessons[lessons[i].ID.String()] = &LessonProgress{
+			Decks: map[string]*DeckProgress{
+				uuid.NewString(): {Cards: cards, IsCompleted: n == 4},
+			},
+			IsCompleted: n == 4,
+		}
+	}
+
+	return lessons, state
+}
+
+func TestCourse_LockedLessons(t *testing.T) {
+	t.Run("free", func(t *testing.T) {
+		lessons, state := gatingState(0, 0, 0)
+
+		locked := Course{GatingMode: GatingFree}.LockedLessons(lessons, state)
+		assert.Empty(t, locked)
+	})
+
+	t.Run("no_mode_is_free", func(t *testing.T) {
+		lessons, state := gatingState(0, 0, 0)
+
+		locked := Course{}.LockedLessons(lessons, state)
+		assert.Empty(t, locked)
+	})
+
+	t.Run("sequential", func(t *testing.T) {
+		lessons, state := gatingState(4, 3, 0)
+
+		locked := Course{GatingMode: GatingSequential}.LockedLessons(lessons, state)
+		assert.False(t, locked[lessons[0].ID])
+		assert.False(t, locked[lessons[1].ID])
+		assert.True(t, locked[lessons[2].ID])
+	})
+
+	t.Run("threshold", func(t *testing.T) {
+		lessons, state := gatingState(3, 2, 1, 0)
+
+		locked := Course{GatingMode: GatingThreshold, UnlockThreshold: 50}.LockedLessons(lessons, state)
+		assert.False(t, locked[lessons[1].ID], "75% of the first lesson is enough")
+		assert.False(t, locked[lessons[2].ID], "50% of the second lesson is enough")
+		assert.True(t, locked[lessons[3].ID], "25% of the third lesson is not")
+	})
+
+	t.Run("locks_cascade", func(t *testing.T) {
+		// The second lesson is well past the threshold, but it is locked
+		// itself, so the third one stays locked too
+		lessons, state := gatingState(0, 3, 0)
+
+		locked := Course{GatingMode: GatingThreshold, UnlockThreshold: 50}.LockedLessons(lessons, state)
+		assert.True(t, locked[lessons[1].ID])
+		assert.True(t, locked[lessons[2].ID])
+	})
+
+	t.Run("completed_lessons_stay_unlocked", func(t *testing.T) {
+		// Lessons may be completed out of order before the course was gated,
+		// or before they were reordered
+		lessons, state := gatingState(0, 4, 0)
+
+		locked := Course{GatingMode: GatingSequential}.LockedLessons(lessons, state)
+		assert.False(t, locked[lessons[1].ID])
+		assert.False(t, locked[lessons[2].ID])
+	})
+
+	t.Run("untracked_lessons_hold_nobody_back", func(t *testing.T) {
+		lessons, state := gatingState(4, 0)
+		added := Lesson{ID: uuid.New(), Order: 2}
+		lessons = []Lesson{lessons[0], added, lessons[1]}
+
+		locked := Course{GatingMode: GatingSequential}.LockedLessons(lessons, state)
+		assert.False(t, locked[added.ID])
+		assert.False(t, locked[lessons[2].ID])
+	})
+}
+
+func TestIsLessonLocked(t *testing.T) {
+	ctx := context.Background()
+
+	// The third lesson has no cards, so the fourth one looks past it
+	lessons, state := gatingState(4, 2, 0, 0)
+	delete(state.Lessons, lessons[2].ID.String())
+
+	for name, c := range map[string]Course{
+		"free":       {ID: uuid.New(), GatingMode: GatingFree},
+		"sequential": {ID: uuid.New(), GatingMode: GatingSequential},
+		"threshold":  {ID: uuid.New(), GatingMode: GatingThreshold, UnlockThreshold: 50},
+	} {
+		t.Run(name, func(t *testing.T) {
+			courseLessons := make([]Lesson, len(lessons))
+			for i, lesson := range lessons {
+				lesson.CourseID = c.ID
+				courseLessons[i] = lesson
+			}
+
+			mockRepo := new(RepositoryMock)
+			mockLessonsBefore(ctx, mockRepo, c.ID, courseLessons)
+
+			want := c.LockedLessons(courseLessons, state)
+			for i, lesson := range courseLessons {
+				locked, err := isLessonLocked(ctx, mockRepo, c, lesson.ID, state)
+				require.NoError(t, err)
+				assert.Equal(t, want[lesson.ID], locked, "lesson %d", i+1)
+			}
+		})
+	}
+
+	t.Run("lesson_of_another_course", func(t *testing.T) {
+		c := Course{ID: uuid.New(), GatingMode: GatingSequential}
+		mockRepo := new(RepositoryMock)
+		mockRepo.On("GetLesson", ctx, lessons[1].ID).Return(lessons[1], nil)
+
+		_, err := isLessonLocked(ctx, mockRepo, c, lessons[1].ID, state)
+		assert.ErrorIs(t, err, ErrLessonNotFound)
+	})
+}
+
+// mockLessonsBefore serves every lesson of lessons, in order, and the page
+// with the lesson before each of them
+func mockLessonsBefore(ctx context.Context, mockRepo *RepositoryMock, courseID uuid.UUID, lessons []Lesson) {
+	for i, lesson := range lessons {
+		mockRepo.On("GetLesson", ctx, lesson.ID).Return(lesson, nil).Maybe()
+
+		before, _ := pagination.ToCursor(LessonCursor{Order: lesson.Order})
+		var conn LessonsConnection
+		if i > 0 {
+			conn.Edges = []LessonEdge{{Lesson: lessons[i-1]}}
+		}
+
+		mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.NewOldestFirstPagination(
+			pagination.WithLast(1),
+			pagination.WithBefore(before),
+		), true).Return(conn, nil).Maybe()
+	}
+}
+
+func TestProgressState_IsCourseCompleted(t *testing.T) {
+	lessons, state := gatingState(4, 4)
+	assert.True(t, state.IsCourseCompleted(lessons))
+
+	lessons, state = gatingState(4, 3)
+	assert.False(t, state.IsCourseCompleted(lessons))
+
+	// Lessons deleted from the course don't count, ones added to it do
+	assert.True(t, state.IsCourseCompleted(lessons[:1]))
+	assert.False(t, state.IsCourseCompleted([]Lesson{lessons[0], {ID: uuid.New()}}))
+
+	assert.False(t, NewProgressState().IsCourseCompleted(lessons))
+
+	// Courses without lessons are completed, whatever the progress
+	assert.True(t, NewProgressState().IsCourseCompleted(nil))
+	assert.True(t, (*ProgressState)(nil).IsCourseCompleted(nil))
+}
@@ -27,11 +27,36 @@ func (m *RepositoryMock) UpdateCourse(ctx context.Context, id uuid.UUID, updates
 	return args.Get(0).(Course), args.Error(1)
 }
 
+func (m *RepositoryMock) DeleteCourse(ctx context.Context, id uuid.UUID) (Course, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(Course), args.Error(1)
+}
+
+func (m *RepositoryMock) RestoreCourse(ctx context.Context, id uuid.UUID) (Course, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(Course), args.Error(1)
+}
+
 func (m *RepositoryMock) GetEnrolledCourses(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (CoursesWithProgressConnection, error) {
 	args := m.Called(ctx, userID, p)
 	return args.Get(0).(CoursesWithProgressConnection), args.Error(1)
 }
 
+func (m *RepositoryMock) ListCourses(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error) {
+	args := m.Called(ctx, query, p)
+	return args.Get(0).(CatalogConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) GetCoursePrerequisites(ctx context.Context, courseID uuid.UUID) ([]Course, error) {
+	args := m.Called(ctx, courseID)
+	return args.Get(0).([]Course), args.Error(1)
+}
+
+func (m *RepositoryMock) SetCoursePrerequisites(ctx context.Context, courseID uuid.UUID, prerequisiteIDs []uuid.UUID) ([]Course, error) {
+	args := m.Called(ctx, courseID, prerequisiteIDs)
+	return args.Get(0).([]Course), args.Error(1)
+}
+
 func (m *RepositoryMock) GetLesson(ctx context.Context, id uuid.UUID) (Lesson, error) {
 	args := m.Called(ctx, id)
 	return args[0].(Lesson), args.Error(1)
@@ -56,6 +81,60 @@ func (m *RepositoryMock) UpdateLesson(ctx context.Context, id uuid.UUID, updates
 	return args.Get(0).(Lesson), args.Error(1)
 }
 
+func (m *RepositoryMock) DeleteLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error) {
+	args := m.Called(ctx, courseID, id)
+	return args.Get(0).(Lesson), args.Error(1)
+}
+
+func (m *RepositoryMock) RestoreLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error) {
+	args := m.Called(ctx, courseID, id)
+	return args.Get(0).(Lesson), args.Error(1)
+}
+
+func (m *RepositoryMock) ReorderLessons(ctx context.Context, courseID uuid.UUID, lessonIDs []uuid.UUID) ([]Lesson, error) {
+	args := m.Called(ctx, courseID, lessonIDs)
+	return args.Get(0).([]Lesson), args.Error(1)
+}
+
+func (m *RepositoryMock) GetModule(ctx context.Context, id uuid.UUID) (Module, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(Module), args.Error(1)
+}
+
+func (m *RepositoryMock) GetModulesByCourseID(ctx context.Context, courseID uuid.UUID) ([]Module, error) {
+	args := m.Called(ctx, courseID)
+	return args.Get(0).([]Module), args.Error(1)
+}
+
+func (m *RepositoryMock) StoreModule(ctx context.Context, module Module) error {
+	return m.Called(ctx, module).Error(0)
+}
+
+func (m *RepositoryMock) UpdateModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates ModuleUpdates) (Module, error) {
+	args := m.Called(ctx, courseID, id, updates)
+	return args.Get(0).(Module), args.Error(1)
+}
+
+func (m *RepositoryMock) DeleteModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error) {
+	args := m.Called(ctx, courseID, id)
+	return args.Get(0).(Module), args.Error(1)
+}
+
+func (m *RepositoryMock) RestoreModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error) {
+	args := m.Called(ctx, courseID, id)
+	return args.Get(0).(Module), args.Error(1)
+}
+
+func (m *RepositoryMock) ReorderModules(ctx context.Context, courseID uuid.UUID, moduleIDs []uuid.UUID) ([]Module, error) {
+	args := m.Called(ctx, courseID, moduleIDs)
+	return args.Get(0).([]Module), args.Error(1)
+}
+
+func (m *RepositoryMock) GetEnrolledUserIDs(ctx context.Context, courseID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, courseID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
 func (m *RepositoryMock) GetUserCourseProgress(ctx context.Context, userID uuid.UUID, courseID uuid.UUID) (UserCourseProgress, error) {
 	args := m.Called(ctx, userID, courseID)
 	return args[0].(UserCourseProgress), args.Error(1)
@@ -96,6 +175,16 @@ func (m *CoursesBrowserMock) BrowseEnrolled(ctx context.Context, userID uuid.UUI
 	return args.Get(0).(CoursesWithProgressConnection), args.Error(1)
 }
 
+func (m *CoursesBrowserMock) BrowseCatalog(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error) {
+	args := m.Called(ctx, query, p)
+	return args.Get(0).(CatalogConnection), args.Error(1)
+}
+
+func (m *CoursesBrowserMock) Outline(ctx context.Context, courseID uuid.UUID) (CourseOutline, error) {
+	args := m.Called(ctx, courseID)
+	return args.Get(0).(CourseOutline), args.Error(1)
+}
+
 type StateSyncerMock struct {
 	mock.Mock
 }
@@ -104,3 +193,8 @@ func (m *StateSyncerMock) Sync(ctx context.Context, userID uuid.UUID, courseID u
 	args := m.Called(ctx, userID, courseID)
 	return args.Error(0)
 }
+
+func (m *StateSyncerMock) SyncCourse(ctx context.Context, courseID uuid.UUID) error {
+	args := m.Called(ctx, courseID)
+	return args.Error(0)
+}
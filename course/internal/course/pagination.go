@@ -1,6 +1,10 @@
 package course
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
 	"github.com/XaviFP/toshokan/common/pagination"
 )
 
@@ -22,6 +26,9 @@ type LessonWithProgress struct {
 	Lesson      Lesson
 	IsCompleted bool
 	IsCurrent   bool
+	// IsLocked is set on lessons the gating mode of the course keeps the
+	// user from answering yet
+	IsLocked bool
 }
 
 type LessonWithProgressEdge struct {
@@ -32,6 +39,17 @@ type LessonWithProgressEdge struct {
 type LessonsWithProgressConnection struct {
 	Edges    []LessonWithProgressEdge
 	PageInfo pagination.PageInfo
+	// Modules are the modules of the lessons in the page, in order
+	Modules []ModuleWithProgress
+}
+
+// ModuleWithProgress is a module along with how many of its lessons the
+// user completed
+type ModuleWithProgress struct {
+	Module           Module
+	LessonCount      int
+	CompletedLessons int
+	IsCompleted      bool
 }
 
 // Course pagination types
@@ -63,3 +81,29 @@ type CoursesWithProgressConnection struct {
 	Edges    []CourseWithProgressEdge
 	PageInfo pagination.PageInfo
 }
+
+// CatalogCursor points at a course of the catalog. Courses are sorted by
+// their order, then by when they were created, and by ID among those
+// created at the same time, as orders may repeat.
+type CatalogCursor struct {
+	Order     int64     `json:"order"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// CatalogCourse is a course of the catalog along with how many lessons it
+// has.
+type CatalogCourse struct {
+	Course      Course
+	LessonCount int
+}
+
+type CatalogEdge struct {
+	Course CatalogCourse
+	Cursor pagination.Cursor
+}
+
+type CatalogConnection struct {
+	Edges    []CatalogEdge
+	PageInfo pagination.PageInfo
+}
@@ -12,16 +12,28 @@ var (
 )
 
 type ProgressState struct {
-	Lessons         map[string]*LessonProgress `json:"lessons"`
+	Lessons map[string]*LessonProgress `json:"lessons"`
+	// Modules rolls the completion of the lessons up to their modules, see
+	// RollUpModules
+	Modules         map[string]*ModuleProgress `json:"modules,omitempty"`
 	CurrentLessonID uuid.UUID                  `json:"current_lesson_id,omitempty"`
 }
 
 type LessonProgress struct {
+	// ModuleID is empty for lessons that are not part of a module
+	ModuleID    string                   `json:"module_id,omitempty"`
 	Decks       map[string]*DeckProgress `json:"decks"`
 	IsCompleted bool                     `json:"is_completed"`
 	CompletedAt *time.Time               `json:"completed_at,omitempty"`
 }
 
+type ModuleProgress struct {
+	LessonCount      int        `json:"lesson_count"`
+	CompletedLessons int        `json:"completed_lessons"`
+	IsCompleted      bool       `json:"is_completed"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+}
+
 type DeckProgress struct {
 	Cards       map[string]*CardProgress `json:"cards"`
 	IsCompleted bool                     `json:"is_completed"`
@@ -35,6 +47,15 @@ type CardProgress struct {
 	CompletedAt      *time.Time `json:"completed_at,omitempty"`
 }
 
+// stateModuleID is how a lesson's module is kept in the progress state
+func stateModuleID(moduleID uuid.UUID) string {
+	if moduleID == uuid.Nil {
+		return ""
+	}
+
+	return moduleID.String()
+}
+
 // NewProgressState creates a new empty progress state
 func NewProgressState() *ProgressState {
 	return &ProgressState{
@@ -46,22 +67,22 @@ func NewProgressState() *ProgressState {
 // Returns an error if the lesson, deck, or card do not exist in the progress state.
 func (ps *ProgressState) AnswerCard(lessonID, deckID, cardID string, correct bool) error {
 	if ps.Lessons == nil {
-		return errors.New("progress state not initialized")
+		return errors.Trace(ErrUnitProgressStateNotInitialized)
 	}
 
 	lesson, exists := ps.Lessons[lessonID]
 	if !exists {
-		return errors.Errorf("lesson %s not found in progress state", lessonID)
+		return errors.Annotatef(ErrLessonNotFound, "lesson %s", lessonID)
 	}
 
 	deck, exists := lesson.Decks[deckID]
 	if !exists {
-		return errors.Errorf("deck %s not found in lesson %s", deckID, lessonID)
+		return errors.Annotatef(ErrDeckNotInLesson, "deck %s", deckID)
 	}
 
 	card, exists := deck.Cards[cardID]
 	if !exists {
-		return errors.Errorf("card %s not found in state's deck %s", cardID, deckID)
+		return errors.Annotatef(ErrCardNotInDeck, "card %s", cardID)
 	}
 
 	if correct {
@@ -102,6 +123,10 @@ func (ps *ProgressState) AnswerCard(lessonID, deckID, cardID string, correct boo
 				lesson.CompletedAt = &now
 				// TODO Move To Next Lesson?
 				// Currently Answerer and Syncher take care of this
+
+				if lesson.ModuleID != "" {
+					ps.RollUpModules()
+				}
 			}
 		}
 	} else {
@@ -148,7 +173,7 @@ func (ps *ProgressState) IsDeckCompleted(lessonID, deckID string) bool {
 
 // IsLessonCompleted checks if all decks in a lesson are completed
 func (ps *ProgressState) IsLessonCompleted(lessonID string) bool {
-	if ps.Lessons == nil || ps.Lessons[lessonID] == nil {
+	if ps == nil || ps.Lessons == nil || ps.Lessons[lessonID] == nil {
 		return false
 	}
 
@@ -172,6 +197,39 @@ func (ps *ProgressState) IsLessonCompleted(lessonID string) bool {
 	return true
 }
 
+// CompletedCards counts the cards of a lesson and how many of them are
+// completed. Lessons that are completed count every card as completed.
+func (ps *ProgressState) CompletedCards(lessonID string) (completed, total int) {
+	if ps == nil || ps.Lessons == nil || ps.Lessons[lessonID] == nil {
+		return 0, 0
+	}
+
+	lesson := ps.Lessons[lessonID]
+	for _, deck := range lesson.Decks {
+		for _, card := range deck.Cards {
+			total++
+			if card.IsCompleted || lesson.IsCompleted {
+				completed++
+			}
+		}
+	}
+
+	return completed, total
+}
+
+// IsCourseCompleted checks if every lesson of the course, lessons, is
+// completed. The lessons of the state are left aside, as they may be out
+// of sync with the course, and courses without lessons are completed.
+func (ps *ProgressState) IsCourseCompleted(lessons []Lesson) bool {
+	for _, lesson := range lessons {
+		if !ps.IsLessonCompleted(lesson.ID.String()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 type LessonState map[string]LessonProgress
 
 // GetLessonState returns the lesson progress as a map with lessonID as key
@@ -184,3 +242,58 @@ func (ps *ProgressState) GetLessonState(lessonID string) LessonState {
 		lessonID: *ps.Lessons[lessonID],
 	}
 }
+
+// RollUpModules counts the lessons of every module and how many of them are
+// completed. A module is completed once all of its lessons are; it keeps
+// the time it was first completed at until a lesson makes it incomplete
+// again. Modules left without lessons are dropped.
+func (ps *ProgressState) RollUpModules() {
+	modules := make(map[string]*ModuleProgress)
+	for _, lesson := range ps.Lessons {
+		if lesson.ModuleID == "" {
+			continue
+		}
+
+		module, ok := modules[lesson.ModuleID]
+		if !ok {
+			module = &ModuleProgress{}
+			modules[lesson.ModuleID] = module
+		}
+
+		module.LessonCount++
+		if lesson.IsCompleted {
+			module.CompletedLessons++
+		}
+	}
+
+	now := time.Now()
+	for moduleID, module := range modules {
+		module.IsCompleted = module.CompletedLessons == module.LessonCount
+		if !module.IsCompleted {
+			continue
+		}
+
+		if previous, ok := ps.Modules[moduleID]; ok && previous.IsCompleted && previous.CompletedAt != nil {
+			module.CompletedAt = previous.CompletedAt
+		} else {
+			module.CompletedAt = &now
+		}
+	}
+
+	if len(modules) == 0 {
+		ps.Modules = nil
+		return
+	}
+
+	ps.Modules = modules
+}
+
+// IsModuleCompleted checks if all lessons of a module are completed, as of
+// the last roll up
+func (ps *ProgressState) IsModuleCompleted(moduleID string) bool {
+	if ps.Modules == nil || ps.Modules[moduleID] == nil {
+		return false
+	}
+
+	return ps.Modules[moduleID].IsCompleted
+}
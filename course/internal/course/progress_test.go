@@ -143,22 +143,19 @@ func TestProgressState_AnswerCard(t *testing.T) {
 	t.Run("lesson_not_found", func(t *testing.T) {
 		unknownLessonID := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff").String()
 		err := ts.State.AnswerCard(unknownLessonID, ts.Lesson1Deck1ID, ts.Lesson1Deck1Cards[0], true)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "lesson")
+		assert.ErrorIs(t, err, ErrLessonNotFound)
 	})
 
 	t.Run("deck_not_found", func(t *testing.T) {
 		unknownDeckID := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff").String()
 		err := ts.State.AnswerCard(ts.Lesson1ID, unknownDeckID, ts.Lesson1Deck1Cards[0], true)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "deck")
+		assert.ErrorIs(t, err, ErrDeckNotInLesson)
 	})
 
 	t.Run("card_not_found", func(t *testing.T) {
 		unknownCardID := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff").String()
 		err := ts.State.AnswerCard(ts.Lesson1ID, ts.Lesson1Deck1ID, unknownCardID, true)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "card")
+		assert.ErrorIs(t, err, ErrCardNotInDeck)
 	})
 }
 
@@ -340,3 +337,59 @@ func TestProgressState_GetLessonState(t *testing.T) {
 	})
 
 }
+
+func TestProgressState_RollUpModules(t *testing.T) {
+	ts := newTestState(t)
+	moduleA := uuid.MustParse("5b0bb8a1-93a4-4d43-a7d5-2b84c1b2f3a1").String()
+	moduleB := uuid.MustParse("0e3f6b43-6c5e-4f0b-9c53-1d2b6f3b8e02").String()
+
+	ts.State.Lessons[ts.Lesson1ID].ModuleID = moduleA
+	ts.State.Lessons[ts.Lesson2ID].ModuleID = moduleA
+	ts.State.Lessons[ts.Lesson3ID].ModuleID = moduleB
+	ts.State.RollUpModules()
+
+	assert.Len(t, ts.State.Modules, 2)
+	assert.Equal(t, 2, ts.State.Modules[moduleA].LessonCount)
+	assert.Equal(t, 0, ts.State.Modules[moduleA].CompletedLessons)
+	assert.False(t, ts.State.IsModuleCompleted(moduleA))
+
+	answerAll := func(lessonID, deckID string, cardIDs []string) {
+		for _, cardID := range cardIDs {
+			assert.NoError(t, ts.State.AnswerCard(lessonID, deckID, cardID, true))
+		}
+	}
+
+	t.Run("partially_completed", func(t *testing.T) {
+		answerAll(ts.Lesson1ID, ts.Lesson1Deck1ID, ts.Lesson1Deck1Cards)
+		answerAll(ts.Lesson1ID, ts.Lesson1Deck2ID, ts.Lesson1Deck2Cards)
+
+		assert.Equal(t, 1, ts.State.Modules[moduleA].CompletedLessons)
+		assert.False(t, ts.State.IsModuleCompleted(moduleA))
+		assert.Nil(t, ts.State.Modules[moduleA].CompletedAt)
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		answerAll(ts.Lesson2ID, ts.Lesson2Deck1ID, ts.Lesson2Deck1Cards)
+		answerAll(ts.Lesson2ID, ts.Lesson2Deck2ID, ts.Lesson2Deck2Cards)
+
+		assert.Equal(t, 2, ts.State.Modules[moduleA].CompletedLessons)
+		assert.True(t, ts.State.IsModuleCompleted(moduleA))
+		assert.NotNil(t, ts.State.Modules[moduleA].CompletedAt)
+		assert.False(t, ts.State.IsModuleCompleted(moduleB))
+	})
+
+	t.Run("keeps_completion_time", func(t *testing.T) {
+		completedAt := ts.State.Modules[moduleA].CompletedAt
+		ts.State.RollUpModules()
+
+		assert.Same(t, completedAt, ts.State.Modules[moduleA].CompletedAt)
+	})
+
+	t.Run("drops_modules_without_lessons", func(t *testing.T) {
+		ts.State.Lessons[ts.Lesson3ID].ModuleID = ""
+		ts.State.RollUpModules()
+
+		assert.NotContains(t, ts.State.Modules, moduleB)
+		assert.Contains(t, ts.State.Modules, moduleA)
+	})
+}
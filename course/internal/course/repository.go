@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/juju/errors"
+	"github.com/lib/pq"
 	"github.com/mediocregopher/radix/v4"
 
 	cachelib "github.com/XaviFP/toshokan/common/cache"
@@ -21,13 +22,46 @@ import (
 
 var ErrNotFound = errors.New("not found")
 
+// lessonOrderConstraint keeps two lessons of a course from sharing an order
+const lessonOrderConstraint = "lessons_course_id_order_key"
+
+// moduleOrderConstraint keeps two modules of a course from sharing an order
+const moduleOrderConstraint = "modules_course_id_order_key"
+
+// gatingConstraint keeps the gating mode and unlock threshold of a course
+// consistent
+const gatingConstraint = "courses_gating_check"
+
+// prerequisitesLockKey is the key of the advisory lock that serializes the
+// changes to course prerequisites. Locking the course alone lets two
+// courses require each other at once, as neither sees the other's change
+// when looking for cycles.
+const prerequisitesLockKey = 0x746f7368 // "tosh"
+
+const courseColumns = `id, "order", title, description, created_at, updated_at, deleted_at, version, gating_mode, unlock_threshold`
+
+const moduleColumns = `id, course_id, "order", title, description, created_at, updated_at, deleted_at, version`
+
 // Repository defines the interface for courses data access
 type Repository interface {
 	// Courses
 	GetCourse(ctx context.Context, id uuid.UUID) (Course, error)
 	StoreCourse(ctx context.Context, course Course) error
 	UpdateCourse(ctx context.Context, id uuid.UUID, updates CourseUpdates) (Course, error)
+	// DeleteCourse sets deleted_at on a course, which hides it until
+	// RestoreCourse clears it again
+	DeleteCourse(ctx context.Context, id uuid.UUID) (Course, error)
+	RestoreCourse(ctx context.Context, id uuid.UUID) (Course, error)
 	GetEnrolledCourses(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (CoursesWithProgressConnection, error)
+	// ListCourses pages through every course, the ones whose title or
+	// description contain query only when it's not empty
+	ListCourses(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error)
+	// GetCoursePrerequisites retrieves the courses to complete before
+	// enrolling in a course, leaving out deleted ones
+	GetCoursePrerequisites(ctx context.Context, courseID uuid.UUID) ([]Course, error)
+	// SetCoursePrerequisites replaces the prerequisites of a course. A
+	// course can't end up requiring itself, directly or not.
+	SetCoursePrerequisites(ctx context.Context, courseID uuid.UUID, prerequisiteIDs []uuid.UUID) ([]Course, error)
 
 	// Lessons
 	GetLesson(ctx context.Context, id uuid.UUID) (Lesson, error)
@@ -38,8 +72,41 @@ type Repository interface {
 	GetLessonsByCourseID(ctx context.Context, courseID uuid.UUID, p pagination.Pagination, bodyless bool) (LessonsConnection, error)
 	StoreLesson(ctx context.Context, lesson Lesson) error
 	UpdateLesson(ctx context.Context, id uuid.UUID, updates LessonUpdates) (Lesson, error)
+	// DeleteLesson sets deleted_at on a lesson of a course, which hides it
+	// until RestoreLesson clears it again and puts it last in the course.
+	// Both move the users enrolled in the course to the lesson they should
+	// be at.
+	DeleteLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error)
+	RestoreLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error)
+	// ReorderLessons numbers the lessons of a course from 1 in the order of
+	// lessonIDs, which must list each of them once, and moves the users
+	// enrolled in the course to their first incomplete lesson in the new
+	// order. It returns the lessons in their new order, bodyless.
+	ReorderLessons(ctx context.Context, courseID uuid.UUID, lessonIDs []uuid.UUID) ([]Lesson, error)
+
+	// Modules
+	GetModule(ctx context.Context, id uuid.UUID) (Module, error)
+	// GetModulesByCourseID retrieves every module of a course in order
+	GetModulesByCourseID(ctx context.Context, courseID uuid.UUID) ([]Module, error)
+	StoreModule(ctx context.Context, module Module) error
+	// UpdateModule updates a module of a course, modules of other courses
+	// are ErrNotFound. Moving it renumbers the lessons of the course as
+	// ReorderModules does.
+	UpdateModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates ModuleUpdates) (Module, error)
+	// DeleteModule sets deleted_at on a module of a course, which hides it
+	// until RestoreModule clears it again and puts it last in the course.
+	// Only modules without lessons can be deleted.
+	DeleteModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error)
+	RestoreModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error)
+	// ReorderModules numbers the modules of a course from 1 in the order of
+	// moduleIDs, which must list each of them once, and its lessons from 1
+	// module by module, keeping their order within each module and putting
+	// the ones without a module last. The users enrolled in the course move
+	// to their first incomplete lesson in the new order.
+	ReorderModules(ctx context.Context, courseID uuid.UUID, moduleIDs []uuid.UUID) ([]Module, error)
 
 	// User Progress
+	GetEnrolledUserIDs(ctx context.Context, courseID uuid.UUID) ([]uuid.UUID, error)
 	GetUserCourseProgress(ctx context.Context, userID uuid.UUID, courseID uuid.UUID) (UserCourseProgress, error)
 	EnrollUserInCourse(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, progressState ProgressState) error
 	UpdateUserProgress(ctx context.Context, progress UserCourseProgress) error
@@ -47,14 +114,19 @@ type Repository interface {
 
 // CourseUpdates contains optional fields for updating a course
 type CourseUpdates struct {
-	Order       *int64
-	Title       *string
-	Description *string
+	Order           *int64
+	Title           *string
+	Description     *string
+	GatingMode      *GatingMode
+	UnlockThreshold *int
+	// ExpectedVersion, when set, makes the update fail with
+	// ErrVersionConflict unless the course is still at that version
+	ExpectedVersion *int
 }
 
 // HasUpdates returns true if at least one field is set
 func (u CourseUpdates) HasUpdates() bool {
-	return u.Order != nil || u.Title != nil || u.Description != nil
+	return u.Order != nil || u.Title != nil || u.Description != nil || u.GatingMode != nil || u.UnlockThreshold != nil
 }
 
 // LessonUpdates contains optional fields for updating a lesson
@@ -63,11 +135,32 @@ type LessonUpdates struct {
 	Title       *string
 	Description *string
 	Body        *string
+	// ModuleID moves the lesson into a module of its course, or out of its
+	// module when it is uuid.Nil
+	ModuleID *uuid.UUID
+	// ExpectedVersion, when set, makes the update fail with
+	// ErrVersionConflict unless the lesson is still at that version
+	ExpectedVersion *int
 }
 
 // HasUpdates returns true if at least one field is set
 func (u LessonUpdates) HasUpdates() bool {
-	return u.Order != nil || u.Title != nil || u.Description != nil || u.Body != nil
+	return u.Order != nil || u.Title != nil || u.Description != nil || u.Body != nil || u.ModuleID != nil
+}
+
+// ModuleUpdates contains optional fields for updating a module
+type ModuleUpdates struct {
+	Order       *int64
+	Title       *string
+	Description *string
+	// ExpectedVersion, when set, makes the update fail with
+	// ErrVersionConflict unless the module is still at that version
+	ExpectedVersion *int
+}
+
+// HasUpdates returns true if at least one field is set
+func (u ModuleUpdates) HasUpdates() bool {
+	return u.Order != nil || u.Title != nil || u.Description != nil
 }
 
 type redisRepository struct {
@@ -108,6 +201,10 @@ func (r *redisRepository) lessonKey(id uuid.UUID) string {
 	return fmt.Sprintf("lesson:%s", id.String())
 }
 
+func (r *redisRepository) moduleKey(id uuid.UUID) string {
+	return fmt.Sprintf("module:%s", id.String())
+}
+
 func (r *redisRepository) userProgressKey(userID, courseID uuid.UUID) string {
 	return fmt.Sprintf("user_progress:%s:%s", userID.String(), courseID.String())
 }
@@ -176,6 +273,36 @@ func (r *redisRepository) UpdateCourse(ctx context.Context, id uuid.UUID, update
 	return course, nil
 }
 
+// DeleteCourse deletes a course and invalidates cache
+func (r *redisRepository) DeleteCourse(ctx context.Context, id uuid.UUID) (Course, error) {
+	course, err := r.db.DeleteCourse(ctx, id)
+	if err != nil {
+		return Course{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.courseKey(id)); err != nil {
+		log.Printf("cache delete failed for course %s: %v", id, err)
+	}
+
+	return course, nil
+}
+
+// RestoreCourse restores a deleted course and invalidates cache
+func (r *redisRepository) RestoreCourse(ctx context.Context, id uuid.UUID) (Course, error) {
+	course, err := r.db.RestoreCourse(ctx, id)
+	if err != nil {
+		return Course{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.courseKey(id)); err != nil {
+		log.Printf("cache delete failed for course %s: %v", id, err)
+	}
+
+	return course, nil
+}
+
 // GetLesson retrieves a lesson, checking cache first
 func (r *redisRepository) GetLesson(ctx context.Context, id uuid.UUID) (Lesson, error) {
 	key := r.lessonKey(id)
@@ -221,7 +348,23 @@ func (r *redisRepository) GetEnrolledCourses(ctx context.Context, userID uuid.UU
 	return r.db.GetEnrolledCourses(ctx, userID, p)
 }
 
+// ListCourses isn't cached, as lesson counts and filters make for too many
+// distinct pages to be worth it
+func (r *redisRepository) ListCourses(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error) {
+	return r.db.ListCourses(ctx, query, p)
+}
+
 // StoreLesson saves a lesson and invalidates cache
+// GetCoursePrerequisites passes through to the DB
+func (r *redisRepository) GetCoursePrerequisites(ctx context.Context, courseID uuid.UUID) ([]Course, error) {
+	return r.db.GetCoursePrerequisites(ctx, courseID)
+}
+
+// SetCoursePrerequisites passes through to the DB
+func (r *redisRepository) SetCoursePrerequisites(ctx context.Context, courseID uuid.UUID, prerequisiteIDs []uuid.UUID) ([]Course, error) {
+	return r.db.SetCoursePrerequisites(ctx, courseID, prerequisiteIDs)
+}
+
 func (r *redisRepository) StoreLesson(ctx context.Context, lesson Lesson) error {
 	err := r.db.StoreLesson(ctx, lesson)
 	if err != nil {
@@ -251,6 +394,213 @@ func (r *redisRepository) UpdateLesson(ctx context.Context, id uuid.UUID, update
 	return lesson, nil
 }
 
+// DeleteLesson deletes a lesson and invalidates cache
+func (r *redisRepository) DeleteLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error) {
+	lesson, err := r.db.DeleteLesson(ctx, courseID, id)
+	if err != nil {
+		return Lesson{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.lessonKey(id)); err != nil {
+		log.Printf("cache delete failed for lesson %s: %v", id, err)
+	}
+	r.invalidateCourseProgress(ctx, courseID)
+
+	return lesson, nil
+}
+
+// RestoreLesson restores a deleted lesson and invalidates cache
+func (r *redisRepository) RestoreLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error) {
+	lesson, err := r.db.RestoreLesson(ctx, courseID, id)
+	if err != nil {
+		return Lesson{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.lessonKey(id)); err != nil {
+		log.Printf("cache delete failed for lesson %s: %v", id, err)
+	}
+	r.invalidateCourseProgress(ctx, courseID)
+
+	return lesson, nil
+}
+
+// invalidateCourseLessons drops the cached lessons of a course, renumbered
+// when its modules move, and the cached progress of its users
+func (r *redisRepository) invalidateCourseLessons(ctx context.Context, courseID uuid.UUID) {
+	lessons, err := allLessons(ctx, r.db, courseID)
+	if err != nil {
+		log.Printf("cache delete failed for the lessons of course %s: %v", courseID, err)
+	}
+
+	for _, lesson := range lessons {
+		if err := r.cache.Delete(ctx, r.lessonKey(lesson.ID)); err != nil {
+			log.Printf("cache delete failed for lesson %s: %v", lesson.ID, err)
+		}
+	}
+
+	r.invalidateCourseProgress(ctx, courseID)
+}
+
+// invalidateCourseProgress drops the cached progress of the users enrolled
+// in a course, whose current lesson may have been moved
+func (r *redisRepository) invalidateCourseProgress(ctx context.Context, courseID uuid.UUID) {
+	userIDs, err := r.db.GetEnrolledUserIDs(ctx, courseID)
+	if err != nil {
+		log.Printf("cache delete failed for the user_progress of course %s: %v", courseID, err)
+		return
+	}
+
+	for _, userID := range userIDs {
+		if err := r.cache.Delete(ctx, r.userProgressKey(userID, courseID)); err != nil {
+			log.Printf("cache delete failed for user_progress %s:%s: %v", userID, courseID, err)
+		}
+	}
+}
+
+// ReorderLessons reorders the lessons of a course and invalidates cache
+func (r *redisRepository) ReorderLessons(ctx context.Context, courseID uuid.UUID, lessonIDs []uuid.UUID) ([]Lesson, error) {
+	lessons, err := r.db.ReorderLessons(ctx, courseID, lessonIDs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	for _, lesson := range lessons {
+		if err := r.cache.Delete(ctx, r.lessonKey(lesson.ID)); err != nil {
+			log.Printf("cache delete failed for lesson %s: %v", lesson.ID, err)
+		}
+	}
+	r.invalidateCourseProgress(ctx, courseID)
+
+	return lessons, nil
+}
+
+// GetModule retrieves a module, checking cache first
+func (r *redisRepository) GetModule(ctx context.Context, id uuid.UUID) (Module, error) {
+	key := r.moduleKey(id)
+
+	var module Module
+	err := r.getCachedJSON(ctx, key, &module)
+	if err != nil && !errors.Is(err, cachelib.ErrNoValueForKey) {
+		return Module{}, errors.Trace(err)
+	}
+
+	// Cache hit
+	if err == nil {
+		return module, nil
+	}
+
+	// Cache miss, fetch from DB
+	module, err = r.db.GetModule(ctx, id)
+	if err != nil {
+		return Module{}, errors.Trace(err)
+	}
+
+	// Cache it
+	data, err := json.Marshal(module)
+	if err == nil {
+		if err := r.cache.SetEx(ctx, key, string(data), 3600); err != nil {
+			log.Printf("cache set failed for module %s: %v", module.ID, err)
+		}
+	}
+
+	return module, nil
+}
+
+// GetModulesByCourseID retrieves the modules of a course
+func (r *redisRepository) GetModulesByCourseID(ctx context.Context, courseID uuid.UUID) ([]Module, error) {
+	return r.db.GetModulesByCourseID(ctx, courseID)
+}
+
+// StoreModule stores a module and invalidates cache
+func (r *redisRepository) StoreModule(ctx context.Context, module Module) error {
+	err := r.db.StoreModule(ctx, module)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.moduleKey(module.ID)); err != nil {
+		log.Printf("cache delete failed for module %s: %v", module.ID, err)
+	}
+
+	return nil
+}
+
+// UpdateModule updates a module and invalidates cache
+func (r *redisRepository) UpdateModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates ModuleUpdates) (Module, error) {
+	module, err := r.db.UpdateModule(ctx, courseID, id, updates)
+	if err != nil {
+		return Module{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.moduleKey(id)); err != nil {
+		log.Printf("cache delete failed for module %s: %v", id, err)
+	}
+	if updates.Order != nil {
+		r.invalidateCourseLessons(ctx, courseID)
+	}
+
+	return module, nil
+}
+
+// DeleteModule deletes a module and invalidates cache
+func (r *redisRepository) DeleteModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error) {
+	module, err := r.db.DeleteModule(ctx, courseID, id)
+	if err != nil {
+		return Module{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.moduleKey(id)); err != nil {
+		log.Printf("cache delete failed for module %s: %v", id, err)
+	}
+
+	return module, nil
+}
+
+// RestoreModule restores a deleted module and invalidates cache
+func (r *redisRepository) RestoreModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error) {
+	module, err := r.db.RestoreModule(ctx, courseID, id)
+	if err != nil {
+		return Module{}, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	if err := r.cache.Delete(ctx, r.moduleKey(id)); err != nil {
+		log.Printf("cache delete failed for module %s: %v", id, err)
+	}
+
+	return module, nil
+}
+
+// ReorderModules reorders the modules of a course and invalidates cache
+func (r *redisRepository) ReorderModules(ctx context.Context, courseID uuid.UUID, moduleIDs []uuid.UUID) ([]Module, error) {
+	modules, err := r.db.ReorderModules(ctx, courseID, moduleIDs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Invalidate cache
+	for _, module := range modules {
+		if err := r.cache.Delete(ctx, r.moduleKey(module.ID)); err != nil {
+			log.Printf("cache delete failed for module %s: %v", module.ID, err)
+		}
+	}
+
+	r.invalidateCourseLessons(ctx, courseID)
+
+	return modules, nil
+}
+
+// GetEnrolledUserIDs retrieves the users enrolled in a course
+func (r *redisRepository) GetEnrolledUserIDs(ctx context.Context, courseID uuid.UUID) ([]uuid.UUID, error) {
+	return r.db.GetEnrolledUserIDs(ctx, courseID)
+}
+
 // GetUserCourseProgress retrieves user progress, checking cache first
 func (r *redisRepository) GetUserCourseProgress(ctx context.Context, userID uuid.UUID, courseID uuid.UUID) (UserCourseProgress, error) {
 	key := r.userProgressKey(userID, courseID)
@@ -327,10 +677,10 @@ func NewPGRepository(logger *slog.Logger, db *sql.DB) Repository {
 func (r *pgRepository) GetCourse(ctx context.Context, id uuid.UUID) (Course, error) {
 	var course Course
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, "order", title, description, created_at, updated_at, deleted_at 
+		`SELECT `+courseColumns+`
 		 FROM courses WHERE id = $1 AND deleted_at IS NULL`,
 		id,
-	).Scan(&course.ID, &course.Order, &course.Title, &course.Description, &course.CreatedAt, &course.UpdatedAt, &course.DeletedAt)
+	).Scan(courseFields(&course)...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Course{}, errors.Trace(ErrCourseNotFound)
@@ -351,15 +701,23 @@ func (r *pgRepository) StoreCourse(ctx context.Context, course Course) error {
 		return errors.Trace(ErrNoDescription)
 	}
 
+	if course.GatingMode == "" {
+		course.GatingMode = GatingFree
+	}
+	if err := ValidateGating(course.GatingMode, course.UnlockThreshold); err != nil {
+		return errors.Trace(err)
+	}
+
 	if course.ID == uuid.Nil {
 		course.ID = uuid.New()
 	}
 
 	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO courses (id, "order", title, description, created_at) 
-		 VALUES ($1, $2, $3, $4, $5)
-		 ON CONFLICT (id) DO UPDATE SET "order" = $2, title = $3, description = $4, updated_at = $5`,
-		course.ID, course.Order, course.Title, course.Description, time.Now(),
+		`INSERT INTO courses (id, "order", title, description, created_at, gating_mode, unlock_threshold) 
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 ON CONFLICT (id) DO UPDATE SET "order" = $2, title = $3, description = $4, updated_at = $5,
+		 gating_mode = $6, unlock_threshold = $7, version = courses.version + 1`,
+		course.ID, course.Order, course.Title, course.Description, time.Now(), course.GatingMode, course.UnlockThreshold,
 	)
 
 	return errors.Trace(err)
@@ -379,22 +737,42 @@ func (r *pgRepository) UpdateCourse(ctx context.Context, id uuid.UUID, updates C
 	if updates.Description != nil {
 		setClauses = append(setClauses, fmt.Sprintf("description = %s", arger.Add(*updates.Description)))
 	}
+	// Whether the mode and the threshold fit each other may depend on the one
+	// left as is, which is up to gatingConstraint
+	if updates.GatingMode != nil {
+		if !updates.GatingMode.IsValid() {
+			return Course{}, errors.Trace(ErrInvalidGating)
+		}
+		setClauses = append(setClauses, fmt.Sprintf("gating_mode = %s", arger.Add(*updates.GatingMode)))
+	}
+	if updates.UnlockThreshold != nil {
+		if *updates.UnlockThreshold < 0 || *updates.UnlockThreshold > 100 {
+			return Course{}, errors.Trace(ErrInvalidGating)
+		}
+		setClauses = append(setClauses, fmt.Sprintf("unlock_threshold = %s", arger.Add(*updates.UnlockThreshold)))
+	}
+	setClauses = append(setClauses, "version = version + 1")
+
+	where := fmt.Sprintf("id = %s AND deleted_at IS NULL", arger.Add(id))
+	if updates.ExpectedVersion != nil {
+		where += fmt.Sprintf(" AND version = %s", arger.Add(*updates.ExpectedVersion))
+	}
 
 	query := fmt.Sprintf(
-		`UPDATE courses SET %s WHERE id = %s AND deleted_at IS NULL
-		 RETURNING id, "order", title, description, created_at, updated_at, deleted_at`,
+		`UPDATE courses SET %s WHERE %s
+		 RETURNING `+courseColumns,
 		strings.Join(setClauses, ", "),
-		arger.Add(id),
+		where,
 	)
 
 	var course Course
-	err := r.db.QueryRowContext(ctx, query, arger.Values()...).Scan(
-		&course.ID, &course.Order, &course.Title, &course.Description,
-		&course.CreatedAt, &course.UpdatedAt, &course.DeletedAt,
-	)
+	err := r.db.QueryRowContext(ctx, query, arger.Values()...).Scan(courseFields(&course)...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return Course{}, errors.Trace(ErrNotFound)
+			return Course{}, errors.Trace(updateMiss(ctx, r.db, "courses", id, updates.ExpectedVersion))
+		}
+		if db.IsConstraintError(err, gatingConstraint) {
+			return Course{}, errors.Trace(ErrInvalidGating)
 		}
 
 		return Course{}, errors.Trace(err)
@@ -403,86 +781,251 @@ func (r *pgRepository) UpdateCourse(ctx context.Context, id uuid.UUID, updates C
 	return course, nil
 }
 
-// GetLesson retrieves a lesson by ID
-func (r *pgRepository) GetLesson(ctx context.Context, id uuid.UUID) (Lesson, error) {
-	var lesson Lesson
+// DeleteCourse marks a course as deleted
+func (r *pgRepository) DeleteCourse(ctx context.Context, id uuid.UUID) (Course, error) {
+	return r.setCourseDeletedAt(ctx, id, true)
+}
+
+// RestoreCourse clears the deletion mark of a course
+func (r *pgRepository) RestoreCourse(ctx context.Context, id uuid.UUID) (Course, error) {
+	return r.setCourseDeletedAt(ctx, id, false)
+}
+
+// setCourseDeletedAt deletes or restores a course. Deleting a course that
+// is deleted already, or restoring one that isn't, is ErrNotFound.
+func (r *pgRepository) setCourseDeletedAt(ctx context.Context, id uuid.UUID, deleted bool) (Course, error) {
+	set, where := deletedAtClauses(deleted)
+
+	var course Course
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, course_id, "order", title, description, body, created_at, updated_at, deleted_at 
-		 FROM lessons WHERE id = $1 AND deleted_at IS NULL`,
-		id,
-	).Scan(&lesson.ID, &lesson.CourseID, &lesson.Order, &lesson.Title, &lesson.Description, &lesson.Body, &lesson.CreatedAt, &lesson.UpdatedAt, &lesson.DeletedAt)
+		`UPDATE courses SET `+set+`, updated_at = $2, version = version + 1
+		 WHERE id = $1 AND `+where+`
+		 RETURNING `+courseColumns,
+		id, time.Now(),
+	).Scan(courseFields(&course)...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return Lesson{}, errors.Trace(ErrLessonNotFound)
+			return Course{}, errors.Trace(ErrNotFound)
 		}
 
-		return Lesson{}, errors.Trace(err)
+		return Course{}, errors.Trace(err)
 	}
 
-	return lesson, nil
+	return course, nil
 }
 
-// GetLessonsByCourseID retrieves lessons for a course with pagination
-// If bodyless is true, the body field is not fetched for faster pagination
-func (r *pgRepository) GetLessonsByCourseID(ctx context.Context, courseID uuid.UUID, p pagination.Pagination, bodyless bool) (LessonsConnection, error) {
-	var (
-		out   LessonsConnection
-		arger db.Argumenter
-	)
+// GetCoursePrerequisites retrieves the prerequisites of a course by their
+// order
+func (r *pgRepository) GetCoursePrerequisites(ctx context.Context, courseID uuid.UUID) ([]Course, error) {
+	return coursePrerequisites(ctx, r.db, courseID)
+}
 
-	whereClauses := []string{
-		"deleted_at IS NULL",
-		fmt.Sprintf("course_id = %s", arger.Add(courseID)),
+// SetCoursePrerequisites replaces the prerequisites of a course within a
+// transaction, rejecting those that would make a course require itself
+func (r *pgRepository) SetCoursePrerequisites(ctx context.Context, courseID uuid.UUID, prerequisiteIDs []uuid.UUID) ([]Course, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
-
-	if !p.Cursor().IsEmpty() {
-		var cursor LessonCursor
-		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
-			return out, errors.Trace(err)
+	defer func() {
+		if err != nil {
+			if errTx := tx.Rollback(); errTx != nil {
+				r.logger.Error("rolling back transaction", "error", errTx, "course_id", courseID, "source_error", err)
+			}
+		} else {
+			if errTx := tx.Commit(); errTx != nil {
+				r.logger.Error("committing transaction", "error", errTx, "course_id", courseID, "source_error", err)
+			}
 		}
-		whereClauses = append(whereClauses, fmt.Sprintf(`"order" %s %s`, p.Comparator(), arger.Add(cursor.Order)))
+	}()
+
+	if _, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, prerequisitesLockKey); err != nil {
+		return nil, errors.Trace(err)
 	}
 
-	// Build query with or without body based on bodyless flag
-	var query string
-	if bodyless {
-		query = fmt.Sprintf(
-			`SELECT id, course_id, "order", title, description, created_at, updated_at, deleted_at
-			 FROM lessons
-			 WHERE %s
-			 ORDER BY "order" %s
-			 LIMIT %s`,
-			strings.Join(whereClauses, " AND "),
-			p.OrderBy(),
-			arger.Add(p.Limit()+1),
-		)
-	} else {
-		query = fmt.Sprintf(
-			`SELECT id, course_id, "order", title, description, body, created_at, updated_at, deleted_at
-			 FROM lessons
-			 WHERE %s
-			 ORDER BY "order" %s
-			 LIMIT %s`,
-			strings.Join(whereClauses, " AND "),
-			p.OrderBy(),
-			arger.Add(p.Limit()+1),
-		)
+	if err = lockCourseTx(ctx, tx, courseID); err != nil {
+		return nil, errors.Trace(err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
-	if err != nil {
-		return out, errors.Trace(err)
+	if _, err = tx.ExecContext(ctx, `DELETE FROM course_prerequisites WHERE course_id = $1`, courseID); err != nil {
+		return nil, errors.Trace(err)
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var l Lesson
+	for _, prerequisiteID := range prerequisiteIDs {
+		if prerequisiteID == courseID {
+			err = ErrPrerequisiteCycle
+			return nil, errors.Trace(err)
+		}
+
+		var exists bool
+		err = tx.QueryRowContext(ctx,
+			`SELECT EXISTS(SELECT 1 FROM courses WHERE id = $1 AND deleted_at IS NULL)`,
+			prerequisiteID,
+		).Scan(&exists)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		if !exists {
+			err = ErrCourseNotFound
+			return nil, errors.Trace(err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO course_prerequisites (course_id, prerequisite_id, created_at)
+			 VALUES ($1, $2, $3)
+			 ON CONFLICT DO NOTHING`,
+			courseID, prerequisiteID, time.Now(),
+		)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	// A cycle goes through the course itself, so it is enough to look for
+	// the course among everything its new prerequisites require
+	var cycle bool
+	err = tx.QueryRowContext(ctx,
+		`WITH RECURSIVE required(id) AS (
+			SELECT prerequisite_id FROM course_prerequisites WHERE course_id = $1
+			UNION
+			SELECT cp.prerequisite_id FROM course_prerequisites cp JOIN required r ON cp.course_id = r.id
+		 )
+		 SELECT EXISTS(SELECT 1 FROM required WHERE id = $1)`,
+		courseID,
+	).Scan(&cycle)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if cycle {
+		err = ErrPrerequisiteCycle
+		return nil, errors.Trace(err)
+	}
+
+	prerequisites, err := coursePrerequisites(ctx, tx, courseID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return prerequisites, nil
+}
+
+// coursePrerequisites retrieves the prerequisites of a course that are not
+// deleted
+func coursePrerequisites(ctx context.Context, q rowsQuerier, courseID uuid.UUID) ([]Course, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT c.id, c."order", c.title, c.description, c.created_at, c.updated_at, c.deleted_at, c.version,
+		        c.gating_mode, c.unlock_threshold
+		 FROM course_prerequisites cp
+		 JOIN courses c ON c.id = cp.prerequisite_id
+		 WHERE cp.course_id = $1 AND c.deleted_at IS NULL
+		 ORDER BY c."order", c.created_at`,
+		courseID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	prerequisites := []Course{}
+	for rows.Next() {
+		var c Course
+		if err := rows.Scan(courseFields(&c)...); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		prerequisites = append(prerequisites, c)
+	}
+
+	return prerequisites, errors.Trace(rows.Err())
+}
+
+// courseFields returns where to scan the courseColumns of a course into
+func courseFields(c *Course) []any {
+	return []any{&c.ID, &c.Order, &c.Title, &c.Description, &c.CreatedAt, &c.UpdatedAt, &c.DeletedAt, &c.Version, &c.GatingMode, &c.UnlockThreshold}
+}
+
+// GetLesson retrieves a lesson by ID
+func (r *pgRepository) GetLesson(ctx context.Context, id uuid.UUID) (Lesson, error) {
+	var lesson Lesson
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, course_id, module_id, "order", title, description, body, created_at, updated_at, deleted_at, version
+		 FROM lessons WHERE id = $1 AND deleted_at IS NULL`,
+		id,
+	).Scan(&lesson.ID, &lesson.CourseID, &lesson.ModuleID, &lesson.Order, &lesson.Title, &lesson.Description, &lesson.Body, &lesson.CreatedAt, &lesson.UpdatedAt, &lesson.DeletedAt, &lesson.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Lesson{}, errors.Trace(ErrLessonNotFound)
+		}
+
+		return Lesson{}, errors.Trace(err)
+	}
+
+	return lesson, nil
+}
+
+// GetLessonsByCourseID retrieves lessons for a course with pagination
+// If bodyless is true, the body field is not fetched for faster pagination
+func (r *pgRepository) GetLessonsByCourseID(ctx context.Context, courseID uuid.UUID, p pagination.Pagination, bodyless bool) (LessonsConnection, error) {
+	var (
+		out   LessonsConnection
+		arger db.Argumenter
+	)
+
+	whereClauses := []string{
+		"deleted_at IS NULL",
+		fmt.Sprintf("course_id = %s", arger.Add(courseID)),
+	}
+
+	if !p.Cursor().IsEmpty() {
+		var cursor LessonCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, errors.Trace(err)
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf(`"order" %s %s`, p.Comparator(), arger.Add(cursor.Order)))
+	}
+
+	// Build query with or without body based on bodyless flag
+	var query string
+	if bodyless {
+		query = fmt.Sprintf(
+			`SELECT id, course_id, module_id, "order", title, description, created_at, updated_at, deleted_at, version
+			 FROM lessons
+			 WHERE %s
+			 ORDER BY "order" %s
+			 LIMIT %s`,
+			strings.Join(whereClauses, " AND "),
+			p.OrderBy(),
+			arger.Add(p.Limit()+1),
+		)
+	} else {
+		query = fmt.Sprintf(
+			`SELECT id, course_id, module_id, "order", title, description, body, created_at, updated_at, deleted_at, version
+			 FROM lessons
+			 WHERE %s
+			 ORDER BY "order" %s
+			 LIMIT %s`,
+			strings.Join(whereClauses, " AND "),
+			p.OrderBy(),
+			arger.Add(p.Limit()+1),
+		)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var l Lesson
 		if bodyless {
-			if err := rows.Scan(&l.ID, &l.CourseID, &l.Order, &l.Title, &l.Description, &l.CreatedAt, &l.UpdatedAt, &l.DeletedAt); err != nil {
+			if err := rows.Scan(&l.ID, &l.CourseID, &l.ModuleID, &l.Order, &l.Title, &l.Description, &l.CreatedAt, &l.UpdatedAt, &l.DeletedAt, &l.Version); err != nil {
 				return out, errors.Trace(err)
 			}
 		} else {
-			if err := rows.Scan(&l.ID, &l.CourseID, &l.Order, &l.Title, &l.Description, &l.Body, &l.CreatedAt, &l.UpdatedAt, &l.DeletedAt); err != nil {
+			if err := rows.Scan(&l.ID, &l.CourseID, &l.ModuleID, &l.Order, &l.Title, &l.Description, &l.Body, &l.CreatedAt, &l.UpdatedAt, &l.DeletedAt, &l.Version); err != nil {
 				return out, errors.Trace(err)
 			}
 		}
@@ -552,8 +1095,8 @@ func (r *pgRepository) GetEnrolledCourses(ctx context.Context, userID uuid.UUID,
 	}
 
 	query := fmt.Sprintf(
-		`SELECT c.id, c."order", c.title, c.description, c.created_at, c.updated_at, c.deleted_at,
-		        ucp.current_lesson
+		`SELECT c.id, c."order", c.title, c.description, c.created_at, c.updated_at, c.deleted_at, c.version,
+		        c.gating_mode, c.unlock_threshold, ucp.current_lesson
 		 FROM user_course_progress ucp
 		 JOIN courses c ON c.id = ucp.course_id
 		 WHERE %s
@@ -574,7 +1117,7 @@ func (r *pgRepository) GetEnrolledCourses(ctx context.Context, userID uuid.UUID,
 		var c Course
 		var currentLessonID uuid.UUID
 
-		if err := rows.Scan(&c.ID, &c.Order, &c.Title, &c.Description, &c.CreatedAt, &c.UpdatedAt, &c.DeletedAt, &currentLessonID); err != nil {
+		if err := rows.Scan(append(courseFields(&c), &currentLessonID)...); err != nil {
 			return out, errors.Trace(err)
 		}
 
@@ -624,6 +1167,108 @@ func (r *pgRepository) GetEnrolledCourses(ctx context.Context, userID uuid.UUID,
 	return out, nil
 }
 
+// ListCourses retrieves the courses of the catalog with their lesson count.
+// Matching query is case insensitive, and its wildcards are taken literally.
+func (r *pgRepository) ListCourses(ctx context.Context, query string, p pagination.Pagination) (CatalogConnection, error) {
+	var (
+		out   CatalogConnection
+		arger db.Argumenter
+	)
+
+	whereClauses := []string{"c.deleted_at IS NULL"}
+
+	if query != "" {
+		pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(query) + "%"
+		arg := arger.Add(pattern)
+		whereClauses = append(whereClauses, fmt.Sprintf("(c.title ILIKE %s OR c.description ILIKE %s)", arg, arg))
+	}
+
+	if !p.Cursor().IsEmpty() {
+		var cursor CatalogCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, errors.Trace(ErrInvalidCursor)
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			`(c."order", c.created_at, c.id) %s (%s, %s, %s)`,
+			p.Comparator(),
+			arger.Add(cursor.Order),
+			arger.Add(cursor.CreatedAt),
+			arger.Add(cursor.ID),
+		))
+	}
+
+	sqlQuery := fmt.Sprintf(
+		`SELECT c.id, c."order", c.title, c.description, c.created_at, c.updated_at, c.deleted_at, c.version,
+		        c.gating_mode, c.unlock_threshold,
+		        (SELECT COUNT(*) FROM lessons l WHERE l.course_id = c.id AND l.deleted_at IS NULL)
+		 FROM courses c
+		 WHERE %s
+		 ORDER BY c."order" %s, c.created_at %s, c.id %s
+		 LIMIT %s`,
+		strings.Join(whereClauses, " AND "),
+		p.OrderBy(),
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cc CatalogCourse
+		c := &cc.Course
+
+		if err := rows.Scan(append(courseFields(c), &cc.LessonCount)...); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(CatalogCursor{Order: c.Order, CreatedAt: c.CreatedAt, ID: c.ID})
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, CatalogEdge{
+			Course: cc,
+			Cursor: cursor,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	// If backward pagination, reverse to restore natural order
+	if !p.IsForward() {
+		for i, j := 0, len(out.Edges)-1; i < j; i, j = i+1, j-1 {
+			out.Edges[i], out.Edges[j] = out.Edges[j], out.Edges[i]
+		}
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
 // StoreLesson saves a lesson to the database
 func (r *pgRepository) StoreLesson(ctx context.Context, lesson Lesson) error {
 	if lesson.Title == "" {
@@ -654,13 +1299,23 @@ func (r *pgRepository) StoreLesson(ctx context.Context, lesson Lesson) error {
 		}
 	}()
 
+	if lesson.ModuleID != uuid.Nil {
+		if err = moduleInCourse(ctx, tx, lesson.ModuleID, lesson.CourseID); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	_, err = tx.ExecContext(ctx,
-		`INSERT INTO lessons (id, course_id, "order", title, description, body, created_at) 
-		 VALUES ($1, $2, $3, $4, $5, $6, $7)
-		 ON CONFLICT (id) DO UPDATE SET title = $4, description = $5, body = $6, updated_at = $7`,
-		lesson.ID, lesson.CourseID, lesson.Order, lesson.Title, lesson.Description, lesson.Body, time.Now(),
+		`INSERT INTO lessons (id, course_id, "order", title, description, body, created_at, module_id) 
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 ON CONFLICT (id) DO UPDATE SET title = $4, description = $5, body = $6, updated_at = $7, module_id = $8, version = lessons.version + 1`,
+		lesson.ID, lesson.CourseID, lesson.Order, lesson.Title, lesson.Description, lesson.Body, time.Now(), nullableID(lesson.ModuleID),
 	)
 	if err != nil {
+		if db.IsConstraintError(err, lessonOrderConstraint) {
+			return errors.Trace(ErrLessonOrderTaken)
+		}
+
 		return errors.Trace(err)
 	}
 
@@ -704,26 +1359,46 @@ func (r *pgRepository) UpdateLesson(ctx context.Context, id uuid.UUID, updates L
 	if updates.Body != nil {
 		setClauses = append(setClauses, fmt.Sprintf("body = %s", arger.Add(*updates.Body)))
 	}
+	if updates.ModuleID != nil {
+		setClauses = append(setClauses, fmt.Sprintf("module_id = %s", arger.Add(nullableID(*updates.ModuleID))))
+	}
+	setClauses = append(setClauses, "version = version + 1")
+
+	where := fmt.Sprintf("id = %s AND deleted_at IS NULL", arger.Add(id))
+	if updates.ExpectedVersion != nil {
+		where += fmt.Sprintf(" AND version = %s", arger.Add(*updates.ExpectedVersion))
+	}
 
 	query := fmt.Sprintf(
-		`UPDATE lessons SET %s WHERE id = %s AND deleted_at IS NULL
-		 RETURNING id, course_id, "order", title, description, body, created_at, updated_at, deleted_at`,
+		`UPDATE lessons SET %s WHERE %s
+		 RETURNING id, course_id, module_id, "order", title, description, body, created_at, updated_at, deleted_at, version`,
 		strings.Join(setClauses, ", "),
-		arger.Add(id),
+		where,
 	)
 
 	var lesson Lesson
 	err = tx.QueryRowContext(ctx, query, arger.Values()...).Scan(
-		&lesson.ID, &lesson.CourseID, &lesson.Order, &lesson.Title, &lesson.Description,
-		&lesson.Body, &lesson.CreatedAt, &lesson.UpdatedAt, &lesson.DeletedAt,
+		&lesson.ID, &lesson.CourseID, &lesson.ModuleID, &lesson.Order, &lesson.Title, &lesson.Description,
+		&lesson.Body, &lesson.CreatedAt, &lesson.UpdatedAt, &lesson.DeletedAt, &lesson.Version,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return Lesson{}, errors.Trace(ErrNotFound)
+			return Lesson{}, errors.Trace(updateMiss(ctx, tx, "lessons", id, updates.ExpectedVersion))
+		}
+		if db.IsConstraintError(err, lessonOrderConstraint) {
+			return Lesson{}, errors.Trace(ErrLessonOrderTaken)
 		}
 		return Lesson{}, errors.Trace(err)
 	}
 
+	// The course of the lesson is only known once it's updated, the update
+	// is rolled back if the module is not part of it
+	if lesson.ModuleID != uuid.Nil && updates.ModuleID != nil {
+		if err = moduleInCourse(ctx, tx, lesson.ModuleID, lesson.CourseID); err != nil {
+			return Lesson{}, errors.Trace(err)
+		}
+	}
+
 	if err := syncLessonDecksTx(ctx, tx, lesson); err != nil {
 		return Lesson{}, errors.Trace(err)
 	}
@@ -731,52 +1406,738 @@ func (r *pgRepository) UpdateLesson(ctx context.Context, id uuid.UUID, updates L
 	return lesson, nil
 }
 
-// syncLessonDecksTx ensures lesson_decks are in sync with deck references in lesson body
-func syncLessonDecksTx(ctx context.Context, tx *sql.Tx, lesson Lesson) error {
-	deckIDs := ParseDeckReferences(lesson.Body)
+// DeleteLesson marks a lesson as deleted
+func (r *pgRepository) DeleteLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error) {
+	return r.setLessonDeletedAt(ctx, courseID, id, true)
+}
 
-	if _, err := tx.ExecContext(ctx, `DELETE FROM lesson_decks WHERE lesson_id = $1`, lesson.ID); err != nil {
-		return err
-	}
+// RestoreLesson clears the deletion mark of a lesson
+func (r *pgRepository) RestoreLesson(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Lesson, error) {
+	return r.setLessonDeletedAt(ctx, courseID, id, false)
+}
 
-	for i, deckID := range deckIDs {
-		_, err := tx.ExecContext(ctx, `
-			INSERT INTO lesson_decks (id, lesson_id, deck_id, "order", created_at)
-			VALUES ($1, $2, $3, $4, $5)`,
-			uuid.New(), lesson.ID, deckID, i, time.Now().UTC(),
-		)
+// setLessonDeletedAt deletes or restores a lesson of a course. Deleting a
+// lesson that is deleted already, or restoring one that isn't, is
+// ErrNotFound, as is a lesson of another course.
+func (r *pgRepository) setLessonDeletedAt(ctx context.Context, courseID uuid.UUID, id uuid.UUID, deleted bool) (Lesson, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Lesson{}, errors.Trace(err)
+	}
+	defer func() {
 		if err != nil {
-			return errors.Trace(err)
+			if errTx := tx.Rollback(); errTx != nil {
+				r.logger.Error("rolling back transaction", "error", errTx, "lesson_id", id, "source_error", err)
+			}
+		} else {
+			if errTx := tx.Commit(); errTx != nil {
+				r.logger.Error("committing transaction", "error", errTx, "lesson_id", id, "source_error", err)
+			}
 		}
-	}
-
-	return nil
-}
-
-// GetUserCourseProgress retrieves a user's progress in a course
-func (r *pgRepository) GetUserCourseProgress(ctx context.Context, userID uuid.UUID, courseID uuid.UUID) (UserCourseProgress, error) {
-	var progress UserCourseProgress
-	var stateJSON []byte
+	}()
 
-	err := r.db.QueryRowContext(ctx,
-		`SELECT id, user_id, course_id, current_lesson, state, created_at, updated_at 
-		 FROM user_course_progress WHERE user_id = $1 AND course_id = $2`,
-		userID, courseID,
-	).Scan(&progress.ID, &progress.UserID, &progress.CourseID, &progress.CurrentLessonID, &stateJSON, &progress.CreatedAt, &progress.UpdatedAt)
+	// Restored lessons go last, so the lessons of the course can't be added
+	// or renumbered meanwhile
+	if err = lockCourseTx(ctx, tx, courseID); err != nil {
+		return Lesson{}, errors.Trace(err)
+	}
 
-	if errors.Is(err, sql.ErrNoRows) {
-		return UserCourseProgress{}, errors.Trace(ErrUserProgressNotFound)
+	set, where := deletedAtClauses(deleted)
+	if !deleted {
+		// The order the lesson had may have been taken since
+		set += `, "order" = (SELECT COALESCE(MAX(l."order"), 0) + 1 FROM lessons l WHERE l.course_id = lessons.course_id AND l.deleted_at IS NULL)`
+		// and its module may have been deleted, which leaves it ungrouped
+		set += `, module_id = (SELECT m.id FROM modules m WHERE m.id = lessons.module_id AND m.deleted_at IS NULL)`
 	}
+
+	var lesson Lesson
+	err = tx.QueryRowContext(ctx,
+		`UPDATE lessons SET `+set+`, updated_at = $2, version = version + 1
+		 WHERE id = $1 AND course_id = $3 AND `+where+`
+		 RETURNING id, course_id, module_id, "order", title, description, body, created_at, updated_at, deleted_at, version`,
+		id, time.Now(), courseID,
+	).Scan(
+		&lesson.ID, &lesson.CourseID, &lesson.ModuleID, &lesson.Order, &lesson.Title, &lesson.Description,
+		&lesson.Body, &lesson.CreatedAt, &lesson.UpdatedAt, &lesson.DeletedAt, &lesson.Version,
+	)
 	if err != nil {
-		return UserCourseProgress{}, errors.Trace(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return Lesson{}, errors.Trace(ErrNotFound)
+		}
+
+		return Lesson{}, errors.Trace(err)
 	}
 
-	progress.State = &ProgressState{}
-	if err := json.Unmarshal(stateJSON, progress.State); err != nil {
-		return UserCourseProgress{}, errors.Trace(err)
+	if err = fixCurrentLessonsTx(ctx, tx, courseID); err != nil {
+		return Lesson{}, errors.Trace(err)
 	}
 
-	return progress, nil
+	return lesson, nil
+}
+
+// ReorderLessons numbers the lessons of a course in the order of lessonIDs
+func (r *pgRepository) ReorderLessons(ctx context.Context, courseID uuid.UUID, lessonIDs []uuid.UUID) ([]Lesson, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			if errTx := tx.Rollback(); errTx != nil {
+				r.logger.Error("rolling back transaction", "error", errTx, "course_id", courseID, "source_error", err)
+			}
+		} else {
+			if errTx := tx.Commit(); errTx != nil {
+				r.logger.Error("committing transaction", "error", errTx, "course_id", courseID, "source_error", err)
+			}
+		}
+	}()
+
+	if err = lockCourseTx(ctx, tx, courseID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	current, err := ordersTx(ctx, tx, "lessons", courseID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err = renumberTx(ctx, tx, "lessons", current, lessonIDs, ErrInvalidLessonOrder); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err = fixCurrentLessonsTx(ctx, tx, courseID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id, course_id, module_id, "order", title, description, created_at, updated_at, deleted_at, version
+		 FROM lessons WHERE course_id = $1 AND deleted_at IS NULL
+		 ORDER BY "order"`,
+		courseID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	lessons := make([]Lesson, 0, len(lessonIDs))
+	for rows.Next() {
+		var lesson Lesson
+		if err = rows.Scan(
+			&lesson.ID, &lesson.CourseID, &lesson.ModuleID, &lesson.Order, &lesson.Title, &lesson.Description,
+			&lesson.CreatedAt, &lesson.UpdatedAt, &lesson.DeletedAt, &lesson.Version,
+		); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		lessons = append(lessons, lesson)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return lessons, nil
+}
+
+// GetModule retrieves a module by ID
+func (r *pgRepository) GetModule(ctx context.Context, id uuid.UUID) (Module, error) {
+	var module Module
+	err := r.db.QueryRowContext(ctx,
+		`SELECT `+moduleColumns+` FROM modules WHERE id = $1 AND deleted_at IS NULL`,
+		id,
+	).Scan(moduleFields(&module)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Module{}, errors.Trace(ErrModuleNotFound)
+		}
+
+		return Module{}, errors.Trace(err)
+	}
+
+	return module, nil
+}
+
+// GetModulesByCourseID retrieves the modules of a course ordered by order
+func (r *pgRepository) GetModulesByCourseID(ctx context.Context, courseID uuid.UUID) ([]Module, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+moduleColumns+` FROM modules
+		 WHERE course_id = $1 AND deleted_at IS NULL
+		 ORDER BY "order"`,
+		courseID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	modules := []Module{}
+	for rows.Next() {
+		var module Module
+		if err := rows.Scan(moduleFields(&module)...); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		modules = append(modules, module)
+	}
+
+	return modules, errors.Trace(rows.Err())
+}
+
+// StoreModule saves a module to the database
+func (r *pgRepository) StoreModule(ctx context.Context, module Module) error {
+	if module.Title == "" {
+		return errors.Trace(ErrNoTitle)
+	}
+	if module.Description == "" {
+		return errors.Trace(ErrNoDescription)
+	}
+
+	if module.ID == uuid.Nil {
+		module.ID = uuid.New()
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO modules (id, course_id, "order", title, description, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		module.ID, module.CourseID, module.Order, module.Title, module.Description, time.Now(),
+	)
+	if err != nil {
+		if db.IsConstraintError(err, moduleOrderConstraint) {
+			return errors.Trace(ErrModuleOrderTaken)
+		}
+
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+// UpdateModule updates a module with the provided fields
+func (r *pgRepository) UpdateModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates ModuleUpdates) (Module, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Module{}, errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			if errTx := tx.Rollback(); errTx != nil {
+				r.logger.Error("rolling back transaction", "error", errTx, "module_id", id, "source_error", err)
+			}
+		} else {
+			if errTx := tx.Commit(); errTx != nil {
+				r.logger.Error("committing transaction", "error", errTx, "module_id", id, "source_error", err)
+			}
+		}
+	}()
+
+	// Moving a module renumbers the lessons of its course
+	if updates.Order != nil {
+		if err = lockCourseTx(ctx, tx, courseID); err != nil {
+			return Module{}, errors.Trace(err)
+		}
+	}
+
+	var arger db.Argumenter
+	setClauses := []string{fmt.Sprintf("updated_at = %s", arger.Add(time.Now()))}
+
+	if updates.Order != nil {
+		setClauses = append(setClauses, fmt.Sprintf(`"order" = %s`, arger.Add(*updates.Order)))
+	}
+	if updates.Title != nil {
+		setClauses = append(setClauses, fmt.Sprintf("title = %s", arger.Add(*updates.Title)))
+	}
+	if updates.Description != nil {
+		setClauses = append(setClauses, fmt.Sprintf("description = %s", arger.Add(*updates.Description)))
+	}
+	setClauses = append(setClauses, "version = version + 1")
+
+	where := fmt.Sprintf("id = %s AND course_id = %s AND deleted_at IS NULL", arger.Add(id), arger.Add(courseID))
+	if updates.ExpectedVersion != nil {
+		where += fmt.Sprintf(" AND version = %s", arger.Add(*updates.ExpectedVersion))
+	}
+
+	query := fmt.Sprintf(
+		`UPDATE modules SET %s WHERE %s RETURNING `+moduleColumns,
+		strings.Join(setClauses, ", "),
+		where,
+	)
+
+	var module Module
+	err = tx.QueryRowContext(ctx, query, arger.Values()...).Scan(moduleFields(&module)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Modules of other courses are not found, whatever their version
+			if err := moduleInCourse(ctx, tx, id, courseID); err != nil {
+				if errors.Is(err, ErrInvalidModule) {
+					return Module{}, errors.Trace(ErrNotFound)
+				}
+				return Module{}, errors.Trace(err)
+			}
+
+			return Module{}, errors.Trace(updateMiss(ctx, tx, "modules", id, updates.ExpectedVersion))
+		}
+		if db.IsConstraintError(err, moduleOrderConstraint) {
+			return Module{}, errors.Trace(ErrModuleOrderTaken)
+		}
+
+		return Module{}, errors.Trace(err)
+	}
+
+	if updates.Order != nil {
+		if err = renumberLessonsByModuleTx(ctx, tx, courseID); err != nil {
+			return Module{}, errors.Trace(err)
+		}
+
+		if err = fixCurrentLessonsTx(ctx, tx, courseID); err != nil {
+			return Module{}, errors.Trace(err)
+		}
+	}
+
+	return module, nil
+}
+
+// DeleteModule marks a module without lessons as deleted
+func (r *pgRepository) DeleteModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error) {
+	return r.setModuleDeletedAt(ctx, courseID, id, true)
+}
+
+// RestoreModule clears the deletion mark of a module
+func (r *pgRepository) RestoreModule(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (Module, error) {
+	return r.setModuleDeletedAt(ctx, courseID, id, false)
+}
+
+// setModuleDeletedAt deletes or restores a module of a course. Deleting a
+// module that is deleted already, or restoring one that isn't, is
+// ErrNotFound, as is a module of another course, and deleting one that
+// still has lessons is ErrModuleNotEmpty.
+func (r *pgRepository) setModuleDeletedAt(ctx context.Context, courseID uuid.UUID, id uuid.UUID, deleted bool) (Module, error) {
+	set, where := deletedAtClauses(deleted)
+	if deleted {
+		where += ` AND NOT EXISTS (SELECT 1 FROM lessons l WHERE l.module_id = modules.id AND l.deleted_at IS NULL)`
+	} else {
+		// The order the module had may have been taken since
+		set += `, "order" = (SELECT COALESCE(MAX(m."order"), 0) + 1 FROM modules m WHERE m.course_id = modules.course_id AND m.deleted_at IS NULL)`
+	}
+
+	var module Module
+	err := r.db.QueryRowContext(ctx,
+		`UPDATE modules SET `+set+`, updated_at = $2, version = version + 1
+		 WHERE id = $1 AND course_id = $3 AND `+where+`
+		 RETURNING `+moduleColumns,
+		id, time.Now(), courseID,
+	).Scan(moduleFields(&module)...)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return Module{}, errors.Trace(err)
+		}
+		if !deleted {
+			return Module{}, errors.Trace(ErrNotFound)
+		}
+
+		var exists bool
+		err = r.db.QueryRowContext(ctx,
+			`SELECT EXISTS(SELECT 1 FROM modules WHERE id = $1 AND course_id = $2 AND deleted_at IS NULL)`,
+			id, courseID,
+		).Scan(&exists)
+		if err != nil {
+			return Module{}, errors.Trace(err)
+		}
+
+		if exists {
+			return Module{}, errors.Trace(ErrModuleNotEmpty)
+		}
+
+		return Module{}, errors.Trace(ErrNotFound)
+	}
+
+	return module, nil
+}
+
+// ReorderModules numbers the modules of a course in the order of moduleIDs
+func (r *pgRepository) ReorderModules(ctx context.Context, courseID uuid.UUID, moduleIDs []uuid.UUID) ([]Module, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			if errTx := tx.Rollback(); errTx != nil {
+				r.logger.Error("rolling back transaction", "error", errTx, "course_id", courseID, "source_error", err)
+			}
+		} else {
+			if errTx := tx.Commit(); errTx != nil {
+				r.logger.Error("committing transaction", "error", errTx, "course_id", courseID, "source_error", err)
+			}
+		}
+	}()
+
+	if err = lockCourseTx(ctx, tx, courseID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	current, err := ordersTx(ctx, tx, "modules", courseID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err = renumberTx(ctx, tx, "modules", current, moduleIDs, ErrInvalidModuleOrder); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err = renumberLessonsByModuleTx(ctx, tx, courseID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err = fixCurrentLessonsTx(ctx, tx, courseID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT `+moduleColumns+` FROM modules
+		 WHERE course_id = $1 AND deleted_at IS NULL
+		 ORDER BY "order"`,
+		courseID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	modules := make([]Module, 0, len(moduleIDs))
+	for rows.Next() {
+		var module Module
+		if err = rows.Scan(moduleFields(&module)...); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		modules = append(modules, module)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return modules, nil
+}
+
+// renumberLessonsByModuleTx numbers the lessons of a course in the order of
+// their modules, keeping their order within each module, with the ones
+// without a module last
+func renumberLessonsByModuleTx(ctx context.Context, tx *sql.Tx, courseID uuid.UUID) error {
+	current, err := ordersTx(ctx, tx, "lessons", courseID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT l.id FROM lessons l
+		 LEFT JOIN modules m ON m.id = l.module_id AND m.deleted_at IS NULL
+		 WHERE l.course_id = $1 AND l.deleted_at IS NULL
+		 ORDER BY m."order" NULLS LAST, l."order"`,
+		courseID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer rows.Close()
+
+	lessonIDs := make([]uuid.UUID, 0, len(current))
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return errors.Trace(err)
+		}
+
+		lessonIDs = append(lessonIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return errors.Trace(renumberTx(ctx, tx, "lessons", current, lessonIDs, ErrInvalidLessonOrder))
+}
+
+// moduleFields returns where to scan the moduleColumns of a module into
+func moduleFields(m *Module) []any {
+	return []any{&m.ID, &m.CourseID, &m.Order, &m.Title, &m.Description, &m.CreatedAt, &m.UpdatedAt, &m.DeletedAt, &m.Version}
+}
+
+// lockCourseTx locks a course, which keeps concurrent reorders of its
+// lessons or modules from interleaving. A missing course is ErrNotFound.
+func lockCourseTx(ctx context.Context, tx *sql.Tx, courseID uuid.UUID) error {
+	var locked uuid.UUID
+	err := tx.QueryRowContext(ctx,
+		`SELECT id FROM courses WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		courseID,
+	).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+// fixCurrentLessonsTx moves the users enrolled in a course to the lesson
+// syncing their state would: the first lesson they haven't completed, or
+// the last one when they completed them all. It runs along with the changes
+// to the lessons so that no user is left at a deleted lesson, or out of
+// order, when syncing their state fails.
+func fixCurrentLessonsTx(ctx context.Context, tx *sql.Tx, courseID uuid.UUID) error {
+	_, err := tx.ExecContext(ctx,
+		`UPDATE user_course_progress p
+		 SET current_lesson = f.lesson_id,
+		     state = jsonb_set(p.state, '{current_lesson_id}', to_jsonb(COALESCE(f.lesson_id, '00000000-0000-0000-0000-000000000000')::text)),
+		     updated_at = $2
+		 FROM (
+		   SELECT p.id, COALESCE(
+		     (SELECT l.id FROM lessons l
+		      WHERE l.course_id = p.course_id AND l.deleted_at IS NULL
+		        AND NOT COALESCE((p.state->'lessons'->(l.id::text)->>'is_completed')::BOOLEAN, FALSE)
+		      ORDER BY l."order" LIMIT 1),
+		     (SELECT l.id FROM lessons l
+		      WHERE l.course_id = p.course_id AND l.deleted_at IS NULL
+		      ORDER BY l."order" DESC LIMIT 1)
+		   ) AS lesson_id
+		   FROM user_course_progress p
+		   WHERE p.course_id = $1
+		 ) f
+		 WHERE p.id = f.id AND p.current_lesson IS DISTINCT FROM f.lesson_id`,
+		courseID, time.Now().UTC(),
+	)
+
+	return errors.Trace(err)
+}
+
+// ordersTx returns the order of every row of a course in table, either
+// lessons or modules, locking them
+func ordersTx(ctx context.Context, tx *sql.Tx, table string, courseID uuid.UUID) (map[uuid.UUID]int, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id, "order" FROM `+table+` WHERE course_id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		courseID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	orders := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			id    uuid.UUID
+			order int
+		)
+		if err := rows.Scan(&id, &order); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		orders[id] = order
+	}
+
+	return orders, errors.Trace(rows.Err())
+}
+
+// renumberTx numbers the rows of table from 1 in the order of ids. ids must
+// list every row of current once, or it is the invalid error.
+func renumberTx(ctx context.Context, tx *sql.Tx, table string, current map[uuid.UUID]int, ids []uuid.UUID, invalid error) error {
+	if len(ids) != len(current) {
+		return invalid
+	}
+
+	minOrder := 1
+	for _, order := range current {
+		minOrder = min(minOrder, order)
+	}
+
+	var (
+		changed   []uuid.UUID
+		positions []int64
+		seen      = make(map[uuid.UUID]bool, len(ids))
+	)
+	for i, id := range ids {
+		order, ok := current[id]
+		if !ok || seen[id] {
+			return invalid
+		}
+		seen[id] = true
+
+		if order != i+1 {
+			changed = append(changed, id)
+			positions = append(positions, int64(i+1))
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	// Orders are unique at every step, so the rows that move are first
+	// parked below every order in use, then set to their new positions
+	move := `UPDATE ` + table + ` t SET "order" = %s
+		 FROM unnest($1::uuid[], $2::int[]) AS v(id, position)
+		 WHERE t.id = v.id`
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(move, "$3 - v.position"), pq.Array(changed), pq.Array(positions), minOrder); err != nil {
+		return errors.Trace(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(move, `v.position, updated_at = $3, version = t.version + 1`), pq.Array(changed), pq.Array(positions), time.Now()); err != nil {
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+// moduleInCourse checks that a module is part of a course, which is
+// ErrInvalidModule otherwise
+func moduleInCourse(ctx context.Context, q querier, moduleID, courseID uuid.UUID) error {
+	var exists bool
+	err := q.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM modules WHERE id = $1 AND course_id = $2 AND deleted_at IS NULL)`,
+		moduleID, courseID,
+	).Scan(&exists)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if !exists {
+		return ErrInvalidModule
+	}
+
+	return nil
+}
+
+// nullableID stores uuid.Nil, like the module of a lesson without one or
+// the current lesson of a course without lessons, as NULL
+func nullableID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+// deletedAtClauses returns the SET and WHERE clauses that delete a row, or
+// that restore it when deleted is false. The time of the deletion is the
+// second argument of the query.
+func deletedAtClauses(deleted bool) (string, string) {
+	if deleted {
+		return "deleted_at = $2", "deleted_at IS NULL"
+	}
+
+	return "deleted_at = NULL", "deleted_at IS NOT NULL"
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// rowsQuerier is implemented by both *sql.DB and *sql.Tx
+type rowsQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// updateMiss tells why an update of the row with id in table matched no
+// rows: either it doesn't exist, ErrNotFound, or it is no longer at the
+// expected version.
+func updateMiss(ctx context.Context, q querier, table string, id uuid.UUID, expectedVersion *int) error {
+	if expectedVersion == nil {
+		return ErrNotFound
+	}
+
+	var exists bool
+	err := q.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM `+table+` WHERE id = $1 AND deleted_at IS NULL)`,
+		id,
+	).Scan(&exists)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if exists {
+		return ErrVersionConflict
+	}
+
+	return ErrNotFound
+}
+
+// syncLessonDecksTx ensures lesson_decks are in sync with deck references in lesson body
+func syncLessonDecksTx(ctx context.Context, tx *sql.Tx, lesson Lesson) error {
+	deckIDs := ParseDeckReferences(lesson.Body)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM lesson_decks WHERE lesson_id = $1`, lesson.ID); err != nil {
+		return err
+	}
+
+	for i, deckID := range deckIDs {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO lesson_decks (id, lesson_id, deck_id, "order", created_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			uuid.New(), lesson.ID, deckID, i, time.Now().UTC(),
+		)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	return nil
+}
+
+// GetUserCourseProgress retrieves a user's progress in a course
+func (r *pgRepository) GetUserCourseProgress(ctx context.Context, userID uuid.UUID, courseID uuid.UUID) (UserCourseProgress, error) {
+	var progress UserCourseProgress
+	var stateJSON []byte
+
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, user_id, course_id, current_lesson, state, created_at, updated_at 
+		 FROM user_course_progress WHERE user_id = $1 AND course_id = $2`,
+		userID, courseID,
+	).Scan(&progress.ID, &progress.UserID, &progress.CourseID, &progress.CurrentLessonID, &stateJSON, &progress.CreatedAt, &progress.UpdatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return UserCourseProgress{}, errors.Trace(ErrUserProgressNotFound)
+	}
+	if err != nil {
+		return UserCourseProgress{}, errors.Trace(err)
+	}
+
+	progress.State = &ProgressState{}
+	if err := json.Unmarshal(stateJSON, progress.State); err != nil {
+		return UserCourseProgress{}, errors.Trace(err)
+	}
+
+	return progress, nil
+}
+
+// GetEnrolledUserIDs retrieves the IDs of the users enrolled in a course
+func (r *pgRepository) GetEnrolledUserIDs(ctx context.Context, courseID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT user_id FROM user_course_progress WHERE course_id = $1 ORDER BY created_at`,
+		courseID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return userIDs, nil
 }
 
 // EnrollUserInCourse enrolls a user in a course
@@ -802,7 +2163,7 @@ func (r *pgRepository) EnrollUserInCourse(ctx context.Context, userID uuid.UUID,
 	_, err = r.db.ExecContext(ctx,
 		`INSERT INTO user_course_progress (id, user_id, course_id, current_lesson, state, created_at, updated_at) 
 		 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
-		uuid.New(), userID, courseID, progressState.CurrentLessonID, initialState, time.Now().UTC(), time.Now().UTC(),
+		uuid.New(), userID, courseID, nullableID(progressState.CurrentLessonID), initialState, time.Now().UTC(), time.Now().UTC(),
 	)
 
 	return errors.Trace(err)
@@ -818,7 +2179,7 @@ func (r *pgRepository) UpdateUserProgress(ctx context.Context, progress UserCour
 	_, err = r.db.ExecContext(ctx,
 		`UPDATE user_course_progress SET state = $1, current_lesson = $2, updated_at = $3 
 		 WHERE user_id = $4 AND course_id = $5`,
-		stateJSON, progress.CurrentLessonID, time.Now().UTC(), progress.UserID, progress.CourseID,
+		stateJSON, nullableID(progress.CurrentLessonID), time.Now().UTC(), progress.UserID, progress.CourseID,
 	)
 
 	return errors.Trace(err)
@@ -220,6 +220,26 @@ func TestRepository_UpdateCourse(t *testing.T) {
 		assert.Equal(t, "", course.Title)
 	})
 
+	t.Run("expected_version", func(t *testing.T) {
+		id := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+		current, err := repo.GetCourse(context.Background(), id)
+		require.NoError(t, err)
+
+		newTitle := "Versioned Go"
+		course, err := repo.UpdateCourse(context.Background(), id, CourseUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &current.Version,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, current.Version+1, course.Version)
+
+		_, err = repo.UpdateCourse(context.Background(), id, CourseUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &current.Version,
+		})
+		assert.True(t, errors.Is(err, ErrVersionConflict))
+	})
+
 	t.Run("not_found", func(t *testing.T) {
 		id := uuid.MustParse("00000000-0000-0000-0000-000000000000")
 		newTitle := "Does not exist"
@@ -299,12 +319,22 @@ func TestRepository_UpdateLesson(t *testing.T) {
 		assert.Equal(t, int(newOrder), lesson.Order)
 	})
 
+	t.Run("update_order_taken", func(t *testing.T) {
+		id := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+		takenOrder := int64(2)
+
+		_, err := repo.UpdateLesson(context.Background(), id, LessonUpdates{
+			Order: &takenOrder,
+		})
+		assert.ErrorIs(t, err, ErrLessonOrderTaken)
+	})
+
 	t.Run("update_multiple_fields", func(t *testing.T) {
 		id := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
 		newTitle := "Concurrency Masterclass"
 		newDesc := "Deep dive into Go concurrency"
 		newBody := "Updated content ![deck](60766223-ff9f-4871-a497-f765c05a0c5e)"
-		newOrder := int64(5)
+		newOrder := int64(6)
 
 		lesson, err := repo.UpdateLesson(context.Background(), id, LessonUpdates{
 			Title:       &newTitle,
@@ -319,6 +349,38 @@ func TestRepository_UpdateLesson(t *testing.T) {
 		assert.Equal(t, int(newOrder), lesson.Order)
 	})
 
+	t.Run("expected_version", func(t *testing.T) {
+		id := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+		current, err := repo.GetLesson(context.Background(), id)
+		require.NoError(t, err)
+
+		newTitle := "Versioned Goroutines"
+		lesson, err := repo.UpdateLesson(context.Background(), id, LessonUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &current.Version,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, current.Version+1, lesson.Version)
+
+		_, err = repo.UpdateLesson(context.Background(), id, LessonUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &current.Version,
+		})
+		assert.True(t, errors.Is(err, ErrVersionConflict))
+	})
+
+	t.Run("expected_version_not_found", func(t *testing.T) {
+		id := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+		newTitle := "Does not exist"
+		version := 1
+
+		_, err := repo.UpdateLesson(context.Background(), id, LessonUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &version,
+		})
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
 	t.Run("not_found", func(t *testing.T) {
 		id := uuid.MustParse("00000000-0000-0000-0000-000000000000")
 		newTitle := "Does not exist"
@@ -331,6 +393,272 @@ func TestRepository_UpdateLesson(t *testing.T) {
 	})
 }
 
+func TestRepository_DeleteCourse(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+
+	t.Run("restore_not_deleted", func(t *testing.T) {
+		_, err := repo.RestoreCourse(ctx, courseID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("delete_and_restore", func(t *testing.T) {
+		deleted, err := repo.DeleteCourse(ctx, courseID)
+		require.NoError(t, err)
+		assert.NotNil(t, deleted.DeletedAt)
+		assert.Equal(t, 2, deleted.Version)
+
+		_, err = repo.GetCourse(ctx, courseID)
+		assert.Error(t, err)
+
+		_, err = repo.DeleteCourse(ctx, courseID)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		restored, err := repo.RestoreCourse(ctx, courseID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, 3, restored.Version)
+
+		_, err = repo.GetCourse(ctx, courseID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		_, err := repo.DeleteCourse(ctx, uuid.New())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestRepository_ReorderLessons(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	lessonIDs := []uuid.UUID{
+		uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c"),
+		uuid.MustParse("60766223-ff9f-4871-a497-f765c05a0c5e"),
+		uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		reordered := []uuid.UUID{lessonIDs[4], lessonIDs[1], lessonIDs[2], lessonIDs[3], lessonIDs[0]}
+
+		lessons, err := repo.ReorderLessons(ctx, courseID, reordered)
+		require.NoError(t, err)
+		require.Len(t, lessons, 5)
+
+		for i, lesson := range lessons {
+			assert.Equal(t, reordered[i], lesson.ID)
+			assert.Equal(t, i+1, lesson.Order)
+			assert.Empty(t, lesson.Body)
+		}
+
+		// Only the lessons that moved are updated
+		assert.Equal(t, 2, lessons[0].Version)
+		assert.Equal(t, 1, lessons[1].Version)
+	})
+
+	t.Run("closes_gaps", func(t *testing.T) {
+		_, err := h.db.Exec(`UPDATE lessons SET "order" = "order" * 10 WHERE course_id = $1`, courseID)
+		require.NoError(t, err)
+
+		lessons, err := repo.ReorderLessons(ctx, courseID, lessonIDs)
+		require.NoError(t, err)
+
+		for i, lesson := range lessons {
+			assert.Equal(t, lessonIDs[i], lesson.ID)
+			assert.Equal(t, i+1, lesson.Order)
+		}
+	})
+
+	t.Run("invalid_order", func(t *testing.T) {
+		for name, ids := range map[string][]uuid.UUID{
+			"missing":   lessonIDs[:4],
+			"duplicate": {lessonIDs[0], lessonIDs[0], lessonIDs[1], lessonIDs[2], lessonIDs[3]},
+			"unknown":   {lessonIDs[0], lessonIDs[1], lessonIDs[2], lessonIDs[3], uuid.New()},
+		} {
+			_, err := repo.ReorderLessons(ctx, courseID, ids)
+			assert.ErrorIs(t, err, ErrInvalidLessonOrder, name)
+		}
+
+		lessons, err := repo.GetLessonsByCourseID(ctx, courseID, pagination.NewOldestFirstPagination(pagination.WithFirst(10)), true)
+		require.NoError(t, err)
+		for i, edge := range lessons.Edges {
+			assert.Equal(t, lessonIDs[i], edge.Lesson.ID)
+		}
+	})
+
+	t.Run("moves_enrolled_users", func(t *testing.T) {
+		userID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+		require.NoError(t, repo.EnrollUserInCourse(ctx, userID, courseID, *newTestProgressState()))
+
+		_, err := repo.ReorderLessons(ctx, courseID, []uuid.UUID{lessonIDs[2], lessonIDs[0], lessonIDs[1], lessonIDs[3], lessonIDs[4]})
+		require.NoError(t, err)
+
+		progress, err := repo.GetUserCourseProgress(ctx, userID, courseID)
+		require.NoError(t, err)
+		assert.Equal(t, lessonIDs[2], progress.CurrentLessonID, "users move to their first incomplete lesson")
+	})
+
+	t.Run("course_not_found", func(t *testing.T) {
+		_, err := repo.ReorderLessons(ctx, uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestRepository_Modules(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	basics := Module{ID: uuid.New(), CourseID: courseID, Order: 1, Title: "Basics", Description: "First steps"}
+	advanced := Module{ID: uuid.New(), CourseID: courseID, Order: 2, Title: "Advanced", Description: "Going further"}
+
+	t.Run("store_and_get", func(t *testing.T) {
+		require.NoError(t, repo.StoreModule(ctx, basics))
+		require.NoError(t, repo.StoreModule(ctx, advanced))
+
+		module, err := repo.GetModule(ctx, basics.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Basics", module.Title)
+		assert.Equal(t, 1, module.Version)
+
+		modules, err := repo.GetModulesByCourseID(ctx, courseID)
+		require.NoError(t, err)
+		require.Len(t, modules, 2)
+		assert.Equal(t, basics.ID, modules[0].ID)
+		assert.Equal(t, advanced.ID, modules[1].ID)
+	})
+
+	t.Run("order_taken", func(t *testing.T) {
+		err := repo.StoreModule(ctx, Module{CourseID: courseID, Order: 1, Title: "Other", Description: "Other"})
+		assert.ErrorIs(t, err, ErrModuleOrderTaken)
+
+		order := int64(2)
+		_, err = repo.UpdateModule(ctx, courseID, basics.ID, ModuleUpdates{Order: &order})
+		assert.ErrorIs(t, err, ErrModuleOrderTaken)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		title := "The basics"
+		version := 1
+		module, err := repo.UpdateModule(ctx, courseID, basics.ID, ModuleUpdates{Title: &title, ExpectedVersion: &version})
+		require.NoError(t, err)
+		assert.Equal(t, "The basics", module.Title)
+		assert.Equal(t, 2, module.Version)
+
+		_, err = repo.UpdateModule(ctx, courseID, basics.ID, ModuleUpdates{Title: &title, ExpectedVersion: &version})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+	})
+
+	t.Run("move_lesson_into_module", func(t *testing.T) {
+		lesson, err := repo.UpdateLesson(ctx, lessonID, LessonUpdates{ModuleID: &basics.ID})
+		require.NoError(t, err)
+		assert.Equal(t, basics.ID, lesson.ModuleID)
+
+		lesson, err = repo.GetLesson(ctx, lessonID)
+		require.NoError(t, err)
+		assert.Equal(t, basics.ID, lesson.ModuleID)
+	})
+
+	t.Run("module_of_another_course", func(t *testing.T) {
+		other := Course{ID: uuid.New(), Order: 2, Title: "Other", Description: "Other"}
+		require.NoError(t, repo.StoreCourse(ctx, other))
+		foreign := Module{ID: uuid.New(), CourseID: other.ID, Order: 1, Title: "Foreign", Description: "Foreign"}
+		require.NoError(t, repo.StoreModule(ctx, foreign))
+
+		_, err := repo.UpdateLesson(ctx, lessonID, LessonUpdates{ModuleID: &foreign.ID})
+		assert.ErrorIs(t, err, ErrInvalidModule)
+
+		title := "Not yours"
+		version := 1
+		_, err = repo.UpdateModule(ctx, courseID, foreign.ID, ModuleUpdates{Title: &title, ExpectedVersion: &version})
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = repo.DeleteModule(ctx, courseID, foreign.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		lesson, err := repo.GetLesson(ctx, lessonID)
+		require.NoError(t, err)
+		assert.Equal(t, basics.ID, lesson.ModuleID)
+	})
+
+	t.Run("delete_not_empty", func(t *testing.T) {
+		_, err := repo.DeleteModule(ctx, courseID, basics.ID)
+		assert.ErrorIs(t, err, ErrModuleNotEmpty)
+	})
+
+	t.Run("delete_and_restore", func(t *testing.T) {
+		noModule := uuid.Nil
+		lesson, err := repo.UpdateLesson(ctx, lessonID, LessonUpdates{ModuleID: &noModule})
+		require.NoError(t, err)
+		assert.Equal(t, uuid.Nil, lesson.ModuleID)
+
+		deleted, err := repo.DeleteModule(ctx, courseID, basics.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, deleted.DeletedAt)
+
+		_, err = repo.GetModule(ctx, basics.ID)
+		assert.ErrorIs(t, err, ErrModuleNotFound)
+
+		_, err = repo.DeleteModule(ctx, courseID, basics.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// Its order was free to take meanwhile
+		taken := Module{ID: uuid.New(), CourseID: courseID, Order: 1, Title: "New basics", Description: "First steps"}
+		require.NoError(t, repo.StoreModule(ctx, taken))
+
+		restored, err := repo.RestoreModule(ctx, courseID, basics.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, 3, restored.Order)
+
+		_, err = repo.RestoreModule(ctx, courseID, basics.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("reorder", func(t *testing.T) {
+		modules, err := repo.GetModulesByCourseID(ctx, courseID)
+		require.NoError(t, err)
+		require.Len(t, modules, 3)
+
+		// The last lesson goes into the module about to go first
+		lastID := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+		_, err = repo.UpdateLesson(ctx, lastID, LessonUpdates{ModuleID: &modules[2].ID})
+		require.NoError(t, err)
+
+		reordered := []uuid.UUID{modules[2].ID, modules[0].ID, modules[1].ID}
+		modules, err = repo.ReorderModules(ctx, courseID, reordered)
+		require.NoError(t, err)
+		for i, module := range modules {
+			assert.Equal(t, reordered[i], module.ID)
+			assert.Equal(t, i+1, module.Order)
+		}
+
+		// and the lessons are renumbered module by module, the ones without
+		// a module last
+		lessons, err := repo.GetLessonsByCourseID(ctx, courseID, pagination.NewOldestFirstPagination(pagination.WithFirst(10)), true)
+		require.NoError(t, err)
+		require.Len(t, lessons.Edges, 5)
+		assert.Equal(t, lastID, lessons.Edges[0].Lesson.ID)
+		assert.Equal(t, lessonID, lessons.Edges[1].Lesson.ID)
+		for i, edge := range lessons.Edges {
+			assert.Equal(t, i+1, edge.Lesson.Order)
+		}
+
+		_, err = repo.ReorderModules(ctx, courseID, reordered[:2])
+		assert.ErrorIs(t, err, ErrInvalidModuleOrder)
+	})
+}
+
 func TestRepository_GetLesson(t *testing.T) {
 	h := newTestHarness(t)
 	repo := NewPGRepository(slog.Default(), h.db)
@@ -431,6 +759,59 @@ func TestRepository_GetLessonsByCourseID(t *testing.T) {
 	})
 }
 
+func TestRepository_DeleteLesson(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	userID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	pag := pagination.NewOldestFirstPagination(pagination.WithFirst(10))
+
+	// The user is at the lesson about to be deleted
+	require.NoError(t, repo.EnrollUserInCourse(ctx, userID, courseID, *newTestProgressState()))
+
+	_, err := repo.DeleteLesson(ctx, uuid.New(), lessonID)
+	assert.ErrorIs(t, err, ErrNotFound, "lessons of other courses are not found")
+
+	deleted, err := repo.DeleteLesson(ctx, courseID, lessonID)
+	require.NoError(t, err)
+	assert.NotNil(t, deleted.DeletedAt)
+	assert.Equal(t, courseID, deleted.CourseID)
+
+	_, err = repo.GetLesson(ctx, lessonID)
+	assert.Error(t, err)
+
+	conn, err := repo.GetLessonsByCourseID(ctx, courseID, pag, true)
+	require.NoError(t, err)
+	require.Len(t, conn.Edges, 4)
+
+	// and is moved to the first lesson they haven't completed
+	progress, err := repo.GetUserCourseProgress(ctx, userID, courseID)
+	require.NoError(t, err)
+	assert.Equal(t, conn.Edges[0].Lesson.ID, progress.CurrentLessonID)
+	assert.Equal(t, conn.Edges[0].Lesson.ID, progress.State.CurrentLessonID)
+
+	_, err = repo.DeleteLesson(ctx, courseID, lessonID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = repo.RestoreLesson(ctx, uuid.New(), lessonID)
+	assert.ErrorIs(t, err, ErrNotFound, "lessons of other courses are not found")
+
+	restored, err := repo.RestoreLesson(ctx, courseID, lessonID)
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+	assert.Equal(t, 6, restored.Order, "restored lessons go last")
+
+	conn, err = repo.GetLessonsByCourseID(ctx, courseID, pag, true)
+	require.NoError(t, err)
+	assert.Len(t, conn.Edges, 5)
+
+	_, err = repo.RestoreLesson(ctx, courseID, lessonID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
 func TestRepository_EnrollUserInCourse(t *testing.T) {
 	h := newTestHarness(t)
 	repo := NewPGRepository(slog.Default(), h.db)
@@ -456,6 +837,30 @@ func TestRepository_EnrollUserInCourse(t *testing.T) {
 	})
 }
 
+func TestRepository_GetEnrolledUserIDs(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	userIDs := []uuid.UUID{
+		uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+		uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
+	}
+
+	for _, userID := range userIDs {
+		require.NoError(t, repo.EnrollUserInCourse(ctx, userID, courseID, *newTestProgressState()))
+	}
+
+	got, err := repo.GetEnrolledUserIDs(ctx, courseID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, userIDs, got)
+
+	got, err = repo.GetEnrolledUserIDs(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
 func TestRepository_GetUserCourseProgress(t *testing.T) {
 	h := newTestHarness(t)
 	repo := NewPGRepository(slog.Default(), h.db)
@@ -589,6 +994,189 @@ type testHarness struct {
 	redisClient radix.Client
 }
 
+func TestRepository_ListCourses(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	// Go Fundamentals, order 1, is seeded with five lessons
+	for _, c := range []Course{
+		{ID: uuid.MustParse("cccccccc-cccc-cccc-cccc-cccccccccccc"), Order: 2, Title: "Advanced Go", Description: "Master advanced Go techniques", CreatedAt: time.Now()},
+		{ID: uuid.MustParse("dddddddd-dddd-dddd-dddd-dddddddddddd"), Order: 3, Title: "Rust 100%", Description: "Ownership and borrowing", CreatedAt: time.Now()},
+		{ID: uuid.MustParse("eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"), Order: 4, Title: "Deleted", Description: "Gone", CreatedAt: time.Now()},
+	} {
+		require.NoError(t, repo.StoreCourse(ctx, c))
+	}
+
+	_, err := h.db.Exec(`UPDATE courses SET deleted_at = NOW() WHERE id = 'eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee'`)
+	require.NoError(t, err)
+
+	titles := func(conn CatalogConnection) []string {
+		var out []string
+		for _, e := range conn.Edges {
+			out = append(out, e.Course.Course.Title)
+		}
+		return out
+	}
+
+	t.Run("all", func(t *testing.T) {
+		conn, err := repo.ListCourses(ctx, "", pagination.NewOldestFirstPagination(pagination.WithFirst(10)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go Fundamentals", "Advanced Go", "Rust 100%"}, titles(conn))
+		assert.Equal(t, 5, conn.Edges[0].Course.LessonCount)
+		assert.Equal(t, 0, conn.Edges[1].Course.LessonCount)
+		assert.False(t, conn.PageInfo.HasNextPage)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		conn, err := repo.ListCourses(ctx, "go", pagination.NewOldestFirstPagination(pagination.WithFirst(10)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go Fundamentals", "Advanced Go"}, titles(conn))
+
+		conn, err = repo.ListCourses(ctx, "BORROW", pagination.NewOldestFirstPagination(pagination.WithFirst(10)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Rust 100%"}, titles(conn))
+	})
+
+	t.Run("query_escapes_wildcards", func(t *testing.T) {
+		conn, err := repo.ListCourses(ctx, "%", pagination.NewOldestFirstPagination(pagination.WithFirst(10)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Rust 100%"}, titles(conn))
+	})
+
+	t.Run("pagination_forward_and_backward", func(t *testing.T) {
+		forward := pagination.NewOldestFirstPagination(pagination.WithFirst(2))
+
+		page1, err := repo.ListCourses(ctx, "", forward)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go Fundamentals", "Advanced Go"}, titles(page1))
+		assert.True(t, page1.PageInfo.HasNextPage)
+
+		forward.After = page1.PageInfo.EndCursor
+		page2, err := repo.ListCourses(ctx, "", forward)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Rust 100%"}, titles(page2))
+		assert.False(t, page2.PageInfo.HasNextPage)
+
+		backward := pagination.NewOldestFirstPagination(pagination.WithLast(2), pagination.WithBefore(page2.PageInfo.StartCursor))
+		page3, err := repo.ListCourses(ctx, "", backward)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go Fundamentals", "Advanced Go"}, titles(page3))
+		assert.False(t, page3.PageInfo.HasPreviousPage)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		p := pagination.NewOldestFirstPagination(pagination.WithFirst(2), pagination.WithAfter("not a cursor"))
+
+		_, err := repo.ListCourses(ctx, "", p)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestRepository_CourseGating(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+
+	t.Run("free_by_default", func(t *testing.T) {
+		c, err := repo.GetCourse(ctx, courseID)
+		require.NoError(t, err)
+		assert.Equal(t, GatingFree, c.GatingMode)
+		assert.Equal(t, 0, c.UnlockThreshold)
+	})
+
+	t.Run("threshold_needs_a_percentage", func(t *testing.T) {
+		mode := GatingThreshold
+
+		_, err := repo.UpdateCourse(ctx, courseID, CourseUpdates{GatingMode: &mode})
+		assert.ErrorIs(t, err, ErrInvalidGating)
+
+		threshold := 80
+		c, err := repo.UpdateCourse(ctx, courseID, CourseUpdates{GatingMode: &mode, UnlockThreshold: &threshold})
+		require.NoError(t, err)
+		assert.Equal(t, GatingThreshold, c.GatingMode)
+		assert.Equal(t, 80, c.UnlockThreshold)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		mode := GatingMode("whenever")
+		_, err := repo.UpdateCourse(ctx, courseID, CourseUpdates{GatingMode: &mode})
+		assert.ErrorIs(t, err, ErrInvalidGating)
+
+		threshold := 101
+		_, err = repo.UpdateCourse(ctx, courseID, CourseUpdates{UnlockThreshold: &threshold})
+		assert.ErrorIs(t, err, ErrInvalidGating)
+
+		err = repo.StoreCourse(ctx, Course{Title: "Gated", Description: "Gated", GatingMode: GatingThreshold})
+		assert.ErrorIs(t, err, ErrInvalidGating)
+	})
+}
+
+func TestRepository_CoursePrerequisites(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(slog.Default(), h.db)
+	ctx := context.Background()
+
+	basics := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	advanced := uuid.MustParse("cccccccc-cccc-cccc-cccc-cccccccccccc")
+	expert := uuid.MustParse("dddddddd-dddd-dddd-dddd-dddddddddddd")
+	require.NoError(t, repo.StoreCourse(ctx, Course{ID: advanced, Order: 2, Title: "Advanced Go", Description: "Advanced"}))
+	require.NoError(t, repo.StoreCourse(ctx, Course{ID: expert, Order: 3, Title: "Expert Go", Description: "Expert"}))
+
+	t.Run("set_and_get", func(t *testing.T) {
+		prerequisites, err := repo.SetCoursePrerequisites(ctx, expert, []uuid.UUID{advanced, basics})
+		require.NoError(t, err)
+		require.Len(t, prerequisites, 2)
+		assert.Equal(t, basics, prerequisites[0].ID)
+		assert.Equal(t, advanced, prerequisites[1].ID)
+
+		_, err = repo.SetCoursePrerequisites(ctx, advanced, []uuid.UUID{basics})
+		require.NoError(t, err)
+
+		prerequisites, err = repo.GetCoursePrerequisites(ctx, advanced)
+		require.NoError(t, err)
+		require.Len(t, prerequisites, 1)
+		assert.Equal(t, "Go Fundamentals", prerequisites[0].Title)
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		_, err := repo.SetCoursePrerequisites(ctx, basics, []uuid.UUID{basics})
+		assert.ErrorIs(t, err, ErrPrerequisiteCycle)
+
+		_, err = repo.SetCoursePrerequisites(ctx, basics, []uuid.UUID{expert})
+		assert.ErrorIs(t, err, ErrPrerequisiteCycle)
+
+		// The rejected prerequisites were rolled back
+		prerequisites, err := repo.GetCoursePrerequisites(ctx, basics)
+		require.NoError(t, err)
+		assert.Empty(t, prerequisites)
+	})
+
+	t.Run("unknown_course", func(t *testing.T) {
+		_, err := repo.SetCoursePrerequisites(ctx, expert, []uuid.UUID{uuid.New()})
+		assert.ErrorIs(t, err, ErrCourseNotFound)
+
+		_, err = repo.SetCoursePrerequisites(ctx, uuid.New(), []uuid.UUID{basics})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		prerequisites, err := repo.SetCoursePrerequisites(ctx, expert, nil)
+		require.NoError(t, err)
+		assert.Empty(t, prerequisites)
+	})
+
+	t.Run("deleted_prerequisites_are_left_out", func(t *testing.T) {
+		_, err := repo.DeleteCourse(ctx, basics)
+		require.NoError(t, err)
+
+		prerequisites, err := repo.GetCoursePrerequisites(ctx, advanced)
+		require.NoError(t, err)
+		assert.Empty(t, prerequisites)
+	})
+}
+
 func newTestProgressState() *ProgressState {
 	state := NewProgressState()
 	lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
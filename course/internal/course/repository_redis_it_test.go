@@ -357,6 +357,145 @@ func TestRedisRepository_UpdateLesson(t *testing.T) {
 	mockDB.AssertExpectations(t)
 }
 
+func TestRedisRepository_DeleteCourse(t *testing.T) {
+	h := newTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	courseID := uuid.New()
+	now := time.Now()
+	course := Course{ID: courseID, Title: "Go Fundamentals"}
+
+	// Pre-populate cache to verify invalidation
+	courseJSON, _ := json.Marshal(course)
+	key := "course:" + courseID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(courseJSON)))
+	assert.NoError(t, err)
+
+	course.DeletedAt = &now
+	mockDB.On("DeleteCourse", ctx, courseID).Return(course, nil)
+
+	result, err := repo.DeleteCourse(ctx, courseID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.DeletedAt)
+
+	// Verify cache was invalidated
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null, "Cache should be invalidated after delete")
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_DeleteLesson(t *testing.T) {
+	h := newTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	lessonID := uuid.New()
+	courseID := uuid.New()
+	userID := uuid.New()
+	now := time.Now()
+	lesson := Lesson{ID: lessonID, CourseID: courseID, Title: "Introduction", Body: "Content", Order: 1}
+
+	// Pre-populate cache to verify invalidation
+	lessonJSON, _ := json.Marshal(lesson)
+	key := "lesson:" + lessonID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(lessonJSON)))
+	assert.NoError(t, err)
+
+	progressKey := "user_progress:" + userID.String() + ":" + courseID.String()
+	err = h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", progressKey, 3600, "{}"))
+	assert.NoError(t, err)
+
+	lesson.DeletedAt = &now
+	mockDB.On("DeleteLesson", ctx, courseID, lessonID).Return(lesson, nil)
+	mockDB.On("GetEnrolledUserIDs", ctx, courseID).Return([]uuid.UUID{userID}, nil)
+
+	result, err := repo.DeleteLesson(ctx, courseID, lessonID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.DeletedAt)
+
+	// Verify cache was invalidated
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null, "Cache should be invalidated after delete")
+
+	// along with the progress of the users enrolled in the course
+	mb = radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", progressKey))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null, "Progress cache should be invalidated after delete")
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_GetModule_CacheHit(t *testing.T) {
+	h := newTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	module := Module{ID: uuid.New(), CourseID: uuid.New(), Order: 1, Title: "Basics", Description: "First steps"}
+
+	moduleJSON, _ := json.Marshal(module)
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", "module:"+module.ID.String(), 3600, string(moduleJSON)))
+	assert.NoError(t, err)
+
+	result, err := repo.GetModule(ctx, module.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, module.Title, result.Title)
+	mockDB.AssertNotCalled(t, "GetModule", ctx, module.ID)
+}
+
+func TestRedisRepository_ReorderModules(t *testing.T) {
+	h := newTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	courseID := uuid.New()
+	first := Module{ID: uuid.New(), CourseID: courseID, Order: 2, Title: "First"}
+	second := Module{ID: uuid.New(), CourseID: courseID, Order: 1, Title: "Second"}
+
+	// Pre-populate cache to verify invalidation
+	for _, module := range []Module{first, second} {
+		moduleJSON, _ := json.Marshal(module)
+		err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", "module:"+module.ID.String(), 3600, string(moduleJSON)))
+		assert.NoError(t, err)
+	}
+
+	first.Order, second.Order = 1, 2
+	mockDB.On("ReorderModules", ctx, courseID, []uuid.UUID{first.ID, second.ID}).Return([]Module{first, second}, nil)
+
+	_, err := repo.ReorderModules(ctx, courseID, []uuid.UUID{first.ID, second.ID})
+	assert.NoError(t, err)
+
+	// Verify cache was invalidated
+	for _, module := range []Module{first, second} {
+		var cached string
+		mb := radix.Maybe{Rcv: &cached}
+		err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", "module:"+module.ID.String()))
+		assert.NoError(t, err)
+		assert.True(t, mb.Null, "Cache should be invalidated after reorder")
+	}
+
+	mockDB.AssertExpectations(t)
+}
+
 func TestRedisRepository_GetUserCourseProgress_CacheHit(t *testing.T) {
 	h := newTestHarness(t)
 	mockDB := new(RepositoryMock)
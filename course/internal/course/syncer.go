@@ -3,6 +3,7 @@ package course
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/juju/errors"
@@ -13,6 +14,8 @@ import (
 
 type StateSyncer interface {
 	Sync(ctx context.Context, userID, courseID uuid.UUID) error
+	// SyncCourse syncs every user enrolled in the course
+	SyncCourse(ctx context.Context, courseID uuid.UUID) error
 	// AnswerCards()
 }
 
@@ -62,7 +65,10 @@ func (s *stateSyncer) Sync(ctx context.Context, userID, courseID uuid.UUID) erro
 			continue
 		}
 
-		// Lesson exists in progress state, check for new decks
+		// Lesson exists in progress state, it may have moved to another
+		// module, and may have new decks
+		state.Lessons[lesson.ID.String()].ModuleID = stateModuleID(lesson.ModuleID)
+
 		parsedDeckIDs := ParseDeckReferences(lesson.Body)
 		if len(parsedDeckIDs) == 0 {
 			log.Printf("course: no decks found for lesson %s during sync", lesson.ID.String())
@@ -92,6 +98,8 @@ func (s *stateSyncer) Sync(ctx context.Context, userID, courseID uuid.UUID) erro
 		return errors.Trace(err)
 	}
 
+	state.RollUpModules()
+
 	// Course lessons are ordered by order already
 	// Set current lesson to the first incompleted lesson
 	// If all lessons are completed, keep/set last completed lesson as current
@@ -134,9 +142,31 @@ func (s *stateSyncer) Sync(ctx context.Context, userID, courseID uuid.UUID) erro
 	return nil
 }
 
+// SyncCourse runs Sync for every user enrolled in the course, so that none
+// of them is left with a lesson that was deleted, or without one that was
+// restored. A user whose sync fails doesn't keep the others from syncing;
+// the last error is returned once all were tried.
+func (s *stateSyncer) SyncCourse(ctx context.Context, courseID uuid.UUID) error {
+	userIDs, err := s.repo.GetEnrolledUserIDs(ctx, courseID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var lastErr error
+	for _, userID := range userIDs {
+		if err := s.Sync(ctx, userID, courseID); err != nil {
+			log.Printf("course: failed to sync user %s in course %s: %v", userID.String(), courseID.String(), err)
+			lastErr = errors.Annotatef(err, "syncing user %s", userID.String())
+		}
+	}
+
+	return lastErr
+}
+
 // ensureLessonInState makes sure a lesson progress exists in the state and initializes it with all decks and cards.
 func (s *stateSyncer) ensureLessonInState(ctx context.Context, lesson Lesson, state *ProgressState) error {
 	state.Lessons[lesson.ID.String()] = &LessonProgress{
+		ModuleID:    stateModuleID(lesson.ModuleID),
 		Decks:       make(map[string]*DeckProgress),
 		IsCompleted: false,
 	}
@@ -248,11 +278,47 @@ func (s *stateSyncer) pruneState(ctx context.Context, courseLessons []Lesson, st
 
 			s.pruneRemovedCards(stateDeck, res.Deck.Cards)
 		}
+
+		s.completeIfDone(stateLesson)
 	}
 
 	return nil
 }
 
+// completeIfDone marks decks and the lesson completed when pruning removed
+// the last cards or decks keeping them from being so. Otherwise a learner
+// who completed every other card would be stuck on a card that is gone.
+func (s *stateSyncer) completeIfDone(stateLesson *LessonProgress) {
+	now := time.Now()
+
+	allDecksComplete := len(stateLesson.Decks) > 0
+	for _, stateDeck := range stateLesson.Decks {
+		if !stateDeck.IsCompleted && len(stateDeck.Cards) > 0 {
+			allCardsComplete := true
+			for _, card := range stateDeck.Cards {
+				if !card.IsCompleted {
+					allCardsComplete = false
+					break
+				}
+			}
+
+			if allCardsComplete {
+				stateDeck.IsCompleted = true
+				stateDeck.CompletedAt = &now
+			}
+		}
+
+		if !stateDeck.IsCompleted {
+			allDecksComplete = false
+		}
+	}
+
+	if allDecksComplete && !stateLesson.IsCompleted {
+		stateLesson.IsCompleted = true
+		stateLesson.CompletedAt = &now
+	}
+}
+
 // pruneRemovedDecks removes decks that are no longer part of the course content.
 func (s *stateSyncer) pruneRemovedDecks(stateLesson *LessonProgress, courseDeckIDs []string) {
 	currentDeckIDs := make(map[string]struct{}, len(courseDeckIDs))
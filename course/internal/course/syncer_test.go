@@ -3,6 +3,7 @@ package course
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -358,6 +359,111 @@ func TestSync_RemovesRemovedCard(t *testing.T) {
 	assert.Nil(t, userProgress.State.Lessons[lessonID.String()].Decks[deckID.String()].Cards[removedCardID.String()])
 }
 
+func TestSync_CompletesDeckWhenIncompleteCardIsRemoved(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	courseID := uuid.New()
+	lessonID := uuid.New()
+	nextLessonID := uuid.New()
+	deckID := uuid.New()
+	nextDeckID := uuid.New()
+	completedCardID := uuid.New()
+	removedCardID := uuid.New()
+	nextCardID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	state := NewProgressState()
+	state.Lessons[lessonID.String()] = &LessonProgress{
+		Decks: map[string]*DeckProgress{
+			deckID.String(): {
+				Cards: map[string]*CardProgress{
+					completedCardID.String(): {IsCompleted: true, CorrectAnswers: 1},
+					removedCardID.String():   {IncorrectAnswers: 2},
+				},
+			},
+		},
+	}
+	state.Lessons[nextLessonID.String()] = &LessonProgress{
+		Decks: map[string]*DeckProgress{
+			nextDeckID.String(): {
+				Cards: map[string]*CardProgress{
+					nextCardID.String(): {},
+				},
+			},
+		},
+	}
+
+	userProgress := UserCourseProgress{
+		State:           state,
+		CurrentLessonID: lessonID,
+	}
+
+	firstCursor, _ := pagination.ToCursor(LessonCursor{Order: 1})
+	secondCursor, _ := pagination.ToCursor(LessonCursor{Order: 2})
+	lessons := LessonsConnection{
+		Edges: []LessonEdge{
+			{
+				Lesson: Lesson{
+					ID:       lessonID,
+					CourseID: courseID,
+					Order:    1,
+					Title:    "Lesson 1",
+					Body:     "Content with ![deck](" + deckID.String() + ")",
+				},
+				Cursor: firstCursor,
+			},
+			{
+				Lesson: Lesson{
+					ID:       nextLessonID,
+					CourseID: courseID,
+					Order:    2,
+					Title:    "Lesson 2",
+					Body:     "Content with ![deck](" + nextDeckID.String() + ")",
+				},
+				Cursor: secondCursor,
+			},
+		},
+	}
+
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.Pagination{Kind: pagination.PaginationKindOldestFirst, First: 1000}, false).Return(lessons, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
+
+	mockDecksClient.On("GetDeck", ctx, &pbDeck.GetDeckRequest{DeckId: deckID.String()}).Return(&pbDeck.GetDeckResponse{
+		Deck: &pbDeck.Deck{
+			Id:    deckID.String(),
+			Title: "Test Deck",
+			Cards: []*pbDeck.Card{{Id: completedCardID.String(), Title: "Q1"}},
+		},
+	}, nil)
+	mockDecksClient.On("GetDeck", ctx, &pbDeck.GetDeckRequest{DeckId: nextDeckID.String()}).Return(&pbDeck.GetDeckResponse{
+		Deck: &pbDeck.Deck{
+			Id:    nextDeckID.String(),
+			Title: "Next Deck",
+			Cards: []*pbDeck.Card{{Id: nextCardID.String(), Title: "Q2"}},
+		},
+	}, nil)
+
+	syncer := NewStateSyncer(mockRepo, mockDecksClient)
+
+	err := syncer.Sync(ctx, userID, courseID)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+
+	lesson := userProgress.State.Lessons[lessonID.String()]
+	assert.Nil(t, lesson.Decks[deckID.String()].Cards[removedCardID.String()])
+	assert.True(t, lesson.Decks[deckID.String()].IsCompleted)
+	assert.NotNil(t, lesson.Decks[deckID.String()].CompletedAt)
+	assert.True(t, lesson.IsCompleted)
+	assert.NotNil(t, lesson.CompletedAt)
+
+	assert.False(t, userProgress.State.Lessons[nextLessonID.String()].IsCompleted)
+	assert.Equal(t, nextLessonID, userProgress.State.CurrentLessonID)
+}
+
 func TestSync_RemovesRemovedLesson(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
@@ -848,6 +954,74 @@ func TestSync_NoChangesWhenAlreadyInSync(t *testing.T) {
 	assert.Contains(t, userProgress.State.Lessons[lessonID.String()].Decks[deckID.String()].Cards, cardID.String())
 }
 
+func TestSync_RollsUpLessonsMovedToModules(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	courseID := uuid.New()
+	moduleID := uuid.New()
+	lesson1ID := uuid.New()
+	lesson2ID := uuid.New()
+	deckID := uuid.New()
+	cardID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	completedAt := time.Now()
+	state := NewProgressState()
+	for _, lessonID := range []uuid.UUID{lesson1ID, lesson2ID} {
+		state.Lessons[lessonID.String()] = &LessonProgress{
+			IsCompleted: true,
+			CompletedAt: &completedAt,
+			Decks: map[string]*DeckProgress{
+				deckID.String(): {
+					IsCompleted: true,
+					CompletedAt: &completedAt,
+					Cards: map[string]*CardProgress{
+						cardID.String(): {IsCompleted: true, CorrectAnswers: 1, CompletedAt: &completedAt},
+					},
+				},
+			},
+		}
+	}
+	// The lesson was in a module that no longer has it
+	state.Lessons[lesson2ID.String()].ModuleID = uuid.NewString()
+	state.RollUpModules()
+
+	userProgress := UserCourseProgress{State: state}
+
+	body := "Content with ![deck](" + deckID.String() + ")"
+	lessons := LessonsConnection{
+		Edges: []LessonEdge{
+			{Lesson: Lesson{ID: lesson1ID, CourseID: courseID, ModuleID: moduleID, Order: 1, Body: body}},
+			{Lesson: Lesson{ID: lesson2ID, CourseID: courseID, Order: 2, Body: body}},
+		},
+	}
+
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.Pagination{Kind: pagination.PaginationKindOldestFirst, First: 1000}, false).Return(lessons, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, userID, courseID).Return(userProgress, nil)
+	mockRepo.On("UpdateUserProgress", ctx, mock.Anything).Return(nil)
+
+	mockDecksClient.On("GetDeck", ctx, mock.Anything).Return(&pbDeck.GetDeckResponse{
+		Deck: &pbDeck.Deck{
+			Id:    deckID.String(),
+			Cards: []*pbDeck.Card{{Id: cardID.String()}},
+		},
+	}, nil)
+
+	syncer := NewStateSyncer(mockRepo, mockDecksClient)
+
+	err := syncer.Sync(ctx, userID, courseID)
+	require.NoError(t, err)
+
+	assert.Equal(t, moduleID.String(), state.Lessons[lesson1ID.String()].ModuleID)
+	assert.Empty(t, state.Lessons[lesson2ID.String()].ModuleID)
+	require.Len(t, state.Modules, 1)
+	assert.Equal(t, 1, state.Modules[moduleID.String()].LessonCount)
+	assert.True(t, state.IsModuleCompleted(moduleID.String()))
+	mockRepo.AssertExpectations(t)
+}
+
 // Helper function for test
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
@@ -857,3 +1031,78 @@ func contains(slice []string, item string) bool {
 	}
 	return false
 }
+
+func TestSyncCourse_MovesEveryUserOffDeletedLesson(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	syncedUserID := uuid.New()
+	failingUserID := uuid.New()
+	deletedLessonID := uuid.New()
+	remainingLessonID := uuid.New()
+	deckID := uuid.New()
+	cardID := uuid.New()
+
+	mockRepo := new(RepositoryMock)
+	mockDecksClient := new(MockDecksAPIClient)
+
+	state := NewProgressState()
+	state.CurrentLessonID = deletedLessonID
+	state.Lessons[deletedLessonID.String()] = &LessonProgress{
+		Decks: make(map[string]*DeckProgress),
+	}
+	state.Lessons[remainingLessonID.String()] = &LessonProgress{
+		Decks: map[string]*DeckProgress{
+			deckID.String(): {
+				Cards: map[string]*CardProgress{cardID.String(): {}},
+			},
+		},
+	}
+
+	userProgress := UserCourseProgress{
+		UserID:          syncedUserID,
+		CourseID:        courseID,
+		CurrentLessonID: deletedLessonID,
+		State:           state,
+	}
+
+	lessonCursor, _ := pagination.ToCursor(LessonCursor{Order: 2})
+	lessons := LessonsConnection{
+		Edges: []LessonEdge{
+			{
+				Lesson: Lesson{
+					ID:       remainingLessonID,
+					CourseID: courseID,
+					Order:    2,
+					Title:    "Lesson 2",
+					Body:     "Content with ![deck](" + deckID.String() + ")",
+				},
+				Cursor: lessonCursor,
+			},
+		},
+	}
+
+	mockRepo.On("GetEnrolledUserIDs", ctx, courseID).Return([]uuid.UUID{failingUserID, syncedUserID}, nil)
+	mockRepo.On("GetLessonsByCourseID", ctx, courseID, pagination.Pagination{Kind: pagination.PaginationKindOldestFirst, First: 1000}, false).Return(lessons, nil)
+	mockRepo.On("GetUserCourseProgress", ctx, failingUserID, courseID).Return(UserCourseProgress{}, ErrUserProgressNotFound)
+	mockRepo.On("GetUserCourseProgress", ctx, syncedUserID, courseID).Return(userProgress, nil)
+	mockRepo.On("UpdateUserProgress", ctx, mock.MatchedBy(func(ucp UserCourseProgress) bool {
+		return ucp.UserID == syncedUserID && ucp.CurrentLessonID == remainingLessonID
+	})).Return(nil)
+
+	mockDecksClient.On("GetDeck", ctx, mock.MatchedBy(func(req *pbDeck.GetDeckRequest) bool {
+		return req.DeckId == deckID.String()
+	})).Return(&pbDeck.GetDeckResponse{
+		Deck: &pbDeck.Deck{Id: deckID.String(), Title: "Test Deck", Cards: []*pbDeck.Card{{Id: cardID.String()}}},
+	}, nil)
+
+	syncer := NewStateSyncer(mockRepo, mockDecksClient)
+
+	err := syncer.SyncCourse(ctx, courseID)
+
+	assert.ErrorIs(t, err, ErrUserProgressNotFound)
+	mockRepo.AssertExpectations(t)
+	mockDecksClient.AssertExpectations(t)
+
+	assert.Equal(t, remainingLessonID, userProgress.State.CurrentLessonID)
+	assert.Nil(t, userProgress.State.Lessons[deletedLessonID.String()])
+}
@@ -2,6 +2,8 @@ package course
 
 import (
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 type ErrorKey string
@@ -17,8 +19,75 @@ var (
 	ErrorKeyNoDescription        ErrorKey = "NO_DESCRIPTION"
 	ErrorKeyNoBody               ErrorKey = "NO_BODY"
 	ErrorKeyNoDecksReferenced    ErrorKey = "NO_DECKS_REFERENCED"
+	ErrorKeyModuleNotFound       ErrorKey = "MODULE_NOT_FOUND"
+	ErrorKeyVersionConflict      ErrorKey = "VERSION_CONFLICT"
+	ErrorKeyInvalidCursor        ErrorKey = "INVALID_CURSOR"
+	ErrorKeyLessonOrderTaken     ErrorKey = "LESSON_ORDER_TAKEN"
+	ErrorKeyInvalidLessonOrder   ErrorKey = "INVALID_LESSON_ORDER"
+	ErrorKeyModuleOrderTaken     ErrorKey = "MODULE_ORDER_TAKEN"
+	ErrorKeyInvalidModuleOrder   ErrorKey = "INVALID_MODULE_ORDER"
+	ErrorKeyModuleNotEmpty       ErrorKey = "MODULE_NOT_EMPTY"
+	ErrorKeyInvalidModule        ErrorKey = "INVALID_MODULE"
+	ErrorKeyInvalidGating        ErrorKey = "INVALID_GATING"
+	ErrorKeyLessonLocked         ErrorKey = "LESSON_LOCKED"
+	ErrorKeyPrerequisitesNotMet  ErrorKey = "PREREQUISITES_NOT_MET"
+	ErrorKeyPrerequisiteCycle    ErrorKey = "PREREQUISITE_CYCLE"
+	ErrorKeyNoUpdates            ErrorKey = "NO_UPDATES"
+	ErrorKeyDeckNotFound         ErrorKey = "DECK_NOT_FOUND"
+	ErrorKeyDeckNotInLesson      ErrorKey = "DECK_NOT_IN_LESSON"
+	ErrorKeyCardNotInDeck        ErrorKey = "CARD_NOT_IN_DECK"
+	ErrorKeyStateNotInitialized  ErrorKey = "STATE_NOT_INITIALIZED"
 )
 
+// errorKeys are the keys of the errors of this package, which clients
+// should tell errors apart by rather than by their messages
+var errorKeys = map[error]ErrorKey{
+	ErrCourseNotFound:                  ErrorKeyCourseNotFound,
+	ErrLessonNotFound:                  ErrorKeyLessonNotFound,
+	ErrModuleNotFound:                  ErrorKeyModuleNotFound,
+	ErrUserProgressNotFound:            ErrorKeyUserProgressNotFound,
+	ErrInvalidCourse:                   ErrorKeyInvalidCourse,
+	ErrInvalidLesson:                   ErrorKeyInvalidLesson,
+	ErrUserAlreadyEnrolled:             ErrorKeyUserAlreadyEnrolled,
+	ErrNoTitle:                         ErrorKeyNoTitle,
+	ErrNoDescription:                   ErrorKeyNoDescription,
+	ErrNoBody:                          ErrorKeyNoBody,
+	ErrNoDecksReferenced:               ErrorKeyNoDecksReferenced,
+	ErrVersionConflict:                 ErrorKeyVersionConflict,
+	ErrInvalidCursor:                   ErrorKeyInvalidCursor,
+	ErrLessonOrderTaken:                ErrorKeyLessonOrderTaken,
+	ErrInvalidLessonOrder:              ErrorKeyInvalidLessonOrder,
+	ErrModuleOrderTaken:                ErrorKeyModuleOrderTaken,
+	ErrInvalidModuleOrder:              ErrorKeyInvalidModuleOrder,
+	ErrModuleNotEmpty:                  ErrorKeyModuleNotEmpty,
+	ErrInvalidModule:                   ErrorKeyInvalidModule,
+	ErrInvalidGating:                   ErrorKeyInvalidGating,
+	ErrLessonLocked:                    ErrorKeyLessonLocked,
+	ErrPrerequisitesNotMet:             ErrorKeyPrerequisitesNotMet,
+	ErrPrerequisiteCycle:               ErrorKeyPrerequisiteCycle,
+	ErrNoUpdates:                       ErrorKeyNoUpdates,
+	ErrDeckNotFound:                    ErrorKeyDeckNotFound,
+	ErrDeckNotInLesson:                 ErrorKeyDeckNotInLesson,
+	ErrCardNotInDeck:                   ErrorKeyCardNotInDeck,
+	ErrUnitProgressStateNotInitialized: ErrorKeyStateNotInitialized,
+}
+
+// KeyOf returns the key of err, which may be traced or annotated. It returns
+// false for errors that don't come from this package.
+func KeyOf(err error) (ErrorKey, bool) {
+	if key, ok := errorKeys[errors.Cause(err)]; ok {
+		return key, true
+	}
+
+	for e, key := range errorKeys {
+		if errors.Is(err, e) {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
 type ValidationErrors struct {
 	ErrorKeys []ErrorKey `json:"errors"`
 }
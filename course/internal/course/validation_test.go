@@ -3,6 +3,7 @@ package course
 import (
 	"testing"
 
+	"github.com/juju/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,3 +72,12 @@ func TestValidationErrors_Error_MultipleErrors(t *testing.T) {
 	errStr := ve.Error()
 	assert.JSONEq(t, `{"errors":["COURSE_NOT_FOUND","LESSON_NOT_FOUND","NO_TITLE"]}`, errStr)
 }
+
+func TestKeyOf(t *testing.T) {
+	key, ok := KeyOf(errors.Annotate(ErrPrerequisitesNotMet, "complete \"Go\" first"))
+	assert.True(t, ok)
+	assert.Equal(t, ErrorKeyPrerequisitesNotMet, key)
+
+	_, ok = KeyOf(errors.New("something else"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	course "github.com/XaviFP/toshokan/course/internal/course"
+)
+
+// errorDomain is the domain of the ErrorInfo details this service attaches
+// to its errors.
+const errorDomain = "course.toshokan"
+
+// reasonInvalidID is the reason of the errors of malformed IDs, which don't
+// come from the course package.
+const reasonInvalidID = "INVALID_ID"
+
+// statusCodes are the gRPC codes of the errors of the course package callers
+// can do something about. Errors left out are server errors.
+var statusCodes = map[error]codes.Code{
+	course.ErrCourseNotFound:                  codes.NotFound,
+	course.ErrLessonNotFound:                  codes.NotFound,
+	course.ErrModuleNotFound:                  codes.NotFound,
+	course.ErrUserProgressNotFound:            codes.NotFound,
+	course.ErrNoTitle:                         codes.InvalidArgument,
+	course.ErrNoDescription:                   codes.InvalidArgument,
+	course.ErrNoDecksReferenced:               codes.InvalidArgument,
+	course.ErrDeckNotFound:                    codes.InvalidArgument,
+	course.ErrInvalidCursor:                   codes.InvalidArgument,
+	course.ErrInvalidLessonOrder:              codes.InvalidArgument,
+	course.ErrInvalidModuleOrder:              codes.InvalidArgument,
+	course.ErrInvalidModule:                   codes.InvalidArgument,
+	course.ErrInvalidGating:                   codes.InvalidArgument,
+	course.ErrNoUpdates:                       codes.InvalidArgument,
+	course.ErrDeckNotInLesson:                 codes.InvalidArgument,
+	course.ErrCardNotInDeck:                   codes.InvalidArgument,
+	course.ErrUserAlreadyEnrolled:             codes.AlreadyExists,
+	course.ErrLessonOrderTaken:                codes.AlreadyExists,
+	course.ErrModuleOrderTaken:                codes.AlreadyExists,
+	course.ErrVersionConflict:                 codes.FailedPrecondition,
+	course.ErrModuleNotEmpty:                  codes.FailedPrecondition,
+	course.ErrPrerequisiteCycle:               codes.FailedPrecondition,
+	course.ErrUnitProgressStateNotInitialized: codes.FailedPrecondition,
+	course.ErrLessonLocked:                    codes.PermissionDenied,
+	course.ErrPrerequisitesNotMet:             codes.PermissionDenied,
+}
+
+// statusError returns a status with code and the message of err. Errors of
+// the course package carry their key as the reason of an ErrorInfo detail,
+// and field, if given, goes in a BadRequest detail, so clients can tell
+// errors apart without parsing their messages.
+func statusError(code codes.Code, err error, field ...string) error {
+	key, _ := course.KeyOf(err)
+
+	fields := make([]*errdetails.BadRequest_FieldViolation, 0, len(field))
+	for _, f := range field {
+		fields = append(fields, &errdetails.BadRequest_FieldViolation{
+			Field:       f,
+			Description: err.Error(),
+			Reason:      string(key),
+		})
+	}
+
+	return withDetails(status.New(code, err.Error()), string(key), fields)
+}
+
+// courseStatus maps err to a gRPC status, returning nil for unexpected
+// errors. Statuses from the services this one calls, like the grades of the
+// deck service, are passed on as they are.
+func courseStatus(err error) error {
+	for e, code := range statusCodes {
+		if errors.Is(err, e) {
+			return statusError(code, err)
+		}
+	}
+
+	if st, ok := status.FromError(errors.Cause(err)); ok && len(st.Details()) > 0 {
+		return st.Err()
+	}
+
+	return nil
+}
+
+// invalidIDError returns the error of a request whose field holds a
+// malformed ID, like "invalid course id" for course_id.
+func invalidIDError(field string) error {
+	name := field
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+
+	message := "invalid " + strings.ReplaceAll(name, "_", " ")
+
+	return withDetails(status.New(codes.InvalidArgument, message), reasonInvalidID, []*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: message, Reason: reasonInvalidID},
+	})
+}
+
+// withDetails attaches an ErrorInfo with reason, unless it's empty, and a
+// BadRequest with fields, unless there are none, to st.
+func withDetails(st *status.Status, reason string, fields []*errdetails.BadRequest_FieldViolation) error {
+	var details []protoadapt.MessageV1
+
+	if reason != "" {
+		details = append(details, &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain})
+	}
+
+	if len(fields) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: fields})
+	}
+
+	if len(details) == 0 {
+		return st.Err()
+	}
+
+	// Details only fail to be attached to OK statuses, which are never errors
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
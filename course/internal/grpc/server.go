@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"log/slog"
 	"net"
@@ -11,6 +12,8 @@ import (
 	"github.com/juju/errors"
 	"github.com/tilinna/clock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/XaviFP/toshokan/common/pagination"
@@ -119,30 +122,53 @@ func toProtoLessonState(lessonStateMap course.LessonState) map[string]*pb.Lesson
 	return pbLessonState
 }
 
+// fromGRPCMultiAnswer converts the answers to multiple choice, ordering and
+// matching cards, reporting malformed IDs by their path under field
+func fromGRPCMultiAnswer(cardID uuid.UUID, ca *pb.CardAnswer, field string) (course.CardAnswer, error) {
+	out := course.CardAnswer{CardID: cardID}
+
+	for _, idStr := range ca.AnswerIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return course.CardAnswer{}, invalidIDError(field + ".answer_ids")
+		}
+		out.AnswerIDs = append(out.AnswerIDs, id)
+	}
+
+	for _, p := range ca.Pairs {
+		left, err := uuid.Parse(p.LeftId)
+		if err != nil {
+			return course.CardAnswer{}, invalidIDError(field + ".pairs.left_id")
+		}
+		right, err := uuid.Parse(p.RightId)
+		if err != nil {
+			return course.CardAnswer{}, invalidIDError(field + ".pairs.right_id")
+		}
+		out.Pairs = append(out.Pairs, course.AnswerPair{LeftID: left, RightID: right})
+	}
+
+	return out, nil
+}
+
 // GetCourse retrieves a course by ID
 func (s *Server) GetCourse(ctx context.Context, req *pb.GetCourseRequest) (*pb.GetCourseResponse, error) {
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("GetCourse: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	course, err := s.Repository.GetCourse(ctx, courseID)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetCourse: failed to get course from repository", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
 	return &pb.GetCourseResponse{
-		Course: &pb.Course{
-			Id:          course.ID.String(),
-			Order:       course.Order,
-			Title:       course.Title,
-			Description: course.Description,
-			CreatedAt:   toProtoTimestamp(course.CreatedAt),
-			UpdatedAt:   toProtoTimestampPtr(course.UpdatedAt),
-			DeletedAt:   toProtoTimestampPtr(course.DeletedAt),
-		},
+		Course: courseToProto(&course),
 	}, nil
 }
 
@@ -151,11 +177,14 @@ func (s *Server) GetLesson(ctx context.Context, req *pb.GetLessonRequest) (*pb.G
 	lessonID, err := uuid.Parse(req.LessonId)
 	if err != nil {
 		slog.Error("GetLesson: failed to parse lesson ID", "error", err, "lessonId", req.LessonId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("lesson_id")
 	}
 
 	lesson, err := s.Repository.GetLesson(ctx, lessonID)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetLesson: failed to get lesson from repository", "error", err, "lessonId", lessonID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -164,6 +193,7 @@ func (s *Server) GetLesson(ctx context.Context, req *pb.GetLessonRequest) (*pb.G
 		Lesson: &pb.Lesson{
 			Id:          lesson.ID.String(),
 			CourseId:    lesson.CourseID.String(),
+			ModuleId:    moduleIDToProto(lesson.ModuleID),
 			Order:       int64(lesson.Order),
 			Title:       lesson.Title,
 			Description: lesson.Description,
@@ -171,6 +201,7 @@ func (s *Server) GetLesson(ctx context.Context, req *pb.GetLessonRequest) (*pb.G
 			CreatedAt:   toProtoTimestamp(lesson.CreatedAt),
 			UpdatedAt:   toProtoTimestampPtr(lesson.UpdatedAt),
 			DeletedAt:   toProtoTimestampPtr(lesson.DeletedAt),
+			Version:     int32(lesson.Version),
 		},
 	}, nil
 }
@@ -180,7 +211,7 @@ func (s *Server) GetLessons(ctx context.Context, req *pb.GetLessonsRequest) (*pb
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("GetLessons: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	p := pagination.NewOldestFirstPagination(
@@ -193,6 +224,9 @@ func (s *Server) GetLessons(ctx context.Context, req *pb.GetLessonsRequest) (*pb
 	// Public endpoint - no user context
 	result, err := s.LessonsBrowser.Browse(ctx, courseID, p, course.BrowseOptions{})
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetLessons: failed to browse lessons", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -223,13 +257,13 @@ func (s *Server) GetFocusedLessons(ctx context.Context, req *pb.GetFocusedLesson
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("GetFocusedLessons: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("GetFocusedLessons: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
 	}
 
 	p := pagination.NewOldestFirstPagination(
@@ -245,6 +279,9 @@ func (s *Server) GetFocusedLessons(ctx context.Context, req *pb.GetFocusedLesson
 		Bodyless: req.Bodyless,
 	})
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetFocusedLessons: failed to browse lessons", "error", err, "courseId", courseID.String(), "userId", userID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -266,11 +303,22 @@ func (s *Server) GetFocusedLessons(ctx context.Context, req *pb.GetFocusedLesson
 				Lesson:      lessonToProto(&edge.Lesson.Lesson),
 				IsCompleted: edge.Lesson.IsCompleted,
 				IsCurrent:   edge.Lesson.IsCurrent,
+				IsLocked:    edge.Lesson.IsLocked,
 			},
 			Cursor: string(edge.Cursor),
 		})
 	}
 
+	for i := range result.ProgressLessons.Modules {
+		module := result.ProgressLessons.Modules[i]
+		resp.Modules = append(resp.Modules, &pb.ModuleWithProgress{
+			Module:           moduleToProto(&module.Module),
+			LessonCount:      int32(module.LessonCount),
+			CompletedLessons: int32(module.CompletedLessons),
+			IsCompleted:      module.IsCompleted,
+		})
+	}
+
 	return resp, nil
 }
 
@@ -279,7 +327,7 @@ func (s *Server) GetEnrolledCourses(ctx context.Context, req *pb.GetEnrolledCour
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("GetEnrolledCourses: failed to parse user ID", "error", err, "userId", req.UserId, "userIdLen", len(req.UserId), "stack", errors.ErrorStack(err))
-		return nil, errors.Annotatef(err, "invalid user_id: %q", req.UserId)
+		return nil, invalidIDError("user_id")
 	}
 
 	p := pagination.NewOldestFirstPagination(
@@ -291,6 +339,9 @@ func (s *Server) GetEnrolledCourses(ctx context.Context, req *pb.GetEnrolledCour
 
 	conn, err := s.CoursesBrowser.BrowseEnrolled(ctx, userID, p)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetEnrolledCourses: failed to browse enrolled courses", "error", err, "userId", userID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -319,15 +370,60 @@ func (s *Server) GetEnrolledCourses(ctx context.Context, req *pb.GetEnrolledCour
 	return resp, nil
 }
 
+func (s *Server) ListCourses(ctx context.Context, req *pb.ListCoursesRequest) (*pb.ListCoursesResponse, error) {
+	// The catalog is public, so requests without pagination are let through
+	p := pagination.NewOldestFirstPagination(
+		pagination.WithFirst(int(req.GetPagination().GetFirst())),
+		pagination.WithLast(int(req.GetPagination().GetLast())),
+		pagination.WithAfter(pagination.Cursor(req.GetPagination().GetAfter())),
+		pagination.WithBefore(pagination.Cursor(req.GetPagination().GetBefore())),
+	)
+
+	conn, err := s.CoursesBrowser.BrowseCatalog(ctx, req.Query, p)
+	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("ListCourses: failed to browse the catalog", "error", err, "query", req.Query, "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	resp := &pb.ListCoursesResponse{
+		Courses: &pb.CatalogConnection{
+			PageInfo: &pb.PageInfo{
+				HasPreviousPage: conn.PageInfo.HasPreviousPage,
+				HasNextPage:     conn.PageInfo.HasNextPage,
+				StartCursor:     conn.PageInfo.StartCursor.String(),
+				EndCursor:       conn.PageInfo.EndCursor.String(),
+			},
+		},
+	}
+
+	for _, edge := range conn.Edges {
+		resp.Courses.Edges = append(resp.Courses.Edges, &pb.CatalogConnection_Edge{
+			Node: &pb.CatalogCourse{
+				Course:      courseToProto(&edge.Course.Course),
+				LessonCount: int32(edge.Course.LessonCount),
+			},
+			Cursor: string(edge.Cursor),
+		})
+	}
+
+	return resp, nil
+}
+
 func courseToProto(c *course.Course) *pb.Course {
 	return &pb.Course{
-		Id:          c.ID.String(),
-		Order:       c.Order,
-		Title:       c.Title,
-		Description: c.Description,
-		CreatedAt:   toProtoTimestamp(c.CreatedAt),
-		UpdatedAt:   toProtoTimestampPtr(c.UpdatedAt),
-		DeletedAt:   toProtoTimestampPtr(c.DeletedAt),
+		Id:              c.ID.String(),
+		Order:           c.Order,
+		Title:           c.Title,
+		Description:     c.Description,
+		CreatedAt:       toProtoTimestamp(c.CreatedAt),
+		UpdatedAt:       toProtoTimestampPtr(c.UpdatedAt),
+		DeletedAt:       toProtoTimestampPtr(c.DeletedAt),
+		Version:         int32(c.Version),
+		GatingMode:      string(c.GatingMode),
+		UnlockThreshold: int32(c.UnlockThreshold),
 	}
 }
 
@@ -335,30 +431,74 @@ func lessonToProto(l *course.Lesson) *pb.Lesson {
 	return &pb.Lesson{
 		Id:          l.ID.String(),
 		CourseId:    l.CourseID.String(),
+		ModuleId:    moduleIDToProto(l.ModuleID),
 		Order:       int64(l.Order),
 		Title:       l.Title,
 		Description: l.Description,
 		Body:        l.Body,
 		CreatedAt:   timestamppb.New(l.CreatedAt),
+		UpdatedAt:   toProtoTimestampPtr(l.UpdatedAt),
+		DeletedAt:   toProtoTimestampPtr(l.DeletedAt),
+		Version:     int32(l.Version),
 	}
 }
 
+func moduleToProto(m *course.Module) *pb.Module {
+	return &pb.Module{
+		Id:          m.ID.String(),
+		CourseId:    m.CourseID.String(),
+		Order:       int64(m.Order),
+		Title:       m.Title,
+		Description: m.Description,
+		CreatedAt:   toProtoTimestamp(m.CreatedAt),
+		UpdatedAt:   toProtoTimestampPtr(m.UpdatedAt),
+		DeletedAt:   toProtoTimestampPtr(m.DeletedAt),
+		Version:     int32(m.Version),
+	}
+}
+
+// moduleIDToProto leaves the module of lessons without one empty
+func moduleIDToProto(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+// moduleIDFromProto parses the module of a lesson, where empty is none
+func moduleIDFromProto(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+
+	moduleID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, invalidIDError("module_id")
+	}
+
+	return moduleID, nil
+}
+
 // EnrollUser enrolls a user in a course
 func (s *Server) EnrollUser(ctx context.Context, req *pb.EnrollUserRequest) (*pb.EnrollUserResponse, error) {
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("EnrollUser: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
 	}
 
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("EnrollUser: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	_, err = s.Enroller.Enroll(ctx, userID, courseID)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("EnrollUser: failed to enroll user", "error", err, "userId", userID.String(), "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -371,17 +511,20 @@ func (s *Server) GetUserProgress(ctx context.Context, req *pb.GetUserProgressReq
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("GetUserProgress: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
 	}
 
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("GetUserProgress: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	progress, err := s.Repository.GetUserCourseProgress(ctx, userID, courseID)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetUserProgress: failed to get user course progress", "error", err, "userId", userID.String(), "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -406,36 +549,45 @@ func (s *Server) GetUserProgress(ctx context.Context, req *pb.GetUserProgressReq
 // CreateCourse creates a new course
 func (s *Server) CreateCourse(ctx context.Context, req *pb.CreateCourseRequest) (*pb.CreateCourseResponse, error) {
 	if req.Title == "" {
-		slog.Error("CreateCourse: missing title", "error", course.ErrNoTitle)
-		return nil, errors.Trace(course.ErrNoTitle)
+		return nil, statusError(codes.InvalidArgument, course.ErrNoTitle, "title")
 	}
 	if req.Description == "" {
-		slog.Error("CreateCourse: missing description", "error", course.ErrNoDescription)
-		return nil, errors.Trace(course.ErrNoDescription)
+		return nil, statusError(codes.InvalidArgument, course.ErrNoDescription, "description")
+	}
+
+	gatingMode := course.GatingMode(req.GatingMode)
+	if gatingMode == "" {
+		gatingMode = course.GatingFree
+	}
+	if err := course.ValidateGating(gatingMode, int(req.UnlockThreshold)); err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		return nil, statusError(codes.InvalidArgument, err, "gating_mode")
 	}
 
 	course := course.Course{
-		ID:          uuid.New(),
-		Order:       req.Order,
-		Title:       req.Title,
-		Description: req.Description,
-		CreatedAt:   time.Now(),
+		ID:              uuid.New(),
+		Order:           req.Order,
+		Title:           req.Title,
+		Description:     req.Description,
+		CreatedAt:       time.Now(),
+		Version:         1,
+		GatingMode:      gatingMode,
+		UnlockThreshold: int(req.UnlockThreshold),
 	}
 
 	err := s.Repository.StoreCourse(ctx, course)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("CreateCourse: failed to store course", "error", err, "courseId", course.ID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
 	return &pb.CreateCourseResponse{
-		Course: &pb.Course{
-			Id:          course.ID.String(),
-			Order:       course.Order,
-			Title:       course.Title,
-			Description: course.Description,
-			CreatedAt:   toProtoTimestamp(course.CreatedAt),
-		},
+		Course: courseToProto(&course),
 	}, nil
 }
 
@@ -444,50 +596,46 @@ func (s *Server) CreateLesson(ctx context.Context, req *pb.CreateLessonRequest)
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("CreateLesson: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	if req.Title == "" {
-		slog.Error("CreateLesson: missing title", "error", course.ErrNoTitle)
-		return nil, errors.Trace(course.ErrNoTitle)
+		return nil, statusError(codes.InvalidArgument, course.ErrNoTitle, "title")
 	}
 	if req.Description == "" {
-		slog.Error("CreateLesson: missing description", "error", course.ErrNoDescription)
-		return nil, errors.Trace(course.ErrNoDescription)
+		return nil, statusError(codes.InvalidArgument, course.ErrNoDescription, "description")
 	}
 
-	// Parse body for deck references
-	deckIDs := course.ParseDeckReferences(req.Body)
-
-	// Require at least one deck
-	if len(deckIDs) == 0 {
-		err := errors.New("lesson must reference at least one deck in the body using ![deck](uuid) format")
-		slog.Error("CreateLesson: no deck references found", "error", err)
-		return nil, errors.Trace(err)
+	moduleID, err := moduleIDFromProto(req.ModuleId)
+	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("CreateLesson: failed to parse module ID", "error", err, "moduleId", req.ModuleId, "stack", errors.ErrorStack(err))
+		return nil, err
 	}
 
-	// Validate that all decks exist
-	for _, deckID := range deckIDs {
-		deckReq := &pbDeck.GetDeckRequest{DeckId: deckID.String()}
-		_, err := s.DeckClient.GetDeck(ctx, deckReq)
-		if err != nil {
-			slog.Error("CreateLesson: deck does not exist", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
-			return nil, errors.Annotatef(err, "deck %s does not exist", deckID.String())
-		}
+	if err := s.checkDeckReferences(ctx, "CreateLesson", req.Body); err != nil {
+		return nil, err
 	}
 
 	lesson := course.Lesson{
 		ID:          uuid.New(),
 		CourseID:    courseID,
+		ModuleID:    moduleID,
 		Order:       int(req.Order),
 		Title:       req.Title,
 		Description: req.Description,
 		Body:        req.Body,
 		CreatedAt:   time.Now(),
+		Version:     1,
 	}
 
 	err = s.Repository.StoreLesson(ctx, lesson)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("CreateLesson: failed to store lesson", "error", err, "lessonId", lesson.ID.String(), "courseId", lesson.CourseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -496,21 +644,46 @@ func (s *Server) CreateLesson(ctx context.Context, req *pb.CreateLessonRequest)
 		Lesson: &pb.Lesson{
 			Id:          lesson.ID.String(),
 			CourseId:    lesson.CourseID.String(),
+			ModuleId:    moduleIDToProto(lesson.ModuleID),
 			Order:       int64(lesson.Order),
 			Title:       lesson.Title,
 			Description: lesson.Description,
 			Body:        lesson.Body,
 			CreatedAt:   toProtoTimestamp(lesson.CreatedAt),
+			Version:     int32(lesson.Version),
 		},
 	}, nil
 }
 
+// checkDeckReferences makes sure the body of a lesson references at least
+// one deck, using the ![deck](uuid) format, and that every deck it references
+// exists.
+func (s *Server) checkDeckReferences(ctx context.Context, op, body string) error {
+	deckIDs := course.ParseDeckReferences(body)
+	if len(deckIDs) == 0 {
+		return statusError(codes.InvalidArgument, course.ErrNoDecksReferenced, "body")
+	}
+
+	for _, deckID := range deckIDs {
+		_, err := s.DeckClient.GetDeck(ctx, &pbDeck.GetDeckRequest{DeckId: deckID.String()})
+		if status.Code(err) == codes.NotFound {
+			return statusError(codes.InvalidArgument, errors.Annotatef(course.ErrDeckNotFound, "deck %s", deckID.String()), "body")
+		}
+		if err != nil {
+			slog.Error(op+": failed to get referenced deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+			return errors.Trace(err)
+		}
+	}
+
+	return nil
+}
+
 // UpdateCourse updates a course with the provided fields
 func (s *Server) UpdateCourse(ctx context.Context, req *pb.UpdateCourseRequest) (*pb.UpdateCourseResponse, error) {
 	courseID, err := uuid.Parse(req.Id)
 	if err != nil {
 		slog.Error("UpdateCourse: failed to parse course ID", "error", err, "courseId", req.Id, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("id")
 	}
 
 	updates := course.CourseUpdates{
@@ -519,32 +692,39 @@ func (s *Server) UpdateCourse(ctx context.Context, req *pb.UpdateCourseRequest)
 		Description: req.Description,
 	}
 
+	if req.GatingMode != nil {
+		mode := course.GatingMode(*req.GatingMode)
+		updates.GatingMode = &mode
+	}
+
+	if req.UnlockThreshold != nil {
+		threshold := int(*req.UnlockThreshold)
+		updates.UnlockThreshold = &threshold
+	}
+
+	if req.ExpectedVersion != nil {
+		version := int(*req.ExpectedVersion)
+		updates.ExpectedVersion = &version
+	}
+
 	if !updates.HasUpdates() {
-		err := errors.New("no fields to update")
-		slog.Error("UpdateCourse: no fields provided", "error", err, "courseId", courseID.String())
-		return nil, errors.Trace(err)
+		return nil, statusError(codes.InvalidArgument, course.ErrNoUpdates)
 	}
 
 	updatedCourse, err := s.Repository.UpdateCourse(ctx, courseID, updates)
 	if err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			slog.Error("UpdateCourse: course not found", "error", err, "courseId", courseID.String())
-			return nil, errors.Trace(course.ErrCourseNotFound)
+			return nil, statusError(codes.NotFound, course.ErrCourseNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
 		}
 		slog.Error("UpdateCourse: failed to update course", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
 	return &pb.UpdateCourseResponse{
-		Course: &pb.Course{
-			Id:          updatedCourse.ID.String(),
-			Order:       updatedCourse.Order,
-			Title:       updatedCourse.Title,
-			Description: updatedCourse.Description,
-			CreatedAt:   toProtoTimestamp(updatedCourse.CreatedAt),
-			UpdatedAt:   toProtoTimestampPtr(updatedCourse.UpdatedAt),
-			DeletedAt:   toProtoTimestampPtr(updatedCourse.DeletedAt),
-		},
+		Course: courseToProto(&updatedCourse),
 	}, nil
 }
 
@@ -553,7 +733,7 @@ func (s *Server) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest)
 	lessonID, err := uuid.Parse(req.Id)
 	if err != nil {
 		slog.Error("UpdateLesson: failed to parse lesson ID", "error", err, "lessonId", req.Id, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("id")
 	}
 
 	updates := course.LessonUpdates{
@@ -563,46 +743,53 @@ func (s *Server) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest)
 		Body:        req.Body,
 	}
 
+	if req.ModuleId != nil {
+		moduleID, err := moduleIDFromProto(*req.ModuleId)
+		if err != nil {
+			slog.Error("UpdateLesson: failed to parse module ID", "error", err, "moduleId", *req.ModuleId, "stack", errors.ErrorStack(err))
+			return nil, err
+		}
+		updates.ModuleID = &moduleID
+	}
+
+	if req.ExpectedVersion != nil {
+		version := int(*req.ExpectedVersion)
+		updates.ExpectedVersion = &version
+	}
+
 	if !updates.HasUpdates() {
-		err := errors.New("no fields to update")
-		slog.Error("UpdateLesson: no fields provided", "error", err, "lessonId", lessonID.String())
-		return nil, errors.Trace(err)
+		return nil, statusError(codes.InvalidArgument, course.ErrNoUpdates)
 	}
 
 	if updates.Body != nil {
 		// Re-validate deck references if body is being updated
-		deckIDs := course.ParseDeckReferences(*updates.Body)
-		if len(deckIDs) == 0 {
-			err := errors.New("lesson must reference at least one deck in the body using ![deck](uuid) format")
-			slog.Error("UpdateLesson: no deck references found", "error", err)
-			return nil, errors.Trace(err)
-		}
-
-		// Validate that all decks exist
-		for _, deckID := range deckIDs {
-			deckReq := &pbDeck.GetDeckRequest{DeckId: deckID.String()}
-			_, err := s.DeckClient.GetDeck(ctx, deckReq)
-			if err != nil {
-				slog.Error("UpdateLesson: deck does not exist", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
-				return nil, errors.Annotatef(err, "deck %s does not exist", deckID.String())
-			}
+		if err := s.checkDeckReferences(ctx, "UpdateLesson", *updates.Body); err != nil {
+			return nil, err
 		}
 	}
 
 	updatedLesson, err := s.Repository.UpdateLesson(ctx, lessonID, updates)
 	if err != nil {
 		if errors.Is(err, course.ErrNotFound) {
-			slog.Error("UpdateLesson: lesson not found", "error", err, "lessonId", lessonID.String())
-			return nil, errors.Trace(course.ErrLessonNotFound)
+			return nil, statusError(codes.NotFound, course.ErrLessonNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
 		}
 		slog.Error("UpdateLesson: failed to update lesson", "error", err, "lessonId", lessonID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
+	if updates.ModuleID != nil {
+		// Module completion is rolled up from the lessons in it
+		s.syncCourse(ctx, "UpdateLesson", updatedLesson.CourseID)
+	}
+
 	return &pb.UpdateLessonResponse{
 		Lesson: &pb.Lesson{
 			Id:          updatedLesson.ID.String(),
 			CourseId:    updatedLesson.CourseID.String(),
+			ModuleId:    moduleIDToProto(updatedLesson.ModuleID),
 			Order:       int64(updatedLesson.Order),
 			Title:       updatedLesson.Title,
 			Description: updatedLesson.Description,
@@ -610,33 +797,536 @@ func (s *Server) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest)
 			CreatedAt:   toProtoTimestamp(updatedLesson.CreatedAt),
 			UpdatedAt:   toProtoTimestampPtr(updatedLesson.UpdatedAt),
 			DeletedAt:   toProtoTimestampPtr(updatedLesson.DeletedAt),
+			Version:     int32(updatedLesson.Version),
 		},
 	}, nil
 }
 
+// DeleteCourse hides a course until it is restored. Enrollments are kept,
+// but the course no longer shows among the enrolled ones.
+func (s *Server) DeleteCourse(ctx context.Context, req *pb.DeleteCourseRequest) (*pb.DeleteCourseResponse, error) {
+	courseID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("DeleteCourse: failed to parse course ID", "error", err, "courseId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	deletedCourse, err := s.Repository.DeleteCourse(ctx, courseID)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrCourseNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("DeleteCourse: failed to delete course", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.DeleteCourseResponse{Course: courseToProto(&deletedCourse)}, nil
+}
+
+// RestoreCourse brings back a deleted course
+func (s *Server) RestoreCourse(ctx context.Context, req *pb.RestoreCourseRequest) (*pb.RestoreCourseResponse, error) {
+	courseID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("RestoreCourse: failed to parse course ID", "error", err, "courseId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	restoredCourse, err := s.Repository.RestoreCourse(ctx, courseID)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrCourseNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("RestoreCourse: failed to restore course", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.RestoreCourseResponse{Course: courseToProto(&restoredCourse)}, nil
+}
+
+// DeleteLesson hides a lesson until it is restored, and takes it out of
+// the progress of the users enrolled in its course
+func (s *Server) DeleteLesson(ctx context.Context, req *pb.DeleteLessonRequest) (*pb.DeleteLessonResponse, error) {
+	lessonID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("DeleteLesson: failed to parse lesson ID", "error", err, "lessonId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("DeleteLesson: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	deletedLesson, err := s.Repository.DeleteLesson(ctx, courseID, lessonID)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrLessonNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("DeleteLesson: failed to delete lesson", "error", err, "lessonId", lessonID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	s.syncCourse(ctx, "DeleteLesson", courseID)
+
+	return &pb.DeleteLessonResponse{Lesson: lessonToProto(&deletedLesson)}, nil
+}
+
+// RestoreLesson brings back a deleted lesson, and adds it back to the
+// progress of the users enrolled in its course
+func (s *Server) RestoreLesson(ctx context.Context, req *pb.RestoreLessonRequest) (*pb.RestoreLessonResponse, error) {
+	lessonID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("RestoreLesson: failed to parse lesson ID", "error", err, "lessonId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("RestoreLesson: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	restoredLesson, err := s.Repository.RestoreLesson(ctx, courseID, lessonID)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrLessonNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("RestoreLesson: failed to restore lesson", "error", err, "lessonId", lessonID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	s.syncCourse(ctx, "RestoreLesson", courseID)
+
+	return &pb.RestoreLessonResponse{Lesson: lessonToProto(&restoredLesson)}, nil
+}
+
+// ReorderLessons numbers the lessons of a course in the order given, and
+// moves the users enrolled in it to their first incomplete lesson in the
+// new order
+func (s *Server) ReorderLessons(ctx context.Context, req *pb.ReorderLessonsRequest) (*pb.ReorderLessonsResponse, error) {
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("ReorderLessons: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	lessonIDs := make([]uuid.UUID, 0, len(req.LessonIds))
+	for i, id := range req.LessonIds {
+		lessonID, err := uuid.Parse(id)
+		if err != nil {
+			slog.Error("ReorderLessons: failed to parse lesson ID", "error", err, "lessonId", id, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError(fmt.Sprintf("lesson_ids[%d]", i))
+		}
+
+		lessonIDs = append(lessonIDs, lessonID)
+	}
+
+	lessons, err := s.Repository.ReorderLessons(ctx, courseID, lessonIDs)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrCourseNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("ReorderLessons: failed to reorder lessons", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	s.syncCourse(ctx, "ReorderLessons", courseID)
+
+	resp := &pb.ReorderLessonsResponse{Lessons: make([]*pb.Lesson, 0, len(lessons))}
+	for i := range lessons {
+		resp.Lessons = append(resp.Lessons, lessonToProto(&lessons[i]))
+	}
+
+	return resp, nil
+}
+
+// GetModule retrieves a module of a course by ID
+func (s *Server) GetModule(ctx context.Context, req *pb.GetModuleRequest) (*pb.GetModuleResponse, error) {
+	moduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("GetModule: failed to parse module ID", "error", err, "moduleId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("GetModule: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	module, err := s.Repository.GetModule(ctx, moduleID)
+	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("GetModule: failed to get module from repository", "error", err, "moduleId", moduleID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	if module.CourseID != courseID {
+		return nil, statusError(codes.NotFound, course.ErrModuleNotFound)
+	}
+
+	return &pb.GetModuleResponse{Module: moduleToProto(&module)}, nil
+}
+
+// CreateModule creates a new module in a course
+func (s *Server) CreateModule(ctx context.Context, req *pb.CreateModuleRequest) (*pb.CreateModuleResponse, error) {
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("CreateModule: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	if req.Title == "" {
+		return nil, statusError(codes.InvalidArgument, course.ErrNoTitle, "title")
+	}
+	if req.Description == "" {
+		return nil, statusError(codes.InvalidArgument, course.ErrNoDescription, "description")
+	}
+
+	// Modules can only be added to courses that exist
+	if _, err := s.Repository.GetCourse(ctx, courseID); err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("CreateModule: failed to get course", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	module := course.Module{
+		ID:          uuid.New(),
+		CourseID:    courseID,
+		Order:       int(req.Order),
+		Title:       req.Title,
+		Description: req.Description,
+		CreatedAt:   time.Now(),
+		Version:     1,
+	}
+
+	if err := s.Repository.StoreModule(ctx, module); err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("CreateModule: failed to store module", "error", err, "moduleId", module.ID.String(), "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.CreateModuleResponse{Module: moduleToProto(&module)}, nil
+}
+
+// UpdateModule updates a module with the provided fields
+func (s *Server) UpdateModule(ctx context.Context, req *pb.UpdateModuleRequest) (*pb.UpdateModuleResponse, error) {
+	moduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("UpdateModule: failed to parse module ID", "error", err, "moduleId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("UpdateModule: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	updates := course.ModuleUpdates{
+		Order:       req.Order,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+
+	if req.ExpectedVersion != nil {
+		version := int(*req.ExpectedVersion)
+		updates.ExpectedVersion = &version
+	}
+
+	if !updates.HasUpdates() {
+		return nil, statusError(codes.InvalidArgument, course.ErrNoUpdates)
+	}
+
+	updatedModule, err := s.Repository.UpdateModule(ctx, courseID, moduleID, updates)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrModuleNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("UpdateModule: failed to update module", "error", err, "moduleId", moduleID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.UpdateModuleResponse{Module: moduleToProto(&updatedModule)}, nil
+}
+
+// DeleteModule hides a module until it is restored. Its lessons must have
+// been moved out of it or deleted first.
+func (s *Server) DeleteModule(ctx context.Context, req *pb.DeleteModuleRequest) (*pb.DeleteModuleResponse, error) {
+	moduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("DeleteModule: failed to parse module ID", "error", err, "moduleId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("DeleteModule: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	deletedModule, err := s.Repository.DeleteModule(ctx, courseID, moduleID)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrModuleNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("DeleteModule: failed to delete module", "error", err, "moduleId", moduleID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.DeleteModuleResponse{Module: moduleToProto(&deletedModule)}, nil
+}
+
+// RestoreModule brings back a deleted module, without lessons
+func (s *Server) RestoreModule(ctx context.Context, req *pb.RestoreModuleRequest) (*pb.RestoreModuleResponse, error) {
+	moduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("RestoreModule: failed to parse module ID", "error", err, "moduleId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("RestoreModule: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	restoredModule, err := s.Repository.RestoreModule(ctx, courseID, moduleID)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrModuleNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("RestoreModule: failed to restore module", "error", err, "moduleId", moduleID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.RestoreModuleResponse{Module: moduleToProto(&restoredModule)}, nil
+}
+
+// ReorderModules numbers the modules of a course in the order given, and
+// its lessons module by module, moving the users enrolled in it to their
+// first incomplete lesson in the new order
+func (s *Server) ReorderModules(ctx context.Context, req *pb.ReorderModulesRequest) (*pb.ReorderModulesResponse, error) {
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("ReorderModules: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	moduleIDs := make([]uuid.UUID, 0, len(req.ModuleIds))
+	for i, id := range req.ModuleIds {
+		moduleID, err := uuid.Parse(id)
+		if err != nil {
+			slog.Error("ReorderModules: failed to parse module ID", "error", err, "moduleId", id, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError(fmt.Sprintf("module_ids[%d]", i))
+		}
+
+		moduleIDs = append(moduleIDs, moduleID)
+	}
+
+	modules, err := s.Repository.ReorderModules(ctx, courseID, moduleIDs)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrCourseNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("ReorderModules: failed to reorder modules", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	resp := &pb.ReorderModulesResponse{Modules: make([]*pb.Module, 0, len(modules))}
+	for i := range modules {
+		resp.Modules = append(resp.Modules, moduleToProto(&modules[i]))
+	}
+
+	return resp, nil
+}
+
+// GetCourseOutline retrieves a course with its modules and lessons, without
+// the lessons' body
+func (s *Server) GetCourseOutline(ctx context.Context, req *pb.GetCourseOutlineRequest) (*pb.GetCourseOutlineResponse, error) {
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("GetCourseOutline: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	outline, err := s.CoursesBrowser.Outline(ctx, courseID)
+	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("GetCourseOutline: failed to outline course", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	resp := &pb.CourseOutline{
+		Course:  courseToProto(&outline.Course),
+		Modules: make([]*pb.CourseOutline_ModuleOutline, 0, len(outline.Modules)),
+		Lessons: make([]*pb.Lesson, 0, len(outline.Lessons)),
+	}
+
+	for i := range outline.Modules {
+		module := &pb.CourseOutline_ModuleOutline{
+			Module:  moduleToProto(&outline.Modules[i].Module),
+			Lessons: make([]*pb.Lesson, 0, len(outline.Modules[i].Lessons)),
+		}
+		for j := range outline.Modules[i].Lessons {
+			module.Lessons = append(module.Lessons, lessonToProto(&outline.Modules[i].Lessons[j]))
+		}
+
+		resp.Modules = append(resp.Modules, module)
+	}
+
+	for i := range outline.Lessons {
+		resp.Lessons = append(resp.Lessons, lessonToProto(&outline.Lessons[i]))
+	}
+
+	return &pb.GetCourseOutlineResponse{Outline: resp}, nil
+}
+
+// GetCoursePrerequisites retrieves the courses to complete before enrolling
+// in a course
+func (s *Server) GetCoursePrerequisites(ctx context.Context, req *pb.GetCoursePrerequisitesRequest) (*pb.GetCoursePrerequisitesResponse, error) {
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("GetCoursePrerequisites: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	if _, err := s.Repository.GetCourse(ctx, courseID); err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("GetCoursePrerequisites: failed to get course", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	prerequisites, err := s.Repository.GetCoursePrerequisites(ctx, courseID)
+	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("GetCoursePrerequisites: failed to get prerequisites", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.GetCoursePrerequisitesResponse{Prerequisites: coursesToProto(prerequisites)}, nil
+}
+
+// SetCoursePrerequisites replaces the prerequisites of a course
+func (s *Server) SetCoursePrerequisites(ctx context.Context, req *pb.SetCoursePrerequisitesRequest) (*pb.SetCoursePrerequisitesResponse, error) {
+	courseID, err := uuid.Parse(req.CourseId)
+	if err != nil {
+		slog.Error("SetCoursePrerequisites: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("course_id")
+	}
+
+	prerequisiteIDs := make([]uuid.UUID, 0, len(req.PrerequisiteIds))
+	for i, id := range req.PrerequisiteIds {
+		prerequisiteID, err := uuid.Parse(id)
+		if err != nil {
+			slog.Error("SetCoursePrerequisites: failed to parse prerequisite ID", "error", err, "prerequisiteId", id, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError(fmt.Sprintf("prerequisite_ids[%d]", i))
+		}
+
+		prerequisiteIDs = append(prerequisiteIDs, prerequisiteID)
+	}
+
+	prerequisites, err := s.Repository.SetCoursePrerequisites(ctx, courseID, prerequisiteIDs)
+	if err != nil {
+		if errors.Is(err, course.ErrNotFound) {
+			return nil, statusError(codes.NotFound, course.ErrCourseNotFound)
+		}
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("SetCoursePrerequisites: failed to set prerequisites", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.SetCoursePrerequisitesResponse{Prerequisites: coursesToProto(prerequisites)}, nil
+}
+
+func coursesToProto(courses []course.Course) []*pb.Course {
+	out := make([]*pb.Course, 0, len(courses))
+	for i := range courses {
+		out = append(out, courseToProto(&courses[i]))
+	}
+
+	return out
+}
+
+// syncCourse syncs the progress of the users enrolled in a course after its
+// lessons changed. The change, which already moved the users whose current
+// lesson was deleted, is done by then, so failures are only logged: the
+// lessons and decks in the state of the users left behind catch up the next
+// time it is synced.
+func (s *Server) syncCourse(ctx context.Context, op string, courseID uuid.UUID) {
+	if err := s.StateSyncer.SyncCourse(ctx, courseID); err != nil {
+		slog.Error(op+": failed to sync enrolled users", "error", err, "courseId", courseID.String(), "stack", errors.ErrorStack(err))
+	}
+}
+
 // GetLessonState returns the completion state of a lesson and its decks
 func (s *Server) GetLessonState(ctx context.Context, req *pb.GetLessonStateRequest) (*pb.GetLessonStateResponse, error) {
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("GetLessonState: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	lessonID, err := uuid.Parse(req.LessonId)
 	if err != nil {
 		slog.Error("GetLessonState: failed to parse lesson ID", "error", err, "lessonId", req.LessonId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("lesson_id")
 	}
 
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("GetLessonState: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
 	}
 
 	// Get user progress
 	progress, err := s.Repository.GetUserCourseProgress(ctx, userID, courseID)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("GetLessonState: failed to get user course progress", "error", err, "userId", userID.String(), "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -655,38 +1345,55 @@ func (s *Server) AnswerCards(ctx context.Context, req *pb.AnswerCardsRequest) (*
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("AnswerCards: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
 	}
 
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("AnswerCards: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	lessonID, err := uuid.Parse(req.LessonId)
 	if err != nil {
 		slog.Error("AnswerCards: failed to parse lesson ID", "error", err, "lessonId", req.LessonId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("lesson_id")
 	}
 
 	deckID, err := uuid.Parse(req.DeckId)
 	if err != nil {
 		slog.Error("AnswerCards: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("deck_id")
 	}
 
 	cardAnswers := make([]course.CardAnswer, len(req.CardAnswers))
 	for i, ca := range req.CardAnswers {
+		field := fmt.Sprintf("card_answers[%d]", i)
+
 		cardID, err := uuid.Parse(ca.CardId)
 		if err != nil {
 			slog.Error("AnswerCards: failed to parse card ID", "error", err, "cardId", ca.CardId, "index", i, "stack", errors.ErrorStack(err))
-			return nil, errors.Trace(err)
+			return nil, invalidIDError(field + ".card_id")
+		}
+		if len(ca.TypedBlanks) > 0 {
+			cardAnswers[i] = course.CardAnswer{
+				CardID:      cardID,
+				TypedBlanks: ca.TypedBlanks,
+			}
+			continue
+		}
+		if len(ca.AnswerIds) > 0 || len(ca.Pairs) > 0 {
+			cardAnswers[i], err = fromGRPCMultiAnswer(cardID, ca, field)
+			if err != nil {
+				slog.Error("AnswerCards: failed to parse answer IDs", "error", err, "cardId", cardID.String(), "index", i, "stack", errors.ErrorStack(err))
+				return nil, err
+			}
+			continue
 		}
 		answerID, err := uuid.Parse(ca.AnswerId)
 		if err != nil {
 			slog.Error("AnswerCards: failed to parse answer ID", "error", err, "answerId", ca.AnswerId, "cardId", cardID.String(), "index", i, "stack", errors.ErrorStack(err))
-			return nil, errors.Trace(err)
+			return nil, invalidIDError(field + ".answer_id")
 		}
 		cardAnswers[i] = course.CardAnswer{
 			CardID:   cardID,
@@ -694,14 +1401,27 @@ func (s *Server) AnswerCards(ctx context.Context, req *pb.AnswerCardsRequest) (*
 		}
 	}
 
-	err = s.Answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
+	results, err := s.Answerer.Answer(ctx, userID, courseID, lessonID, deckID, cardAnswers)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("AnswerCards: failed to answer cards", "error", err, "userId", userID.String(), "courseId", courseID.String(), "lessonId", lessonID.String(), "deckId", deckID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
+	pbResults := make([]*pb.CardResult, 0, len(results))
+	for _, r := range results {
+		pbResults = append(pbResults, &pb.CardResult{
+			CardId:      r.CardID.String(),
+			IsCorrect:   r.IsCorrect,
+			Explanation: r.Explanation,
+		})
+	}
+
 	return &pb.AnswerCardsResponse{
 		Success: true,
+		Results: pbResults,
 	}, nil
 }
 
@@ -710,17 +1430,20 @@ func (s *Server) SyncState(ctx context.Context, req *pb.SyncStateRequest) (*pb.S
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("SyncState: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
 	}
 
 	courseID, err := uuid.Parse(req.CourseId)
 	if err != nil {
 		slog.Error("SyncState: failed to parse course ID", "error", err, "courseId", req.CourseId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("course_id")
 	}
 
 	err = s.StateSyncer.Sync(ctx, userID, courseID)
 	if err != nil {
+		if err := courseStatus(err); err != nil {
+			return nil, err
+		}
 		slog.Error("SyncState: failed to sync user course state", "error", err, "userId", userID.String(), "courseId", courseID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
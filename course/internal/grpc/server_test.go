@@ -8,7 +8,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/XaviFP/toshokan/common/pagination"
 	pb "github.com/XaviFP/toshokan/course/api/proto/v1"
@@ -152,6 +156,60 @@ func TestServer_CreateCourse(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
+
+	t.Run("gating", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		repoMock.On("StoreCourse", mock.Anything, mock.MatchedBy(func(c course.Course) bool {
+			return c.GatingMode == course.GatingThreshold && c.UnlockThreshold == 80
+		})).Return(nil)
+		srv := &Server{Repository: repoMock}
+
+		req := pb.CreateCourseRequest{
+			Title:           successTitle,
+			Description:     "Master the Go programming language",
+			GatingMode:      "threshold",
+			UnlockThreshold: 80,
+		}
+
+		res, err := srv.CreateCourse(context.Background(), &req)
+		assert.NoError(t, err)
+		assert.Equal(t, "threshold", res.Course.GatingMode)
+		assert.Equal(t, int32(80), res.Course.UnlockThreshold)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("gating_defaults_to_free", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		repoMock.On("StoreCourse", mock.Anything, mock.MatchedBy(func(c course.Course) bool {
+			return c.GatingMode == course.GatingFree
+		})).Return(nil)
+		srv := &Server{Repository: repoMock}
+
+		req := pb.CreateCourseRequest{
+			Title:       successTitle,
+			Description: "Master the Go programming language",
+		}
+
+		res, err := srv.CreateCourse(context.Background(), &req)
+		assert.NoError(t, err)
+		assert.Equal(t, "free", res.Course.GatingMode)
+	})
+
+	t.Run("invalid_gating", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		req := pb.CreateCourseRequest{
+			Title:       successTitle,
+			Description: "Master the Go programming language",
+			GatingMode:  "threshold",
+		}
+
+		res, err := srv.CreateCourse(context.Background(), &req)
+		assertStatus(t, err, codes.InvalidArgument, course.ErrInvalidGating)
+		assert.Nil(t, res)
+		repoMock.AssertNotCalled(t, "StoreCourse", mock.Anything, mock.Anything)
+	})
 }
 
 func TestServer_EnrollUser(t *testing.T) {
@@ -276,6 +334,74 @@ func TestServer_GetLessons(t *testing.T) {
 	repoMock.AssertExpectations(t)
 }
 
+func TestServer_ListCourses(t *testing.T) {
+	browserMock := &course.CoursesBrowserMock{}
+	srv := &Server{
+		CoursesBrowser: browserMock,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		c := course.Course{
+			ID:          uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"),
+			Order:       1,
+			Title:       "Go Fundamentals",
+			Description: "Learn the basics of Go",
+			CreatedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		conn := course.CatalogConnection{
+			Edges: []course.CatalogEdge{
+				{
+					Course: course.CatalogCourse{Course: c, LessonCount: 3},
+					Cursor: "cursor1",
+				},
+			},
+			PageInfo: pagination.PageInfo{
+				HasNextPage: true,
+				StartCursor: "cursor1",
+				EndCursor:   "cursor1",
+			},
+		}
+
+		expectedPagination := pagination.NewOldestFirstPagination(
+			pagination.WithFirst(1),
+		)
+
+		browserMock.On("BrowseCatalog", mock.Anything, "go", expectedPagination).Return(conn, nil).Once()
+
+		res, err := srv.ListCourses(context.Background(), &pb.ListCoursesRequest{
+			Query:      "go",
+			Pagination: &pb.Pagination{First: 1},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Courses.Edges, 1)
+		assert.Equal(t, c.Title, res.Courses.Edges[0].Node.Course.Title)
+		assert.Equal(t, int32(3), res.Courses.Edges[0].Node.LessonCount)
+		assert.Equal(t, "cursor1", res.Courses.Edges[0].Cursor)
+		assert.True(t, res.Courses.PageInfo.HasNextPage)
+	})
+
+	t.Run("without_pagination", func(t *testing.T) {
+		browserMock.On("BrowseCatalog", mock.Anything, "", pagination.NewOldestFirstPagination()).Return(course.CatalogConnection{}, nil).Once()
+
+		res, err := srv.ListCourses(context.Background(), &pb.ListCoursesRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Courses.Edges)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		browserMock.On("BrowseCatalog", mock.Anything, "", mock.Anything).Return(course.CatalogConnection{}, course.ErrInvalidCursor).Once()
+
+		res, err := srv.ListCourses(context.Background(), &pb.ListCoursesRequest{
+			Pagination: &pb.Pagination{First: 10, After: "bad"},
+		})
+		assertStatus(t, err, codes.InvalidArgument, course.ErrInvalidCursor)
+		assert.Nil(t, res)
+	})
+
+	browserMock.AssertExpectations(t)
+}
+
 func TestServer_GetUserProgress(t *testing.T) {
 	repoMock := &course.RepositoryMock{}
 	srv := &Server{Repository: repoMock}
@@ -517,7 +643,7 @@ func TestServer_UpdateCourse(t *testing.T) {
 		res, err := srv.UpdateCourse(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
-		assert.Contains(t, err.Error(), "no fields to update")
+		assertStatus(t, err, codes.InvalidArgument, course.ErrNoUpdates)
 	})
 
 	t.Run("error_course_not_found", func(t *testing.T) {
@@ -541,6 +667,30 @@ func TestServer_UpdateCourse(t *testing.T) {
 		repoMock.AssertExpectations(t)
 	})
 
+	t.Run("error_version_conflict", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+		newTitle := "Updated Title"
+		expected := 3
+		updates := course.CourseUpdates{Title: &newTitle, ExpectedVersion: &expected}
+
+		repoMock.On("UpdateCourse", mock.Anything, courseID, updates).Return(course.Course{}, course.ErrVersionConflict)
+
+		version := int32(3)
+		req := &pb.UpdateCourseRequest{
+			Id:              courseID.String(),
+			Title:           &newTitle,
+			ExpectedVersion: &version,
+		}
+
+		res, err := srv.UpdateCourse(context.Background(), req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Nil(t, res)
+		repoMock.AssertExpectations(t)
+	})
+
 	t.Run("error_invalid_course_id", func(t *testing.T) {
 		repoMock := &course.RepositoryMock{}
 		srv := &Server{Repository: repoMock}
@@ -726,7 +876,7 @@ func TestServer_UpdateLesson(t *testing.T) {
 		res, err := srv.UpdateLesson(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
-		assert.Contains(t, err.Error(), "lesson must reference at least one deck")
+		assertStatus(t, err, codes.InvalidArgument, course.ErrNoDecksReferenced)
 	})
 
 	t.Run("error_update_body_with_invalid_deck", func(t *testing.T) {
@@ -739,7 +889,7 @@ func TestServer_UpdateLesson(t *testing.T) {
 		newBody := "Content ![deck](" + deckID.String() + ")"
 
 		deckClientMock.On("GetDeck", mock.Anything, &pbDeck.GetDeckRequest{DeckId: deckID.String()}).
-			Return(nil, assert.AnError)
+			Return(nil, status.Error(codes.NotFound, "deck: deck not found"))
 
 		req := &pb.UpdateLessonRequest{
 			Id:   lessonID.String(),
@@ -749,7 +899,7 @@ func TestServer_UpdateLesson(t *testing.T) {
 		res, err := srv.UpdateLesson(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
-		assert.Contains(t, err.Error(), "does not exist")
+		assertStatus(t, err, codes.InvalidArgument, course.ErrDeckNotFound)
 		deckClientMock.AssertExpectations(t)
 	})
 
@@ -812,7 +962,7 @@ func TestServer_UpdateLesson(t *testing.T) {
 		res, err := srv.UpdateLesson(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
-		assert.Contains(t, err.Error(), "no fields to update")
+		assertStatus(t, err, codes.InvalidArgument, course.ErrNoUpdates)
 	})
 
 	t.Run("error_lesson_not_found", func(t *testing.T) {
@@ -836,6 +986,30 @@ func TestServer_UpdateLesson(t *testing.T) {
 		repoMock.AssertExpectations(t)
 	})
 
+	t.Run("error_version_conflict", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+		newTitle := "Updated Title"
+		expected := 2
+		updates := course.LessonUpdates{Title: &newTitle, ExpectedVersion: &expected}
+
+		repoMock.On("UpdateLesson", mock.Anything, lessonID, updates).Return(course.Lesson{}, course.ErrVersionConflict)
+
+		version := int32(2)
+		req := &pb.UpdateLessonRequest{
+			Id:              lessonID.String(),
+			Title:           &newTitle,
+			ExpectedVersion: &version,
+		}
+
+		res, err := srv.UpdateLesson(context.Background(), req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Nil(t, res)
+		repoMock.AssertExpectations(t)
+	})
+
 	t.Run("error_invalid_lesson_id", func(t *testing.T) {
 		repoMock := &course.RepositoryMock{}
 		srv := &Server{Repository: repoMock}
@@ -939,3 +1113,742 @@ func (m *DeckClientMock) UpdateAnswer(ctx context.Context, in *pbDeck.UpdateAnsw
 	}
 	return args.Get(0).(*pbDeck.UpdateAnswerResponse), args.Error(1)
 }
+
+func (m *DeckClientMock) GradeAnswers(ctx context.Context, in *pbDeck.GradeAnswersRequest, opts ...grpc.CallOption) (*pbDeck.GradeAnswersResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GradeAnswersResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetDeckAnalytics(ctx context.Context, in *pbDeck.GetDeckAnalyticsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckAnalyticsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDeckAnalyticsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetDeckCards(ctx context.Context, in *pbDeck.GetDeckCardsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckCardsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDeckCardsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetDecksCards(ctx context.Context, in *pbDeck.GetDecksCardsRequest, opts ...grpc.CallOption) (*pbDeck.GetDecksCardsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDecksCardsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) StreamDeckCards(ctx context.Context, in *pbDeck.StreamDeckCardsRequest, opts ...grpc.CallOption) (pbDeck.DecksAPI_StreamDeckCardsClient, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pbDeck.DecksAPI_StreamDeckCardsClient), args.Error(1)
+}
+
+func (m *DeckClientMock) AddToLibrary(ctx context.Context, in *pbDeck.AddToLibraryRequest, opts ...grpc.CallOption) (*pbDeck.AddToLibraryResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.AddToLibraryResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) RemoveFromLibrary(ctx context.Context, in *pbDeck.RemoveFromLibraryRequest, opts ...grpc.CallOption) (*pbDeck.RemoveFromLibraryResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RemoveFromLibraryResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetLibrary(ctx context.Context, in *pbDeck.GetLibraryRequest, opts ...grpc.CallOption) (*pbDeck.GetLibraryResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetLibraryResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetAuthoredDecks(ctx context.Context, in *pbDeck.GetAuthoredDecksRequest, opts ...grpc.CallOption) (*pbDeck.GetAuthoredDecksResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetAuthoredDecksResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) RateDeck(ctx context.Context, in *pbDeck.RateDeckRequest, opts ...grpc.CallOption) (*pbDeck.RateDeckResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RateDeckResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetDeckReviews(ctx context.Context, in *pbDeck.GetDeckReviewsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckReviewsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDeckReviewsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) ListDeckMembers(ctx context.Context, in *pbDeck.ListDeckMembersRequest, opts ...grpc.CallOption) (*pbDeck.ListDeckMembersResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ListDeckMembersResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) InviteDeckMember(ctx context.Context, in *pbDeck.InviteDeckMemberRequest, opts ...grpc.CallOption) (*pbDeck.InviteDeckMemberResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.InviteDeckMemberResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) ChangeDeckMemberRole(ctx context.Context, in *pbDeck.ChangeDeckMemberRoleRequest, opts ...grpc.CallOption) (*pbDeck.ChangeDeckMemberRoleResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ChangeDeckMemberRoleResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) RemoveDeckMember(ctx context.Context, in *pbDeck.RemoveDeckMemberRequest, opts ...grpc.CallOption) (*pbDeck.RemoveDeckMemberResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RemoveDeckMemberResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetSharedDecks(ctx context.Context, in *pbDeck.GetSharedDecksRequest, opts ...grpc.CallOption) (*pbDeck.GetSharedDecksResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetSharedDecksResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) DeleteCard(ctx context.Context, in *pbDeck.DeleteCardRequest, opts ...grpc.CallOption) (*pbDeck.DeleteCardResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DeleteCardResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) CreateAnswer(ctx context.Context, in *pbDeck.CreateAnswerRequest, opts ...grpc.CallOption) (*pbDeck.CreateAnswerResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.CreateAnswerResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) DeleteAnswer(ctx context.Context, in *pbDeck.DeleteAnswerRequest, opts ...grpc.CallOption) (*pbDeck.DeleteAnswerResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DeleteAnswerResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) ForkDeck(ctx context.Context, in *pbDeck.ForkDeckRequest, opts ...grpc.CallOption) (*pbDeck.ForkDeckResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ForkDeckResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetUpstreamChanges(ctx context.Context, in *pbDeck.GetUpstreamChangesRequest, opts ...grpc.CallOption) (*pbDeck.GetUpstreamChangesResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetUpstreamChangesResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) PullUpstreamChanges(ctx context.Context, in *pbDeck.PullUpstreamChangesRequest, opts ...grpc.CallOption) (*pbDeck.PullUpstreamChangesResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.PullUpstreamChangesResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) ListCardRevisions(ctx context.Context, in *pbDeck.ListCardRevisionsRequest, opts ...grpc.CallOption) (*pbDeck.ListCardRevisionsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ListCardRevisionsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) DiffCardRevisions(ctx context.Context, in *pbDeck.DiffCardRevisionsRequest, opts ...grpc.CallOption) (*pbDeck.DiffCardRevisionsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DiffCardRevisionsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) RestoreCardRevision(ctx context.Context, in *pbDeck.RestoreCardRevisionRequest, opts ...grpc.CallOption) (*pbDeck.RestoreCardRevisionResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RestoreCardRevisionResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) AutocompleteTags(ctx context.Context, in *pbDeck.AutocompleteTagsRequest, opts ...grpc.CallOption) (*pbDeck.AutocompleteTagsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.AutocompleteTagsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) GetTopics(ctx context.Context, in *pbDeck.GetTopicsRequest, opts ...grpc.CallOption) (*pbDeck.GetTopicsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetTopicsResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) CreateTopic(ctx context.Context, in *pbDeck.CreateTopicRequest, opts ...grpc.CallOption) (*pbDeck.CreateTopicResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.CreateTopicResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) DeleteTopic(ctx context.Context, in *pbDeck.DeleteTopicRequest, opts ...grpc.CallOption) (*pbDeck.DeleteTopicResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DeleteTopicResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) SetTagTopic(ctx context.Context, in *pbDeck.SetTagTopicRequest, opts ...grpc.CallOption) (*pbDeck.SetTagTopicResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.SetTagTopicResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) SearchDecks(ctx context.Context, in *pbDeck.SearchDecksRequest, opts ...grpc.CallOption) (*pbDeck.SearchDecksResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.SearchDecksResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) ImportDeck(ctx context.Context, in *pbDeck.ImportDeckRequest, opts ...grpc.CallOption) (*pbDeck.ImportDeckResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ImportDeckResponse), args.Error(1)
+}
+
+func (m *DeckClientMock) ExportDeck(ctx context.Context, in *pbDeck.ExportDeckRequest, opts ...grpc.CallOption) (*pbDeck.ExportDeckResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ExportDeckResponse), args.Error(1)
+}
+
+func TestServer_DeleteCourse(t *testing.T) {
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	deletedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteCourse", mock.Anything, courseID).Return(course.Course{
+			ID:        courseID,
+			Title:     "Go Fundamentals",
+			CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			DeletedAt: &deletedAt,
+			Version:   2,
+		}, nil)
+
+		res, err := srv.DeleteCourse(context.Background(), &pb.DeleteCourseRequest{Id: courseID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, deletedAt, res.Course.DeletedAt.AsTime())
+		assert.Equal(t, int32(2), res.Course.Version)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteCourse", mock.Anything, courseID).Return(course.Course{}, course.ErrNotFound)
+
+		res, err := srv.DeleteCourse(context.Background(), &pb.DeleteCourseRequest{Id: courseID.String()})
+		assertStatus(t, err, codes.NotFound, course.ErrCourseNotFound)
+		assert.Nil(t, res)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("RestoreCourse", mock.Anything, courseID).Return(course.Course{ID: courseID, Version: 3}, nil)
+
+		res, err := srv.RestoreCourse(context.Background(), &pb.RestoreCourseRequest{Id: courseID.String()})
+		assert.NoError(t, err)
+		assert.Nil(t, res.Course.DeletedAt)
+		repoMock.AssertExpectations(t)
+	})
+}
+
+func TestServer_DeleteLesson(t *testing.T) {
+	lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	deletedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success_syncs_enrolled_users", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("DeleteLesson", mock.Anything, courseID, lessonID).Return(course.Lesson{
+			ID:        lessonID,
+			CourseID:  courseID,
+			DeletedAt: &deletedAt,
+		}, nil)
+		syncerMock.On("SyncCourse", mock.Anything, courseID).Return(nil)
+
+		res, err := srv.DeleteLesson(context.Background(), &pb.DeleteLessonRequest{Id: lessonID.String(), CourseId: courseID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, deletedAt, res.Lesson.DeletedAt.AsTime())
+		repoMock.AssertExpectations(t)
+		syncerMock.AssertExpectations(t)
+	})
+
+	t.Run("sync_failure_is_not_an_error", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("DeleteLesson", mock.Anything, courseID, lessonID).Return(course.Lesson{ID: lessonID, CourseID: courseID, DeletedAt: &deletedAt}, nil)
+		syncerMock.On("SyncCourse", mock.Anything, courseID).Return(assert.AnError)
+
+		res, err := srv.DeleteLesson(context.Background(), &pb.DeleteLessonRequest{Id: lessonID.String(), CourseId: courseID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, lessonID.String(), res.Lesson.Id)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("DeleteLesson", mock.Anything, courseID, lessonID).Return(course.Lesson{}, course.ErrNotFound)
+
+		res, err := srv.DeleteLesson(context.Background(), &pb.DeleteLessonRequest{Id: lessonID.String(), CourseId: courseID.String()})
+		assertStatus(t, err, codes.NotFound, course.ErrLessonNotFound)
+		assert.Nil(t, res)
+		syncerMock.AssertNotCalled(t, "SyncCourse", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_invalid_course_id", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		res, err := srv.DeleteLesson(context.Background(), &pb.DeleteLessonRequest{Id: lessonID.String(), CourseId: "invalid-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+		repoMock.AssertNotCalled(t, "DeleteLesson", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("restore_syncs_enrolled_users", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("RestoreLesson", mock.Anything, courseID, lessonID).Return(course.Lesson{ID: lessonID, CourseID: courseID}, nil)
+		syncerMock.On("SyncCourse", mock.Anything, courseID).Return(nil)
+
+		res, err := srv.RestoreLesson(context.Background(), &pb.RestoreLessonRequest{Id: lessonID.String(), CourseId: courseID.String()})
+		assert.NoError(t, err)
+		assert.Nil(t, res.Lesson.DeletedAt)
+		repoMock.AssertExpectations(t)
+		syncerMock.AssertExpectations(t)
+	})
+}
+
+func TestServer_ReorderLessons(t *testing.T) {
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	first := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	second := uuid.MustParse("60766223-ff9f-4871-a497-f765c05a0c5e")
+
+	t.Run("success_syncs_enrolled_users", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("ReorderLessons", mock.Anything, courseID, []uuid.UUID{second, first}).Return([]course.Lesson{
+			{ID: second, CourseID: courseID, Order: 1, Version: 2},
+			{ID: first, CourseID: courseID, Order: 2, Version: 2},
+		}, nil)
+		syncerMock.On("SyncCourse", mock.Anything, courseID).Return(nil)
+
+		res, err := srv.ReorderLessons(context.Background(), &pb.ReorderLessonsRequest{
+			CourseId:  courseID.String(),
+			LessonIds: []string{second.String(), first.String()},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Lessons, 2)
+		assert.Equal(t, second.String(), res.Lessons[0].Id)
+		assert.Equal(t, int64(1), res.Lessons[0].Order)
+		assert.Equal(t, first.String(), res.Lessons[1].Id)
+		assert.Equal(t, int64(2), res.Lessons[1].Order)
+		repoMock.AssertExpectations(t)
+		syncerMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid_lesson_id", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		res, err := srv.ReorderLessons(context.Background(), &pb.ReorderLessonsRequest{
+			CourseId:  courseID.String(),
+			LessonIds: []string{first.String(), "nope"},
+		})
+		assert.Nil(t, res)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		require.Len(t, st.Details(), 2)
+		assert.Equal(t, reasonInvalidID, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+		assert.Equal(t, "lesson_ids[1]", st.Details()[1].(*errdetails.BadRequest).FieldViolations[0].Field)
+		repoMock.AssertNotCalled(t, "ReorderLessons", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_order", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("ReorderLessons", mock.Anything, courseID, []uuid.UUID{first}).Return([]course.Lesson(nil), course.ErrInvalidLessonOrder)
+
+		res, err := srv.ReorderLessons(context.Background(), &pb.ReorderLessonsRequest{
+			CourseId:  courseID.String(),
+			LessonIds: []string{first.String()},
+		})
+		assertStatus(t, err, codes.InvalidArgument, course.ErrInvalidLessonOrder)
+		assert.Nil(t, res)
+		syncerMock.AssertNotCalled(t, "SyncCourse", mock.Anything, mock.Anything)
+	})
+
+	t.Run("course_not_found", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("ReorderLessons", mock.Anything, courseID, []uuid.UUID{}).Return([]course.Lesson(nil), course.ErrNotFound)
+
+		res, err := srv.ReorderLessons(context.Background(), &pb.ReorderLessonsRequest{CourseId: courseID.String()})
+		assertStatus(t, err, codes.NotFound, course.ErrCourseNotFound)
+		assert.Nil(t, res)
+	})
+}
+
+func TestServer_CreateModule(t *testing.T) {
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetCourse", mock.Anything, courseID).Return(course.Course{ID: courseID}, nil)
+		repoMock.On("StoreModule", mock.Anything, mock.MatchedBy(func(m course.Module) bool {
+			return m.CourseID == courseID && m.Order == 2 && m.Title == "Basics" && m.Version == 1
+		})).Return(nil)
+
+		res, err := srv.CreateModule(context.Background(), &pb.CreateModuleRequest{
+			CourseId:    courseID.String(),
+			Order:       2,
+			Title:       "Basics",
+			Description: "The first steps",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, courseID.String(), res.Module.CourseId)
+		assert.Equal(t, int64(2), res.Module.Order)
+		assert.Equal(t, "Basics", res.Module.Title)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("course_not_found", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetCourse", mock.Anything, courseID).Return(course.Course{}, course.ErrCourseNotFound)
+
+		res, err := srv.CreateModule(context.Background(), &pb.CreateModuleRequest{
+			CourseId:    courseID.String(),
+			Title:       "Basics",
+			Description: "The first steps",
+		})
+		assertStatus(t, err, codes.NotFound, course.ErrCourseNotFound)
+		assert.Nil(t, res)
+		repoMock.AssertNotCalled(t, "StoreModule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("order_taken", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetCourse", mock.Anything, courseID).Return(course.Course{ID: courseID}, nil)
+		repoMock.On("StoreModule", mock.Anything, mock.Anything).Return(course.ErrModuleOrderTaken)
+
+		res, err := srv.CreateModule(context.Background(), &pb.CreateModuleRequest{
+			CourseId:    courseID.String(),
+			Order:       1,
+			Title:       "Basics",
+			Description: "The first steps",
+		})
+		assertStatus(t, err, codes.AlreadyExists, course.ErrModuleOrderTaken)
+		assert.Nil(t, res)
+	})
+}
+
+func TestServer_DeleteModule(t *testing.T) {
+	moduleID := uuid.MustParse("5b0bb8a1-93a4-4d43-a7d5-2b84c1b2f3a1")
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+
+	t.Run("not_empty", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteModule", mock.Anything, courseID, moduleID).Return(course.Module{}, course.ErrModuleNotEmpty)
+
+		res, err := srv.DeleteModule(context.Background(), &pb.DeleteModuleRequest{Id: moduleID.String(), CourseId: courseID.String()})
+		assertStatus(t, err, codes.FailedPrecondition, course.ErrModuleNotEmpty)
+		assert.Nil(t, res)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteModule", mock.Anything, courseID, moduleID).Return(course.Module{}, course.ErrNotFound)
+
+		res, err := srv.DeleteModule(context.Background(), &pb.DeleteModuleRequest{Id: moduleID.String(), CourseId: courseID.String()})
+		assertStatus(t, err, codes.NotFound, course.ErrModuleNotFound)
+		assert.Nil(t, res)
+	})
+}
+
+func TestServer_GetModule(t *testing.T) {
+	moduleID := uuid.MustParse("5b0bb8a1-93a4-4d43-a7d5-2b84c1b2f3a1")
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetModule", mock.Anything, moduleID).Return(course.Module{ID: moduleID, CourseID: courseID, Title: "Basics"}, nil)
+
+		res, err := srv.GetModule(context.Background(), &pb.GetModuleRequest{Id: moduleID.String(), CourseId: courseID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, "Basics", res.Module.Title)
+	})
+
+	t.Run("module_of_another_course", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetModule", mock.Anything, moduleID).Return(course.Module{ID: moduleID, CourseID: uuid.New(), Title: "Basics"}, nil)
+
+		res, err := srv.GetModule(context.Background(), &pb.GetModuleRequest{Id: moduleID.String(), CourseId: courseID.String()})
+		assertStatus(t, err, codes.NotFound, course.ErrModuleNotFound)
+		assert.Nil(t, res)
+	})
+}
+
+func TestServer_UpdateLesson_MovesToModule(t *testing.T) {
+	lessonID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	moduleID := uuid.MustParse("5b0bb8a1-93a4-4d43-a7d5-2b84c1b2f3a1")
+
+	t.Run("into_module", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("UpdateLesson", mock.Anything, lessonID, course.LessonUpdates{ModuleID: &moduleID}).
+			Return(course.Lesson{ID: lessonID, CourseID: courseID, ModuleID: moduleID}, nil)
+		syncerMock.On("SyncCourse", mock.Anything, courseID).Return(nil)
+
+		moduleIDStr := moduleID.String()
+		res, err := srv.UpdateLesson(context.Background(), &pb.UpdateLessonRequest{Id: lessonID.String(), ModuleId: &moduleIDStr})
+		assert.NoError(t, err)
+		assert.Equal(t, moduleID.String(), res.Lesson.ModuleId)
+		repoMock.AssertExpectations(t)
+		syncerMock.AssertExpectations(t)
+	})
+
+	t.Run("out_of_module", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		noModule := uuid.Nil
+		repoMock.On("UpdateLesson", mock.Anything, lessonID, course.LessonUpdates{ModuleID: &noModule}).
+			Return(course.Lesson{ID: lessonID, CourseID: courseID}, nil)
+		syncerMock.On("SyncCourse", mock.Anything, courseID).Return(nil)
+
+		empty := ""
+		res, err := srv.UpdateLesson(context.Background(), &pb.UpdateLessonRequest{Id: lessonID.String(), ModuleId: &empty})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Lesson.ModuleId)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("module_of_another_course", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		syncerMock := &course.StateSyncerMock{}
+		srv := &Server{Repository: repoMock, StateSyncer: syncerMock}
+
+		repoMock.On("UpdateLesson", mock.Anything, lessonID, course.LessonUpdates{ModuleID: &moduleID}).
+			Return(course.Lesson{}, course.ErrInvalidModule)
+
+		moduleIDStr := moduleID.String()
+		res, err := srv.UpdateLesson(context.Background(), &pb.UpdateLessonRequest{Id: lessonID.String(), ModuleId: &moduleIDStr})
+		assertStatus(t, err, codes.InvalidArgument, course.ErrInvalidModule)
+		assert.Nil(t, res)
+		syncerMock.AssertNotCalled(t, "SyncCourse", mock.Anything, mock.Anything)
+	})
+}
+
+func TestServer_GetCourseOutline(t *testing.T) {
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	moduleID := uuid.MustParse("5b0bb8a1-93a4-4d43-a7d5-2b84c1b2f3a1")
+	inModule := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	ungrouped := uuid.MustParse("60766223-ff9f-4871-a497-f765c05a0c5e")
+
+	browserMock := &course.CoursesBrowserMock{}
+	srv := &Server{CoursesBrowser: browserMock}
+
+	browserMock.On("Outline", mock.Anything, courseID).Return(course.CourseOutline{
+		Course: course.Course{ID: courseID, Title: "Go"},
+		Modules: []course.ModuleOutline{{
+			Module:  course.Module{ID: moduleID, CourseID: courseID, Order: 1, Title: "Basics"},
+			Lessons: []course.Lesson{{ID: inModule, CourseID: courseID, ModuleID: moduleID, Order: 1}},
+		}},
+		Lessons: []course.Lesson{{ID: ungrouped, CourseID: courseID, Order: 2}},
+	}, nil)
+
+	res, err := srv.GetCourseOutline(context.Background(), &pb.GetCourseOutlineRequest{CourseId: courseID.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, courseID.String(), res.Outline.Course.Id)
+	assert.Len(t, res.Outline.Modules, 1)
+	assert.Equal(t, moduleID.String(), res.Outline.Modules[0].Module.Id)
+	assert.Len(t, res.Outline.Modules[0].Lessons, 1)
+	assert.Equal(t, inModule.String(), res.Outline.Modules[0].Lessons[0].Id)
+	assert.Equal(t, moduleID.String(), res.Outline.Modules[0].Lessons[0].ModuleId)
+	assert.Len(t, res.Outline.Lessons, 1)
+	assert.Equal(t, ungrouped.String(), res.Outline.Lessons[0].Id)
+	assert.Empty(t, res.Outline.Lessons[0].ModuleId)
+	browserMock.AssertExpectations(t)
+}
+
+func TestServer_SetCoursePrerequisites(t *testing.T) {
+	courseID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	prerequisiteID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("SetCoursePrerequisites", mock.Anything, courseID, []uuid.UUID{prerequisiteID}).
+			Return([]course.Course{{ID: prerequisiteID, Title: "Go Basics"}}, nil)
+
+		res, err := srv.SetCoursePrerequisites(context.Background(), &pb.SetCoursePrerequisitesRequest{
+			CourseId:        courseID.String(),
+			PrerequisiteIds: []string{prerequisiteID.String()},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Prerequisites, 1)
+		assert.Equal(t, prerequisiteID.String(), res.Prerequisites[0].Id)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("SetCoursePrerequisites", mock.Anything, courseID, []uuid.UUID{prerequisiteID}).
+			Return([]course.Course(nil), course.ErrPrerequisiteCycle)
+
+		res, err := srv.SetCoursePrerequisites(context.Background(), &pb.SetCoursePrerequisitesRequest{
+			CourseId:        courseID.String(),
+			PrerequisiteIds: []string{prerequisiteID.String()},
+		})
+		assertStatus(t, err, codes.FailedPrecondition, course.ErrPrerequisiteCycle)
+		assert.Nil(t, res)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &course.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("SetCoursePrerequisites", mock.Anything, courseID, []uuid.UUID{}).
+			Return([]course.Course(nil), course.ErrNotFound)
+
+		res, err := srv.SetCoursePrerequisites(context.Background(), &pb.SetCoursePrerequisitesRequest{
+			CourseId: courseID.String(),
+		})
+		assertStatus(t, err, codes.NotFound, course.ErrCourseNotFound)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid_prerequisite_id", func(t *testing.T) {
+		srv := &Server{Repository: &course.RepositoryMock{}}
+
+		res, err := srv.SetCoursePrerequisites(context.Background(), &pb.SetCoursePrerequisitesRequest{
+			CourseId:        courseID.String(),
+			PrerequisiteIds: []string{"nope"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "invalid prerequisite ids", status.Convert(err).Message())
+		assert.Nil(t, res)
+	})
+}
+
+// assertStatus asserts err is a status with code whose ErrorInfo has the key
+// of want as its reason
+func assertStatus(t *testing.T, err error, code codes.Code, want error) {
+	t.Helper()
+
+	st := status.Convert(err)
+	assert.Equal(t, code, st.Code())
+
+	key, ok := course.KeyOf(want)
+	require.True(t, ok)
+	require.NotEmpty(t, st.Details())
+	assert.Equal(t, string(key), st.Details()[0].(*errdetails.ErrorInfo).Reason)
+}
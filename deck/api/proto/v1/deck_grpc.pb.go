@@ -28,10 +28,43 @@ type DecksAPIClient interface {
 	DeleteDeck(ctx context.Context, in *DeleteDeckRequest, opts ...grpc.CallOption) (*DeleteDeckResponse, error)
 	UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*UpdateDeckResponse, error)
 	GetPopularDecks(ctx context.Context, in *GetPopularDecksRequest, opts ...grpc.CallOption) (*GetPopularDecksResponse, error)
+	SearchDecks(ctx context.Context, in *SearchDecksRequest, opts ...grpc.CallOption) (*SearchDecksResponse, error)
 	CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*CreateCardResponse, error)
 	GetCards(ctx context.Context, in *GetCardsRequest, opts ...grpc.CallOption) (*GetCardsResponse, error)
+	GetDeckCards(ctx context.Context, in *GetDeckCardsRequest, opts ...grpc.CallOption) (*GetDeckCardsResponse, error)
+	GetDecksCards(ctx context.Context, in *GetDecksCardsRequest, opts ...grpc.CallOption) (*GetDecksCardsResponse, error)
+	StreamDeckCards(ctx context.Context, in *StreamDeckCardsRequest, opts ...grpc.CallOption) (DecksAPI_StreamDeckCardsClient, error)
 	UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*UpdateCardResponse, error)
 	UpdateAnswer(ctx context.Context, in *UpdateAnswerRequest, opts ...grpc.CallOption) (*UpdateAnswerResponse, error)
+	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error)
+	CreateAnswer(ctx context.Context, in *CreateAnswerRequest, opts ...grpc.CallOption) (*CreateAnswerResponse, error)
+	DeleteAnswer(ctx context.Context, in *DeleteAnswerRequest, opts ...grpc.CallOption) (*DeleteAnswerResponse, error)
+	ListCardRevisions(ctx context.Context, in *ListCardRevisionsRequest, opts ...grpc.CallOption) (*ListCardRevisionsResponse, error)
+	DiffCardRevisions(ctx context.Context, in *DiffCardRevisionsRequest, opts ...grpc.CallOption) (*DiffCardRevisionsResponse, error)
+	RestoreCardRevision(ctx context.Context, in *RestoreCardRevisionRequest, opts ...grpc.CallOption) (*RestoreCardRevisionResponse, error)
+	ForkDeck(ctx context.Context, in *ForkDeckRequest, opts ...grpc.CallOption) (*ForkDeckResponse, error)
+	GetUpstreamChanges(ctx context.Context, in *GetUpstreamChangesRequest, opts ...grpc.CallOption) (*GetUpstreamChangesResponse, error)
+	PullUpstreamChanges(ctx context.Context, in *PullUpstreamChangesRequest, opts ...grpc.CallOption) (*PullUpstreamChangesResponse, error)
+	ListDeckMembers(ctx context.Context, in *ListDeckMembersRequest, opts ...grpc.CallOption) (*ListDeckMembersResponse, error)
+	InviteDeckMember(ctx context.Context, in *InviteDeckMemberRequest, opts ...grpc.CallOption) (*InviteDeckMemberResponse, error)
+	ChangeDeckMemberRole(ctx context.Context, in *ChangeDeckMemberRoleRequest, opts ...grpc.CallOption) (*ChangeDeckMemberRoleResponse, error)
+	RemoveDeckMember(ctx context.Context, in *RemoveDeckMemberRequest, opts ...grpc.CallOption) (*RemoveDeckMemberResponse, error)
+	GetSharedDecks(ctx context.Context, in *GetSharedDecksRequest, opts ...grpc.CallOption) (*GetSharedDecksResponse, error)
+	RateDeck(ctx context.Context, in *RateDeckRequest, opts ...grpc.CallOption) (*RateDeckResponse, error)
+	GetDeckReviews(ctx context.Context, in *GetDeckReviewsRequest, opts ...grpc.CallOption) (*GetDeckReviewsResponse, error)
+	GetDeckAnalytics(ctx context.Context, in *GetDeckAnalyticsRequest, opts ...grpc.CallOption) (*GetDeckAnalyticsResponse, error)
+	AddToLibrary(ctx context.Context, in *AddToLibraryRequest, opts ...grpc.CallOption) (*AddToLibraryResponse, error)
+	RemoveFromLibrary(ctx context.Context, in *RemoveFromLibraryRequest, opts ...grpc.CallOption) (*RemoveFromLibraryResponse, error)
+	GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error)
+	GetAuthoredDecks(ctx context.Context, in *GetAuthoredDecksRequest, opts ...grpc.CallOption) (*GetAuthoredDecksResponse, error)
+	GradeAnswers(ctx context.Context, in *GradeAnswersRequest, opts ...grpc.CallOption) (*GradeAnswersResponse, error)
+	ImportDeck(ctx context.Context, in *ImportDeckRequest, opts ...grpc.CallOption) (*ImportDeckResponse, error)
+	ExportDeck(ctx context.Context, in *ExportDeckRequest, opts ...grpc.CallOption) (*ExportDeckResponse, error)
+	AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*AutocompleteTagsResponse, error)
+	GetTopics(ctx context.Context, in *GetTopicsRequest, opts ...grpc.CallOption) (*GetTopicsResponse, error)
+	CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error)
+	DeleteTopic(ctx context.Context, in *DeleteTopicRequest, opts ...grpc.CallOption) (*DeleteTopicResponse, error)
+	SetTagTopic(ctx context.Context, in *SetTagTopicRequest, opts ...grpc.CallOption) (*SetTagTopicResponse, error)
 }
 
 type decksAPIClient struct {
@@ -96,6 +129,15 @@ func (c *decksAPIClient) GetPopularDecks(ctx context.Context, in *GetPopularDeck
 	return out, nil
 }
 
+func (c *decksAPIClient) SearchDecks(ctx context.Context, in *SearchDecksRequest, opts ...grpc.CallOption) (*SearchDecksResponse, error) {
+	out := new(SearchDecksResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/SearchDecks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *decksAPIClient) CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*CreateCardResponse, error) {
 	out := new(CreateCardResponse)
 	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/CreateCard", in, out, opts...)
@@ -114,6 +156,56 @@ func (c *decksAPIClient) GetCards(ctx context.Context, in *GetCardsRequest, opts
 	return out, nil
 }
 
+func (c *decksAPIClient) GetDeckCards(ctx context.Context, in *GetDeckCardsRequest, opts ...grpc.CallOption) (*GetDeckCardsResponse, error) {
+	out := new(GetDeckCardsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetDeckCards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetDecksCards(ctx context.Context, in *GetDecksCardsRequest, opts ...grpc.CallOption) (*GetDecksCardsResponse, error) {
+	out := new(GetDecksCardsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetDecksCards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) StreamDeckCards(ctx context.Context, in *StreamDeckCardsRequest, opts ...grpc.CallOption) (DecksAPI_StreamDeckCardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DecksAPI_ServiceDesc.Streams[0], "/deck.v1.DecksAPI/StreamDeckCards", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &decksAPIStreamDeckCardsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DecksAPI_StreamDeckCardsClient interface {
+	Recv() (*StreamDeckCardsResponse, error)
+	grpc.ClientStream
+}
+
+type decksAPIStreamDeckCardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *decksAPIStreamDeckCardsClient) Recv() (*StreamDeckCardsResponse, error) {
+	m := new(StreamDeckCardsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *decksAPIClient) UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*UpdateCardResponse, error) {
 	out := new(UpdateCardResponse)
 	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/UpdateCard", in, out, opts...)
@@ -132,6 +224,267 @@ func (c *decksAPIClient) UpdateAnswer(ctx context.Context, in *UpdateAnswerReque
 	return out, nil
 }
 
+func (c *decksAPIClient) DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error) {
+	out := new(DeleteCardResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/DeleteCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) CreateAnswer(ctx context.Context, in *CreateAnswerRequest, opts ...grpc.CallOption) (*CreateAnswerResponse, error) {
+	out := new(CreateAnswerResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/CreateAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) DeleteAnswer(ctx context.Context, in *DeleteAnswerRequest, opts ...grpc.CallOption) (*DeleteAnswerResponse, error) {
+	out := new(DeleteAnswerResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/DeleteAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) ListCardRevisions(ctx context.Context, in *ListCardRevisionsRequest, opts ...grpc.CallOption) (*ListCardRevisionsResponse, error) {
+	out := new(ListCardRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/ListCardRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) DiffCardRevisions(ctx context.Context, in *DiffCardRevisionsRequest, opts ...grpc.CallOption) (*DiffCardRevisionsResponse, error) {
+	out := new(DiffCardRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/DiffCardRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) RestoreCardRevision(ctx context.Context, in *RestoreCardRevisionRequest, opts ...grpc.CallOption) (*RestoreCardRevisionResponse, error) {
+	out := new(RestoreCardRevisionResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/RestoreCardRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) ForkDeck(ctx context.Context, in *ForkDeckRequest, opts ...grpc.CallOption) (*ForkDeckResponse, error) {
+	out := new(ForkDeckResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/ForkDeck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetUpstreamChanges(ctx context.Context, in *GetUpstreamChangesRequest, opts ...grpc.CallOption) (*GetUpstreamChangesResponse, error) {
+	out := new(GetUpstreamChangesResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetUpstreamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) PullUpstreamChanges(ctx context.Context, in *PullUpstreamChangesRequest, opts ...grpc.CallOption) (*PullUpstreamChangesResponse, error) {
+	out := new(PullUpstreamChangesResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/PullUpstreamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) ListDeckMembers(ctx context.Context, in *ListDeckMembersRequest, opts ...grpc.CallOption) (*ListDeckMembersResponse, error) {
+	out := new(ListDeckMembersResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/ListDeckMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) InviteDeckMember(ctx context.Context, in *InviteDeckMemberRequest, opts ...grpc.CallOption) (*InviteDeckMemberResponse, error) {
+	out := new(InviteDeckMemberResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/InviteDeckMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) ChangeDeckMemberRole(ctx context.Context, in *ChangeDeckMemberRoleRequest, opts ...grpc.CallOption) (*ChangeDeckMemberRoleResponse, error) {
+	out := new(ChangeDeckMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/ChangeDeckMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) RemoveDeckMember(ctx context.Context, in *RemoveDeckMemberRequest, opts ...grpc.CallOption) (*RemoveDeckMemberResponse, error) {
+	out := new(RemoveDeckMemberResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/RemoveDeckMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetSharedDecks(ctx context.Context, in *GetSharedDecksRequest, opts ...grpc.CallOption) (*GetSharedDecksResponse, error) {
+	out := new(GetSharedDecksResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetSharedDecks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) RateDeck(ctx context.Context, in *RateDeckRequest, opts ...grpc.CallOption) (*RateDeckResponse, error) {
+	out := new(RateDeckResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/RateDeck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetDeckReviews(ctx context.Context, in *GetDeckReviewsRequest, opts ...grpc.CallOption) (*GetDeckReviewsResponse, error) {
+	out := new(GetDeckReviewsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetDeckReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetDeckAnalytics(ctx context.Context, in *GetDeckAnalyticsRequest, opts ...grpc.CallOption) (*GetDeckAnalyticsResponse, error) {
+	out := new(GetDeckAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetDeckAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) AddToLibrary(ctx context.Context, in *AddToLibraryRequest, opts ...grpc.CallOption) (*AddToLibraryResponse, error) {
+	out := new(AddToLibraryResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/AddToLibrary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) RemoveFromLibrary(ctx context.Context, in *RemoveFromLibraryRequest, opts ...grpc.CallOption) (*RemoveFromLibraryResponse, error) {
+	out := new(RemoveFromLibraryResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/RemoveFromLibrary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error) {
+	out := new(GetLibraryResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetLibrary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetAuthoredDecks(ctx context.Context, in *GetAuthoredDecksRequest, opts ...grpc.CallOption) (*GetAuthoredDecksResponse, error) {
+	out := new(GetAuthoredDecksResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetAuthoredDecks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GradeAnswers(ctx context.Context, in *GradeAnswersRequest, opts ...grpc.CallOption) (*GradeAnswersResponse, error) {
+	out := new(GradeAnswersResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GradeAnswers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) ImportDeck(ctx context.Context, in *ImportDeckRequest, opts ...grpc.CallOption) (*ImportDeckResponse, error) {
+	out := new(ImportDeckResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/ImportDeck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) ExportDeck(ctx context.Context, in *ExportDeckRequest, opts ...grpc.CallOption) (*ExportDeckResponse, error) {
+	out := new(ExportDeckResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/ExportDeck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*AutocompleteTagsResponse, error) {
+	out := new(AutocompleteTagsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/AutocompleteTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) GetTopics(ctx context.Context, in *GetTopicsRequest, opts ...grpc.CallOption) (*GetTopicsResponse, error) {
+	out := new(GetTopicsResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/GetTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error) {
+	out := new(CreateTopicResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/CreateTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) DeleteTopic(ctx context.Context, in *DeleteTopicRequest, opts ...grpc.CallOption) (*DeleteTopicResponse, error) {
+	out := new(DeleteTopicResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/DeleteTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decksAPIClient) SetTagTopic(ctx context.Context, in *SetTagTopicRequest, opts ...grpc.CallOption) (*SetTagTopicResponse, error) {
+	out := new(SetTagTopicResponse)
+	err := c.cc.Invoke(ctx, "/deck.v1.DecksAPI/SetTagTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DecksAPIServer is the server API for DecksAPI service.
 // All implementations should embed UnimplementedDecksAPIServer
 // for forward compatibility
@@ -142,10 +495,43 @@ type DecksAPIServer interface {
 	DeleteDeck(context.Context, *DeleteDeckRequest) (*DeleteDeckResponse, error)
 	UpdateDeck(context.Context, *UpdateDeckRequest) (*UpdateDeckResponse, error)
 	GetPopularDecks(context.Context, *GetPopularDecksRequest) (*GetPopularDecksResponse, error)
+	SearchDecks(context.Context, *SearchDecksRequest) (*SearchDecksResponse, error)
 	CreateCard(context.Context, *CreateCardRequest) (*CreateCardResponse, error)
 	GetCards(context.Context, *GetCardsRequest) (*GetCardsResponse, error)
+	GetDeckCards(context.Context, *GetDeckCardsRequest) (*GetDeckCardsResponse, error)
+	GetDecksCards(context.Context, *GetDecksCardsRequest) (*GetDecksCardsResponse, error)
+	StreamDeckCards(*StreamDeckCardsRequest, DecksAPI_StreamDeckCardsServer) error
 	UpdateCard(context.Context, *UpdateCardRequest) (*UpdateCardResponse, error)
 	UpdateAnswer(context.Context, *UpdateAnswerRequest) (*UpdateAnswerResponse, error)
+	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
+	CreateAnswer(context.Context, *CreateAnswerRequest) (*CreateAnswerResponse, error)
+	DeleteAnswer(context.Context, *DeleteAnswerRequest) (*DeleteAnswerResponse, error)
+	ListCardRevisions(context.Context, *ListCardRevisionsRequest) (*ListCardRevisionsResponse, error)
+	DiffCardRevisions(context.Context, *DiffCardRevisionsRequest) (*DiffCardRevisionsResponse, error)
+	RestoreCardRevision(context.Context, *RestoreCardRevisionRequest) (*RestoreCardRevisionResponse, error)
+	ForkDeck(context.Context, *ForkDeckRequest) (*ForkDeckResponse, error)
+	GetUpstreamChanges(context.Context, *GetUpstreamChangesRequest) (*GetUpstreamChangesResponse, error)
+	PullUpstreamChanges(context.Context, *PullUpstreamChangesRequest) (*PullUpstreamChangesResponse, error)
+	ListDeckMembers(context.Context, *ListDeckMembersRequest) (*ListDeckMembersResponse, error)
+	InviteDeckMember(context.Context, *InviteDeckMemberRequest) (*InviteDeckMemberResponse, error)
+	ChangeDeckMemberRole(context.Context, *ChangeDeckMemberRoleRequest) (*ChangeDeckMemberRoleResponse, error)
+	RemoveDeckMember(context.Context, *RemoveDeckMemberRequest) (*RemoveDeckMemberResponse, error)
+	GetSharedDecks(context.Context, *GetSharedDecksRequest) (*GetSharedDecksResponse, error)
+	RateDeck(context.Context, *RateDeckRequest) (*RateDeckResponse, error)
+	GetDeckReviews(context.Context, *GetDeckReviewsRequest) (*GetDeckReviewsResponse, error)
+	GetDeckAnalytics(context.Context, *GetDeckAnalyticsRequest) (*GetDeckAnalyticsResponse, error)
+	AddToLibrary(context.Context, *AddToLibraryRequest) (*AddToLibraryResponse, error)
+	RemoveFromLibrary(context.Context, *RemoveFromLibraryRequest) (*RemoveFromLibraryResponse, error)
+	GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error)
+	GetAuthoredDecks(context.Context, *GetAuthoredDecksRequest) (*GetAuthoredDecksResponse, error)
+	GradeAnswers(context.Context, *GradeAnswersRequest) (*GradeAnswersResponse, error)
+	ImportDeck(context.Context, *ImportDeckRequest) (*ImportDeckResponse, error)
+	ExportDeck(context.Context, *ExportDeckRequest) (*ExportDeckResponse, error)
+	AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error)
+	GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error)
+	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
+	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error)
+	SetTagTopic(context.Context, *SetTagTopicRequest) (*SetTagTopicResponse, error)
 }
 
 // UnimplementedDecksAPIServer should be embedded to have forward compatible implementations.
@@ -170,18 +556,117 @@ func (UnimplementedDecksAPIServer) UpdateDeck(context.Context, *UpdateDeckReques
 func (UnimplementedDecksAPIServer) GetPopularDecks(context.Context, *GetPopularDecksRequest) (*GetPopularDecksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPopularDecks not implemented")
 }
+func (UnimplementedDecksAPIServer) SearchDecks(context.Context, *SearchDecksRequest) (*SearchDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDecks not implemented")
+}
 func (UnimplementedDecksAPIServer) CreateCard(context.Context, *CreateCardRequest) (*CreateCardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCard not implemented")
 }
 func (UnimplementedDecksAPIServer) GetCards(context.Context, *GetCardsRequest) (*GetCardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCards not implemented")
 }
+func (UnimplementedDecksAPIServer) GetDeckCards(context.Context, *GetDeckCardsRequest) (*GetDeckCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeckCards not implemented")
+}
+func (UnimplementedDecksAPIServer) GetDecksCards(context.Context, *GetDecksCardsRequest) (*GetDecksCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecksCards not implemented")
+}
+func (UnimplementedDecksAPIServer) StreamDeckCards(*StreamDeckCardsRequest, DecksAPI_StreamDeckCardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDeckCards not implemented")
+}
 func (UnimplementedDecksAPIServer) UpdateCard(context.Context, *UpdateCardRequest) (*UpdateCardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCard not implemented")
 }
 func (UnimplementedDecksAPIServer) UpdateAnswer(context.Context, *UpdateAnswerRequest) (*UpdateAnswerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnswer not implemented")
 }
+func (UnimplementedDecksAPIServer) DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
+}
+func (UnimplementedDecksAPIServer) CreateAnswer(context.Context, *CreateAnswerRequest) (*CreateAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnswer not implemented")
+}
+func (UnimplementedDecksAPIServer) DeleteAnswer(context.Context, *DeleteAnswerRequest) (*DeleteAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnswer not implemented")
+}
+func (UnimplementedDecksAPIServer) ListCardRevisions(context.Context, *ListCardRevisionsRequest) (*ListCardRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCardRevisions not implemented")
+}
+func (UnimplementedDecksAPIServer) DiffCardRevisions(context.Context, *DiffCardRevisionsRequest) (*DiffCardRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCardRevisions not implemented")
+}
+func (UnimplementedDecksAPIServer) RestoreCardRevision(context.Context, *RestoreCardRevisionRequest) (*RestoreCardRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCardRevision not implemented")
+}
+func (UnimplementedDecksAPIServer) ForkDeck(context.Context, *ForkDeckRequest) (*ForkDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkDeck not implemented")
+}
+func (UnimplementedDecksAPIServer) GetUpstreamChanges(context.Context, *GetUpstreamChangesRequest) (*GetUpstreamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpstreamChanges not implemented")
+}
+func (UnimplementedDecksAPIServer) PullUpstreamChanges(context.Context, *PullUpstreamChangesRequest) (*PullUpstreamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullUpstreamChanges not implemented")
+}
+func (UnimplementedDecksAPIServer) ListDeckMembers(context.Context, *ListDeckMembersRequest) (*ListDeckMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeckMembers not implemented")
+}
+func (UnimplementedDecksAPIServer) InviteDeckMember(context.Context, *InviteDeckMemberRequest) (*InviteDeckMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteDeckMember not implemented")
+}
+func (UnimplementedDecksAPIServer) ChangeDeckMemberRole(context.Context, *ChangeDeckMemberRoleRequest) (*ChangeDeckMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDeckMemberRole not implemented")
+}
+func (UnimplementedDecksAPIServer) RemoveDeckMember(context.Context, *RemoveDeckMemberRequest) (*RemoveDeckMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeckMember not implemented")
+}
+func (UnimplementedDecksAPIServer) GetSharedDecks(context.Context, *GetSharedDecksRequest) (*GetSharedDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedDecks not implemented")
+}
+func (UnimplementedDecksAPIServer) RateDeck(context.Context, *RateDeckRequest) (*RateDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateDeck not implemented")
+}
+func (UnimplementedDecksAPIServer) GetDeckReviews(context.Context, *GetDeckReviewsRequest) (*GetDeckReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeckReviews not implemented")
+}
+func (UnimplementedDecksAPIServer) GetDeckAnalytics(context.Context, *GetDeckAnalyticsRequest) (*GetDeckAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeckAnalytics not implemented")
+}
+func (UnimplementedDecksAPIServer) AddToLibrary(context.Context, *AddToLibraryRequest) (*AddToLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToLibrary not implemented")
+}
+func (UnimplementedDecksAPIServer) RemoveFromLibrary(context.Context, *RemoveFromLibraryRequest) (*RemoveFromLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromLibrary not implemented")
+}
+func (UnimplementedDecksAPIServer) GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLibrary not implemented")
+}
+func (UnimplementedDecksAPIServer) GetAuthoredDecks(context.Context, *GetAuthoredDecksRequest) (*GetAuthoredDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthoredDecks not implemented")
+}
+func (UnimplementedDecksAPIServer) GradeAnswers(context.Context, *GradeAnswersRequest) (*GradeAnswersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeAnswers not implemented")
+}
+func (UnimplementedDecksAPIServer) ImportDeck(context.Context, *ImportDeckRequest) (*ImportDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDeck not implemented")
+}
+func (UnimplementedDecksAPIServer) ExportDeck(context.Context, *ExportDeckRequest) (*ExportDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDeck not implemented")
+}
+func (UnimplementedDecksAPIServer) AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteTags not implemented")
+}
+func (UnimplementedDecksAPIServer) GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopics not implemented")
+}
+func (UnimplementedDecksAPIServer) CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTopic not implemented")
+}
+func (UnimplementedDecksAPIServer) DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopic not implemented")
+}
+func (UnimplementedDecksAPIServer) SetTagTopic(context.Context, *SetTagTopicRequest) (*SetTagTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTagTopic not implemented")
+}
 
 // UnsafeDecksAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DecksAPIServer will
@@ -302,6 +787,24 @@ func _DecksAPI_GetPopularDecks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DecksAPI_SearchDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).SearchDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/SearchDecks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).SearchDecks(ctx, req.(*SearchDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DecksAPI_CreateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCardRequest)
 	if err := dec(in); err != nil {
@@ -338,7 +841,64 @@ func _DecksAPI_GetCards_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DecksAPI_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _DecksAPI_GetDeckCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeckCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetDeckCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetDeckCards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetDeckCards(ctx, req.(*GetDeckCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetDecksCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecksCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetDecksCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetDecksCards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetDecksCards(ctx, req.(*GetDecksCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_StreamDeckCards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDeckCardsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DecksAPIServer).StreamDeckCards(m, &decksAPIStreamDeckCardsServer{stream})
+}
+
+type DecksAPI_StreamDeckCardsServer interface {
+	Send(*StreamDeckCardsResponse) error
+	grpc.ServerStream
+}
+
+type decksAPIStreamDeckCardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *decksAPIStreamDeckCardsServer) Send(m *StreamDeckCardsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DecksAPI_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -374,6 +934,528 @@ func _DecksAPI_UpdateAnswer_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DecksAPI_DeleteCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).DeleteCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/DeleteCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).DeleteCard(ctx, req.(*DeleteCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_CreateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).CreateAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/CreateAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).CreateAnswer(ctx, req.(*CreateAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_DeleteAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).DeleteAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/DeleteAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).DeleteAnswer(ctx, req.(*DeleteAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_ListCardRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCardRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).ListCardRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/ListCardRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).ListCardRevisions(ctx, req.(*ListCardRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_DiffCardRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCardRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).DiffCardRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/DiffCardRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).DiffCardRevisions(ctx, req.(*DiffCardRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_RestoreCardRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCardRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).RestoreCardRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/RestoreCardRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).RestoreCardRevision(ctx, req.(*RestoreCardRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_ForkDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).ForkDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/ForkDeck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).ForkDeck(ctx, req.(*ForkDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetUpstreamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpstreamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetUpstreamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetUpstreamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetUpstreamChanges(ctx, req.(*GetUpstreamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_PullUpstreamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullUpstreamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).PullUpstreamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/PullUpstreamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).PullUpstreamChanges(ctx, req.(*PullUpstreamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_ListDeckMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeckMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).ListDeckMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/ListDeckMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).ListDeckMembers(ctx, req.(*ListDeckMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_InviteDeckMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteDeckMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).InviteDeckMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/InviteDeckMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).InviteDeckMember(ctx, req.(*InviteDeckMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_ChangeDeckMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeDeckMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).ChangeDeckMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/ChangeDeckMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).ChangeDeckMemberRole(ctx, req.(*ChangeDeckMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_RemoveDeckMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeckMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).RemoveDeckMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/RemoveDeckMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).RemoveDeckMember(ctx, req.(*RemoveDeckMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetSharedDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetSharedDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetSharedDecks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetSharedDecks(ctx, req.(*GetSharedDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_RateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).RateDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/RateDeck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).RateDeck(ctx, req.(*RateDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetDeckReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeckReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetDeckReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetDeckReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetDeckReviews(ctx, req.(*GetDeckReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetDeckAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeckAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetDeckAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetDeckAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetDeckAnalytics(ctx, req.(*GetDeckAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_AddToLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).AddToLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/AddToLibrary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).AddToLibrary(ctx, req.(*AddToLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_RemoveFromLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).RemoveFromLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/RemoveFromLibrary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).RemoveFromLibrary(ctx, req.(*RemoveFromLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetLibrary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetLibrary(ctx, req.(*GetLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetAuthoredDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthoredDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetAuthoredDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetAuthoredDecks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetAuthoredDecks(ctx, req.(*GetAuthoredDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GradeAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeAnswersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GradeAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GradeAnswers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GradeAnswers(ctx, req.(*GradeAnswersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_ImportDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).ImportDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/ImportDeck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).ImportDeck(ctx, req.(*ImportDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_ExportDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).ExportDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/ExportDeck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).ExportDeck(ctx, req.(*ExportDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_AutocompleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).AutocompleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/AutocompleteTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).AutocompleteTags(ctx, req.(*AutocompleteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_GetTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).GetTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/GetTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).GetTopics(ctx, req.(*GetTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).CreateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/CreateTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).CreateTopic(ctx, req.(*CreateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).DeleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/DeleteTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).DeleteTopic(ctx, req.(*DeleteTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecksAPI_SetTagTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecksAPIServer).SetTagTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deck.v1.DecksAPI/SetTagTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecksAPIServer).SetTagTopic(ctx, req.(*SetTagTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DecksAPI_ServiceDesc is the grpc.ServiceDesc for DecksAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -405,6 +1487,10 @@ var DecksAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPopularDecks",
 			Handler:    _DecksAPI_GetPopularDecks_Handler,
 		},
+		{
+			MethodName: "SearchDecks",
+			Handler:    _DecksAPI_SearchDecks_Handler,
+		},
 		{
 			MethodName: "CreateCard",
 			Handler:    _DecksAPI_CreateCard_Handler,
@@ -413,6 +1499,14 @@ var DecksAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCards",
 			Handler:    _DecksAPI_GetCards_Handler,
 		},
+		{
+			MethodName: "GetDeckCards",
+			Handler:    _DecksAPI_GetDeckCards_Handler,
+		},
+		{
+			MethodName: "GetDecksCards",
+			Handler:    _DecksAPI_GetDecksCards_Handler,
+		},
 		{
 			MethodName: "UpdateCard",
 			Handler:    _DecksAPI_UpdateCard_Handler,
@@ -421,7 +1515,129 @@ var DecksAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAnswer",
 			Handler:    _DecksAPI_UpdateAnswer_Handler,
 		},
+		{
+			MethodName: "DeleteCard",
+			Handler:    _DecksAPI_DeleteCard_Handler,
+		},
+		{
+			MethodName: "CreateAnswer",
+			Handler:    _DecksAPI_CreateAnswer_Handler,
+		},
+		{
+			MethodName: "DeleteAnswer",
+			Handler:    _DecksAPI_DeleteAnswer_Handler,
+		},
+		{
+			MethodName: "ListCardRevisions",
+			Handler:    _DecksAPI_ListCardRevisions_Handler,
+		},
+		{
+			MethodName: "DiffCardRevisions",
+			Handler:    _DecksAPI_DiffCardRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreCardRevision",
+			Handler:    _DecksAPI_RestoreCardRevision_Handler,
+		},
+		{
+			MethodName: "ForkDeck",
+			Handler:    _DecksAPI_ForkDeck_Handler,
+		},
+		{
+			MethodName: "GetUpstreamChanges",
+			Handler:    _DecksAPI_GetUpstreamChanges_Handler,
+		},
+		{
+			MethodName: "PullUpstreamChanges",
+			Handler:    _DecksAPI_PullUpstreamChanges_Handler,
+		},
+		{
+			MethodName: "ListDeckMembers",
+			Handler:    _DecksAPI_ListDeckMembers_Handler,
+		},
+		{
+			MethodName: "InviteDeckMember",
+			Handler:    _DecksAPI_InviteDeckMember_Handler,
+		},
+		{
+			MethodName: "ChangeDeckMemberRole",
+			Handler:    _DecksAPI_ChangeDeckMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveDeckMember",
+			Handler:    _DecksAPI_RemoveDeckMember_Handler,
+		},
+		{
+			MethodName: "GetSharedDecks",
+			Handler:    _DecksAPI_GetSharedDecks_Handler,
+		},
+		{
+			MethodName: "RateDeck",
+			Handler:    _DecksAPI_RateDeck_Handler,
+		},
+		{
+			MethodName: "GetDeckReviews",
+			Handler:    _DecksAPI_GetDeckReviews_Handler,
+		},
+		{
+			MethodName: "GetDeckAnalytics",
+			Handler:    _DecksAPI_GetDeckAnalytics_Handler,
+		},
+		{
+			MethodName: "AddToLibrary",
+			Handler:    _DecksAPI_AddToLibrary_Handler,
+		},
+		{
+			MethodName: "RemoveFromLibrary",
+			Handler:    _DecksAPI_RemoveFromLibrary_Handler,
+		},
+		{
+			MethodName: "GetLibrary",
+			Handler:    _DecksAPI_GetLibrary_Handler,
+		},
+		{
+			MethodName: "GetAuthoredDecks",
+			Handler:    _DecksAPI_GetAuthoredDecks_Handler,
+		},
+		{
+			MethodName: "GradeAnswers",
+			Handler:    _DecksAPI_GradeAnswers_Handler,
+		},
+		{
+			MethodName: "ImportDeck",
+			Handler:    _DecksAPI_ImportDeck_Handler,
+		},
+		{
+			MethodName: "ExportDeck",
+			Handler:    _DecksAPI_ExportDeck_Handler,
+		},
+		{
+			MethodName: "AutocompleteTags",
+			Handler:    _DecksAPI_AutocompleteTags_Handler,
+		},
+		{
+			MethodName: "GetTopics",
+			Handler:    _DecksAPI_GetTopics_Handler,
+		},
+		{
+			MethodName: "CreateTopic",
+			Handler:    _DecksAPI_CreateTopic_Handler,
+		},
+		{
+			MethodName: "DeleteTopic",
+			Handler:    _DecksAPI_DeleteTopic_Handler,
+		},
+		{
+			MethodName: "SetTagTopic",
+			Handler:    _DecksAPI_SetTagTopic_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDeckCards",
+			Handler:       _DecksAPI_StreamDeckCards_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "deck.proto",
 }
@@ -0,0 +1,7 @@
+package v1
+
+// MaxMessageSize is the largest message the deck service and its clients
+// exchange. Imported files and exported decks travel whole in a single
+// message, so it leaves room for the gate's 64 MiB uploads plus the rest of
+// the request.
+const MaxMessageSize = 72 << 20
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/mediocregopher/radix/v4"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/XaviFP/toshokan/deck/internal/grpc"
 )
 
+const defaultPopularityRefreshInterval = 15 * time.Minute
+
 var conf DecksConfig
 
 func init() {
@@ -49,6 +52,11 @@ func main() {
 		Repository:    redisRepo,
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go refreshPopularity(ctx, logger, postgresRepo, conf.PopularityRefreshInterval)
+
 	var serverError chan error
 
 	go func() {
@@ -75,10 +83,31 @@ func exitOnTerminationSignal(logger *slog.Logger, serverError chan error) {
 	os.Exit(0)
 }
 
+// refreshPopularity recomputes the popularity of decks right away and then
+// every interval, until ctx is done.
+func refreshPopularity(ctx context.Context, logger *slog.Logger, repo deck.Repository, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		if err := repo.RefreshPopularity(ctx); err != nil {
+			logger.Error("Failed to refresh deck popularity", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 type DecksConfig struct {
 	DBConf    config.DBConfig
 	CacheConf config.CacheConfig
 	GRPCConf  config.GRPCServerConfig
+
+	PopularityRefreshInterval time.Duration
 }
 
 func loadConfig() DecksConfig {
@@ -87,6 +116,16 @@ func loadConfig() DecksConfig {
 	decksConfig.GRPCConf = config.LoadGRPCServerConfig()
 	decksConfig.DBConf = config.LoadDBConfig()
 	decksConfig.CacheConf = config.LoadCacheConfig()
+	decksConfig.PopularityRefreshInterval = defaultPopularityRefreshInterval
+
+	if v := os.Getenv("POPULARITY_REFRESH_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			slog.Error("Wrong format for environment variable: POPULARITY_REFRESH_INTERVAL", "error", err)
+			os.Exit(1)
+		}
+		decksConfig.PopularityRefreshInterval = interval
+	}
 
 	return decksConfig
 }
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS card_revisions;
+DROP FUNCTION IF EXISTS card_revisions_immutable;
+
+COMMIT;
@@ -0,0 +1,61 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS card_revisions (
+    card_id UUID REFERENCES cards (id) ON DELETE CASCADE NOT NULL,
+    revision INTEGER NOT NULL,
+    author_id UUID NOT NULL,
+    changed_fields TEXT[] NOT NULL DEFAULT '{}',
+    -- The card, answers included, as it was after the change
+    snapshot JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (card_id, revision)
+);
+
+CREATE OR REPLACE FUNCTION card_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'card revisions can not be modified';
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER card_revisions_immutable
+    BEFORE UPDATE ON card_revisions
+    FOR EACH ROW EXECUTE FUNCTION card_revisions_immutable();
+
+-- Existing cards start their history with what they look like today
+INSERT INTO card_revisions (card_id, revision, author_id, changed_fields, snapshot, created_at)
+SELECT
+    c.id,
+    1,
+    d.author_id,
+    ARRAY['title'] ||
+        CASE WHEN COALESCE(c.explanation, '') <> '' THEN ARRAY['explanation'] ELSE '{}' END ||
+        ARRAY['kind'] ||
+        COALESCE(a.fields, '{}'),
+    jsonb_build_object(
+        'id', c.id,
+        'title', c.title,
+        'explanation', COALESCE(c.explanation, ''),
+        'kind', c.kind,
+        'possibleAnswers', COALESCE(a.answers, '[]')
+    ),
+    COALESCE(c.updated_at, c.created_at)
+FROM cards c
+JOIN decks d ON d.id = c.deck_id
+LEFT JOIN LATERAL (
+    SELECT
+        array_agg('answers.' || a.id ORDER BY a.blank, a.position, a.created_at) AS fields,
+        jsonb_agg(jsonb_build_object(
+            'id', a.id,
+            'text', a."text",
+            'isCorrect', a.is_correct,
+            'blank', a.blank,
+            'tolerance', a.tolerance,
+            'position', a.position,
+            'side', a.side
+        ) ORDER BY a.blank, a.position, a.created_at) AS answers
+    FROM answers a
+    WHERE a.card_id = c.id AND a.deleted_at IS NULL
+) a ON TRUE
+ON CONFLICT DO NOTHING;
+
+COMMIT;
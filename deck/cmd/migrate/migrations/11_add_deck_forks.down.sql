@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE answers DROP COLUMN IF EXISTS upstream_answer_id;
+
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS upstream_card_id,
+    DROP COLUMN IF EXISTS upstream_revision;
+
+ALTER TABLE decks
+    DROP COLUMN IF EXISTS revision,
+    DROP COLUMN IF EXISTS upstream_deck_id,
+    DROP COLUMN IF EXISTS upstream_revision;
+
+COMMIT;
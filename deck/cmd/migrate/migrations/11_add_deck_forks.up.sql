@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Grows with every change to the cards of the deck
+ALTER TABLE decks
+    ADD COLUMN IF NOT EXISTS revision INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS upstream_deck_id UUID REFERENCES decks (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS upstream_revision INTEGER;
+
+-- upstream_revision is the revision of the upstream card the copy was last
+-- synced with
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS upstream_card_id UUID REFERENCES cards (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS upstream_revision INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS upstream_answer_id UUID REFERENCES answers (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS decks_upstream_deck_id_idx ON decks (upstream_deck_id);
+CREATE INDEX IF NOT EXISTS cards_upstream_card_id_idx ON cards (upstream_card_id);
+
+COMMIT;
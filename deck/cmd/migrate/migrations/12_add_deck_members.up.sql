@@ -0,0 +1,17 @@
+BEGIN;
+
+-- Users a deck is shared with. Authors aren't stored as members of their own
+-- decks, they are always owners of them.
+CREATE TABLE IF NOT EXISTS deck_members (
+    deck_id UUID NOT NULL REFERENCES decks (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'owner')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (deck_id, user_id)
+);
+
+-- Backs the keyset pagination of the decks shared with a user
+CREATE INDEX IF NOT EXISTS deck_members_user_id_created_at_idx ON deck_members (user_id, created_at, deck_id);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS card_practice_created_at_idx;
+DROP TABLE IF EXISTS deck_popularity;
+DROP TABLE IF EXISTS deck_popularity_refreshes;
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- Each refresh of the popularity of decks is a generation. Cursors point
+-- into the generation they were read from, so pages are ranked alike even
+-- when the scores are recomputed in between.
+CREATE TABLE IF NOT EXISTS deck_popularity_refreshes (
+    generation BIGSERIAL PRIMARY KEY,
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Popularity of decks, recomputed periodically from card_practice and
+-- user_deck. Every deck with activity has a row per generation and period
+-- it is ranked over.
+CREATE TABLE IF NOT EXISTS deck_popularity (
+    generation BIGINT NOT NULL REFERENCES deck_popularity_refreshes (generation) ON DELETE CASCADE,
+    deck_id UUID NOT NULL REFERENCES decks (id) ON DELETE CASCADE,
+    period TEXT NOT NULL CHECK (period IN ('week', 'all_time')),
+    learners BIGINT NOT NULL,
+    answers BIGINT NOT NULL,
+    completion_rate DOUBLE PRECISION NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (generation, period, deck_id)
+);
+
+CREATE INDEX IF NOT EXISTS deck_popularity_score_idx ON deck_popularity (generation, period, score DESC, deck_id DESC);
+
+-- Answers given within a window are looked up when refreshing the scores
+CREATE INDEX IF NOT EXISTS card_practice_created_at_idx ON card_practice (created_at);
+
+COMMIT;
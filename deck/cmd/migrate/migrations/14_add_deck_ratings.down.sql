@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE decks DROP COLUMN IF EXISTS rating_average;
+ALTER TABLE decks DROP COLUMN IF EXISTS rating_count;
+DROP TABLE IF EXISTS deck_ratings;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- One rating per user and deck, which its user can change
+CREATE TABLE IF NOT EXISTS deck_ratings (
+    deck_id UUID NOT NULL REFERENCES decks (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    stars SMALLINT NOT NULL CHECK (stars BETWEEN 1 AND 5),
+    review TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (deck_id, user_id)
+);
+
+-- Backs the keyset pagination of the reviews of a deck
+CREATE INDEX IF NOT EXISTS deck_ratings_deck_id_updated_at_idx ON deck_ratings (deck_id, updated_at, user_id) WHERE review <> '';
+
+-- Kept up to date with deck_ratings whenever a rating changes, so reading a
+-- deck doesn't aggregate its ratings
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS rating_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS rating_average DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS decks_author_id_created_at_idx;
+DROP INDEX IF EXISTS card_practice_user_id_created_at_idx;
+DROP INDEX IF EXISTS user_deck_user_id_created_at_idx;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+-- Backs the listing of the decks in a user's library
+CREATE INDEX IF NOT EXISTS user_deck_user_id_created_at_idx ON user_deck (user_id, created_at, deck_id);
+
+-- The last time a user practised each deck of their library is looked up
+-- from their answers
+CREATE INDEX IF NOT EXISTS card_practice_user_id_created_at_idx ON card_practice (user_id, created_at);
+
+-- Backs the keyset pagination of the decks a user authored
+CREATE INDEX IF NOT EXISTS decks_author_id_created_at_idx ON decks (author_id, created_at, id) WHERE deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE cards DROP COLUMN IF EXISTS version;
+ALTER TABLE decks DROP COLUMN IF EXISTS version;
+
+COMMIT;
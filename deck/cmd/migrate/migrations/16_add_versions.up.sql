@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Updates can ask for the version they were based on, and are rejected when
+-- someone else changed the deck or card in between
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS cards_deck_id_created_at_idx;
+
+COMMIT;
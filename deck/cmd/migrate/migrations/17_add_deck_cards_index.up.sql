@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Backs the keyset pagination of the cards of a deck, which large decks are
+-- read with instead of all at once
+CREATE INDEX IF NOT EXISTS cards_deck_id_created_at_idx ON cards (deck_id, created_at, id) WHERE deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS card_practice_answer_id_created_at_idx;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- The statistics of a deck count how many times each of its answers was
+-- chosen, and when
+CREATE INDEX IF NOT EXISTS card_practice_answer_id_created_at_idx ON card_practice (answer_id, created_at);
+
+COMMIT;
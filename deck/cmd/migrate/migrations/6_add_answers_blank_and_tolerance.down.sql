@@ -0,0 +1,3 @@
+ALTER TABLE answers
+    DROP COLUMN IF EXISTS blank,
+    DROP COLUMN IF EXISTS tolerance;
@@ -0,0 +1,3 @@
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS blank INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tolerance INT NOT NULL DEFAULT 0;
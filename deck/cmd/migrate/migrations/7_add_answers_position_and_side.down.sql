@@ -0,0 +1,3 @@
+ALTER TABLE answers
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS side;
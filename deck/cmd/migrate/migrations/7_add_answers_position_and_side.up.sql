@@ -0,0 +1,3 @@
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS side TEXT NOT NULL DEFAULT '';
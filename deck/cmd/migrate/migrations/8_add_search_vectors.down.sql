@@ -0,0 +1,18 @@
+BEGIN;
+
+DROP INDEX IF EXISTS cards_search_vector_idx;
+DROP INDEX IF EXISTS decks_search_vector_idx;
+
+DROP TRIGGER IF EXISTS decks_language_update ON decks;
+DROP FUNCTION IF EXISTS decks_language_update();
+
+DROP TRIGGER IF EXISTS cards_search_vector_update ON cards;
+DROP FUNCTION IF EXISTS cards_search_vector_update();
+
+ALTER TABLE cards DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE decks
+    DROP COLUMN IF EXISTS search_vector,
+    DROP COLUMN IF EXISTS "language";
+
+COMMIT;
@@ -0,0 +1,52 @@
+BEGIN;
+
+ALTER TABLE decks
+    ADD COLUMN IF NOT EXISTS "language" REGCONFIG NOT NULL DEFAULT 'simple';
+
+ALTER TABLE decks
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector("language", title), 'A') ||
+        setweight(to_tsvector("language", "description"), 'B')
+    ) STORED;
+
+-- Cards are stemmed with the language of their deck, which a generated
+-- column can't look up, so their vector is kept up to date by triggers
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE OR REPLACE FUNCTION cards_search_vector_update() RETURNS trigger AS $$
+DECLARE
+    config REGCONFIG;
+BEGIN
+    SELECT "language" INTO config FROM decks WHERE id = NEW.deck_id;
+
+    NEW.search_vector :=
+        setweight(to_tsvector(COALESCE(config, 'simple'), NEW.title), 'C') ||
+        setweight(to_tsvector(COALESCE(config, 'simple'), COALESCE(NEW.explanation, '')), 'D');
+
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER cards_search_vector_update
+    BEFORE INSERT OR UPDATE OF title, explanation, deck_id ON cards
+    FOR EACH ROW EXECUTE FUNCTION cards_search_vector_update();
+
+CREATE OR REPLACE FUNCTION decks_language_update() RETURNS trigger AS $$
+BEGIN
+    UPDATE cards SET title = title WHERE deck_id = NEW.id;
+
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER decks_language_update
+    AFTER UPDATE OF "language" ON decks
+    FOR EACH ROW WHEN (OLD."language" IS DISTINCT FROM NEW."language")
+    EXECUTE FUNCTION decks_language_update();
+
+UPDATE cards SET title = title;
+
+CREATE INDEX IF NOT EXISTS decks_search_vector_idx ON decks USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS cards_search_vector_idx ON cards USING GIN (search_vector);
+
+COMMIT;
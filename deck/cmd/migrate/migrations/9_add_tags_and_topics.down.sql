@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS deck_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS topics;
+
+COMMIT;
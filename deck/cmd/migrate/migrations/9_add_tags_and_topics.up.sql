@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS topics (
+    id UUID DEFAULT gen_random_uuid () PRIMARY KEY,
+    parent_id UUID REFERENCES topics (id) ON DELETE CASCADE,
+    "name" TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Sibling topics can't share a name, root topics included
+CREATE UNIQUE INDEX IF NOT EXISTS topics_parent_id_name_key
+    ON topics (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'), lower("name"));
+
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID DEFAULT gen_random_uuid () PRIMARY KEY,
+    "name" TEXT NOT NULL UNIQUE,
+    topic_id UUID REFERENCES topics (id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS tags_name_prefix_idx ON tags ("name" text_pattern_ops);
+CREATE INDEX IF NOT EXISTS tags_topic_id_idx ON tags (topic_id);
+
+CREATE TABLE IF NOT EXISTS deck_tags (
+    deck_id UUID REFERENCES decks (id) ON DELETE CASCADE NOT NULL,
+    tag_id UUID REFERENCES tags (id) ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (deck_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS deck_tags_tag_id_idx ON deck_tags (tag_id);
+
+COMMIT;
@@ -0,0 +1,165 @@
+package deck
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardFlag marks a card whose statistics suggest it needs a second look.
+type CardFlag string
+
+const (
+	// CardFlagTrappingDistractor marks cards with a wrong answer chosen more
+	// often than any of the right ones.
+	CardFlagTrappingDistractor CardFlag = "trapping_distractor"
+	// CardFlagUnusedDistractor marks cards with a wrong answer nobody
+	// chooses, which only makes the card easier to guess.
+	CardFlagUnusedDistractor CardFlag = "unused_distractor"
+	// CardFlagTooHard marks cards few learners get right at first.
+	CardFlagTooHard CardFlag = "too_hard"
+	// CardFlagTooEasy marks cards nearly every learner gets right at first.
+	CardFlagTooEasy CardFlag = "too_easy"
+)
+
+// Thresholds of the flags. Cards are only flagged once they were tried
+// enough for their statistics to mean something.
+const (
+	minFlaggedAttempts = 10
+	minUnusedAttempts  = 30
+	tooHardRate        = 0.25
+	tooEasyRate        = 0.95
+)
+
+// AnalyticsRange is the time window the statistics of a deck are computed
+// over. A zero From starts at the first answer, and a zero To ends now.
+type AnalyticsRange struct {
+	From time.Time
+	To   time.Time
+}
+
+func (r AnalyticsRange) Validate() error {
+	if !r.From.IsZero() && !r.To.IsZero() && !r.From.Before(r.To) {
+		return ErrInvalidRange
+	}
+
+	return nil
+}
+
+// bounds returns the start and the end of the range, with open ends set to
+// the zero time and to infinity respectively.
+func (r AnalyticsRange) bounds() (time.Time, time.Time) {
+	to := r.To
+	if to.IsZero() {
+		to = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return r.From, to
+}
+
+// AnswerStats is how many times an answer of a card was chosen.
+type AnswerStats struct {
+	AnswerID  uuid.UUID
+	IsCorrect bool
+	Chosen    int
+}
+
+// CardStats are the statistics of a card over a range. An attempt is every
+// answer a learner chose for the card at once, graded like GradeAnswers
+// does. A learner's first try is their first attempt ever, and it only
+// counts when it falls within the range and can be graded.
+type CardStats struct {
+	CardID          uuid.UUID
+	Attempts        int
+	FirstTries      int
+	FirstTryCorrect int
+	// AverageLevel is the current level of the card among the learners
+	// who ever got it right, whatever the range, and 0 if none did
+	AverageLevel float64
+	Answers      []AnswerStats
+	Flags        []CardFlag
+}
+
+// choiceAnswer turns the answers chosen in an attempt, which is all that is
+// kept of it, into the answer the kind of the card grades. The order of
+// ordering cards, the pairs of matching ones and the text typed into blanks
+// aren't kept, so attempts at those can't be graded.
+func choiceAnswer(c Card, chosen []uuid.UUID) (CardAnswer, bool) {
+	a := CardAnswer{CardID: c.ID}
+
+	switch c.Kind {
+	case CardKindSingleChoice, CardKindTrueFalse:
+		// Choosing several answers of a card with only one to choose is
+		// wrong, which leaving AnswerID unset makes it
+		if len(chosen) == 1 {
+			a.AnswerID = chosen[0]
+		}
+	case CardKindMultipleChoice:
+		a.AnswerIDs = chosen
+	default:
+		return CardAnswer{}, false
+	}
+
+	return a, true
+}
+
+// FirstTryCorrectRate is the share of first tries that were correct, 0
+// when nobody tried the card yet.
+func (s CardStats) FirstTryCorrectRate() float64 {
+	if s.FirstTries == 0 {
+		return 0
+	}
+
+	return float64(s.FirstTryCorrect) / float64(s.FirstTries)
+}
+
+// flag returns the flags the statistics of the card deserve.
+func (s CardStats) flag() []CardFlag {
+	var (
+		flags       []CardFlag
+		mostCorrect = -1
+		mostWrong   = -1
+		unusedWrong bool
+	)
+
+	for _, a := range s.Answers {
+		if a.IsCorrect {
+			mostCorrect = max(mostCorrect, a.Chosen)
+			continue
+		}
+
+		mostWrong = max(mostWrong, a.Chosen)
+		if a.Chosen == 0 {
+			unusedWrong = true
+		}
+	}
+
+	// Cards without wrong answers, like those with blanks, have no
+	// distractors to look at
+	if mostCorrect >= 0 && mostWrong > mostCorrect && s.Attempts >= minFlaggedAttempts {
+		flags = append(flags, CardFlagTrappingDistractor)
+	}
+
+	if unusedWrong && s.Attempts >= minUnusedAttempts {
+		flags = append(flags, CardFlagUnusedDistractor)
+	}
+
+	if s.FirstTries >= minFlaggedAttempts {
+		switch rate := s.FirstTryCorrectRate(); {
+		case rate < tooHardRate:
+			flags = append(flags, CardFlagTooHard)
+		case rate >= tooEasyRate:
+			flags = append(flags, CardFlagTooEasy)
+		}
+	}
+
+	return flags
+}
+
+// DeckAnalytics are the statistics of every card of a deck, in the order
+// the cards were added.
+type DeckAnalytics struct {
+	DeckID uuid.UUID
+	Range  AnalyticsRange
+	Cards  []CardStats
+}
@@ -0,0 +1,118 @@
+package deck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalytics_RangeValidate(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, AnalyticsRange{}.Validate())
+	assert.NoError(t, AnalyticsRange{From: day}.Validate())
+	assert.NoError(t, AnalyticsRange{To: day}.Validate())
+	assert.NoError(t, AnalyticsRange{From: day, To: day.AddDate(0, 0, 1)}.Validate())
+
+	assert.ErrorIs(t, AnalyticsRange{From: day, To: day}.Validate(), ErrInvalidRange)
+	assert.ErrorIs(t, AnalyticsRange{From: day, To: day.AddDate(0, 0, -1)}.Validate(), ErrInvalidRange)
+}
+
+func TestAnalytics_FirstTryCorrectRate(t *testing.T) {
+	assert.Equal(t, 0.0, CardStats{}.FirstTryCorrectRate())
+	assert.Equal(t, 0.75, CardStats{FirstTries: 4, FirstTryCorrect: 3}.FirstTryCorrectRate())
+}
+
+func TestAnalytics_ChoiceAnswer(t *testing.T) {
+	right, also, wrong := uuid.New(), uuid.New(), uuid.New()
+	answers := []Answer{{ID: right, IsCorrect: true}, {ID: also, IsCorrect: true}, {ID: wrong}}
+
+	grade := func(kind string, chosen ...uuid.UUID) (bool, bool) {
+		c := Card{ID: uuid.New(), Kind: kind, PossibleAnswers: answers}
+
+		a, ok := choiceAnswer(c, chosen)
+		if !ok {
+			return false, false
+		}
+
+		return c.Grade(a).IsCorrect, true
+	}
+
+	correct, ok := grade(CardKindSingleChoice, right)
+	assert.True(t, ok)
+	assert.True(t, correct)
+
+	correct, _ = grade(CardKindSingleChoice, right, wrong)
+	assert.False(t, correct)
+
+	// Some of the right answers aren't enough
+	correct, ok = grade(CardKindMultipleChoice, right)
+	assert.True(t, ok)
+	assert.False(t, correct)
+
+	correct, _ = grade(CardKindMultipleChoice, also, right)
+	assert.True(t, correct)
+
+	for _, kind := range []string{CardKindOrdering, CardKindMatching, CardKindFillInTheBlanks} {
+		_, ok = grade(kind, right, also)
+		assert.False(t, ok, kind)
+	}
+}
+
+func TestAnalytics_Flags(t *testing.T) {
+	answers := func(chosen ...int) []AnswerStats {
+		out := make([]AnswerStats, 0, len(chosen))
+		for i, c := range chosen {
+			out = append(out, AnswerStats{IsCorrect: i == 0, Chosen: c})
+		}
+
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		stats CardStats
+		want  []CardFlag
+	}{
+		{
+			name:  "healthy",
+			stats: CardStats{Attempts: 40, FirstTries: 20, FirstTryCorrect: 12, Answers: answers(25, 10, 5)},
+		},
+		{
+			name:  "trapping_distractor",
+			stats: CardStats{Attempts: 40, FirstTries: 20, FirstTryCorrect: 8, Answers: answers(15, 20, 5)},
+			want:  []CardFlag{CardFlagTrappingDistractor},
+		},
+		{
+			name:  "unused_distractor",
+			stats: CardStats{Attempts: 40, FirstTries: 20, FirstTryCorrect: 12, Answers: answers(30, 10, 0)},
+			want:  []CardFlag{CardFlagUnusedDistractor},
+		},
+		{
+			name:  "too_hard",
+			stats: CardStats{Attempts: 20, FirstTries: 10, FirstTryCorrect: 2, Answers: answers(8, 7, 5)},
+			want:  []CardFlag{CardFlagTooHard},
+		},
+		{
+			name:  "too_easy",
+			stats: CardStats{Attempts: 20, FirstTries: 20, FirstTryCorrect: 19, Answers: answers(19, 1)},
+			want:  []CardFlag{CardFlagTooEasy},
+		},
+		{
+			name:  "too_few_attempts",
+			stats: CardStats{Attempts: 5, FirstTries: 5, FirstTryCorrect: 0, Answers: answers(0, 5, 0)},
+		},
+		{
+			name:  "no_distractors",
+			stats: CardStats{Attempts: 20, FirstTries: 10, FirstTryCorrect: 6, Answers: []AnswerStats{{IsCorrect: true, Chosen: 12}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.stats.flag())
+		})
+	}
+}
@@ -0,0 +1,700 @@
+package deck
+
+import (
+	"archive/zip"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	// Pure Go SQLite driver, so that reading collections needs no cgo nor
+	// external binaries
+	_ "modernc.org/sqlite"
+)
+
+// FormatAnki is the format of Anki's deck packages, a zip holding a SQLite
+// collection and its media.
+const FormatAnki = "apkg"
+
+// Anki note types
+const (
+	ankiModelStandard = 0
+	ankiModelCloze    = 1
+)
+
+// Collections bigger than this are refused rather than unpacked to disk
+const maxAnkiCollectionSize = 256 << 20
+
+const ankiFieldSeparator = "\x1f"
+
+var (
+	ankiClozeRE      = regexp.MustCompile(`\{\{c(\d+)::([\s\S]*?)(?:::([\s\S]*?))?\}\}`)
+	ankiBreakRE      = regexp.MustCompile(`(?i)<br\s*/?>|</div>|</p>`)
+	ankiTagRE        = regexp.MustCompile(`<[^>]*>`)
+	ankiSoundRE      = regexp.MustCompile(`\[sound:[^\]]*\]`)
+	ankiBlankLinesRE = regexp.MustCompile(`\n{3,}`)
+)
+
+type ankiModel struct {
+	ID    int64          `json:"id"`
+	Name  string         `json:"name"`
+	Type  int            `json:"type"`
+	Flds  []ankiField    `json:"flds"`
+	Tmpls []ankiTemplate `json:"tmpls"`
+}
+
+type ankiField struct {
+	Name string `json:"name"`
+	Ord  int    `json:"ord"`
+}
+
+type ankiTemplate struct {
+	Name string `json:"name"`
+	Ord  int    `json:"ord"`
+	Qfmt string `json:"qfmt"`
+	Afmt string `json:"afmt"`
+}
+
+type ankiDeck struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Desc string `json:"desc"`
+}
+
+// ImportAnki fills d with the cards read from the notes of an .apkg
+// package. Basic notes become fill in the blanks cards whose answer is typed
+// in, as a single answer leaves nothing to choose from, and cloze notes
+// become fill in the blanks cards with one blank per cloze. Notes of any
+// other type end up in the returned ErroredDeck as ErrUnsupportedNoteType,
+// each ErroredCard carrying the 1-based number of its note as its row.
+//
+// Cards have no room for media, so images and sounds are dropped along with
+// the rest of the HTML. When d has no title or description, those of the
+// Anki deck holding the first card are used.
+func ImportAnki(d Deck, r io.ReaderAt, size int64) (Deck, ErroredDeck, error) {
+	db, cleanup, err := openAnkiCollection(r, size)
+	if err != nil {
+		return d, ErroredDeck{}, err
+	}
+	defer cleanup()
+
+	var modelsJSON, decksJSON string
+	if err := db.QueryRow(`SELECT models, decks FROM col`).Scan(&modelsJSON, &decksJSON); err != nil {
+		return d, ErroredDeck{}, errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+
+	models := map[string]ankiModel{}
+	if err := json.Unmarshal([]byte(modelsJSON), &models); err != nil {
+		return d, ErroredDeck{}, errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+
+	if d.Title == "" || d.Description == "" {
+		d = withAnkiDeckInfo(db, d, decksJSON)
+	}
+
+	rows, err := db.Query(`SELECT mid, flds FROM notes ORDER BY id`)
+	if err != nil {
+		return d, ErroredDeck{}, errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+	defer rows.Close()
+
+	d.Cards = nil
+	erroredCards := []ErroredCard{}
+
+	for note := 1; rows.Next(); note++ {
+		var (
+			modelID int64
+			fields  string
+		)
+		if err := rows.Scan(&modelID, &fields); err != nil {
+			return d, ErroredDeck{}, errors.Annotate(ErrUnreadableFile, err.Error())
+		}
+
+		card, errs := cardFromAnkiNote(models[strconv.FormatInt(modelID, 10)], strings.Split(fields, ankiFieldSeparator))
+		if len(errs) == 0 {
+			if _, ec := ValidateCard(card); len(ec.Errs) > 0 {
+				errs = ec.Errs
+			}
+		}
+
+		if len(errs) > 0 {
+			erroredCards = append(erroredCards, ErroredCard{C: card, Row: note, Errs: errs})
+			continue
+		}
+
+		d.Cards = append(d.Cards, card)
+	}
+
+	if err := rows.Err(); err != nil {
+		return d, ErroredDeck{}, errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+
+	return d, importErrors(d, erroredCards), nil
+}
+
+func openAnkiCollection(r io.ReaderAt, size int64) (*sql.DB, func(), error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	// Newer Anki versions write the real collection as collection.anki21 or
+	// collection.anki21b next to a stub collection.anki2 asking to upgrade.
+	// anki21b collections are zstd compressed with another schema and are
+	// not supported.
+	collection, ok := files["collection.anki21"]
+	if !ok {
+		if _, ok := files["collection.anki21b"]; ok {
+			return nil, nil, ErrUnsupportedCollection
+		}
+
+		collection, ok = files["collection.anki2"]
+	}
+	if !ok {
+		return nil, nil, errors.Annotate(ErrUnreadableFile, "no collection in package")
+	}
+
+	dir, err := os.MkdirTemp("", "toshokan-apkg-")
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	cleanup := func() { os.RemoveAll(dir) }
+
+	path := filepath.Join(dir, "collection.anki2")
+	if err := unzipFile(collection, path); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+
+	return db, func() {
+		db.Close()
+		cleanup()
+	}, nil
+}
+
+func unzipFile(f *zip.File, path string) error {
+	in, err := f.Open()
+	if err != nil {
+		return errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+	defer in.Close()
+
+	out, err := os.Create(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer out.Close()
+
+	n, err := io.Copy(out, io.LimitReader(in, maxAnkiCollectionSize+1))
+	if err != nil {
+		return errors.Annotate(ErrUnreadableFile, err.Error())
+	}
+	if n > maxAnkiCollectionSize {
+		return errors.Annotate(ErrUnreadableFile, "collection is too big")
+	}
+
+	return errors.Trace(out.Close())
+}
+
+func withAnkiDeckInfo(db *sql.DB, d Deck, decksJSON string) Deck {
+	decks := map[string]ankiDeck{}
+	if err := json.Unmarshal([]byte(decksJSON), &decks); err != nil {
+		return d
+	}
+
+	var deckID int64
+	if err := db.QueryRow(`SELECT did FROM cards ORDER BY id LIMIT 1`).Scan(&deckID); err != nil {
+		return d
+	}
+
+	ad, ok := decks[strconv.FormatInt(deckID, 10)]
+	if !ok {
+		return d
+	}
+
+	if d.Title == "" {
+		d.Title = ad.Name
+	}
+	if d.Description == "" {
+		d.Description = ankiText(ad.Desc)
+	}
+
+	return d
+}
+
+func cardFromAnkiNote(m ankiModel, fields []string) (Card, []error) {
+	field := func(i int) string {
+		if i >= len(fields) {
+			return ""
+		}
+
+		return ankiText(fields[i])
+	}
+
+	switch {
+	case m.Type == ankiModelCloze && !isImageOcclusion(m, fields):
+		return clozeCard(field(0), field(1)), nil
+	case m.Type == ankiModelStandard && len(m.Flds) >= 2:
+		return Card{
+			Title:           field(0),
+			Explanation:     field(2),
+			Kind:            CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{{Text: field(1), IsCorrect: true}},
+		}, nil
+	default:
+		return Card{Title: field(0)}, []error{ErrUnsupportedNoteType}
+	}
+}
+
+func isImageOcclusion(m ankiModel, fields []string) bool {
+	return strings.Contains(m.Name, "Image Occlusion") ||
+		(len(fields) > 0 && strings.Contains(fields[0], "image-occlusion:"))
+}
+
+// clozeCard turns every cloze of text into a blank, in the order they
+// appear, and the hints are left out.
+func clozeCard(text, extra string) Card {
+	card := Card{Explanation: extra, Kind: CardKindFillInTheBlanks}
+
+	blank := 0
+	card.Title = ankiClozeRE.ReplaceAllStringFunc(text, func(cloze string) string {
+		match := ankiClozeRE.FindStringSubmatch(cloze)
+		card.PossibleAnswers = append(card.PossibleAnswers, Answer{
+			Text:      strings.TrimSpace(match[2]),
+			IsCorrect: true,
+			Blank:     blank,
+		})
+		blank++
+
+		return "___"
+	})
+
+	return card
+}
+
+// ankiText turns the HTML of a field into plain text.
+func ankiText(field string) string {
+	out := ankiBreakRE.ReplaceAllString(field, "\n")
+	out = ankiTagRE.ReplaceAllString(out, "")
+	out = ankiSoundRE.ReplaceAllString(out, "")
+	out = html.UnescapeString(out)
+	out = strings.ReplaceAll(out, "\u00a0", " ")
+	out = ankiBlankLinesRE.ReplaceAllString(out, "\n\n")
+
+	return strings.TrimSpace(out)
+}
+
+// ankiHTML is the reverse of ankiText.
+func ankiHTML(text string) string {
+	return strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")
+}
+
+// Note types written to exported packages. Their IDs are fixed so that
+// importing several exports into Anki reuses them.
+var (
+	ankiBasicModel = ankiModel{
+		ID:   1700000000001,
+		Name: "Toshokan Basic",
+		Type: ankiModelStandard,
+		Flds: []ankiField{{Name: "Front", Ord: 0}, {Name: "Back", Ord: 1}, {Name: "Explanation", Ord: 2}},
+		Tmpls: []ankiTemplate{{
+			Name: "Card 1",
+			Qfmt: "{{Front}}",
+			Afmt: "{{FrontSide}}\n\n<hr id=answer>\n\n{{Back}}\n\n<br><br>{{Explanation}}",
+		}},
+	}
+	ankiClozeModel = ankiModel{
+		ID:   1700000000002,
+		Name: "Toshokan Cloze",
+		Type: ankiModelCloze,
+		Flds: []ankiField{{Name: "Text", Ord: 0}, {Name: "Back Extra", Ord: 1}},
+		Tmpls: []ankiTemplate{{
+			Name: "Cloze",
+			Qfmt: "{{cloze:Text}}",
+			Afmt: "{{cloze:Text}}<br>\n{{Back Extra}}",
+		}},
+	}
+)
+
+type ankiNote struct {
+	GUID   string
+	Model  ankiModel
+	Fields []string
+	// Cards is the number of Anki cards the note makes, one per cloze for
+	// cloze notes
+	Cards int
+}
+
+// ExportAnki writes d as an .apkg package that Anki can import. Fill in the
+// blanks cards become cloze notes and every other card a basic note whose
+// back lists the correct answers, in order for ordering cards and as pairs
+// for matching cards. Wrong answers have no place in Anki and are left out.
+func ExportAnki(w io.Writer, d Deck) error {
+	notes := make([]ankiNote, 0, len(d.Cards))
+	for _, c := range d.Cards {
+		notes = append(notes, ankiNoteFromCard(c))
+	}
+
+	dir, err := os.MkdirTemp("", "toshokan-apkg-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "collection.anki2")
+	if err := writeAnkiCollection(path, d, []ankiModel{ankiBasicModel, ankiClozeModel}, notes); err != nil {
+		return err
+	}
+
+	collection, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	archive := zip.NewWriter(w)
+
+	f, err := archive.Create("collection.anki2")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := f.Write(collection); err != nil {
+		return errors.Trace(err)
+	}
+
+	f, err = archive.Create("media")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := io.WriteString(f, "{}"); err != nil {
+		return errors.Trace(err)
+	}
+
+	return errors.Trace(archive.Close())
+}
+
+func ankiNoteFromCard(c Card) ankiNote {
+	guid := c.ID.String()
+	if c.ID == uuid.Nil {
+		guid = uuid.NewString()
+	}
+
+	if c.Kind == CardKindFillInTheBlanks {
+		return ankiNote{
+			GUID:   guid,
+			Model:  ankiClozeModel,
+			Fields: []string{clozeText(c), ankiHTML(c.Explanation)},
+			Cards:  len(c.acceptedByBlank()),
+		}
+	}
+
+	var back []string
+	if c.Kind == CardKindMatching {
+		lefts := map[int]string{}
+		for _, a := range c.orderedItems() {
+			if a.Side == AnswerSideLeft {
+				lefts[a.Position] = a.Text
+			}
+		}
+
+		for _, a := range c.orderedItems() {
+			if left, ok := lefts[a.Position]; ok && a.Side == AnswerSideRight {
+				back = append(back, ankiHTML(left+" – "+a.Text))
+			}
+		}
+	} else {
+		for _, a := range c.orderedItems() {
+			back = append(back, ankiHTML(a.Text))
+		}
+	}
+
+	return ankiNote{
+		GUID:   guid,
+		Model:  ankiBasicModel,
+		Fields: []string{ankiHTML(c.Title), strings.Join(back, "<br>"), ankiHTML(c.Explanation)},
+		Cards:  1,
+	}
+}
+
+// clozeText fills each "___" of the title with a cloze holding the first
+// accepted answer of its blank. Blanks the title has no room for are
+// appended at the end.
+func clozeText(c Card) string {
+	blanks := c.acceptedByBlank()
+
+	cloze := func(blank int) string {
+		text := ""
+		if len(blanks[blank]) > 0 {
+			text = blanks[blank][0].Text
+		}
+
+		return fmt.Sprintf("{{c%d::%s}}", blank+1, ankiHTML(text))
+	}
+
+	parts := strings.Split(c.Title, "___")
+
+	var b strings.Builder
+	for i, part := range parts {
+		b.WriteString(ankiHTML(part))
+
+		switch {
+		case i == len(parts)-1:
+		case i < len(blanks):
+			b.WriteString(cloze(i))
+		default:
+			b.WriteString("___")
+		}
+	}
+
+	for blank := len(parts) - 1; blank < len(blanks); blank++ {
+		b.WriteString(" " + cloze(blank))
+	}
+
+	return b.String()
+}
+
+const ankiSchema = `
+CREATE TABLE col (id integer PRIMARY KEY, crt integer NOT NULL, mod integer NOT NULL, scm integer NOT NULL, ver integer NOT NULL, dty integer NOT NULL, usn integer NOT NULL, ls integer NOT NULL, conf text NOT NULL, models text NOT NULL, decks text NOT NULL, dconf text NOT NULL, tags text NOT NULL);
+CREATE TABLE notes (id integer PRIMARY KEY, guid text NOT NULL, mid integer NOT NULL, mod integer NOT NULL, usn integer NOT NULL, tags text NOT NULL, flds text NOT NULL, sfld integer NOT NULL, csum integer NOT NULL, flags integer NOT NULL, data text NOT NULL);
+CREATE TABLE cards (id integer PRIMARY KEY, nid integer NOT NULL, did integer NOT NULL, ord integer NOT NULL, mod integer NOT NULL, usn integer NOT NULL, type integer NOT NULL, queue integer NOT NULL, due integer NOT NULL, ivl integer NOT NULL, factor integer NOT NULL, reps integer NOT NULL, lapses integer NOT NULL, left integer NOT NULL, odue integer NOT NULL, odid integer NOT NULL, flags integer NOT NULL, data text NOT NULL);
+CREATE TABLE revlog (id integer PRIMARY KEY, cid integer NOT NULL, usn integer NOT NULL, ease integer NOT NULL, ivl integer NOT NULL, lastIvl integer NOT NULL, factor integer NOT NULL, time integer NOT NULL, type integer NOT NULL);
+CREATE TABLE graves (usn integer NOT NULL, oid integer NOT NULL, type integer NOT NULL);
+CREATE INDEX ix_notes_usn ON notes (usn);
+CREATE INDEX ix_cards_usn ON cards (usn);
+CREATE INDEX ix_revlog_usn ON revlog (usn);
+CREATE INDEX ix_cards_nid ON cards (nid);
+CREATE INDEX ix_cards_sched ON cards (did, queue, due);
+CREATE INDEX ix_revlog_cid ON revlog (cid);
+CREATE INDEX ix_notes_csum ON notes (csum);
+`
+
+// writeAnkiCollection writes a schema 11 collection, the one every Anki
+// version since 2.0 can import, with all notes in a single deck.
+func writeAnkiCollection(path string, d Deck, models []ankiModel, notes []ankiNote) error {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(ankiSchema); err != nil {
+		return errors.Trace(err)
+	}
+
+	now := time.Now()
+	deckID := now.UnixMilli()
+
+	modelsJSON := map[string]any{}
+	for _, m := range models {
+		modelsJSON[strconv.FormatInt(m.ID, 10)] = ankiModelJSON(m, deckID, now)
+	}
+
+	decksJSON := map[string]any{
+		"1":                           ankiDeckJSON(1, "Default", "", now),
+		strconv.FormatInt(deckID, 10): ankiDeckJSON(deckID, d.Title, ankiHTML(d.Description), now),
+	}
+
+	conf := map[string]any{
+		"activeDecks":   []int64{deckID},
+		"curDeck":       deckID,
+		"newSpread":     0,
+		"collapseTime":  1200,
+		"timeLim":       0,
+		"estTimes":      true,
+		"dueCounts":     true,
+		"curModel":      strconv.FormatInt(models[0].ID, 10),
+		"nextPos":       len(notes) + 1,
+		"sortType":      "noteFld",
+		"sortBackwards": false,
+		"addToCur":      true,
+	}
+
+	dconf := map[string]any{"1": ankiDeckConfJSON(now)}
+
+	var encoded [4][]byte
+	for i, v := range []any{conf, modelsJSON, decksJSON, dconf} {
+		if encoded[i], err = json.Marshal(v); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(
+		`INSERT INTO col VALUES (1, ?, ?, ?, 11, 0, 0, 0, ?, ?, ?, ?, '{}')`,
+		now.Unix(), now.UnixMilli(), now.UnixMilli(),
+		string(encoded[0]), string(encoded[1]), string(encoded[2]), string(encoded[3]),
+	); err != nil {
+		return errors.Trace(err)
+	}
+
+	noteID := now.UnixMilli()
+	cardID := now.UnixMilli()
+
+	for i, n := range notes {
+		noteID++
+		sortField := ankiText(n.Fields[0])
+
+		if _, err := tx.Exec(
+			`INSERT INTO notes VALUES (?, ?, ?, ?, -1, '', ?, ?, ?, 0, '')`,
+			noteID, n.GUID, n.Model.ID, now.Unix(), strings.Join(n.Fields, ankiFieldSeparator), sortField, ankiChecksum(sortField),
+		); err != nil {
+			return errors.Trace(err)
+		}
+
+		for ord := 0; ord < n.Cards; ord++ {
+			cardID++
+
+			if _, err := tx.Exec(
+				`INSERT INTO cards VALUES (?, ?, ?, ?, ?, -1, 0, 0, ?, 0, 0, 0, 0, 0, 0, 0, 0, '')`,
+				cardID, noteID, deckID, ord, now.Unix(), i+1,
+			); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return errors.Trace(db.Close())
+}
+
+// ankiChecksum is the first 8 hex digits of the SHA-1 of the sort field,
+// which Anki uses to find duplicates.
+func ankiChecksum(sortField string) int64 {
+	sum := sha1.Sum([]byte(sortField))
+	out, _ := strconv.ParseInt(hex.EncodeToString(sum[:4]), 16, 64)
+
+	return out
+}
+
+func ankiModelJSON(m ankiModel, deckID int64, now time.Time) map[string]any {
+	flds := make([]map[string]any, 0, len(m.Flds))
+	for _, f := range m.Flds {
+		flds = append(flds, map[string]any{
+			"name": f.Name, "ord": f.Ord, "sticky": false, "rtl": false,
+			"font": "Arial", "size": 20, "media": []string{},
+		})
+	}
+
+	tmpls := make([]map[string]any, 0, len(m.Tmpls))
+	for _, t := range m.Tmpls {
+		tmpls = append(tmpls, map[string]any{
+			"name": t.Name, "ord": t.Ord, "qfmt": t.Qfmt, "afmt": t.Afmt,
+			"did": nil, "bqfmt": "", "bafmt": "",
+		})
+	}
+
+	out := map[string]any{
+		"id":        m.ID,
+		"name":      m.Name,
+		"type":      m.Type,
+		"mod":       now.Unix(),
+		"usn":       -1,
+		"sortf":     0,
+		"did":       deckID,
+		"flds":      flds,
+		"tmpls":     tmpls,
+		"tags":      []string{},
+		"vers":      []int{},
+		"css":       ".card {\n font-family: arial;\n font-size: 20px;\n text-align: center;\n color: black;\n background-color: white;\n}\n.cloze {\n font-weight: bold;\n color: blue;\n}\n",
+		"latexPre":  "\\documentclass[12pt]{article}\n\\special{papersize=3in,5in}\n\\usepackage[utf8]{inputenc}\n\\usepackage{amssymb,amsmath}\n\\pagestyle{empty}\n\\setlength{\\parindent}{0in}\n\\begin{document}\n",
+		"latexPost": "\\end{document}",
+		"latexsvg":  false,
+	}
+
+	// Standard notes make a card as soon as their first field is filled
+	if m.Type == ankiModelStandard {
+		out["req"] = []any{[]any{0, "any", []int{0}}}
+	}
+
+	return out
+}
+
+func ankiDeckJSON(id int64, name, desc string, now time.Time) map[string]any {
+	return map[string]any{
+		"id":               id,
+		"name":             name,
+		"desc":             desc,
+		"mod":              now.Unix(),
+		"usn":              -1,
+		"collapsed":        false,
+		"browserCollapsed": false,
+		"newToday":         []int{0, 0},
+		"revToday":         []int{0, 0},
+		"lrnToday":         []int{0, 0},
+		"timeToday":        []int{0, 0},
+		"dyn":              0,
+		"conf":             1,
+		"extendNew":        10,
+		"extendRev":        50,
+	}
+}
+
+func ankiDeckConfJSON(now time.Time) map[string]any {
+	return map[string]any{
+		"id":       1,
+		"name":     "Default",
+		"mod":      now.Unix(),
+		"usn":      -1,
+		"dyn":      false,
+		"maxTaken": 60,
+		"timer":    0,
+		"autoplay": true,
+		"replayq":  true,
+		"new": map[string]any{
+			"delays":        []float64{1, 10},
+			"ints":          []int{1, 4, 7},
+			"initialFactor": 2500,
+			"order":         1,
+			"perDay":        20,
+			"bury":          true,
+			"separate":      true,
+		},
+		"rev": map[string]any{
+			"perDay":   200,
+			"ease4":    1.3,
+			"fuzz":     0.05,
+			"ivlFct":   1,
+			"maxIvl":   36500,
+			"bury":     true,
+			"minSpace": 1,
+		},
+		"lapse": map[string]any{
+			"delays":      []float64{10},
+			"mult":        0,
+			"minInt":      1,
+			"leechFails":  8,
+			"leechAction": 0,
+		},
+	}
+}
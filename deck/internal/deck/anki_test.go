@@ -0,0 +1,203 @@
+package deck
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnki_ImportAnki(t *testing.T) {
+	basic := ankiModel{
+		ID:    1342697561419,
+		Name:  "Basic",
+		Type:  ankiModelStandard,
+		Flds:  []ankiField{{Name: "Front"}, {Name: "Back", Ord: 1}},
+		Tmpls: []ankiTemplate{{Name: "Card 1", Qfmt: "{{Front}}", Afmt: "{{FrontSide}}<hr id=answer>{{Back}}"}},
+	}
+	typeIn := ankiModel{
+		ID:    1342697561420,
+		Name:  "Basic (type in the answer)",
+		Type:  ankiModelStandard,
+		Flds:  []ankiField{{Name: "Front"}, {Name: "Back", Ord: 1}},
+		Tmpls: []ankiTemplate{{Name: "Card 1", Qfmt: "{{Front}}\n\n{{type:Back}}", Afmt: "{{Front}}<hr id=answer>{{type:Back}}"}},
+	}
+	cloze := ankiModel{
+		ID:    1342697561421,
+		Name:  "Cloze",
+		Type:  ankiModelCloze,
+		Flds:  []ankiField{{Name: "Text"}, {Name: "Back Extra", Ord: 1}},
+		Tmpls: []ankiTemplate{{Name: "Cloze", Qfmt: "{{cloze:Text}}", Afmt: "{{cloze:Text}}<br>{{Back Extra}}"}},
+	}
+	occlusion := ankiModel{
+		ID:    1342697561422,
+		Name:  "Image Occlusion",
+		Type:  ankiModelCloze,
+		Flds:  []ankiField{{Name: "Occlusion"}, {Name: "Image", Ord: 1}},
+		Tmpls: []ankiTemplate{{Name: "Image Occlusion", Qfmt: "{{cloze:Occlusion}}", Afmt: "{{cloze:Occlusion}}"}},
+	}
+
+	data := ankiPackage(t, Deck{Title: "Japanese::N5", Description: "Core words"}, []ankiModel{basic, typeIn, cloze, occlusion}, []ankiNote{
+		{GUID: "a", Model: basic, Fields: []string{"本", "book&nbsp;<img src=\"book.png\">"}, Cards: 1},
+		{GUID: "b", Model: typeIn, Fields: []string{"Capital of Japan?", "Tokyo [sound:tokyo.mp3]"}, Cards: 1},
+		{GUID: "c", Model: cloze, Fields: []string{"{{c1::Go}} was designed at <i>{{c2::Google::company}}</i>", "Released in 2009"}, Cards: 2},
+		{GUID: "d", Model: occlusion, Fields: []string{"{{c1::image-occlusion:rect:left=.1:top=.2}}", "<img src=\"map.png\">"}, Cards: 1},
+	})
+
+	d, ed, err := ImportAnki(Deck{}, bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Japanese::N5", d.Title)
+	assert.Equal(t, "Core words", d.Description)
+	assert.Equal(t, []Card{
+		{
+			Title:           "本",
+			Kind:            CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{{Text: "book", IsCorrect: true}},
+		},
+		{
+			Title:           "Capital of Japan?",
+			Kind:            CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{{Text: "Tokyo", IsCorrect: true}},
+		},
+		{
+			Title:       "___ was designed at ___",
+			Explanation: "Released in 2009",
+			Kind:        CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Blank: 0},
+				{Text: "Google", IsCorrect: true, Blank: 1},
+			},
+		},
+	}, d.Cards)
+
+	assert.Equal(t, []error{ErrCards}, ed.Errs)
+	require.Len(t, ed.ErroredCards, 1)
+	assert.Equal(t, []error{ErrUnsupportedNoteType}, ed.ErroredCards[0].Errs)
+	assert.Equal(t, 4, ed.ErroredCards[0].Row)
+}
+
+func TestAnki_ExportAnki(t *testing.T) {
+	d := Deck{
+		Title:       "Go",
+		Description: "Polish your Go skills",
+		Cards: []Card{
+			{
+				ID:          uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"),
+				Title:       "What does CSP stand for?",
+				Explanation: "Hoare, 1978",
+				Kind:        CardKindSingleChoice,
+				PossibleAnswers: []Answer{
+					{Text: "Concurrent Shared Processes"},
+					{Text: "Communicating Sequential Processes", IsCorrect: true},
+				},
+			},
+			{
+				Title: "___ was designed at ___",
+				Kind:  CardKindFillInTheBlanks,
+				PossibleAnswers: []Answer{
+					{Text: "Go", IsCorrect: true, Blank: 0},
+					{Text: "Golang", IsCorrect: true, Blank: 0},
+					{Text: "Google", IsCorrect: true, Blank: 1},
+				},
+			},
+			{
+				Title: "Sort by release",
+				Kind:  CardKindOrdering,
+				PossibleAnswers: []Answer{
+					{Text: "Go 1.18", IsCorrect: true, Position: 1},
+					{Text: "Go 1.0", IsCorrect: true, Position: 0},
+				},
+			},
+			{
+				Title: "Match each language with where it was created",
+				Kind:  CardKindMatching,
+				PossibleAnswers: []Answer{
+					{Text: "Go", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+					{Text: "Google", IsCorrect: true, Position: 0, Side: AnswerSideRight},
+					{Text: "Rust", IsCorrect: true, Position: 1, Side: AnswerSideLeft},
+					{Text: "Mozilla", IsCorrect: true, Position: 1, Side: AnswerSideRight},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportAnki(&buf, d))
+
+	imported, ed, err := ImportAnki(Deck{}, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Empty(t, ed.Errs)
+
+	assert.Equal(t, "Go", imported.Title)
+	assert.Equal(t, "Polish your Go skills", imported.Description)
+	assert.Equal(t, []Card{
+		{
+			Title:           "What does CSP stand for?",
+			Explanation:     "Hoare, 1978",
+			Kind:            CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{{Text: "Communicating Sequential Processes", IsCorrect: true}},
+		},
+		{
+			Title: "___ was designed at ___",
+			Kind:  CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Blank: 0},
+				{Text: "Google", IsCorrect: true, Blank: 1},
+			},
+		},
+		{
+			Title:           "Sort by release",
+			Kind:            CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{{Text: "Go 1.0\nGo 1.18", IsCorrect: true}},
+		},
+		{
+			Title:           "Match each language with where it was created",
+			Kind:            CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{{Text: "Go – Google\nRust – Mozilla", IsCorrect: true}},
+		},
+	}, imported.Cards)
+}
+
+func TestAnki_UnsupportedPackages(t *testing.T) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, name := range []string{"collection.anki2", "collection.anki21b", "media"} {
+		_, err := archive.Create(name)
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	_, _, err := ImportAnki(Deck{}, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Equal(t, ErrUnsupportedCollection, err)
+
+	_, _, err = ImportAnki(Deck{}, bytes.NewReader([]byte("not a zip")), 9)
+	assert.Equal(t, ErrUnreadableFile, errors.Cause(err))
+}
+
+func ankiPackage(t *testing.T, d Deck, models []ankiModel, notes []ankiNote) []byte {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "collection.anki2")
+	require.NoError(t, writeAnkiCollection(path, d, models, notes))
+
+	collection, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	f, err := archive.Create("collection.anki21")
+	require.NoError(t, err)
+	_, err = f.Write(collection)
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+
+	return buf.Bytes()
+}
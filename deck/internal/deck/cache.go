@@ -0,0 +1,287 @@
+package deck
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"github.com/mediocregopher/radix/v4"
+)
+
+// cacheTTL bounds how long values stay cached, so that one an invalidation
+// missed isn't served forever.
+const cacheTTL = time.Hour
+
+// cacheLoadTimeout bounds a load. Loads don't end with the context of the
+// caller that started them, as others may be waiting for what they find.
+const cacheLoadTimeout = 5 * time.Second
+
+// batchCache reads many keys at once from Redis and loads the missing ones
+// in a single batch. Concurrent misses for the same key share one load, so
+// a popular key expiring doesn't send a burst of identical queries to
+// Postgres.
+//
+// Every key has a generation, bumped each time the key is invalidated. A
+// load only writes back the keys whose generation didn't move since it
+// started, so a load racing an invalidation can't cache what it read
+// before the change.
+type batchCache struct {
+	client radix.Client
+
+	mu sync.Mutex
+	// loads are the keys being loaded right now
+	loads map[string]*cacheLoad
+}
+
+// cacheLoad is the pending load of a key. done is closed once value, found
+// and err are set.
+type cacheLoad struct {
+	done  chan struct{}
+	value string
+	found bool
+	err   error
+}
+
+func newBatchCache(client radix.Client) *batchCache {
+	return &batchCache{client: client, loads: map[string]*cacheLoad{}}
+}
+
+// getMany returns the serialized values of keys, leaving out the ones load
+// doesn't find either. load is called at most once with the keys missing
+// from the cache that no other caller is loading, and what it finds is
+// written back in a single pipeline.
+func (c *batchCache) getMany(ctx context.Context, keys []string, load func(ctx context.Context, keys []string) (map[string]string, error)) (map[string]string, error) {
+	out := make(map[string]string, len(keys))
+	if len(keys) == 0 {
+		return out, nil
+	}
+
+	var cached []string
+	if err := c.client.Do(ctx, radix.Cmd(&cached, "MGET", keys...)); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var misses []string
+	for i, key := range keys {
+		// Missing keys are read as empty strings, which are never valid
+		// JSON
+		if i < len(cached) && cached[i] != "" {
+			out[key] = cached[i]
+			continue
+		}
+
+		misses = append(misses, key)
+	}
+
+	if len(misses) == 0 {
+		return out, nil
+	}
+
+	owned, waiting := c.claim(misses)
+
+	if len(owned) > 0 {
+		loaded, err := c.loadAndStore(ctx, owned, load)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		for k, v := range loaded {
+			out[k] = v
+		}
+	}
+
+	for key, l := range waiting {
+		select {
+		case <-l.done:
+		case <-ctx.Done():
+			return nil, errors.Trace(ctx.Err())
+		}
+
+		if l.err != nil {
+			return nil, errors.Trace(l.err)
+		}
+
+		if l.found {
+			out[key] = l.value
+		}
+	}
+
+	return out, nil
+}
+
+// claim splits keys into the ones the caller has to load, now marked as
+// being loaded, and the loads of others it has to wait for.
+func (c *batchCache) claim(keys []string) ([]string, map[string]*cacheLoad) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var owned []string
+	waiting := map[string]*cacheLoad{}
+
+	for _, key := range keys {
+		if l, ok := c.loads[key]; ok {
+			waiting[key] = l
+			continue
+		}
+
+		c.loads[key] = &cacheLoad{done: make(chan struct{})}
+		owned = append(owned, key)
+	}
+
+	return owned, waiting
+}
+
+// storeScript sets each key, with the value following it in ARGV, unless the
+// generation of the key, the next key in KEYS, is no longer the one
+// following the value. ARGV starts with the TTL of the keys.
+var storeScript = radix.NewEvalScript(`
+	for i = 1, #KEYS, 2 do
+		if (redis.call('GET', KEYS[i + 1]) or '') == ARGV[i + 2] then
+			redis.call('SET', KEYS[i], ARGV[i + 1], 'EX', ARGV[1])
+		end
+	end
+	return 0
+`)
+
+// generationKey is the key holding the generation of key.
+func generationKey(key string) string {
+	return key + ":generation"
+}
+
+// invalidate drops keys from the cache and bumps their generations, so that
+// loads still running don't write them back. Generations outlive any load.
+func (c *batchCache) invalidate(ctx context.Context, keys ...string) error {
+	p := radix.NewPipeline()
+	p.Append(radix.Cmd(nil, "DEL", keys...))
+
+	for _, key := range keys {
+		p.Append(radix.Cmd(nil, "INCR", generationKey(key)))
+		p.Append(radix.FlatCmd(nil, "EXPIRE", generationKey(key), int(cacheTTL.Seconds())))
+	}
+
+	return errors.Trace(c.client.Do(ctx, p))
+}
+
+// loadAndStore loads keys, caches what was found for cacheTTL and hands the
+// result over to the callers waiting for it.
+func (c *batchCache) loadAndStore(ctx context.Context, keys []string, load func(ctx context.Context, keys []string) (map[string]string, error)) (loaded map[string]string, err error) {
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		for _, key := range keys {
+			l := c.loads[key]
+			l.value, l.found = loaded[key]
+			l.err = err
+			close(l.done)
+			delete(c.loads, key)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cacheLoadTimeout)
+	defer cancel()
+
+	generationKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		generationKeys = append(generationKeys, generationKey(key))
+	}
+
+	var generations []string
+	if err := c.client.Do(ctx, radix.Cmd(&generations, "MGET", generationKeys...)); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	loaded, err = load(ctx, keys)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if len(loaded) == 0 {
+		return loaded, nil
+	}
+
+	storeKeys := make([]string, 0, 2*len(loaded))
+	args := []string{strconv.Itoa(int(cacheTTL.Seconds()))}
+	for i, key := range keys {
+		value, ok := loaded[key]
+		if !ok {
+			continue
+		}
+
+		// Keys never invalidated have no generation, read as an empty
+		// string
+		var generation string
+		if i < len(generations) {
+			generation = generations[i]
+		}
+
+		storeKeys = append(storeKeys, key, generationKeys[i])
+		args = append(args, value, generation)
+	}
+
+	if err := c.client.Do(ctx, storeScript.Cmd(nil, storeKeys, args...)); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return loaded, nil
+}
+
+// getCached reads the values of ids from the cache, under the keys key
+// gives, and loads the missing ones with load.
+func getCached[T any](ctx context.Context, c *batchCache, ids []uuid.UUID, key func(uuid.UUID) string, load func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]T, error)) (map[uuid.UUID]T, error) {
+	keys := make([]string, 0, len(ids))
+	idsByKey := make(map[string]uuid.UUID, len(ids))
+
+	for _, id := range ids {
+		k := key(id)
+		if _, ok := idsByKey[k]; ok {
+			continue
+		}
+
+		keys = append(keys, k)
+		idsByKey[k] = id
+	}
+
+	serialized, err := c.getMany(ctx, keys, func(ctx context.Context, missing []string) (map[string]string, error) {
+		missingIDs := make([]uuid.UUID, 0, len(missing))
+		for _, k := range missing {
+			missingIDs = append(missingIDs, idsByKey[k])
+		}
+
+		values, err := load(ctx, missingIDs)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		out := make(map[string]string, len(values))
+		for id, v := range values {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			out[key(id)] = string(b)
+		}
+
+		return out, nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	out := make(map[uuid.UUID]T, len(serialized))
+	for k, s := range serialized {
+		var v T
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		out[idsByKey[k]] = v
+	}
+
+	return out, nil
+}
@@ -7,7 +7,17 @@ import (
 // Card kind constants
 const (
 	CardKindSingleChoice    = "single_choice"
+	CardKindMultipleChoice  = "multiple_choice"
+	CardKindTrueFalse       = "true_false"
 	CardKindFillInTheBlanks = "fill_in_the_blanks"
+	CardKindOrdering        = "ordering"
+	CardKindMatching        = "matching"
+)
+
+// Sides of a matching card answer
+const (
+	AnswerSideLeft  = "left"
+	AnswerSideRight = "right"
 )
 
 type Deck struct {
@@ -17,6 +27,22 @@ type Deck struct {
 	Description string    `json:"description"`
 	Cards       []Card    `json:"cards"`
 	Public      bool      `json:"isPublic"`
+	// Language is the text search configuration the deck is stemmed with
+	Language string `json:"language,omitempty"`
+	// Tags are normalized and sorted by name
+	Tags []string `json:"tags,omitempty"`
+	// Revision grows with every change to the cards of the deck
+	Revision int `json:"revision,omitempty"`
+	// UpstreamDeckID is the deck this one was forked from, if any, and
+	// UpstreamRevision the revision it had at the time
+	UpstreamDeckID   uuid.UUID `json:"upstreamDeckId,omitzero"`
+	UpstreamRevision int       `json:"upstreamRevision,omitempty"`
+	// RatingAverage is the mean of the stars learners gave the deck, 0 when
+	// nobody rated it yet
+	RatingAverage float64 `json:"ratingAverage,omitempty"`
+	RatingCount   int     `json:"ratingCount,omitempty"`
+	// Version grows with every update of the deck or of its cards
+	Version int `json:"version,omitempty"`
 }
 
 func (d *Deck) GenerateUUIDs() {
@@ -35,18 +61,58 @@ func (c *Card) GenerateUUIDs() {
 	}
 }
 
+// LearnerView returns a copy of the deck that is safe to show to someone
+// practicing it: answer correctness and card explanations are stripped.
+func (d Deck) LearnerView() Deck {
+	out := d
+	out.Cards = make([]Card, 0, len(d.Cards))
+
+	for _, c := range d.Cards {
+		out.Cards = append(out.Cards, c.LearnerView())
+	}
+
+	return out
+}
+
+// LearnerView returns a copy of the card without its explanation and
+// without anything its kind considers part of the solution.
+func (c Card) LearnerView() Card {
+	out := c
+	out.Explanation = ""
+
+	kind, ok := LookupCardKind(c.Kind)
+	if !ok {
+		return withoutCorrectness(out)
+	}
+
+	return kind.LearnerView(out)
+}
+
 type Card struct {
 	ID              uuid.UUID `json:"id,omitempty"`
 	Title           string    `json:"title"`
 	PossibleAnswers []Answer  `json:"possibleAnswers"`
 	Explanation     string    `json:"explanation"`
 	Kind            string    `json:"kind"`
+	// BlankCount is only set on learner views of fill in the blanks cards
+	BlankCount int `json:"blankCount,omitempty"`
+	// Version grows with every change to the card or its answers
+	Version int `json:"version,omitempty"`
 }
 
 type Answer struct {
 	ID        uuid.UUID `json:"id,omitempty"`
 	Text      string    `json:"text"`
 	IsCorrect bool      `json:"isCorrect"`
+	// Blank is the index of the blank this answer fills in
+	Blank int `json:"blank"`
+	// Tolerance is the edit distance allowed when grading typed text
+	Tolerance int `json:"tolerance"`
+	// Position is the place of the answer in an ordering card, or the pair
+	// it belongs to in a matching card
+	Position int `json:"position"`
+	// Side is either AnswerSideLeft or AnswerSideRight in matching cards
+	Side string `json:"side,omitempty"`
 }
 
 // DeckUpdates contains optional fields for updating a deck
@@ -54,11 +120,17 @@ type DeckUpdates struct {
 	Title       *string
 	Description *string
 	IsPublic    *bool
+	Language    *string
+	// Tags replaces every tag of the deck when set
+	Tags *[]string
+	// ExpectedVersion, when set, makes the update fail with
+	// ErrVersionConflict unless the deck is still at that version
+	ExpectedVersion *int
 }
 
 // HasUpdates returns true if at least one field is set
 func (u DeckUpdates) HasUpdates() bool {
-	return u.Title != nil || u.Description != nil || u.IsPublic != nil
+	return u.Title != nil || u.Description != nil || u.IsPublic != nil || u.Language != nil || u.Tags != nil
 }
 
 // CardUpdates contains optional fields for updating a card
@@ -66,6 +138,12 @@ type CardUpdates struct {
 	Title       *string
 	Explanation *string
 	Kind        *string
+	// AuthorID is recorded as the author of the change in the card's
+	// history, or the deck's author if it is uuid.Nil
+	AuthorID uuid.UUID
+	// ExpectedVersion, when set, makes the update fail with
+	// ErrVersionConflict unless the card is still at that version
+	ExpectedVersion *int
 }
 
 // HasUpdates returns true if at least one field is set
@@ -77,11 +155,19 @@ func (u CardUpdates) HasUpdates() bool {
 type AnswerUpdates struct {
 	Text      *string
 	IsCorrect *bool
+	Blank     *int
+	Tolerance *int
+	Position  *int
+	Side      *string
+	// AuthorID is recorded as the author of the change in the card's
+	// history, or the deck's author if it is uuid.Nil
+	AuthorID uuid.UUID
 }
 
 // HasUpdates returns true if at least one field is set
 func (u AnswerUpdates) HasUpdates() bool {
-	return u.Text != nil || u.IsCorrect != nil
+	return u.Text != nil || u.IsCorrect != nil || u.Blank != nil || u.Tolerance != nil ||
+		u.Position != nil || u.Side != nil
 }
 
 type ErroredDeck struct {
@@ -95,4 +181,9 @@ type ErroredCard struct {
 	C      Card     `json:"card"`
 	Errors []string `json:"errors"`
 	Errs   []error  `json:"-"`
+	// Row is the line of the file, or the number of the Anki note, the card
+	// was imported from, if any
+	Row int `json:"row,omitempty"`
+	// Index is the position of the card among the ones validated with it
+	Index int `json:"index"`
 }
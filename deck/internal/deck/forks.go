@@ -0,0 +1,63 @@
+package deck
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// How a card of the upstream deck differs from the fork
+const (
+	UpstreamCardModified = "modified"
+	UpstreamCardAdded    = "added"
+	UpstreamCardRemoved  = "removed"
+)
+
+// UpstreamChange is a card of the upstream deck that changed since the fork
+// last synced with it.
+type UpstreamChange struct {
+	UpstreamCardID uuid.UUID
+	// CardID is the copy of the card in the fork, uuid.Nil for cards added
+	// upstream after the fork
+	CardID uuid.UUID
+	Status string
+	// Revision is the latest revision of the upstream card
+	Revision int
+	// Changes are the changes made upstream since the copy was last synced.
+	// Answers are named after their upstream IDs.
+	Changes []FieldChange
+	// Card is the upstream card as of Revision
+	Card Card
+}
+
+// Fork copies the deck for authorID. The copy is private, and it and its
+// cards and answers get new IDs. Upstream maps every new card and answer ID
+// to the one it was copied from.
+func (d Deck) Fork(authorID uuid.UUID) (Deck, map[uuid.UUID]uuid.UUID) {
+	fork := d
+	fork.AuthorID = authorID
+	fork.Public = false
+	fork.Tags = slices.Clone(d.Tags)
+	fork.Revision = 0
+	fork.UpstreamDeckID = d.ID
+	fork.UpstreamRevision = d.Revision
+
+	fork.Cards = make([]Card, 0, len(d.Cards))
+	for _, c := range d.Cards {
+		c.PossibleAnswers = slices.Clone(c.PossibleAnswers)
+		fork.Cards = append(fork.Cards, c)
+	}
+
+	fork.GenerateUUIDs()
+
+	upstream := make(map[uuid.UUID]uuid.UUID)
+	for i, c := range fork.Cards {
+		upstream[c.ID] = d.Cards[i].ID
+
+		for j, a := range c.PossibleAnswers {
+			upstream[a.ID] = d.Cards[i].PossibleAnswers[j].ID
+		}
+	}
+
+	return fork, upstream
+}
@@ -0,0 +1,55 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForks_Fork(t *testing.T) {
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	teacherID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+
+	d := Deck{
+		ID:          uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"),
+		AuthorID:    authorID,
+		Title:       "Go",
+		Description: "Polish your Go skills",
+		Public:      true,
+		Tags:        []string{"go"},
+		Revision:    7,
+		Cards: []Card{{
+			ID:    uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33"),
+			Title: "What does CSP stand for?",
+			Kind:  CardKindSingleChoice,
+			PossibleAnswers: []Answer{
+				{ID: uuid.MustParse("7e6926da-82b2-4ae8-99b4-1b803ebf1877"), Text: "Communicating Sequential Processes", IsCorrect: true},
+			},
+		}},
+	}
+
+	fork, upstream := d.Fork(teacherID)
+
+	assert.NotEqual(t, d.ID, fork.ID)
+	assert.Equal(t, teacherID, fork.AuthorID)
+	assert.False(t, fork.Public)
+	assert.Equal(t, d.ID, fork.UpstreamDeckID)
+	assert.Equal(t, 7, fork.UpstreamRevision)
+	assert.Equal(t, "Go", fork.Title)
+
+	card := fork.Cards[0]
+	answer := card.PossibleAnswers[0]
+	assert.NotEqual(t, d.Cards[0].ID, card.ID)
+	assert.NotEqual(t, d.Cards[0].PossibleAnswers[0].ID, answer.ID)
+	assert.Equal(t, "Communicating Sequential Processes", answer.Text)
+
+	assert.Equal(t, map[uuid.UUID]uuid.UUID{
+		card.ID:   d.Cards[0].ID,
+		answer.ID: d.Cards[0].PossibleAnswers[0].ID,
+	}, upstream)
+
+	// The upstream deck is left untouched
+	assert.Equal(t, uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33"), d.Cards[0].ID)
+	assert.Equal(t, uuid.MustParse("7e6926da-82b2-4ae8-99b4-1b803ebf1877"), d.Cards[0].PossibleAnswers[0].ID)
+}
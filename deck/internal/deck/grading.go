@@ -0,0 +1,279 @@
+package deck
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CardAnswer is a learner's response to a card. Which of its fields is set
+// depends on the card's kind: a chosen answer, several chosen answers (in
+// order for ordering cards), the text typed into each blank, or pairs of
+// matched answers.
+type CardAnswer struct {
+	CardID    uuid.UUID
+	AnswerID  uuid.UUID
+	AnswerIDs []uuid.UUID
+	Blanks    []string
+	Pairs     []AnswerPair
+}
+
+// AnswerPair is a left and a right answer matched by the learner.
+type AnswerPair struct {
+	Left  uuid.UUID
+	Right uuid.UUID
+}
+
+// CardGrade is the outcome of grading a single CardAnswer.
+type CardGrade struct {
+	CardID      uuid.UUID
+	IsCorrect   bool
+	Explanation string
+}
+
+// GradeAnswers grades every answer against the given cards, returning one
+// grade per answer in the same order. Answers to cards missing from cards
+// yield ErrCardNotFound.
+func GradeAnswers(cards map[uuid.UUID]Card, answers []CardAnswer) ([]CardGrade, error) {
+	out := make([]CardGrade, 0, len(answers))
+
+	for _, a := range answers {
+		c, ok := cards[a.CardID]
+		if !ok {
+			return nil, errors.Annotatef(ErrCardNotFound, "card %s", a.CardID)
+		}
+
+		out = append(out, c.Grade(a))
+	}
+
+	return out, nil
+}
+
+// Grade reports whether the answer is correct for this card, following the
+// rules of the card's kind. Cards of unknown kinds are never answered
+// correctly.
+func (c Card) Grade(a CardAnswer) CardGrade {
+	g := CardGrade{CardID: c.ID, Explanation: c.Explanation}
+
+	if kind, ok := LookupCardKind(c.Kind); ok {
+		g.IsCorrect = kind.Grade(c, a)
+	}
+
+	return g
+}
+
+// gradeSingleChoice accepts any of the card's correct answers.
+func gradeSingleChoice(c Card, a CardAnswer) bool {
+	if a.AnswerID == uuid.Nil {
+		return false
+	}
+
+	for _, pa := range c.PossibleAnswers {
+		if pa.IsCorrect && pa.ID == a.AnswerID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gradeMultipleChoice requires the chosen answers to be exactly the
+// correct ones, in any order.
+func gradeMultipleChoice(c Card, a CardAnswer) bool {
+	chosen := make(map[uuid.UUID]struct{}, len(a.AnswerIDs))
+	for _, id := range a.AnswerIDs {
+		chosen[id] = struct{}{}
+	}
+
+	var correct int
+	for _, pa := range c.PossibleAnswers {
+		if !pa.IsCorrect {
+			continue
+		}
+
+		if _, ok := chosen[pa.ID]; !ok {
+			return false
+		}
+
+		correct++
+	}
+
+	return correct > 0 && len(chosen) == correct && len(a.AnswerIDs) == correct
+}
+
+// gradeBlanks requires every blank to match one of the answers accepted
+// for it.
+func gradeBlanks(c Card, a CardAnswer) bool {
+	accepted := c.acceptedByBlank()
+	if len(accepted) == 0 || len(a.Blanks) != len(accepted) {
+		return false
+	}
+
+	for i, typed := range a.Blanks {
+		if !matchesAny(typed, accepted[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// gradeOrdering requires the correct answers in the order of their
+// positions, with no distractor among them.
+func gradeOrdering(c Card, a CardAnswer) bool {
+	expected := c.orderedItems()
+	if len(expected) == 0 || len(a.AnswerIDs) != len(expected) {
+		return false
+	}
+
+	for i, item := range expected {
+		if a.AnswerIDs[i] != item.ID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// gradeMatching requires every pair of the card to be matched, and nothing
+// else.
+func gradeMatching(c Card, a CardAnswer) bool {
+	expected := c.pairs()
+	if len(expected) == 0 || len(a.Pairs) != len(expected) {
+		return false
+	}
+
+	seen := make(map[AnswerPair]struct{}, len(a.Pairs))
+	for _, p := range a.Pairs {
+		if _, ok := expected[p]; !ok {
+			return false
+		}
+
+		seen[p] = struct{}{}
+	}
+
+	return len(seen) == len(expected)
+}
+
+// orderedItems returns the card's correct answers sorted by position.
+func (c Card) orderedItems() []Answer {
+	var out []Answer
+
+	for _, a := range c.PossibleAnswers {
+		if a.IsCorrect {
+			out = append(out, a)
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Position < out[j].Position
+	})
+
+	return out
+}
+
+// pairs returns the left and right answers sharing a position among the
+// card's correct answers.
+func (c Card) pairs() map[AnswerPair]struct{} {
+	lefts := map[int]uuid.UUID{}
+	rights := map[int]uuid.UUID{}
+
+	for _, a := range c.PossibleAnswers {
+		if !a.IsCorrect {
+			continue
+		}
+
+		switch a.Side {
+		case AnswerSideLeft:
+			lefts[a.Position] = a.ID
+		case AnswerSideRight:
+			rights[a.Position] = a.ID
+		}
+	}
+
+	out := make(map[AnswerPair]struct{}, len(lefts))
+	for pos, left := range lefts {
+		if right, ok := rights[pos]; ok {
+			out[AnswerPair{Left: left, Right: right}] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// acceptedByBlank groups the card's correct answers by the blank they fill
+// in. Blanks are numbered from zero, so the result is indexed by blank.
+func (c Card) acceptedByBlank() [][]Answer {
+	var out [][]Answer
+
+	for _, a := range c.PossibleAnswers {
+		if !a.IsCorrect || a.Blank < 0 {
+			continue
+		}
+
+		for len(out) <= a.Blank {
+			out = append(out, nil)
+		}
+
+		out[a.Blank] = append(out[a.Blank], a)
+	}
+
+	return out
+}
+
+func matchesAny(typed string, accepted []Answer) bool {
+	typed = NormalizeText(typed)
+	if typed == "" {
+		return false
+	}
+
+	for _, a := range accepted {
+		if editDistance(typed, NormalizeText(a.Text)) <= a.Tolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NormalizeText brings typed text to a canonical form before comparing it:
+// NFKC normalization, case folding and whitespace collapsed to single spaces.
+func NormalizeText(s string) string {
+	s = norm.NFKC.String(s)
+	s = cases.Fold().String(s)
+
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// editDistance is the Levenshtein distance between a and b, in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,210 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrading_GradeAnswers(t *testing.T) {
+	correctID := uuid.MustParse("9a1d5d0e-7f3a-4c1b-9d6e-1f0b6c2a3e41")
+	wrongID := uuid.MustParse("0c7e4b1a-2d3f-4e5a-8b6c-7d8e9f0a1b2c")
+
+	c := Card{
+		ID:          uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"),
+		Title:       "What does CSP stand for?",
+		Explanation: "Hoare, 1978",
+		Kind:        CardKindSingleChoice,
+		PossibleAnswers: []Answer{
+			{ID: correctID, Text: "Communicating Sequential Processes", IsCorrect: true},
+			{ID: wrongID, Text: "Concurrent Shared Processes"},
+		},
+	}
+	cards := map[uuid.UUID]Card{c.ID: c}
+
+	grades, err := GradeAnswers(cards, []CardAnswer{
+		{CardID: c.ID, AnswerID: wrongID},
+		{CardID: c.ID, AnswerID: correctID},
+		// Single choice cards are answered by picking, not typing
+		{CardID: c.ID, Blanks: []string{"Communicating Sequential Processes"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []CardGrade{
+		{CardID: c.ID, IsCorrect: false, Explanation: "Hoare, 1978"},
+		{CardID: c.ID, IsCorrect: true, Explanation: "Hoare, 1978"},
+		{CardID: c.ID, IsCorrect: false, Explanation: "Hoare, 1978"},
+	}, grades)
+
+	_, err = GradeAnswers(cards, []CardAnswer{{CardID: uuid.New(), AnswerID: correctID}})
+	assert.Equal(t, ErrCardNotFound, errors.Cause(err))
+}
+
+func TestGrading_FillInTheBlanks(t *testing.T) {
+	c := Card{
+		ID:    uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"),
+		Title: "___ was designed at ___",
+		Kind:  CardKindFillInTheBlanks,
+		PossibleAnswers: []Answer{
+			{Text: "Go", IsCorrect: true, Blank: 0},
+			{Text: "Golang", IsCorrect: true, Blank: 0},
+			{Text: "Google", IsCorrect: true, Blank: 1, Tolerance: 1},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		blanks  []string
+		correct bool
+	}{
+		{"exact", []string{"Go", "Google"}, true},
+		{"alternative answer", []string{"Golang", "Google"}, true},
+		{"case and whitespace", []string{"  gOLANG ", "GOOGLE"}, true},
+		{"within tolerance", []string{"Go", "Gogle"}, true},
+		{"beyond tolerance", []string{"Go", "Gogl"}, false},
+		{"no tolerance on first blank", []string{"Ga", "Google"}, false},
+		{"unicode compatibility forms", []string{"Ｇｏ", "Google"}, true},
+		{"missing blank", []string{"Go"}, false},
+		{"too many blanks", []string{"Go", "Google", "Mountain View"}, false},
+		{"empty blank", []string{"", "Google"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := c.Grade(CardAnswer{CardID: c.ID, Blanks: tt.blanks})
+			assert.Equal(t, tt.correct, g.IsCorrect)
+		})
+	}
+}
+
+func TestGrading_MultipleChoice(t *testing.T) {
+	mapID, sliceID, chanID, arrayID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	c := Card{
+		Title: "Which of these are reference types?",
+		Kind:  CardKindMultipleChoice,
+		PossibleAnswers: []Answer{
+			{ID: mapID, Text: "map", IsCorrect: true},
+			{ID: sliceID, Text: "slice", IsCorrect: true},
+			{ID: chanID, Text: "chan", IsCorrect: true},
+			{ID: arrayID, Text: "array"},
+		},
+	}
+
+	assert.True(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{chanID, mapID, sliceID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{mapID, sliceID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{mapID, sliceID, chanID, arrayID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{mapID, sliceID, sliceID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerID: mapID}).IsCorrect)
+}
+
+func TestGrading_TrueFalse(t *testing.T) {
+	trueID, falseID := uuid.New(), uuid.New()
+	c := Card{
+		Title: "Maps are safe for concurrent use",
+		Kind:  CardKindTrueFalse,
+		PossibleAnswers: []Answer{
+			{ID: trueID, Text: "True"},
+			{ID: falseID, Text: "False", IsCorrect: true},
+		},
+	}
+
+	assert.True(t, c.Grade(CardAnswer{AnswerID: falseID}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerID: trueID}).IsCorrect)
+}
+
+func TestGrading_Ordering(t *testing.T) {
+	lexID, parseID, typeID, genID, linkID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	c := Card{
+		Title: "Put the compiler phases in order",
+		Kind:  CardKindOrdering,
+		PossibleAnswers: []Answer{
+			{ID: typeID, Text: "Type checking", IsCorrect: true, Position: 2},
+			{ID: lexID, Text: "Lexing", IsCorrect: true, Position: 0},
+			{ID: genID, Text: "Code generation", IsCorrect: true, Position: 3},
+			{ID: parseID, Text: "Parsing", IsCorrect: true, Position: 1},
+			{ID: linkID, Text: "Garbage collection"},
+		},
+	}
+
+	assert.True(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{lexID, parseID, typeID, genID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{parseID, lexID, typeID, genID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{lexID, parseID, typeID, genID, linkID}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{AnswerIDs: []uuid.UUID{lexID, parseID, typeID}}).IsCorrect)
+}
+
+func TestGrading_Matching(t *testing.T) {
+	goID, googleID, rustID, mozillaID, sunID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	c := Card{
+		Title: "Match each language with where it was created",
+		Kind:  CardKindMatching,
+		PossibleAnswers: []Answer{
+			{ID: goID, Text: "Go", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+			{ID: googleID, Text: "Google", IsCorrect: true, Position: 0, Side: AnswerSideRight},
+			{ID: rustID, Text: "Rust", IsCorrect: true, Position: 1, Side: AnswerSideLeft},
+			{ID: mozillaID, Text: "Mozilla", IsCorrect: true, Position: 1, Side: AnswerSideRight},
+			{ID: sunID, Text: "Sun Microsystems", Side: AnswerSideRight},
+		},
+	}
+
+	assert.True(t, c.Grade(CardAnswer{Pairs: []AnswerPair{{Left: rustID, Right: mozillaID}, {Left: goID, Right: googleID}}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{Pairs: []AnswerPair{{Left: goID, Right: googleID}}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{Pairs: []AnswerPair{{Left: goID, Right: googleID}, {Left: rustID, Right: sunID}}}).IsCorrect)
+	assert.False(t, c.Grade(CardAnswer{Pairs: []AnswerPair{{Left: goID, Right: googleID}, {Left: goID, Right: googleID}}}).IsCorrect)
+}
+
+func TestGrading_NormalizeText(t *testing.T) {
+	assert.Equal(t, "communicating sequential processes", NormalizeText("  Communicating\tSequential \n Processes "))
+	assert.Equal(t, NormalizeText("STRASSE"), NormalizeText("Straße"))
+	// Precomposed and combining forms normalize to the same text
+	assert.Equal(t, NormalizeText("caf\u00e9"), NormalizeText("cafe\u0301"))
+}
+
+func TestDeck_LearnerView(t *testing.T) {
+	d := Deck{
+		Title: "Go Learning",
+		Cards: []Card{
+			{
+				Title:       "What does CSP stand for?",
+				Explanation: "Hoare, 1978",
+				PossibleAnswers: []Answer{
+					{Text: "Communicating Sequential Processes", IsCorrect: true},
+				},
+			},
+		},
+	}
+
+	lv := d.LearnerView()
+	assert.Equal(t, "", lv.Cards[0].Explanation)
+	assert.False(t, lv.Cards[0].PossibleAnswers[0].IsCorrect)
+	assert.Equal(t, "Communicating Sequential Processes", lv.Cards[0].PossibleAnswers[0].Text)
+
+	fib := Card{
+		Kind: CardKindFillInTheBlanks,
+		PossibleAnswers: []Answer{
+			{Text: "Go", IsCorrect: true, Blank: 0},
+			{Text: "Google", IsCorrect: true, Blank: 1},
+		},
+	}.LearnerView()
+	assert.Empty(t, fib.PossibleAnswers)
+	assert.Equal(t, 2, fib.BlankCount)
+
+	ordering := Card{
+		Kind: CardKindOrdering,
+		PossibleAnswers: []Answer{
+			{ID: uuid.MustParse("ffffffff-0000-4000-8000-000000000000"), Text: "First", IsCorrect: true, Position: 0},
+			{ID: uuid.MustParse("00000000-0000-4000-8000-000000000000"), Text: "Second", IsCorrect: true, Position: 1},
+		},
+	}.LearnerView()
+	require.Len(t, ordering.PossibleAnswers, 2)
+	assert.ElementsMatch(t, []string{"First", "Second"}, []string{ordering.PossibleAnswers[0].Text, ordering.PossibleAnswers[1].Text})
+	for _, a := range ordering.PossibleAnswers {
+		assert.Equal(t, 0, a.Position)
+		assert.False(t, a.IsCorrect)
+	}
+
+	// The original deck is left untouched
+	assert.Equal(t, "Hoare, 1978", d.Cards[0].Explanation)
+	assert.True(t, d.Cards[0].PossibleAnswers[0].IsCorrect)
+}
@@ -0,0 +1,199 @@
+package deck
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// CardKind holds the rules that set a kind of card apart from the others.
+// New kinds are made available with RegisterCardKind.
+type CardKind interface {
+	// Validate returns the errors specific to this kind, on top of the
+	// checks every card goes through.
+	Validate(c Card) []error
+	// LearnerView strips from the card whatever would give its solution away.
+	LearnerView(c Card) Card
+	// Grade reports whether the answer solves the card.
+	Grade(c Card, a CardAnswer) bool
+}
+
+var (
+	cardKindsMu sync.RWMutex
+	cardKinds   = map[string]CardKind{}
+)
+
+// RegisterCardKind makes a card kind available under the given name.
+// It panics if a kind is registered twice, as that is a programming error.
+func RegisterCardKind(name string, kind CardKind) {
+	cardKindsMu.Lock()
+	defer cardKindsMu.Unlock()
+
+	if kind == nil {
+		panic("deck: RegisterCardKind kind is nil")
+	}
+
+	if _, dup := cardKinds[name]; dup {
+		panic(fmt.Sprintf("deck: RegisterCardKind called twice for kind %q", name))
+	}
+
+	cardKinds[name] = kind
+}
+
+// LookupCardKind returns the card kind registered under name.
+func LookupCardKind(name string) (CardKind, bool) {
+	cardKindsMu.RLock()
+	defer cardKindsMu.RUnlock()
+
+	kind, ok := cardKinds[name]
+
+	return kind, ok
+}
+
+// IsValidKind reports whether a card kind is registered under name.
+func IsValidKind(name string) bool {
+	_, ok := LookupCardKind(name)
+
+	return ok
+}
+
+// CardKindNames returns the names of every registered card kind, sorted.
+func CardKindNames() []string {
+	cardKindsMu.RLock()
+	defer cardKindsMu.RUnlock()
+
+	out := make([]string, 0, len(cardKinds))
+	for name := range cardKinds {
+		out = append(out, name)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+func init() {
+	RegisterCardKind(CardKindSingleChoice, singleChoiceKind{})
+	RegisterCardKind(CardKindMultipleChoice, multipleChoiceKind{})
+	RegisterCardKind(CardKindTrueFalse, trueFalseKind{})
+	RegisterCardKind(CardKindFillInTheBlanks, fillInTheBlanksKind{})
+	RegisterCardKind(CardKindOrdering, orderingKind{})
+	RegisterCardKind(CardKindMatching, matchingKind{})
+}
+
+// singleChoiceKind cards have one or more correct answers, of which the
+// learner picks one.
+type singleChoiceKind struct{}
+
+func (singleChoiceKind) Validate(c Card) []error         { return nil }
+func (singleChoiceKind) LearnerView(c Card) Card         { return withoutCorrectness(c) }
+func (singleChoiceKind) Grade(c Card, a CardAnswer) bool { return gradeSingleChoice(c, a) }
+
+// multipleChoiceKind cards are solved by picking every correct answer and
+// none of the others.
+type multipleChoiceKind struct{}
+
+func (multipleChoiceKind) Validate(c Card) []error         { return validateMultipleChoice(c) }
+func (multipleChoiceKind) LearnerView(c Card) Card         { return withoutCorrectness(c) }
+func (multipleChoiceKind) Grade(c Card, a CardAnswer) bool { return gradeMultipleChoice(c, a) }
+
+// trueFalseKind cards hold a true and a false answer, only one of them correct.
+type trueFalseKind struct{}
+
+func (trueFalseKind) Validate(c Card) []error         { return validateTrueFalse(c) }
+func (trueFalseKind) LearnerView(c Card) Card         { return withoutCorrectness(c) }
+func (trueFalseKind) Grade(c Card, a CardAnswer) bool { return gradeSingleChoice(c, a) }
+
+// fillInTheBlanksKind cards store the accepted answers of each blank, and
+// learners type their answers instead of picking them.
+type fillInTheBlanksKind struct{}
+
+func (fillInTheBlanksKind) Validate(c Card) []error         { return validateBlanks(c) }
+func (fillInTheBlanksKind) Grade(c Card, a CardAnswer) bool { return gradeBlanks(c, a) }
+
+// LearnerView drops every answer, as all of them are accepted ones, and
+// keeps only the number of blanks.
+func (fillInTheBlanksKind) LearnerView(c Card) Card {
+	c.BlankCount = len(c.acceptedByBlank())
+	c.PossibleAnswers = []Answer{}
+
+	return c
+}
+
+// orderingKind cards store their items with the position they must end up
+// in. Incorrect answers are distractors that must be left out.
+type orderingKind struct{}
+
+func (orderingKind) Validate(c Card) []error         { return validateOrdering(c) }
+func (orderingKind) LearnerView(c Card) Card         { return shuffled(withoutCorrectness(c)) }
+func (orderingKind) Grade(c Card, a CardAnswer) bool { return gradeOrdering(c, a) }
+
+// matchingKind cards store each pair as a left and a right answer sharing a
+// position. Incorrect answers are distractors without a counterpart.
+type matchingKind struct{}
+
+func (matchingKind) Validate(c Card) []error         { return validateMatching(c) }
+func (matchingKind) LearnerView(c Card) Card         { return shuffled(withoutCorrectness(c)) }
+func (matchingKind) Grade(c Card, a CardAnswer) bool { return gradeMatching(c, a) }
+
+// withoutCorrectness returns the card with every answer marked as not correct.
+func withoutCorrectness(c Card) Card {
+	answers := make([]Answer, 0, len(c.PossibleAnswers))
+
+	for _, a := range c.PossibleAnswers {
+		a.IsCorrect = false
+		answers = append(answers, a)
+	}
+
+	c.PossibleAnswers = answers
+
+	return c
+}
+
+// shuffled hides the positions of the card's answers and shuffles them, so
+// that their order says nothing about the solution. The answers must not be
+// shared with the card they come from.
+func shuffled(c Card) Card {
+	for i := range c.PossibleAnswers {
+		c.PossibleAnswers[i].Position = 0
+	}
+
+	rand.Shuffle(len(c.PossibleAnswers), func(i, j int) {
+		c.PossibleAnswers[i], c.PossibleAnswers[j] = c.PossibleAnswers[j], c.PossibleAnswers[i]
+	})
+
+	return c
+}
+
+// withCompactPositions renumbers the positions of the correct answers of
+// ordering and matching cards from 0, keeping their order, so that removing
+// one of them leaves no gap. Cards of other kinds are returned as they are.
+func (c Card) withCompactPositions() Card {
+	if c.Kind != CardKindOrdering && c.Kind != CardKindMatching {
+		return c
+	}
+
+	var positions []int
+	for _, a := range c.PossibleAnswers {
+		if a.IsCorrect {
+			positions = append(positions, a.Position)
+		}
+	}
+
+	slices.Sort(positions)
+	positions = slices.Compact(positions)
+
+	answers := make([]Answer, 0, len(c.PossibleAnswers))
+	for _, a := range c.PossibleAnswers {
+		if a.IsCorrect {
+			a.Position, _ = slices.BinarySearch(positions, a.Position)
+		}
+		answers = append(answers, a)
+	}
+
+	c.PossibleAnswers = answers
+
+	return c
+}
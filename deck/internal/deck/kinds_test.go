@@ -0,0 +1,97 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// alwaysRightKind accepts any answer, which is enough to exercise the registry
+type alwaysRightKind struct{}
+
+func (alwaysRightKind) Validate(c Card) []error         { return nil }
+func (alwaysRightKind) LearnerView(c Card) Card         { return c }
+func (alwaysRightKind) Grade(c Card, a CardAnswer) bool { return true }
+
+func TestKinds_RegisterCardKind(t *testing.T) {
+	const name = "test_always_right"
+
+	assert.False(t, IsValidKind(name))
+
+	RegisterCardKind(name, alwaysRightKind{})
+	t.Cleanup(func() {
+		cardKindsMu.Lock()
+		delete(cardKinds, name)
+		cardKindsMu.Unlock()
+	})
+
+	assert.True(t, IsValidKind(name))
+	assert.Contains(t, CardKindNames(), name)
+
+	c := Card{
+		Title:           "Anything goes",
+		Kind:            name,
+		PossibleAnswers: []Answer{{Text: "Yes", IsCorrect: true}},
+	}
+
+	ok, _ := ValidateCard(c)
+	assert.True(t, ok)
+	assert.True(t, c.Grade(CardAnswer{AnswerID: uuid.New()}).IsCorrect)
+
+	assert.Panics(t, func() { RegisterCardKind(name, alwaysRightKind{}) })
+}
+
+func TestKinds_BuiltIn(t *testing.T) {
+	assert.Equal(t, []string{
+		CardKindFillInTheBlanks,
+		CardKindMatching,
+		CardKindMultipleChoice,
+		CardKindOrdering,
+		CardKindSingleChoice,
+		CardKindTrueFalse,
+	}, CardKindNames())
+}
+
+func TestKinds_WithCompactPositions(t *testing.T) {
+	t.Run("ordering", func(t *testing.T) {
+		c := Card{
+			Kind: CardKindOrdering,
+			PossibleAnswers: []Answer{
+				{Text: "First", IsCorrect: true, Position: 0},
+				{Text: "Third", IsCorrect: true, Position: 2},
+				{Text: "Fourth", IsCorrect: true, Position: 3},
+				{Text: "Distractor", Position: 7},
+			},
+		}
+
+		var positions []int
+		for _, a := range c.withCompactPositions().PossibleAnswers {
+			positions = append(positions, a.Position)
+		}
+		assert.Equal(t, []int{0, 1, 2, 7}, positions)
+	})
+
+	t.Run("matching", func(t *testing.T) {
+		c := Card{
+			Kind: CardKindMatching,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Position: 2, Side: AnswerSideLeft},
+				{Text: "Gopher", IsCorrect: true, Position: 2, Side: AnswerSideRight},
+				{Text: "Rust", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+				{Text: "Ferris", IsCorrect: true, Position: 0, Side: AnswerSideRight},
+			},
+		}
+
+		var positions []int
+		for _, a := range c.withCompactPositions().PossibleAnswers {
+			positions = append(positions, a.Position)
+		}
+		assert.Equal(t, []int{1, 1, 0, 0}, positions)
+	})
+
+	t.Run("other_kinds", func(t *testing.T) {
+		c := Card{Kind: CardKindSingleChoice, PossibleAnswers: []Answer{{Text: "Go", IsCorrect: true, Position: 3}}}
+		assert.Equal(t, c, c.withCompactPositions())
+	})
+}
@@ -0,0 +1,94 @@
+package deck
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/XaviFP/toshokan/common/pagination"
+)
+
+// LibraryOrder is how the decks in the library of a user are sorted.
+type LibraryOrder string
+
+const (
+	// LibraryOrderLastPracticed sorts decks by the last time the user
+	// practised them, or added them for those never practised.
+	LibraryOrderLastPracticed LibraryOrder = "last_practiced"
+	// LibraryOrderAdded sorts decks by when they were added to the library.
+	LibraryOrderAdded LibraryOrder = "added"
+)
+
+// maxCardLevel is the level the dealer stops promoting cards at.
+const maxCardLevel = 5
+
+// ParseLibraryOrder parses a library order, defaulting to last practiced
+// when it's empty.
+func ParseLibraryOrder(s string) (LibraryOrder, error) {
+	switch LibraryOrder(s) {
+	case "", LibraryOrderLastPracticed:
+		return LibraryOrderLastPracticed, nil
+	case LibraryOrderAdded:
+		return LibraryOrderAdded, nil
+	}
+
+	return "", ErrInvalidLibraryOrder
+}
+
+// column returns the column of the library listing the order sorts by.
+func (o LibraryOrder) column() string {
+	if o == LibraryOrderAdded {
+		return "added_at"
+	}
+
+	return "last_practiced_at"
+}
+
+// LibraryEntry is a deck in the library of a user, along with how far they
+// got learning it.
+type LibraryEntry struct {
+	DeckID          uuid.UUID
+	AddedAt         time.Time
+	LastPracticedAt time.Time
+	CardCount       int
+	// Mastery goes from 0, when no card was answered correctly, to 1, when
+	// every card reached the top level
+	Mastery float64
+}
+
+// LibraryCursor points at a deck in a library. At is the time the entries
+// are sorted by with Order, and the deck ID breaks ties. Cursors can't be
+// used with another order than the one they were made for.
+type LibraryCursor struct {
+	Order  LibraryOrder
+	At     time.Time
+	DeckID uuid.UUID
+}
+
+type LibraryEdge struct {
+	Entry  LibraryEntry
+	Cursor pagination.Cursor
+}
+
+type LibraryConnection struct {
+	Edges    []LibraryEdge
+	PageInfo pagination.PageInfo
+}
+
+// AuthoredDeckCursor points at a deck a user authored. Authored decks are
+// sorted by when they were created, and by ID among those created at the
+// same time.
+type AuthoredDeckCursor struct {
+	CreatedAt time.Time
+	DeckID    uuid.UUID
+}
+
+type AuthoredDeckEdge struct {
+	DeckID uuid.UUID
+	Cursor pagination.Cursor
+}
+
+type AuthoredDecksConnection struct {
+	Edges    []AuthoredDeckEdge
+	PageInfo pagination.PageInfo
+}
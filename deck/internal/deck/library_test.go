@@ -0,0 +1,20 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibrary_ParseLibraryOrder(t *testing.T) {
+	o, err := ParseLibraryOrder("")
+	assert.NoError(t, err)
+	assert.Equal(t, LibraryOrderLastPracticed, o)
+
+	o, err = ParseLibraryOrder("added")
+	assert.NoError(t, err)
+	assert.Equal(t, LibraryOrderAdded, o)
+
+	_, err = ParseLibraryOrder("title")
+	assert.ErrorIs(t, err, ErrInvalidLibraryOrder)
+}
@@ -0,0 +1,65 @@
+package deck
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/XaviFP/toshokan/common/pagination"
+)
+
+// Roles users can have on a deck, each allowing what the previous one does.
+// Viewers can study a private deck, editors can also change it and its
+// cards, and owners can also manage its members and delete it. The author of
+// a deck is always an owner of it.
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleOwner  = "owner"
+)
+
+var roleRanks = map[string]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+// IsRole reports whether role is one of the roles users can have on a deck.
+func IsRole(role string) bool {
+	return roleRanks[role] > 0
+}
+
+// RoleAllows reports whether having role on a deck allows doing what
+// required does. The empty role, for users who aren't members, allows
+// nothing.
+func RoleAllows(role, required string) bool {
+	return IsRole(role) && roleRanks[role] >= roleRanks[required]
+}
+
+// Member is a user the deck is shared with. Authors aren't members of their
+// own decks.
+type Member struct {
+	DeckID    uuid.UUID
+	UserID    uuid.UUID
+	Role      string
+	CreatedAt time.Time
+}
+
+// SharedDeckCursor points at a deck shared with a user. Shared decks are
+// sorted by when they were shared, and by deck ID among those shared at
+// the same time.
+type SharedDeckCursor struct {
+	SharedAt time.Time
+	DeckID   uuid.UUID
+}
+
+type SharedDeckEdge struct {
+	DeckID uuid.UUID
+	Role   string
+	Cursor pagination.Cursor
+}
+
+type SharedDecksConnection struct {
+	Edges    []SharedDeckEdge
+	PageInfo pagination.PageInfo
+}
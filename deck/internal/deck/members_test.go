@@ -0,0 +1,20 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMembers_RoleAllows(t *testing.T) {
+	assert.True(t, RoleAllows(RoleOwner, RoleEditor))
+	assert.True(t, RoleAllows(RoleEditor, RoleEditor))
+	assert.True(t, RoleAllows(RoleEditor, RoleViewer))
+	assert.False(t, RoleAllows(RoleViewer, RoleEditor))
+	assert.False(t, RoleAllows(RoleEditor, RoleOwner))
+	assert.False(t, RoleAllows("", RoleViewer))
+	assert.False(t, RoleAllows("admin", RoleViewer))
+
+	assert.True(t, IsRole(RoleViewer))
+	assert.False(t, IsRole(""))
+}
@@ -8,9 +8,14 @@ import (
 	"github.com/XaviFP/toshokan/common/pagination"
 )
 
+// Cursor points at a deck in the popularity ranking of a refresh
+// generation. Ties in score are broken by creation date and then ID, so the
+// order is total and pages never skip or repeat decks.
 type Cursor struct {
-	ID        uuid.UUID
-	CreatedAt time.Time
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	Score      float64
+	Generation int64
 }
 
 type PopularDeckEdge struct {
@@ -22,3 +27,20 @@ type PopularDecksConnection struct {
 	Edges    []PopularDeckEdge
 	PageInfo pagination.PageInfo
 }
+
+// DeckCardCursor points at a card of a deck. Cards are sorted by when they
+// were created, and by ID among those created at the same time.
+type DeckCardCursor struct {
+	CreatedAt time.Time
+	CardID    uuid.UUID
+}
+
+type DeckCardEdge struct {
+	CardID uuid.UUID
+	Cursor pagination.Cursor
+}
+
+type DeckCardsConnection struct {
+	Edges    []DeckCardEdge
+	PageInfo pagination.PageInfo
+}
@@ -0,0 +1,52 @@
+package deck
+
+import (
+	"time"
+)
+
+// Period is the time window the popularity of decks is measured over.
+type Period string
+
+const (
+	PeriodWeek    Period = "week"
+	PeriodAllTime Period = "all_time"
+)
+
+// Weights of each signal in the popularity score. Answers are counted on a
+// log scale so a handful of heavy users can't outweigh many learners.
+const (
+	learnersWeight       = 1.0
+	answersWeight        = 2.0
+	completionRateWeight = 3.0
+	ratingsWeight        = 2.0
+)
+
+// popularityRetention is how long a refresh generation outlives the next
+// one, and so how long a client has to page through a ranking.
+const popularityRetention = time.Hour
+
+// popularityRefreshLockKey is the advisory lock key deck service instances
+// take to refresh the popularity of decks one at a time.
+const popularityRefreshLockKey int64 = 0x70707570
+
+// ParsePeriod parses a period, defaulting to all time when it's empty.
+func ParsePeriod(s string) (Period, error) {
+	switch Period(s) {
+	case "", PeriodAllTime:
+		return PeriodAllTime, nil
+	case PeriodWeek:
+		return PeriodWeek, nil
+	}
+
+	return "", ErrInvalidPeriod
+}
+
+// Since returns the start of the window the period covers, counting back
+// from now. All time starts at the zero time.
+func (p Period) Since(now time.Time) time.Time {
+	if p == PeriodWeek {
+		return now.AddDate(0, 0, -7)
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,28 @@
+package deck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopularity_ParsePeriod(t *testing.T) {
+	p, err := ParsePeriod("")
+	assert.NoError(t, err)
+	assert.Equal(t, PeriodAllTime, p)
+
+	p, err = ParsePeriod("week")
+	assert.NoError(t, err)
+	assert.Equal(t, PeriodWeek, p)
+
+	_, err = ParsePeriod("month")
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestPopularity_Since(t *testing.T) {
+	now := time.Date(2024, 5, 8, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), PeriodWeek.Since(now))
+	assert.True(t, PeriodAllTime.Since(now).IsZero())
+}
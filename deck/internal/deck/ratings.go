@@ -0,0 +1,79 @@
+package deck
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/XaviFP/toshokan/common/pagination"
+)
+
+const (
+	MinStars = 1
+	MaxStars = 5
+
+	// MaxReviewLength is the number of characters a review can have.
+	MaxReviewLength = 2000
+)
+
+// Rating is the score from 1 to 5 stars a user gave a deck, with an
+// optional review. Users have at most one rating per deck.
+type Rating struct {
+	DeckID    uuid.UUID
+	UserID    uuid.UUID
+	Stars     int
+	Review    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (r Rating) Validate() error {
+	if r.Stars < MinStars || r.Stars > MaxStars {
+		return ErrInvalidStars
+	}
+
+	if utf8.RuneCountInString(r.Review) > MaxReviewLength {
+		return ErrReviewTooLong
+	}
+
+	return nil
+}
+
+// ratingBoost returns an SQL expression, between -1 and 1, of how much
+// better or worse than average the ratings of the decks aliased as table
+// are. It is damped for decks with few ratings, so a single 5 star rating
+// doesn't beat a hundred 4 star ones.
+func ratingBoost(table string) string {
+	return fmt.Sprintf(
+		"((%[1]s.rating_average - 3) / 2 * %[1]s.rating_count / (%[1]s.rating_count + %[2]d))",
+		table,
+		ratingDamping,
+	)
+}
+
+// ratingDamping is the number of ratings at which a deck's ratings count
+// for half of what they would with many more.
+const ratingDamping = 5
+
+// ratingSearchWeight is how much ratings scale the search rank of a deck:
+// the best rated decks rank up to a quarter higher than they would unrated.
+const ratingSearchWeight = 0.25
+
+// ReviewCursor points at a review. Reviews are sorted by when they were
+// last changed, and by user ID among those changed at the same time.
+type ReviewCursor struct {
+	UpdatedAt time.Time
+	UserID    uuid.UUID
+}
+
+type ReviewEdge struct {
+	Rating Rating
+	Cursor pagination.Cursor
+}
+
+type ReviewsConnection struct {
+	Edges    []ReviewEdge
+	PageInfo pagination.PageInfo
+}
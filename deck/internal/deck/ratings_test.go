@@ -0,0 +1,17 @@
+package deck
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatings_Validate(t *testing.T) {
+	assert.NoError(t, Rating{Stars: 1}.Validate())
+	assert.NoError(t, Rating{Stars: 5, Review: strings.Repeat("本", MaxReviewLength)}.Validate())
+
+	assert.ErrorIs(t, Rating{Stars: 0}.Validate(), ErrInvalidStars)
+	assert.ErrorIs(t, Rating{Stars: 6}.Validate(), ErrInvalidStars)
+	assert.ErrorIs(t, Rating{Stars: 3, Review: strings.Repeat("a", MaxReviewLength+1)}.Validate(), ErrReviewTooLong)
+}
@@ -5,8 +5,9 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"sort"
+	"slices"
 	"strings"
+	"time"
 
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
@@ -19,21 +20,64 @@ import (
 )
 
 var (
-	ErrCards             = errors.New("deck: one or more cards are not valid")
-	ErrCardInvalid       = errors.New("deck: invalid card")
-	ErrCardNotFound      = errors.New("deck: card not found")
-	ErrCardAlreadyExists = errors.New("deck: card already exists")
-	ErrAnswerNotFound    = errors.New("deck: answer not found")
-	ErrDeckNotFound      = errors.New("deck: deck not found")
-	ErrNoTitle           = errors.New("deck: title is missing")
-	ErrNoDescription     = errors.New("deck: description is missing")
-	ErrNoAnswersProvided = errors.New("deck: no answers provided")
-	ErrNoCorrectAnswer   = errors.New("deck: at least one answer must be correct")
-	ErrNoTextAnswer      = errors.New("deck: all answers must have a non-empty text")
-	ErrInvalidKind       = errors.New("deck: kind must be 'single_choice' or 'fill_in_the_blanks'")
-	ErrDeckAlreadyExists = errors.New("deck: deck already exists")
-	ErrDeckInvalid       = errors.New("deck: invalid deck")
-	ErrInvalidCursor     = errors.New("deck: invalid cusror")
+	ErrCards                 = errors.New("deck: one or more cards are not valid")
+	ErrCardInvalid           = errors.New("deck: invalid card")
+	ErrCardNotFound          = errors.New("deck: card not found")
+	ErrCardAlreadyExists     = errors.New("deck: card already exists")
+	ErrAnswerNotFound        = errors.New("deck: answer not found")
+	ErrDeckNotFound          = errors.New("deck: deck not found")
+	ErrNoTitle               = errors.New("deck: title is missing")
+	ErrNoDescription         = errors.New("deck: description is missing")
+	ErrNoAnswersProvided     = errors.New("deck: no answers provided")
+	ErrNoCorrectAnswer       = errors.New("deck: at least one answer must be correct")
+	ErrNoTextAnswer          = errors.New("deck: all answers must have a non-empty text")
+	ErrInvalidKind           = errors.New("deck: unknown card kind")
+	ErrInvalidBlank          = errors.New("deck: blank and tolerance must not be negative")
+	ErrBlankNotAccepted      = errors.New("deck: every blank must have at least one accepted answer")
+	ErrTooFewAnswers         = errors.New("deck: at least two answers are required")
+	ErrTrueFalseAnswers      = errors.New("deck: true/false cards need a True and a False answer, one of them correct")
+	ErrInvalidOrder          = errors.New("deck: positions must go from 0 up without gaps or repeats")
+	ErrInvalidPairs          = errors.New("deck: every pair needs exactly one left and one right answer")
+	ErrInvalidFormat         = errors.New("deck: format must be csv, tsv or apkg")
+	ErrInvalidMapping        = errors.New("deck: column mapping needs a title and at least one answer column, each used once")
+	ErrInvalidCorrectColumn  = errors.New("deck: correct column must list the numbers of non-empty answers")
+	ErrInvalidAnswerColumns  = errors.New("deck: blanks and tolerances columns must list non-negative numbers")
+	ErrUnreadableFile        = errors.New("deck: file could not be read")
+	ErrNoRows                = errors.New("deck: file has no cards")
+	ErrUnsupportedNoteType   = errors.New("deck: unsupported Anki note type")
+	ErrUnsupportedCollection = errors.New("deck: collection is in a newer Anki format, export it with support for older Anki versions")
+	ErrTooManyAnswers        = errors.New("deck: card has more answers than the mapping has answer columns")
+	ErrDeckAlreadyExists     = errors.New("deck: deck already exists")
+	ErrDeckInvalid           = errors.New("deck: invalid deck")
+	ErrInvalidCursor         = errors.New("deck: invalid cusror")
+	ErrInvalidLanguage       = errors.New("deck: unsupported search language")
+	ErrEmptyQuery            = errors.New("deck: search query is empty")
+	ErrInvalidTag            = errors.New("deck: tags must not be empty nor longer than 50 characters")
+	ErrTooManyTags           = errors.New("deck: a deck can have at most 10 tags")
+	ErrTopicNotFound         = errors.New("deck: topic not found")
+	ErrTopicAlreadyExists    = errors.New("deck: a topic with that name already exists under the same parent")
+	ErrNoTopicName           = errors.New("deck: topic name is missing")
+	ErrRevisionNotFound      = errors.New("deck: card revision not found")
+	ErrNotAFork              = errors.New("deck: deck is not a fork")
+	ErrNoUpstreamChanges     = errors.New("deck: card has no upstream changes to pull")
+	ErrNotAllowed            = errors.New("deck: only the author and members with a high enough role can do that")
+	ErrMemberNotFound        = errors.New("deck: member not found")
+	ErrMemberAlreadyExists   = errors.New("deck: user is already a member of the deck")
+	ErrInvalidRole           = errors.New("deck: role must be viewer, editor or owner")
+	ErrAuthorIsOwner         = errors.New("deck: the author of a deck is always an owner of it")
+	ErrInvalidPeriod         = errors.New("deck: period must be week or all_time")
+	ErrExpiredCursor         = errors.New("deck: the ranking the cursor points into has expired")
+	ErrInvalidStars          = errors.New("deck: stars must be between 1 and 5")
+	ErrReviewTooLong         = errors.New("deck: review is too long")
+	ErrDeckNotRateable       = errors.New("deck: only public decks can be rated")
+	ErrOwnDeckRating         = errors.New("deck: authors can't rate their own decks")
+	ErrInvalidLibraryOrder   = errors.New("deck: order must be last_practiced or added")
+	ErrNotInLibrary          = errors.New("deck: deck is not in the library")
+	ErrVersionConflict       = errors.New("deck: version conflict")
+	ErrInvalidRange          = errors.New("deck: the start of the range must be before its end")
+	ErrAuthorOnly            = errors.New("deck: only the author of the deck can do that")
+	ErrNoUpdates             = errors.New("deck: no fields to update")
+	ErrNoUpstreamCards       = errors.New("deck: no upstream cards to pull")
 )
 
 type Repository interface {
@@ -44,19 +88,78 @@ type Repository interface {
 	GetCardAnswers(ctx context.Context, id uuid.UUID) ([]Answer, error)
 	StoreDeck(ctx context.Context, d Deck) error
 	StoreCard(ctx context.Context, card Card, deckID uuid.UUID) error
-	GetPopularDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (PopularDecksConnection, error)
+	GetPopularDecks(ctx context.Context, userID uuid.UUID, period Period, tags []string, p pagination.Pagination) (PopularDecksConnection, error)
+	SearchDecks(ctx context.Context, userID uuid.UUID, query, language string, tags []string, p pagination.Pagination) (SearchDecksConnection, error)
 
 	GetCards(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Card, error)
+	// GetCardDecks returns the deck each of the cards belongs to.
+	GetCardDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	// GetDeckCardsPage pages through the cards of a deck, oldest first. Only
+	// their IDs are returned, for the cards to be read with GetCards.
+	GetDeckCardsPage(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (DeckCardsConnection, error)
+	// GetDecksFirstCards reads the first page of cards of each of the decks
+	// at once, like GetDeckCardsPage does with first set.
+	GetDecksFirstCards(ctx context.Context, deckIDs []uuid.UUID, first int) (map[uuid.UUID]DeckCardsConnection, error)
 
 	// Update operations
 	UpdateDeck(ctx context.Context, id uuid.UUID, updates DeckUpdates) (Deck, error)
 	UpdateCard(ctx context.Context, deckID, cardID uuid.UUID, updates CardUpdates) (Card, error)
 	UpdateAnswer(ctx context.Context, deckID, cardID, answerID uuid.UUID, updates AnswerUpdates) (Answer, error)
+
+	// Card and answer removal, and answers added to existing cards. The
+	// card is validated again after the change.
+	DeleteCard(ctx context.Context, deckID, cardID, authorID uuid.UUID) error
+	CreateAnswer(ctx context.Context, deckID, cardID uuid.UUID, a Answer, authorID uuid.UUID) (Card, error)
+	DeleteAnswer(ctx context.Context, deckID, cardID, answerID, authorID uuid.UUID) (Card, error)
+
+	// Card history, newest revision first
+	GetCardRevisions(ctx context.Context, deckID, cardID uuid.UUID) ([]CardRevision, error)
+	GetCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int) (CardRevision, error)
+	RestoreCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int, authorID uuid.UUID) (Card, error)
+
+	// Forks
+	ForkDeck(ctx context.Context, upstreamID, authorID uuid.UUID) (Deck, error)
+	GetUpstreamChanges(ctx context.Context, forkID uuid.UUID) ([]UpstreamChange, error)
+	PullUpstreamChanges(ctx context.Context, forkID uuid.UUID, upstreamCardIDs []uuid.UUID, authorID uuid.UUID) error
+
+	// Tags and topics
+	AutocompleteTags(ctx context.Context, prefix string, limit int) ([]Tag, error)
+	GetTopics(ctx context.Context) ([]Topic, error)
+	StoreTopic(ctx context.Context, t Topic) error
+	DeleteTopic(ctx context.Context, id uuid.UUID) error
+	SetTagTopic(ctx context.Context, tag string, topicID uuid.UUID) (Tag, error)
+
+	// Members a deck is shared with
+	GetDeckMember(ctx context.Context, deckID, userID uuid.UUID) (Member, error)
+	GetDeckMembers(ctx context.Context, deckID uuid.UUID) ([]Member, error)
+	StoreDeckMember(ctx context.Context, m Member) (Member, error)
+	UpdateDeckMember(ctx context.Context, deckID, userID uuid.UUID, role string) (Member, error)
+	DeleteDeckMember(ctx context.Context, deckID, userID uuid.UUID) error
+	GetSharedDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (SharedDecksConnection, error)
+
+	// RefreshPopularity recomputes the popularity scores GetPopularDecks
+	// ranks decks by.
+	RefreshPopularity(ctx context.Context) error
+
+	// Ratings
+	RateDeck(ctx context.Context, r Rating) (Rating, error)
+	GetDeckReviews(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (ReviewsConnection, error)
+
+	// Library
+	AddToLibrary(ctx context.Context, userID, deckID uuid.UUID) (LibraryEntry, error)
+	RemoveFromLibrary(ctx context.Context, userID, deckID uuid.UUID) error
+	GetLibrary(ctx context.Context, userID uuid.UUID, order LibraryOrder, p pagination.Pagination) (LibraryConnection, error)
+	GetAuthoredDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (AuthoredDecksConnection, error)
+
+	// GetDeckAnalytics returns the statistics of the cards of a deck over a
+	// range, flags included.
+	GetDeckAnalytics(ctx context.Context, deckID uuid.UUID, r AnalyticsRange) (DeckAnalytics, error)
 }
 
 type redisRepository struct {
 	cache  radix.Client
 	pgRepo Repository
+	batch  *batchCache
 }
 
 func (r *redisRepository) StoreCard(ctx context.Context, c Card, dID uuid.UUID) error {
@@ -64,68 +167,94 @@ func (r *redisRepository) StoreCard(ctx context.Context, c Card, dID uuid.UUID)
 		return errors.Trace(err)
 	}
 
-	r.delete(ctx, dID.String())
-
-	// Update cache TODO
-	if _, err := r.GetDeck(ctx, dID); err == nil {
-		return errors.Trace(err)
-	}
-
-	return nil
+	return r.invalidateCards(ctx, dID, c.ID)
 }
 
 func NewRedisRepository(cache radix.Client, pgRepo Repository) Repository {
-	return &redisRepository{cache: cache, pgRepo: pgRepo}
+	return &redisRepository{cache: cache, pgRepo: pgRepo, batch: newBatchCache(cache)}
 }
 
 func (r *redisRepository) GetDeck(ctx context.Context, id uuid.UUID) (Deck, error) {
-	var serialized string
-	if err := r.cache.Do(
-		ctx,
-		radix.Cmd(&serialized, "GET", r.getDeckCacheKey(id)),
-	); err != nil {
-		return Deck{}, errors.Trace(err)
-	}
-
-	// Found in cache
-	if serialized != "" {
-		var d Deck
-		err := json.Unmarshal([]byte(serialized), &d)
+	decks, err := getCached(ctx, r.batch, []uuid.UUID{id}, r.getDeckCacheKey, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Deck, error) {
+		d, err := r.getDeckFromDB(ctx, id)
+		if errors.Cause(err) == ErrDeckNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 
-		return d, errors.Trace(err)
+		return map[uuid.UUID]Deck{id: d}, nil
+	})
+	if err != nil {
+		return Deck{}, errors.Trace(err)
 	}
 
-	// Not found in cache
-	d, err := r.getDeckFromDB(ctx, id)
-	if err != nil {
-		return d, errors.Trace(err)
+	d, ok := decks[id]
+	if !ok {
+		return Deck{}, errors.Trace(ErrDeckNotFound)
 	}
 
-	return r.doCache(ctx, d)
+	return d, nil
 }
 
+// DeleteDeck drops the deck from the cache along with its cards.
 func (r *redisRepository) DeleteDeck(ctx context.Context, id uuid.UUID) error {
-	if err := r.pgRepo.DeleteDeck(ctx, id); err != nil {
+	cards, err := r.pgRepo.GetDeckCards(ctx, id)
+	if err != nil {
 		return errors.Trace(err)
 	}
 
-	if err := r.delete(ctx, r.getDeckCacheKey(id)); err != nil {
+	if err := r.pgRepo.DeleteDeck(ctx, id); err != nil {
 		return errors.Trace(err)
 	}
 
-	return nil
+	cardIDs := make([]uuid.UUID, 0, len(cards))
+	for _, c := range cards {
+		cardIDs = append(cardIDs, c.ID)
+	}
+
+	return r.invalidateCards(ctx, id, cardIDs...)
 }
 
+// GetDecks reads the decks from the cache, without their cards, and only
+// goes to Postgres for the ones missing from it.
 func (r *redisRepository) GetDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Deck, error) {
-	return r.pgRepo.GetDecks(ctx, ids)
+	decks, err := getCached(ctx, r.batch, ids, r.getDeckMetaCacheKey, r.pgRepo.GetDecks)
+
+	return decks, errors.Trace(err)
 }
 
 func (r *redisRepository) GetDeckCards(ctx context.Context, id uuid.UUID) ([]Card, error) {
-	return r.pgRepo.GetDeckCards(ctx, id)
+	cards, err := getCached(ctx, r.batch, []uuid.UUID{id}, r.getDeckCardsCacheKey, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]Card, error) {
+		cards, err := r.pgRepo.GetDeckCards(ctx, id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		return map[uuid.UUID][]Card{id: cards}, nil
+	})
+	if err != nil {
+		return []Card{}, errors.Trace(err)
+	}
+
+	return cards[id], nil
 }
 
 func (r *redisRepository) GetCardAnswers(ctx context.Context, id uuid.UUID) ([]Answer, error) {
-	return r.pgRepo.GetCardAnswers(ctx, id)
+	answers, err := getCached(ctx, r.batch, []uuid.UUID{id}, r.getCardAnswersCacheKey, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]Answer, error) {
+		answers, err := r.pgRepo.GetCardAnswers(ctx, id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		return map[uuid.UUID][]Answer{id: answers}, nil
+	})
+	if err != nil {
+		return []Answer{}, errors.Trace(err)
+	}
+
+	return answers[id], nil
 }
 
 func (r *redisRepository) StoreDeck(ctx context.Context, d Deck) error {
@@ -140,12 +269,121 @@ func (r *redisRepository) StoreDeck(ctx context.Context, d Deck) error {
 	return nil
 }
 
-func (r *redisRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (PopularDecksConnection, error) {
-	return r.pgRepo.GetPopularDecks(ctx, userID, p)
+func (r *redisRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, period Period, tags []string, p pagination.Pagination) (PopularDecksConnection, error) {
+	return r.pgRepo.GetPopularDecks(ctx, userID, period, tags, p)
+}
+
+func (r *redisRepository) SearchDecks(ctx context.Context, userID uuid.UUID, query, language string, tags []string, p pagination.Pagination) (SearchDecksConnection, error) {
+	return r.pgRepo.SearchDecks(ctx, userID, query, language, tags, p)
+}
+
+func (r *redisRepository) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]Tag, error) {
+	return r.pgRepo.AutocompleteTags(ctx, prefix, limit)
+}
+
+func (r *redisRepository) GetTopics(ctx context.Context) ([]Topic, error) {
+	return r.pgRepo.GetTopics(ctx)
+}
+
+func (r *redisRepository) StoreTopic(ctx context.Context, t Topic) error {
+	return r.pgRepo.StoreTopic(ctx, t)
+}
+
+func (r *redisRepository) DeleteTopic(ctx context.Context, id uuid.UUID) error {
+	return r.pgRepo.DeleteTopic(ctx, id)
+}
+
+func (r *redisRepository) SetTagTopic(ctx context.Context, tag string, topicID uuid.UUID) (Tag, error) {
+	return r.pgRepo.SetTagTopic(ctx, tag, topicID)
+}
+
+func (r *redisRepository) GetDeckMember(ctx context.Context, deckID, userID uuid.UUID) (Member, error) {
+	return r.pgRepo.GetDeckMember(ctx, deckID, userID)
+}
+
+func (r *redisRepository) GetDeckMembers(ctx context.Context, deckID uuid.UUID) ([]Member, error) {
+	return r.pgRepo.GetDeckMembers(ctx, deckID)
+}
+
+func (r *redisRepository) StoreDeckMember(ctx context.Context, m Member) (Member, error) {
+	return r.pgRepo.StoreDeckMember(ctx, m)
+}
+
+func (r *redisRepository) UpdateDeckMember(ctx context.Context, deckID, userID uuid.UUID, role string) (Member, error) {
+	return r.pgRepo.UpdateDeckMember(ctx, deckID, userID, role)
 }
 
+func (r *redisRepository) DeleteDeckMember(ctx context.Context, deckID, userID uuid.UUID) error {
+	return r.pgRepo.DeleteDeckMember(ctx, deckID, userID)
+}
+
+func (r *redisRepository) GetSharedDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (SharedDecksConnection, error) {
+	return r.pgRepo.GetSharedDecks(ctx, userID, p)
+}
+
+func (r *redisRepository) RefreshPopularity(ctx context.Context) error {
+	return r.pgRepo.RefreshPopularity(ctx)
+}
+
+func (r *redisRepository) RateDeck(ctx context.Context, rating Rating) (Rating, error) {
+	out, err := r.pgRepo.RateDeck(ctx, rating)
+	if err != nil {
+		return Rating{}, errors.Trace(err)
+	}
+
+	// The cached deck has the previous rating average
+	if err := r.invalidateDeck(ctx, rating.DeckID); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	return out, nil
+}
+
+func (r *redisRepository) GetDeckReviews(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (ReviewsConnection, error) {
+	return r.pgRepo.GetDeckReviews(ctx, deckID, p)
+}
+
+func (r *redisRepository) AddToLibrary(ctx context.Context, userID, deckID uuid.UUID) (LibraryEntry, error) {
+	return r.pgRepo.AddToLibrary(ctx, userID, deckID)
+}
+
+func (r *redisRepository) RemoveFromLibrary(ctx context.Context, userID, deckID uuid.UUID) error {
+	return r.pgRepo.RemoveFromLibrary(ctx, userID, deckID)
+}
+
+func (r *redisRepository) GetLibrary(ctx context.Context, userID uuid.UUID, order LibraryOrder, p pagination.Pagination) (LibraryConnection, error) {
+	return r.pgRepo.GetLibrary(ctx, userID, order, p)
+}
+
+func (r *redisRepository) GetAuthoredDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (AuthoredDecksConnection, error) {
+	return r.pgRepo.GetAuthoredDecks(ctx, userID, p)
+}
+
+func (r *redisRepository) GetDeckAnalytics(ctx context.Context, deckID uuid.UUID, ar AnalyticsRange) (DeckAnalytics, error) {
+	return r.pgRepo.GetDeckAnalytics(ctx, deckID, ar)
+}
+
+// GetCards reads the cards, with their answers, from the cache and only goes
+// to Postgres for the ones missing from it.
 func (r *redisRepository) GetCards(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Card, error) {
-	return r.pgRepo.GetCards(ctx, ids)
+	cards, err := getCached(ctx, r.batch, ids, r.getCardCacheKey, r.pgRepo.GetCards)
+
+	return cards, errors.Trace(err)
+}
+
+// GetCardDecks isn't cached, it is a single lookup on the primary key.
+func (r *redisRepository) GetCardDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	return r.pgRepo.GetCardDecks(ctx, ids)
+}
+
+// GetDeckCardsPage isn't cached, as the cards of the page are read with
+// GetCards, which is.
+func (r *redisRepository) GetDeckCardsPage(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (DeckCardsConnection, error) {
+	return r.pgRepo.GetDeckCardsPage(ctx, deckID, p)
+}
+
+func (r *redisRepository) GetDecksFirstCards(ctx context.Context, deckIDs []uuid.UUID, first int) (map[uuid.UUID]DeckCardsConnection, error) {
+	return r.pgRepo.GetDecksFirstCards(ctx, deckIDs, first)
 }
 
 func (r *redisRepository) UpdateDeck(ctx context.Context, id uuid.UUID, updates DeckUpdates) (Deck, error) {
@@ -155,7 +393,7 @@ func (r *redisRepository) UpdateDeck(ctx context.Context, id uuid.UUID, updates
 	}
 
 	// Invalidate cache
-	if err := r.delete(ctx, r.getDeckCacheKey(id)); err != nil {
+	if err := r.invalidateDeck(ctx, id); err != nil {
 		return d, errors.Trace(err)
 	}
 
@@ -169,7 +407,7 @@ func (r *redisRepository) UpdateCard(ctx context.Context, deckID, cardID uuid.UU
 	}
 
 	// Invalidate deck cache since cards are part of deck
-	if err := r.delete(ctx, r.getDeckCacheKey(deckID)); err != nil {
+	if err := r.invalidateCards(ctx, deckID, cardID); err != nil {
 		return c, errors.Trace(err)
 	}
 
@@ -183,13 +421,102 @@ func (r *redisRepository) UpdateAnswer(ctx context.Context, deckID, cardID, answ
 	}
 
 	// Invalidate deck cache since answers are part of deck
-	if err := r.delete(ctx, r.getDeckCacheKey(deckID)); err != nil {
+	if err := r.invalidateCards(ctx, deckID, cardID); err != nil {
 		return a, errors.Trace(err)
 	}
 
 	return a, nil
 }
 
+func (r *redisRepository) DeleteCard(ctx context.Context, deckID, cardID, authorID uuid.UUID) error {
+	if err := r.pgRepo.DeleteCard(ctx, deckID, cardID, authorID); err != nil {
+		return errors.Trace(err)
+	}
+
+	return r.invalidateCards(ctx, deckID, cardID)
+}
+
+func (r *redisRepository) CreateAnswer(ctx context.Context, deckID, cardID uuid.UUID, a Answer, authorID uuid.UUID) (Card, error) {
+	c, err := r.pgRepo.CreateAnswer(ctx, deckID, cardID, a, authorID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := r.invalidateCards(ctx, deckID, cardID); err != nil {
+		return c, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+func (r *redisRepository) DeleteAnswer(ctx context.Context, deckID, cardID, answerID, authorID uuid.UUID) (Card, error) {
+	c, err := r.pgRepo.DeleteAnswer(ctx, deckID, cardID, answerID, authorID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := r.invalidateCards(ctx, deckID, cardID); err != nil {
+		return c, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+func (r *redisRepository) GetCardRevisions(ctx context.Context, deckID, cardID uuid.UUID) ([]CardRevision, error) {
+	return r.pgRepo.GetCardRevisions(ctx, deckID, cardID)
+}
+
+func (r *redisRepository) GetCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int) (CardRevision, error) {
+	return r.pgRepo.GetCardRevision(ctx, deckID, cardID, revision)
+}
+
+func (r *redisRepository) RestoreCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int, authorID uuid.UUID) (Card, error) {
+	c, err := r.pgRepo.RestoreCardRevision(ctx, deckID, cardID, revision, authorID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	// The cached deck still holds the card as it was before the restore
+	if err := r.invalidateCards(ctx, deckID, cardID); err != nil {
+		return c, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+func (r *redisRepository) ForkDeck(ctx context.Context, upstreamID, authorID uuid.UUID) (Deck, error) {
+	d, err := r.pgRepo.ForkDeck(ctx, upstreamID, authorID)
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	return r.doCache(ctx, d)
+}
+
+func (r *redisRepository) GetUpstreamChanges(ctx context.Context, forkID uuid.UUID) ([]UpstreamChange, error) {
+	return r.pgRepo.GetUpstreamChanges(ctx, forkID)
+}
+
+func (r *redisRepository) PullUpstreamChanges(ctx context.Context, forkID uuid.UUID, upstreamCardIDs []uuid.UUID, authorID uuid.UUID) error {
+	// Pulling can change or remove any card of the fork, so all of them are
+	// invalidated. Cards it adds weren't cached yet.
+	cards, err := r.pgRepo.GetDeckCards(ctx, forkID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := r.pgRepo.PullUpstreamChanges(ctx, forkID, upstreamCardIDs, authorID); err != nil {
+		return errors.Trace(err)
+	}
+
+	cardIDs := make([]uuid.UUID, 0, len(cards))
+	for _, c := range cards {
+		cardIDs = append(cardIDs, c.ID)
+	}
+
+	return r.invalidateCards(ctx, forkID, cardIDs...)
+}
+
 func (r *redisRepository) getDeckFromDB(ctx context.Context, id uuid.UUID) (Deck, error) {
 	d, err := r.pgRepo.GetDeck(ctx, id)
 	if err != nil {
@@ -223,16 +550,31 @@ func (r *redisRepository) doCache(ctx context.Context, d Deck) (Deck, error) {
 
 	err = r.cache.Do(
 		ctx,
-		radix.Cmd(nil, "SET", r.getDeckCacheKey(d.ID), string(serialized)),
+		radix.FlatCmd(nil, "SET", r.getDeckCacheKey(d.ID), string(serialized), "EX", int(cacheTTL.Seconds())),
 	)
 
 	return d, errors.Trace(err)
 }
 
-func (r *redisRepository) delete(ctx context.Context, key string) error {
-	err := r.cache.Do(ctx, radix.Cmd(nil, "DEL", key))
+func (r *redisRepository) delete(ctx context.Context, keys ...string) error {
+	return errors.Trace(r.batch.invalidate(ctx, keys...))
+}
 
-	return errors.Trace(err)
+// invalidateDeck drops the cached copies of the deck itself, with and without
+// its cards.
+func (r *redisRepository) invalidateDeck(ctx context.Context, id uuid.UUID) error {
+	return r.delete(ctx, r.getDeckCacheKey(id), r.getDeckMetaCacheKey(id))
+}
+
+// invalidateCards drops the cached cards and everything they're part of: the
+// deck, whose revision moves with its cards, and its list of cards.
+func (r *redisRepository) invalidateCards(ctx context.Context, deckID uuid.UUID, cardIDs ...uuid.UUID) error {
+	keys := []string{r.getDeckCacheKey(deckID), r.getDeckMetaCacheKey(deckID), r.getDeckCardsCacheKey(deckID)}
+	for _, id := range cardIDs {
+		keys = append(keys, r.getCardCacheKey(id), r.getCardAnswersCacheKey(id))
+	}
+
+	return r.delete(ctx, keys...)
 }
 
 type pgRepository struct {
@@ -264,7 +606,15 @@ func (r *pgRepository) GetDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.
 			author_id,
 			title,
 			"description",
-			is_public
+			is_public,
+			"language"::text,
+			(`+deckTagsQuery+`),
+			revision,
+			upstream_deck_id,
+			COALESCE(upstream_revision, 0),
+			rating_count,
+			rating_average,
+			version
 		FROM decks
 		WHERE
 			deleted_at IS NULL
@@ -278,10 +628,12 @@ func (r *pgRepository) GetDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.
 
 	for rows.Next() {
 		var d Deck
+		var upstream uuid.NullUUID
 
-		if err := rows.Scan(&d.ID, &d.AuthorID, &d.Title, &d.Description, &d.Public); err != nil {
+		if err := rows.Scan(&d.ID, &d.AuthorID, &d.Title, &d.Description, &d.Public, &d.Language, pq.Array(&d.Tags), &d.Revision, &upstream, &d.UpstreamRevision, &d.RatingCount, &d.RatingAverage, &d.Version); err != nil {
 			return out, errors.Trace(err)
 		}
+		d.UpstreamDeckID = upstream.UUID
 
 		out[d.ID] = d
 	}
@@ -289,56 +641,82 @@ func (r *pgRepository) GetDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.
 	return out, nil
 }
 
-func (r *pgRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (PopularDecksConnection, error) {
+// GetPopularDecks ranks the decks the user can see by their popularity over
+// the period, as last computed by RefreshPopularity. Decks without activity
+// in the period score zero and come last, newest first. Pages after the
+// first are ranked by the same refresh as the cursor they follow, and fail
+// with ErrExpiredCursor once it has been pruned.
+func (r *pgRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, period Period, tags []string, p pagination.Pagination) (PopularDecksConnection, error) {
 	var (
-		out   PopularDecksConnection
-		arger db.Argumenter
+		out    PopularDecksConnection
+		arger  db.Argumenter
+		cursor *Cursor
 	)
 
-	whereConditions := []string{
-		"deleted_at IS NULL",
-		fmt.Sprintf("(is_public = true OR author_id = %s)", arger.Add(userID)),
-	}
-
 	if !p.Cursor().IsEmpty() {
-		var cursor Cursor
-		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+		cursor = &Cursor{}
+		if err := pagination.FromCursor(p.Cursor(), cursor); err != nil {
 			return out, ErrInvalidCursor
 		}
+	}
+
+	generation, err := r.popularityGeneration(ctx, cursor)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
 
+	query := fmt.Sprintf(`
+		SELECT d.id, d.created_at, COALESCE(p.score, 0) AS score
+		FROM decks d
+		LEFT JOIN deck_popularity p ON p.deck_id = d.id AND p.generation = %s AND p.period = %s`,
+		arger.Add(generation),
+		arger.Add(string(period)),
+	)
+
+	whereConditions := []string{
+		"d.deleted_at IS NULL",
+		visibleTo(&arger, "d", userID),
+	}
+
+	if len(tags) > 0 {
+		whereConditions = append(whereConditions, taggedWith(&arger, "d.id", tags))
+	}
+
+	if cursor != nil {
 		whereConditions = append(
 			whereConditions,
 			fmt.Sprintf(
-				"(created_at, id) %s (%s, %s)",
+				"(COALESCE(p.score, 0), d.created_at, d.id) %s (%s, %s, %s)",
 				p.Comparator(),
+				arger.Add(cursor.Score),
 				arger.Add(cursor.CreatedAt),
 				arger.Add(cursor.ID),
 			),
 		)
 	}
 
-	query := fmt.Sprintf(`
-		SELECT id, created_at
-		FROM decks
+	query += fmt.Sprintf(`
 		WHERE
 			%s
-		ORDER BY created_at %s, id %s
+		ORDER BY score %s, d.created_at %s, d.id %s
 		LIMIT %s`,
 		strings.Join(whereConditions, " AND "),
 		p.OrderBy(),
 		p.OrderBy(),
+		p.OrderBy(),
 		arger.Add(p.Limit()+1),
 	)
 
-	rows, err := r.db.Query(query, arger.Values()...)
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
 	if err != nil {
 		return out, errors.Trace(err)
 	}
+	defer rows.Close()
 
 	for rows.Next() {
-		var c Cursor
+		c := Cursor{Generation: generation}
 
-		if err := rows.Scan(&c.ID, &c.CreatedAt); err != nil {
+		if err := rows.Scan(&c.ID, &c.CreatedAt, &c.Score); err != nil {
 			return out, errors.Trace(err)
 		}
 
@@ -353,6 +731,10 @@ func (r *pgRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, p
 		})
 	}
 
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
 	hasMore := len(out.Edges) > p.Limit()
 
 	pageInfo := pagination.PageInfo{
@@ -365,9 +747,7 @@ func (r *pgRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, p
 	}
 
 	if !p.IsForward() {
-		sort.SliceStable(out.Edges, func(i, j int) bool {
-			return i > j
-		})
+		slices.Reverse(out.Edges)
 	}
 
 	if hasMore {
@@ -380,83 +760,344 @@ func (r *pgRepository) GetPopularDecks(ctx context.Context, userID uuid.UUID, p
 	return out, nil
 }
 
-func (r *pgRepository) GetDeck(ctx context.Context, id uuid.UUID) (Deck, error) {
-	var d Deck
-	row := r.db.QueryRow(`
-		SELECT 
-			id,
-			author_id,
-			title,
-			description,
-			is_public
-		FROM decks
-		WHERE 
-			id = $1 
-			AND deleted_at IS NULL`,
-		id,
-	)
-	if err := row.Scan(&d.ID, &d.AuthorID, &d.Title, &d.Description, &d.Public); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return Deck{}, ErrDeckNotFound
-		}
+// popularityGeneration returns the refresh a page of popular decks is
+// ranked by: the cursor's one when it follows another page, the latest one
+// otherwise. Generation zero ranks every deck as having no activity, as
+// before the first refresh.
+func (r *pgRepository) popularityGeneration(ctx context.Context, cursor *Cursor) (int64, error) {
+	if cursor == nil {
+		var generation int64
+		err := r.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(generation), 0) FROM deck_popularity_refreshes`).Scan(&generation)
 
-		return Deck{}, errors.Trace(err)
+		return generation, errors.Trace(err)
 	}
 
-	return d, nil
-}
+	if cursor.Generation == 0 {
+		return 0, nil
+	}
 
-func (r *pgRepository) StoreDeck(ctx context.Context, d Deck) error {
-	tx, err := r.db.BeginTx(ctx, nil)
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM deck_popularity_refreshes WHERE generation = $1)`, cursor.Generation).Scan(&exists)
 	if err != nil {
-		return errors.Trace(err)
+		return 0, errors.Trace(err)
 	}
 
-	defer tx.Rollback()
-
-	_, err = tx.Exec(`
-		INSERT INTO decks (
-			id,
-			author_id,
-			title,
-			"description",
-			is_public,
-			created_at
-		) VALUES ($1, $2, $3, $4, $5, NOW());`,
-		d.ID, d.AuthorID, d.Title, d.Description, d.Public,
-	)
-	if db.IsConstraintError(err, "decks_pkey") {
-		return ErrDeckAlreadyExists
+	if !exists {
+		return 0, ErrExpiredCursor
 	}
 
-	stmt, err := tx.Prepare(pq.CopyIn("cards", "id", "deck_id", "title", "explanation", "kind"))
+	return cursor.Generation, nil
+}
+
+// RefreshPopularity recomputes the popularity of every deck with activity,
+// for each period. A deck's learners are the users that practised it or
+// added it to their library within the period, and its completion rate the
+// share of them that answered every one of its cards correctly. Its ratings
+// nudge the score up or down.
+//
+// The scores are stored as a new generation, so cursors into the previous
+// ones keep paging through the ranking they started on until the
+// generation is older than popularityRetention. Only one instance refreshes
+// at a time; the others skip the refresh while it runs.
+func (r *pgRepository) RefreshPopularity(ctx context.Context) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	defer tx.Rollback()
 
-	for _, card := range d.Cards {
-		if _, err := stmt.Exec(card.ID, d.ID, card.Title, card.Explanation, card.Kind); err != nil {
-			return errors.Trace(err)
-		}
-	}
-	if _, err = stmt.Exec(); err != nil {
+	var locked bool
+	if err := tx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, popularityRefreshLockKey).Scan(&locked); err != nil {
 		return errors.Trace(err)
 	}
 
-	stmt, err = tx.Prepare(pq.CopyIn("answers", "id", "card_id", "text", "is_correct"))
+	if !locked {
+		return nil
+	}
+
+	now := time.Now()
+
+	var generation int64
+	err = tx.QueryRowContext(ctx, `INSERT INTO deck_popularity_refreshes (computed_at) VALUES ($1) RETURNING generation`, now).Scan(&generation)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
-	for _, card := range d.Cards {
-		for _, answer := range card.PossibleAnswers {
-			if _, err := stmt.Exec(answer.ID, card.ID, answer.Text, answer.IsCorrect); err != nil {
-				return errors.Trace(err)
-			}
+	for _, period := range []Period{PeriodWeek, PeriodAllTime} {
+		_, err := tx.ExecContext(ctx, `
+			WITH practice AS (
+				SELECT c.deck_id, cp.user_id, COUNT(*) AS answers
+				FROM card_practice cp
+				JOIN answers a ON a.id = cp.answer_id
+				JOIN cards c ON c.id = a.card_id
+				WHERE cp.created_at >= $1
+				GROUP BY c.deck_id, cp.user_id
+			),
+			learners AS (
+				SELECT deck_id, user_id, SUM(answers) AS answers
+				FROM (
+					SELECT deck_id, user_id, answers FROM practice
+					UNION ALL
+					SELECT deck_id, user_id, 0 FROM user_deck WHERE last_practiced >= $1
+				) l
+				GROUP BY deck_id, user_id
+			),
+			completed AS (
+				SELECT l.deck_id, l.user_id
+				FROM learners l
+				JOIN cards c ON c.deck_id = l.deck_id AND c.deleted_at IS NULL
+				LEFT JOIN user_card_level ucl ON ucl.card_id = c.id AND ucl.user_id = l.user_id
+				GROUP BY l.deck_id, l.user_id
+				HAVING COUNT(ucl.card_id) = COUNT(*)
+			),
+			stats AS (
+				SELECT
+					l.deck_id,
+					COUNT(*) AS learners,
+					SUM(l.answers)::BIGINT AS answers,
+					COUNT(co.user_id)::DOUBLE PRECISION / COUNT(*) AS completion_rate
+				FROM learners l
+				LEFT JOIN completed co ON co.deck_id = l.deck_id AND co.user_id = l.user_id
+				GROUP BY l.deck_id
+			)
+			INSERT INTO deck_popularity (generation, deck_id, period, learners, answers, completion_rate, score)
+			SELECT
+				$7,
+				s.deck_id,
+				$2,
+				s.learners,
+				s.answers,
+				s.completion_rate,
+				s.learners * $3 + LN(1 + s.answers) * $4 + s.completion_rate * $5 + `+ratingBoost("d")+` * $6
+			FROM stats s
+			JOIN decks d ON d.id = s.deck_id
+			WHERE d.deleted_at IS NULL`,
+			period.Since(now),
+			string(period),
+			learnersWeight,
+			answersWeight,
+			completionRateWeight,
+			ratingsWeight,
+			generation,
+		)
+		if err != nil {
+			return errors.Trace(err)
 		}
 	}
 
-	if _, err = stmt.Exec(); err != nil {
+	// Their rows go along with the refreshes, as the foreign key cascades
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM deck_popularity_refreshes
+		WHERE generation <> $1 AND computed_at < $2`,
+		generation,
+		now.Add(-popularityRetention),
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return errors.Trace(tx.Commit())
+}
+
+// SearchDecks looks the query up in deck titles and descriptions and in the
+// titles and explanations of their cards, stemming it in each deck's
+// language. Decks are ranked by how well they match plus how well their best
+// card does. When a language is given only decks in that language are
+// searched, and when tags are given only decks carrying all of them.
+//
+// Matching decks are found one language at a time, so that the query is
+// stemmed with a constant configuration the search vector indexes can be
+// used with.
+func (r *pgRepository) SearchDecks(ctx context.Context, userID uuid.UUID, query, language string, tags []string, p pagination.Pagination) (SearchDecksConnection, error) {
+	var (
+		out   SearchDecksConnection
+		arger db.Argumenter
+	)
+
+	terms := arger.Add(query)
+
+	languages := []string{language}
+	if language == "" {
+		languages = searchLanguageNames()
+	}
+
+	var matches []string
+	for _, l := range languages {
+		config := arger.Add(l)
+		matches = append(matches, fmt.Sprintf(`
+			SELECT id FROM decks
+			WHERE "language" = %[1]s::regconfig AND search_vector @@ websearch_to_tsquery(%[1]s::regconfig, %[2]s)
+			UNION
+			SELECT cards.deck_id FROM cards
+			JOIN decks ON decks.id = cards.deck_id AND decks."language" = %[1]s::regconfig
+			WHERE cards.deleted_at IS NULL AND cards.search_vector @@ websearch_to_tsquery(%[1]s::regconfig, %[2]s)`,
+			config, terms,
+		))
+	}
+
+	whereConditions := []string{
+		"d.deleted_at IS NULL",
+		visibleTo(&arger, "d", userID),
+	}
+
+	if len(tags) > 0 {
+		whereConditions = append(whereConditions, taggedWith(&arger, "d.id", tags))
+	}
+
+	cursorCondition := "TRUE"
+	if !p.Cursor().IsEmpty() {
+		var cursor SearchCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, ErrInvalidCursor
+		}
+
+		cursorCondition = fmt.Sprintf(
+			"(rank, id) %s (%s::real, %s)",
+			p.Comparator(),
+			arger.Add(cursor.Rank),
+			arger.Add(cursor.ID),
+		)
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT id, rank
+		FROM (
+			SELECT
+				d.id,
+				((ts_rank(d.search_vector, q.query) + COALESCE(c.rank, 0)) * (1 + %s * `+ratingBoost("d")+`))::real AS rank
+			FROM (%s) matches
+			JOIN decks d ON d.id = matches.id
+			CROSS JOIN LATERAL (
+				SELECT websearch_to_tsquery(d."language", %s) AS query
+			) q
+			LEFT JOIN LATERAL (
+				SELECT MAX(ts_rank(cards.search_vector, q.query)) AS rank
+				FROM cards
+				WHERE
+					cards.deck_id = d.id
+					AND cards.deleted_at IS NULL
+					AND cards.search_vector @@ q.query
+			) c ON TRUE
+			WHERE
+				%s
+		) results
+		WHERE %s
+		ORDER BY rank %s, id %s
+		LIMIT %s`,
+		arger.Add(ratingSearchWeight),
+		strings.Join(matches, " UNION "),
+		terms,
+		strings.Join(whereConditions, " AND "),
+		cursorCondition,
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c SearchCursor
+
+		if err := rows.Scan(&c.ID, &c.Rank); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(c)
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, SearchDeckEdge{
+			DeckID: c.ID,
+			Rank:   c.Rank,
+			Cursor: cursor,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	if !p.IsForward() {
+		slices.Reverse(out.Edges)
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
+func (r *pgRepository) GetDeck(ctx context.Context, id uuid.UUID) (Deck, error) {
+	return readDeck(ctx, r.db, id)
+}
+
+func readDeck(ctx context.Context, q querier, id uuid.UUID) (Deck, error) {
+	var d Deck
+	var upstream uuid.NullUUID
+
+	row := q.QueryRowContext(ctx, `
+		SELECT 
+			id,
+			author_id,
+			title,
+			description,
+			is_public,
+			"language"::text,
+			(`+deckTagsQuery+`),
+			revision,
+			upstream_deck_id,
+			COALESCE(upstream_revision, 0),
+			rating_count,
+			rating_average,
+			version
+		FROM decks
+		WHERE 
+			id = $1 
+			AND deleted_at IS NULL`,
+		id,
+	)
+	if err := row.Scan(&d.ID, &d.AuthorID, &d.Title, &d.Description, &d.Public, &d.Language, pq.Array(&d.Tags), &d.Revision, &upstream, &d.UpstreamRevision, &d.RatingCount, &d.RatingAverage, &d.Version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Deck{}, ErrDeckNotFound
+		}
+
+		return Deck{}, errors.Trace(err)
+	}
+	d.UpstreamDeckID = upstream.UUID
+
+	return d, nil
+}
+
+func (r *pgRepository) StoreDeck(ctx context.Context, d Deck) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	if err := storeDeck(ctx, tx, d); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -467,6 +1108,88 @@ func (r *pgRepository) StoreDeck(ctx context.Context, d Deck) error {
 	return nil
 }
 
+// storeDeck inserts d along with its tags, cards and answers. Every card
+// starts its history with a first revision.
+func storeDeck(ctx context.Context, tx *sql.Tx, d Deck) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO decks (
+			id,
+			author_id,
+			title,
+			"description",
+			is_public,
+			"language",
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, COALESCE(NULLIF($6, ''), 'simple')::regconfig, NOW());`,
+		d.ID, d.AuthorID, d.Title, d.Description, d.Public, d.Language,
+	)
+	if err != nil {
+		if db.IsConstraintError(err, "decks_pkey") {
+			return ErrDeckAlreadyExists
+		}
+
+		return errors.Trace(err)
+	}
+
+	if err := storeDeckTags(ctx, tx, d.ID, d.Tags); err != nil {
+		return errors.Trace(err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("cards", "id", "deck_id", "title", "explanation", "kind"))
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, card := range d.Cards {
+		if _, err := stmt.Exec(card.ID, d.ID, card.Title, card.Explanation, card.Kind); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if _, err = stmt.Exec(); err != nil {
+		return errors.Trace(err)
+	}
+
+	stmt, err = tx.PrepareContext(ctx, pq.CopyIn("answers", "id", "card_id", "text", "is_correct", "blank", "tolerance", "position", "side"))
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, card := range d.Cards {
+		for _, answer := range card.PossibleAnswers {
+			if _, err := stmt.Exec(answer.ID, card.ID, answer.Text, answer.IsCorrect, answer.Blank, answer.Tolerance, answer.Position, answer.Side); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+
+	if _, err = stmt.Exec(); err != nil {
+		return errors.Trace(err)
+	}
+
+	stmt, err = tx.PrepareContext(ctx, pq.CopyIn("card_revisions", "card_id", "revision", "author_id", "changed_fields", "snapshot"))
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, card := range d.Cards {
+		snapshot, err := json.Marshal(card)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		changed := ChangedFields(DiffCards(Card{}, card))
+		if _, err := stmt.Exec(card.ID, 1, d.AuthorID, pq.Array(changed), string(snapshot)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if _, err = stmt.Exec(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
 func (r *pgRepository) StoreCard(ctx context.Context, c Card, dID uuid.UUID) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -475,7 +1198,21 @@ func (r *pgRepository) StoreCard(ctx context.Context, c Card, dID uuid.UUID) err
 
 	defer tx.Rollback()
 
-	_, err = tx.Exec(`INSERT INTO cards (
+	if err := storeCard(ctx, tx, c, dID, uuid.Nil); err != nil {
+		return errors.Trace(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+// storeCard inserts c and its answers into the deck, along with the first
+// revision of the card.
+func storeCard(ctx context.Context, tx *sql.Tx, c Card, dID, authorID uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO cards (
 			id,
 			deck_id,
 			title,
@@ -496,13 +1233,13 @@ func (r *pgRepository) StoreCard(ctx context.Context, c Card, dID uuid.UUID) err
 		return errors.Trace(err)
 	}
 
-	stmt, err := tx.Prepare(pq.CopyIn("answers", "id", "card_id", "text", "is_correct"))
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("answers", "id", "card_id", "text", "is_correct", "blank", "tolerance", "position", "side"))
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	for _, answer := range c.PossibleAnswers {
-		if _, err := stmt.Exec(answer.ID, c.ID, answer.Text, answer.IsCorrect); err != nil {
+		if _, err := stmt.Exec(answer.ID, c.ID, answer.Text, answer.IsCorrect, answer.Blank, answer.Tolerance, answer.Position, answer.Side); err != nil {
 			return errors.Trace(err)
 		}
 	}
@@ -511,21 +1248,23 @@ func (r *pgRepository) StoreCard(ctx context.Context, c Card, dID uuid.UUID) err
 		return errors.Trace(err)
 	}
 
-	if err = tx.Commit(); err != nil {
-		return errors.Trace(err)
-	}
-
-	return nil
+	return insertCardRevision(ctx, tx, c, 1, authorID, DiffCards(Card{}, c))
 }
 
 func (r *pgRepository) GetDeckCards(ctx context.Context, id uuid.UUID) ([]Card, error) {
+	return deckCards(ctx, r.db, id)
+}
+
+// deckCards lists the cards of a deck, without their answers.
+func deckCards(ctx context.Context, q querier, id uuid.UUID) ([]Card, error) {
 	var out []Card
-	rows, err := r.db.Query(`
+	rows, err := q.QueryContext(ctx, `
 		SELECT
 			id,
 			title,
 			explanation,
-			kind
+			kind,
+			version
 		FROM cards
 		WHERE
 			deck_id = $1
@@ -538,7 +1277,7 @@ func (r *pgRepository) GetDeckCards(ctx context.Context, id uuid.UUID) ([]Card,
 
 	for rows.Next() {
 		var c Card
-		if err := rows.Scan(&c.ID, &c.Title, &c.Explanation, &c.Kind); err != nil {
+		if err := rows.Scan(&c.ID, &c.Title, &c.Explanation, &c.Kind, &c.Version); err != nil {
 			return []Card{}, errors.Trace(err)
 		}
 
@@ -549,15 +1288,30 @@ func (r *pgRepository) GetDeckCards(ctx context.Context, id uuid.UUID) ([]Card,
 }
 
 func (r *pgRepository) GetCardAnswers(ctx context.Context, id uuid.UUID) ([]Answer, error) {
-	rows, err := r.db.Query(`
+	return cardAnswers(ctx, r.db, id)
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func cardAnswers(ctx context.Context, q querier, id uuid.UUID) ([]Answer, error) {
+	rows, err := q.QueryContext(ctx, `
 		SELECT
 			id,
 			text,
-			is_correct
+			is_correct,
+			blank,
+			tolerance,
+			position,
+			side
 		FROM answers
 		WHERE
 			card_id = $1
-			AND deleted_at IS NULL`,
+			AND deleted_at IS NULL
+		ORDER BY blank, position, created_at`,
 		id,
 	)
 	if err != nil {
@@ -568,7 +1322,7 @@ func (r *pgRepository) GetCardAnswers(ctx context.Context, id uuid.UUID) ([]Answ
 
 	for rows.Next() {
 		var a Answer
-		if err := rows.Scan(&a.ID, &a.Text, &a.IsCorrect); err != nil {
+		if err := rows.Scan(&a.ID, &a.Text, &a.IsCorrect, &a.Blank, &a.Tolerance, &a.Position, &a.Side); err != nil {
 			return out, errors.Trace(err)
 		}
 
@@ -589,7 +1343,8 @@ func (r *pgRepository) GetCards(ctx context.Context, ids []uuid.UUID) (map[uuid.
 			id,
 			title,
 			explanation,
-			kind
+			kind,
+			version
 		FROM cards
 		WHERE
 			deleted_at IS NULL
@@ -605,22 +1360,92 @@ func (r *pgRepository) GetCards(ctx context.Context, ids []uuid.UUID) (map[uuid.
 		var c Card
 		var explanation sql.NullString
 
-		if err := rows.Scan(&c.ID, &c.Title, &explanation, &c.Kind); err != nil {
+		if err := rows.Scan(&c.ID, &c.Title, &explanation, &c.Kind, &c.Version); err != nil {
 			return out, errors.Trace(err)
 		}
 
 		c.Explanation = explanation.String
-		// Temporary extra db calls per card for convinience
-		answers, err := r.GetCardAnswers(ctx, c.ID)
-		if err != nil {
+
+		out[c.ID] = c
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	if len(out) == 0 {
+		return out, nil
+	}
+
+	// The answers of all the cards are read at once
+	answerRows, err := r.db.QueryContext(ctx, `
+		SELECT
+			card_id,
+			id,
+			text,
+			is_correct,
+			blank,
+			tolerance,
+			position,
+			side
+		FROM answers
+		WHERE
+			card_id = ANY($1)
+			AND deleted_at IS NULL
+		ORDER BY blank, position, created_at`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer answerRows.Close()
+
+	for answerRows.Next() {
+		var (
+			cardID uuid.UUID
+			a      Answer
+		)
+		if err := answerRows.Scan(&cardID, &a.ID, &a.Text, &a.IsCorrect, &a.Blank, &a.Tolerance, &a.Position, &a.Side); err != nil {
 			return out, errors.Trace(err)
 		}
-		c.PossibleAnswers = answers
 
-		out[c.ID] = c
+		c, ok := out[cardID]
+		if !ok {
+			continue
+		}
+
+		c.PossibleAnswers = append(c.PossibleAnswers, a)
+		out[cardID] = c
 	}
 
-	return out, nil
+	return out, errors.Trace(answerRows.Err())
+}
+
+func (r *pgRepository) GetCardDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	out := make(map[uuid.UUID]uuid.UUID, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, deck_id FROM cards WHERE deleted_at IS NULL AND id = ANY($1)`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cardID, deckID uuid.UUID
+		if err := rows.Scan(&cardID, &deckID); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out[cardID] = deckID
+	}
+
+	return out, errors.Trace(rows.Err())
 }
 
 // UpdateDeck updates a deck with the provided fields
@@ -637,22 +1462,57 @@ func (r *pgRepository) UpdateDeck(ctx context.Context, id uuid.UUID, updates Dec
 	if updates.IsPublic != nil {
 		setClauses = append(setClauses, fmt.Sprintf("is_public = %s", arger.Add(*updates.IsPublic)))
 	}
+	if updates.Language != nil {
+		setClauses = append(setClauses, fmt.Sprintf(`"language" = %s::regconfig`, arger.Add(*updates.Language)))
+	}
+	setClauses = append(setClauses, "version = version + 1")
+
+	where := fmt.Sprintf("id = %s AND deleted_at IS NULL", arger.Add(id))
+	if updates.ExpectedVersion != nil {
+		where += fmt.Sprintf(" AND version = %s", arger.Add(*updates.ExpectedVersion))
+	}
 
 	query := fmt.Sprintf(
-		`UPDATE decks SET %s WHERE id = %s AND deleted_at IS NULL
-		 RETURNING id, author_id, title, description, is_public`,
+		`UPDATE decks SET %s WHERE %s
+		 RETURNING id, author_id, title, description, is_public, "language"::text,
+			revision, upstream_deck_id, COALESCE(upstream_revision, 0), version`,
 		strings.Join(setClauses, ", "),
-		arger.Add(id),
+		where,
 	)
 
-	var d Deck
-	err := r.db.QueryRowContext(ctx, query, arger.Values()...).Scan(
-		&d.ID, &d.AuthorID, &d.Title, &d.Description, &d.Public,
-	)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	var d Deck
+	var upstream uuid.NullUUID
+	err = tx.QueryRowContext(ctx, query, arger.Values()...).Scan(
+		&d.ID, &d.AuthorID, &d.Title, &d.Description, &d.Public, &d.Language,
+		&d.Revision, &upstream, &d.UpstreamRevision, &d.Version,
+	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return Deck{}, errors.Trace(ErrDeckNotFound)
+			return Deck{}, errors.Trace(deckUpdateMiss(ctx, tx, id))
+		}
+		return Deck{}, errors.Trace(err)
+	}
+	d.UpstreamDeckID = upstream.UUID
+
+	if updates.Tags != nil {
+		if err := storeDeckTags(ctx, tx, d.ID, *updates.Tags); err != nil {
+			return Deck{}, errors.Trace(err)
 		}
+	}
+
+	err = tx.QueryRowContext(ctx, `SELECT (`+deckTagsQuery+`) FROM decks WHERE id = $1`, d.ID).Scan(pq.Array(&d.Tags))
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return Deck{}, errors.Trace(err)
 	}
 
@@ -674,50 +1534,110 @@ func (r *pgRepository) UpdateCard(ctx context.Context, deckID, cardID uuid.UUID,
 		setClauses = append(setClauses, fmt.Sprintf("kind = %s", arger.Add(*updates.Kind)))
 	}
 
+	where := fmt.Sprintf("id = %s AND deck_id = %s AND deleted_at IS NULL", arger.Add(cardID), arger.Add(deckID))
+	if updates.ExpectedVersion != nil {
+		where += fmt.Sprintf(" AND version = %s", arger.Add(*updates.ExpectedVersion))
+	}
+
 	query := fmt.Sprintf(
-		`UPDATE cards SET %s WHERE id = %s AND deck_id = %s AND deleted_at IS NULL
-		 RETURNING id, title, explanation, kind`,
+		`UPDATE cards SET %s WHERE %s
+		 RETURNING id, title, explanation, kind, version`,
 		strings.Join(setClauses, ", "),
-		arger.Add(cardID),
-		arger.Add(deckID),
+		where,
 	)
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
 	var c Card
 	var explanation sql.NullString
-	err := r.db.QueryRowContext(ctx, query, arger.Values()...).Scan(
-		&c.ID, &c.Title, &explanation, &c.Kind,
+	err = tx.QueryRowContext(ctx, query, arger.Values()...).Scan(
+		&c.ID, &c.Title, &explanation, &c.Kind, &c.Version,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return Card{}, errors.Trace(ErrCardNotFound)
+			return Card{}, errors.Trace(cardUpdateMiss(ctx, tx, deckID, cardID))
 		}
 		return Card{}, errors.Trace(err)
 	}
 	c.Explanation = explanation.String
 
 	// Fetch answers
-	answers, err := r.GetCardAnswers(ctx, cardID)
+	answers, err := cardAnswers(ctx, tx, cardID)
 	if err != nil {
 		return Card{}, errors.Trace(err)
 	}
 	c.PossibleAnswers = answers
 
+	// The change is rolled back if the answers don't fit the card's new kind
+	if err := validateCard(c); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := recordCardRevision(ctx, tx, &c, updates.AuthorID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
 	return c, nil
 }
 
-// UpdateAnswer updates an answer with the provided fields
-func (r *pgRepository) UpdateAnswer(ctx context.Context, deckID, cardID, answerID uuid.UUID, updates AnswerUpdates) (Answer, error) {
-	// First verify the card belongs to the deck
+// deckUpdateMiss tells why an update of the deck matched no rows: either it
+// doesn't exist or it is no longer at the expected version.
+func deckUpdateMiss(ctx context.Context, q querier, id uuid.UUID) error {
+	var exists bool
+	err := q.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM decks WHERE id = $1 AND deleted_at IS NULL)`,
+		id,
+	).Scan(&exists)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if exists {
+		return ErrVersionConflict
+	}
+
+	return ErrDeckNotFound
+}
+
+// cardUpdateMiss is deckUpdateMiss for cards.
+func cardUpdateMiss(ctx context.Context, q querier, deckID, cardID uuid.UUID) error {
 	var exists bool
-	err := r.db.QueryRowContext(ctx,
+	err := q.QueryRowContext(ctx,
 		`SELECT EXISTS(SELECT 1 FROM cards WHERE id = $1 AND deck_id = $2 AND deleted_at IS NULL)`,
 		cardID, deckID,
 	).Scan(&exists)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if exists {
+		return ErrVersionConflict
+	}
+
+	return ErrCardNotFound
+}
+
+// UpdateAnswer updates an answer with the provided fields
+func (r *pgRepository) UpdateAnswer(ctx context.Context, deckID, cardID, answerID uuid.UUID, updates AnswerUpdates) (Answer, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return Answer{}, errors.Trace(err)
 	}
-	if !exists {
-		return Answer{}, errors.Trace(ErrCardNotFound)
+
+	defer tx.Rollback()
+
+	// First verify the card belongs to the deck
+	if err := lockCard(ctx, tx, deckID, cardID); err != nil {
+		return Answer{}, errors.Trace(err)
 	}
 
 	var arger db.Argumenter
@@ -729,18 +1649,30 @@ func (r *pgRepository) UpdateAnswer(ctx context.Context, deckID, cardID, answerI
 	if updates.IsCorrect != nil {
 		setClauses = append(setClauses, fmt.Sprintf("is_correct = %s", arger.Add(*updates.IsCorrect)))
 	}
+	if updates.Blank != nil {
+		setClauses = append(setClauses, fmt.Sprintf("blank = %s", arger.Add(*updates.Blank)))
+	}
+	if updates.Tolerance != nil {
+		setClauses = append(setClauses, fmt.Sprintf("tolerance = %s", arger.Add(*updates.Tolerance)))
+	}
+	if updates.Position != nil {
+		setClauses = append(setClauses, fmt.Sprintf("position = %s", arger.Add(*updates.Position)))
+	}
+	if updates.Side != nil {
+		setClauses = append(setClauses, fmt.Sprintf("side = %s", arger.Add(*updates.Side)))
+	}
 
 	query := fmt.Sprintf(
 		`UPDATE answers SET %s WHERE id = %s AND card_id = %s AND deleted_at IS NULL
-		 RETURNING id, text, is_correct`,
+		 RETURNING id, text, is_correct, blank, tolerance, position, side`,
 		strings.Join(setClauses, ", "),
 		arger.Add(answerID),
 		arger.Add(cardID),
 	)
 
 	var a Answer
-	err = r.db.QueryRowContext(ctx, query, arger.Values()...).Scan(
-		&a.ID, &a.Text, &a.IsCorrect,
+	err = tx.QueryRowContext(ctx, query, arger.Values()...).Scan(
+		&a.ID, &a.Text, &a.IsCorrect, &a.Blank, &a.Tolerance, &a.Position, &a.Side,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -749,9 +1681,2169 @@ func (r *pgRepository) UpdateAnswer(ctx context.Context, deckID, cardID, answerI
 		return Answer{}, errors.Trace(err)
 	}
 
+	c, err := readCard(ctx, tx, cardID)
+	if err != nil {
+		return Answer{}, errors.Trace(err)
+	}
+
+	// The change is rolled back if it leaves the card invalid for its kind
+	if err := validateCard(c); err != nil {
+		return Answer{}, errors.Trace(err)
+	}
+
+	if err := recordCardRevision(ctx, tx, &c, updates.AuthorID); err != nil {
+		return Answer{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Answer{}, errors.Trace(err)
+	}
+
 	return a, nil
 }
 
-func (r *redisRepository) getDeckCacheKey(id uuid.UUID) string {
-	return fmt.Sprintf("cache:deck:%s", id.String())
+// DeleteCard soft deletes a card of the deck. Its history is kept, ending
+// with its removal by authorID, and so is the practice of learners who
+// answered it.
+func (r *pgRepository) DeleteCard(ctx context.Context, deckID, cardID, authorID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCard(ctx, tx, deckID, cardID); err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := recordCardRemoval(ctx, tx, cardID, authorID); err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE cards SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1`,
+		cardID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+// CreateAnswer adds a to an existing card, as long as the card is still
+// valid with it. It returns the card as it is afterwards.
+func (r *pgRepository) CreateAnswer(ctx context.Context, deckID, cardID uuid.UUID, a Answer, authorID uuid.UUID) (Card, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCard(ctx, tx, deckID, cardID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	c, err := readCard(ctx, tx, cardID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	c.PossibleAnswers = append(c.PossibleAnswers, a)
+	if err := validateCard(c); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO answers (id, card_id, "text", is_correct, blank, tolerance, position, side, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())`,
+		a.ID, cardID, a.Text, a.IsCorrect, a.Blank, a.Tolerance, a.Position, a.Side,
+	)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	c, err = readCard(ctx, tx, cardID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := recordCardRevision(ctx, tx, &c, authorID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+// DeleteAnswer soft deletes an answer of a card, as long as the card is
+// still valid without it. The positions of the answers left on ordering and
+// matching cards are renumbered to close the gap. It returns the card as it
+// is afterwards.
+func (r *pgRepository) DeleteAnswer(ctx context.Context, deckID, cardID, answerID, authorID uuid.UUID) (Card, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCard(ctx, tx, deckID, cardID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	c, err := readCard(ctx, tx, cardID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	i := slices.IndexFunc(c.PossibleAnswers, func(a Answer) bool { return a.ID == answerID })
+	if i < 0 {
+		return Card{}, errors.Trace(ErrAnswerNotFound)
+	}
+
+	c.PossibleAnswers = slices.Delete(c.PossibleAnswers, i, i+1)
+	c = c.withCompactPositions()
+	if err := validateCard(c); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE answers SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1`,
+		answerID,
+	)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(c.PossibleAnswers))
+	positions := make([]int64, 0, len(c.PossibleAnswers))
+	for _, a := range c.PossibleAnswers {
+		ids = append(ids, a.ID)
+		positions = append(positions, int64(a.Position))
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE answers a SET position = p.position, updated_at = NOW()
+		FROM unnest($1::uuid[], $2::int[]) AS p(id, position)
+		WHERE a.id = p.id AND a.position <> p.position`,
+		pq.Array(ids), pq.Array(positions),
+	)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := recordCardRevision(ctx, tx, &c, authorID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+// validateCard returns the first reason c isn't valid, if any.
+func validateCard(c Card) error {
+	if ok, ec := ValidateCard(c); !ok {
+		return ec.Errs[0]
+	}
+
+	return nil
+}
+
+func (r *pgRepository) GetCardRevisions(ctx context.Context, deckID, cardID uuid.UUID) ([]CardRevision, error) {
+	revisions, err := r.cardRevisions(ctx, deckID, cardID, 0)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if len(revisions) == 0 {
+		if err := lockCard(ctx, r.db, deckID, cardID); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	return revisions, nil
+}
+
+func (r *pgRepository) GetCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int) (CardRevision, error) {
+	revisions, err := r.cardRevisions(ctx, deckID, cardID, revision)
+	if err != nil {
+		return CardRevision{}, errors.Trace(err)
+	}
+
+	if len(revisions) == 0 {
+		if err := lockCard(ctx, r.db, deckID, cardID); err != nil {
+			return CardRevision{}, errors.Trace(err)
+		}
+
+		return CardRevision{}, errors.Trace(ErrRevisionNotFound)
+	}
+
+	return revisions[0], nil
+}
+
+// cardRevisions returns the revisions of a card, or only the given one when
+// revision isn't 0. Learners are counted from the time a revision was made
+// until the next one was.
+func (r *pgRepository) cardRevisions(ctx context.Context, deckID, cardID uuid.UUID, revision int) ([]CardRevision, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			r.card_id,
+			r.revision,
+			r.author_id,
+			r.changed_fields,
+			r.snapshot,
+			r.created_at,
+			(
+				SELECT COUNT(DISTINCT cp.user_id)
+				FROM card_practice cp
+				JOIN answers a ON a.id = cp.answer_id
+				WHERE
+					a.card_id = r.card_id
+					AND cp.created_at >= r.created_at
+					AND cp.created_at < COALESCE(r.superseded_at, 'infinity')
+			)
+		FROM (
+			SELECT
+				cr.*,
+				LEAD(cr.created_at) OVER (ORDER BY cr.revision) AS superseded_at
+			FROM card_revisions cr
+			JOIN cards c ON c.id = cr.card_id
+			WHERE
+				cr.card_id = $1
+				AND c.deck_id = $2
+				AND c.deleted_at IS NULL
+		) r
+		WHERE $3 = 0 OR r.revision = $3
+		ORDER BY r.revision DESC`,
+		cardID, deckID, revision,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	defer rows.Close()
+
+	var out []CardRevision
+
+	for rows.Next() {
+		var rev CardRevision
+		var snapshot []byte
+
+		if err := rows.Scan(
+			&rev.CardID, &rev.Revision, &rev.AuthorID, pq.Array(&rev.ChangedFields), &snapshot, &rev.CreatedAt, &rev.LearnerCount,
+		); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		if err := json.Unmarshal(snapshot, &rev.Card); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		out = append(out, rev)
+	}
+
+	return out, errors.Trace(rows.Err())
+}
+
+// RestoreCardRevision brings the card and its answers back to how they were
+// in the given revision. Answers added since then are deleted, and the
+// restore itself is recorded as a new revision.
+func (r *pgRepository) RestoreCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int, authorID uuid.UUID) (Card, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	if err := lockCard(ctx, tx, deckID, cardID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	var snapshot []byte
+	err = tx.QueryRowContext(ctx,
+		`SELECT snapshot FROM card_revisions WHERE card_id = $1 AND revision = $2`,
+		cardID, revision,
+	).Scan(&snapshot)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Card{}, errors.Trace(ErrRevisionNotFound)
+		}
+		return Card{}, errors.Trace(err)
+	}
+
+	var old Card
+	if err := json.Unmarshal(snapshot, &old); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE cards SET title = $2, explanation = $3, kind = $4, updated_at = NOW() WHERE id = $1`,
+		cardID, old.Title, old.Explanation, old.Kind,
+	)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	answerIDs := make([]uuid.UUID, 0, len(old.PossibleAnswers))
+	for _, a := range old.PossibleAnswers {
+		answerIDs = append(answerIDs, a.ID)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE answers SET deleted_at = NOW(), updated_at = NOW()
+		WHERE card_id = $1 AND deleted_at IS NULL AND NOT (id = ANY($2))`,
+		cardID, pq.Array(answerIDs),
+	)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	for _, a := range old.PossibleAnswers {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO answers (id, card_id, "text", is_correct, blank, tolerance, position, side, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+			ON CONFLICT (id) DO UPDATE SET
+				"text" = EXCLUDED."text",
+				is_correct = EXCLUDED.is_correct,
+				blank = EXCLUDED.blank,
+				tolerance = EXCLUDED.tolerance,
+				position = EXCLUDED.position,
+				side = EXCLUDED.side,
+				updated_at = NOW(),
+				deleted_at = NULL`,
+			a.ID, cardID, a.Text, a.IsCorrect, a.Blank, a.Tolerance, a.Position, a.Side,
+		)
+		if err != nil {
+			return Card{}, errors.Trace(err)
+		}
+	}
+
+	c, err := readCard(ctx, tx, cardID)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := recordCardRevision(ctx, tx, &c, authorID); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+// lockCard locks the card for the rest of the transaction, so that its
+// revisions are numbered one after the other. It fails with ErrCardNotFound
+// if the card isn't in the deck. Outside of a transaction it only checks it is.
+func lockCard(ctx context.Context, q querier, deckID, cardID uuid.UUID) error {
+	var id uuid.UUID
+	err := q.QueryRowContext(ctx,
+		`SELECT id FROM cards WHERE id = $1 AND deck_id = $2 AND deleted_at IS NULL FOR UPDATE`,
+		cardID, deckID,
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrCardNotFound
+	}
+
+	return errors.Trace(err)
+}
+
+func readCard(ctx context.Context, q querier, id uuid.UUID) (Card, error) {
+	var c Card
+	var explanation sql.NullString
+
+	err := q.QueryRowContext(ctx,
+		`SELECT id, title, explanation, kind, version FROM cards WHERE id = $1`,
+		id,
+	).Scan(&c.ID, &c.Title, &explanation, &c.Kind, &c.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Card{}, errors.Trace(ErrCardNotFound)
+		}
+		return Card{}, errors.Trace(err)
+	}
+	c.Explanation = explanation.String
+
+	c.PossibleAnswers, err = cardAnswers(ctx, q, id)
+	if err != nil {
+		return Card{}, errors.Trace(err)
+	}
+
+	return c, nil
+}
+
+// recordCardRevision appends c, the card as it is after a change, to its
+// history and bumps its version. Nothing is recorded if it is the same as the
+// latest revision.
+func recordCardRevision(ctx context.Context, tx *sql.Tx, c *Card, authorID uuid.UUID) error {
+	var latest int
+	var snapshot []byte
+
+	err := tx.QueryRowContext(ctx,
+		`SELECT revision, snapshot FROM card_revisions WHERE card_id = $1 ORDER BY revision DESC LIMIT 1`,
+		c.ID,
+	).Scan(&latest, &snapshot)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Trace(err)
+	}
+
+	var previous Card
+	if latest > 0 {
+		if err := json.Unmarshal(snapshot, &previous); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	changes := DiffCards(previous, *c)
+	if latest > 0 && len(changes) == 0 {
+		return nil
+	}
+
+	err = tx.QueryRowContext(ctx,
+		`UPDATE cards SET version = version + 1 WHERE id = $1 RETURNING version`,
+		c.ID,
+	).Scan(&c.Version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return insertCardRevision(ctx, tx, *c, latest+1, authorID, changes)
+}
+
+// recordCardRemoval appends the removal of the card to its history, with the
+// card as it was when removed, and bumps its version.
+func recordCardRemoval(ctx context.Context, tx *sql.Tx, cardID, authorID uuid.UUID) error {
+	c, err := readCard(ctx, tx, cardID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var latest int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(revision), 0) FROM card_revisions WHERE card_id = $1`,
+		cardID,
+	).Scan(&latest)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = tx.QueryRowContext(ctx,
+		`UPDATE cards SET version = version + 1 WHERE id = $1 RETURNING version`,
+		cardID,
+	).Scan(&c.Version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	removal := []FieldChange{{Field: "deleted", From: "false", To: "true"}}
+
+	return insertCardRevision(ctx, tx, c, latest+1, authorID, removal)
+}
+
+// insertCardRevision stores a revision of c and bumps the revision and the
+// version of its deck, as the deck with its cards is no longer what it was.
+// The deck's author is recorded as the author of the revision when
+// authorID is uuid.Nil.
+func insertCardRevision(ctx context.Context, tx *sql.Tx, c Card, revision int, authorID uuid.UUID, changes []FieldChange) error {
+	snapshot, err := json.Marshal(c)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO card_revisions (card_id, revision, author_id, changed_fields, snapshot, created_at)
+		SELECT c.id, $2, COALESCE($3, d.author_id), $4, $5, NOW()
+		FROM cards c
+		JOIN decks d ON d.id = c.deck_id
+		WHERE c.id = $1`,
+		c.ID, revision, uuid.NullUUID{UUID: authorID, Valid: authorID != uuid.Nil}, pq.Array(ChangedFields(changes)), string(snapshot),
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE decks SET revision = revision + 1, version = version + 1 WHERE id = (SELECT deck_id FROM cards WHERE id = $1)`,
+		c.ID,
+	)
+
+	return errors.Trace(err)
+}
+
+// ForkDeck copies the deck for authorID, linking the copy and every one of
+// its cards and answers to the one it was copied from. The cards are copied
+// whole, answer key included, and nothing is copied unless all of them are
+// valid.
+func (r *pgRepository) ForkDeck(ctx context.Context, upstreamID, authorID uuid.UUID) (Deck, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	upstream, err := readDeck(ctx, tx, upstreamID)
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	upstream.Cards, err = deckCards(ctx, tx, upstreamID)
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	for i, c := range upstream.Cards {
+		upstream.Cards[i].PossibleAnswers, err = cardAnswers(ctx, tx, c.ID)
+		if err != nil {
+			return Deck{}, errors.Trace(err)
+		}
+	}
+
+	fork, links := upstream.Fork(authorID)
+	for _, c := range fork.Cards {
+		if err := validateCard(c); err != nil {
+			return Deck{}, errors.Trace(err)
+		}
+	}
+
+	if err := storeDeck(ctx, tx, fork); err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE decks SET upstream_deck_id = $2, upstream_revision = $3 WHERE id = $1`,
+		fork.ID, upstream.ID, upstream.Revision,
+	)
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	for _, c := range fork.Cards {
+		if err := linkCard(ctx, tx, c, links); err != nil {
+			return Deck{}, errors.Trace(err)
+		}
+	}
+
+	out, err := readDeck(ctx, tx, fork.ID)
+	if err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+	out.Cards = fork.Cards
+
+	if err := tx.Commit(); err != nil {
+		return Deck{}, errors.Trace(err)
+	}
+
+	return out, nil
+}
+
+// linkCard links c and its answers to the upstream ones they were copied
+// from. The card is marked as synced with the latest upstream revision.
+func linkCard(ctx context.Context, tx *sql.Tx, c Card, links map[uuid.UUID]uuid.UUID) error {
+	upstreamID := links[c.ID]
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE cards SET
+			upstream_card_id = $2,
+			upstream_revision = COALESCE((SELECT MAX(revision) FROM card_revisions WHERE card_id = $2), 0)
+		WHERE id = $1`,
+		c.ID, upstreamID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(c.PossibleAnswers))
+	upstreamIDs := make([]uuid.UUID, 0, len(c.PossibleAnswers))
+	for _, a := range c.PossibleAnswers {
+		ids = append(ids, a.ID)
+		upstreamIDs = append(upstreamIDs, links[a.ID])
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE answers a SET upstream_answer_id = l.upstream_id
+		FROM unnest($1::uuid[], $2::uuid[]) AS l(id, upstream_id)
+		WHERE a.id = l.id`,
+		pq.Array(ids), pq.Array(upstreamIDs),
+	)
+
+	return errors.Trace(err)
+}
+
+func (r *pgRepository) GetUpstreamChanges(ctx context.Context, forkID uuid.UUID) ([]UpstreamChange, error) {
+	return upstreamChanges(ctx, r.db, forkID)
+}
+
+// upstreamChanges lists the cards of the upstream deck that changed since
+// the fork last synced them: cards edited or removed upstream, and cards
+// added upstream after the fork. Within a transaction the fork is locked
+// until it ends.
+func upstreamChanges(ctx context.Context, q querier, forkID uuid.UUID) ([]UpstreamChange, error) {
+	var upstreamID uuid.NullUUID
+	err := q.QueryRowContext(ctx,
+		`SELECT upstream_deck_id FROM decks WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		forkID,
+	).Scan(&upstreamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Trace(ErrDeckNotFound)
+		}
+		return nil, errors.Trace(err)
+	}
+
+	if !upstreamID.Valid {
+		return nil, errors.Trace(ErrNotAFork)
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT
+			c.id,
+			u.id,
+			u.deleted_at IS NOT NULL,
+			COALESCE(latest.revision, 0),
+			latest.snapshot,
+			base.snapshot
+		FROM cards c
+		JOIN cards u ON u.id = c.upstream_card_id
+		LEFT JOIN LATERAL (
+			SELECT revision, snapshot
+			FROM card_revisions
+			WHERE card_id = u.id
+			ORDER BY revision DESC
+			LIMIT 1
+		) latest ON true
+		LEFT JOIN card_revisions base ON base.card_id = u.id AND base.revision = c.upstream_revision
+		WHERE
+			c.deck_id = $1
+			AND c.deleted_at IS NULL
+			AND (u.deleted_at IS NOT NULL OR latest.revision > c.upstream_revision)
+		ORDER BY c.created_at, c.id`,
+		forkID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	defer rows.Close()
+
+	var out []UpstreamChange
+
+	for rows.Next() {
+		var change UpstreamChange
+		var removed bool
+		var latest, base []byte
+
+		if err := rows.Scan(&change.CardID, &change.UpstreamCardID, &removed, &change.Revision, &latest, &base); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		var from Card
+		if err := unmarshalSnapshot(base, &from); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		if err := unmarshalSnapshot(latest, &change.Card); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		change.Status = UpstreamCardModified
+		change.Changes = DiffCards(from, change.Card)
+		if removed {
+			change.Status = UpstreamCardRemoved
+			change.Changes = nil
+		}
+
+		out = append(out, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Cards the fork has a copy of aren't new to it, unless the copy was
+	// deleted and the card changed upstream since. Those are added again by
+	// bringing the deleted copy back.
+	rows, err = q.QueryContext(ctx, `
+		SELECT
+			u.id,
+			copy.id,
+			COALESCE(latest.revision, 0),
+			latest.snapshot
+		FROM cards u
+		LEFT JOIN LATERAL (
+			SELECT revision, snapshot
+			FROM card_revisions
+			WHERE card_id = u.id
+			ORDER BY revision DESC
+			LIMIT 1
+		) latest ON true
+		LEFT JOIN LATERAL (
+			SELECT id, upstream_revision, deleted_at
+			FROM cards c
+			WHERE c.deck_id = $2 AND c.upstream_card_id = u.id
+			ORDER BY c.deleted_at IS NULL DESC, c.deleted_at DESC
+			LIMIT 1
+		) copy ON true
+		WHERE
+			u.deck_id = $1
+			AND u.deleted_at IS NULL
+			AND (
+				copy.id IS NULL
+				OR (copy.deleted_at IS NOT NULL AND COALESCE(latest.revision, 0) > copy.upstream_revision)
+			)
+		ORDER BY u.created_at, u.id`,
+		upstreamID.UUID, forkID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	defer rows.Close()
+
+	var added []UpstreamChange
+
+	for rows.Next() {
+		change := UpstreamChange{Status: UpstreamCardAdded}
+		var copyID uuid.NullUUID
+		var latest []byte
+
+		if err := rows.Scan(&change.UpstreamCardID, &copyID, &change.Revision, &latest); err != nil {
+			return nil, errors.Trace(err)
+		}
+		change.CardID = copyID.UUID
+
+		if err := unmarshalSnapshot(latest, &change.Card); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		added = append(added, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	for _, change := range added {
+		// Cards stored before revisions were recorded have no snapshot
+		if change.Revision == 0 {
+			change.Card, err = readCard(ctx, q, change.UpstreamCardID)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+
+		change.Changes = DiffCards(Card{}, change.Card)
+		out = append(out, change)
+	}
+
+	return out, nil
+}
+
+func unmarshalSnapshot(snapshot []byte, c *Card) error {
+	if snapshot == nil {
+		return nil
+	}
+
+	return errors.Trace(json.Unmarshal(snapshot, c))
+}
+
+// PullUpstreamChanges brings the given upstream cards into the fork as they
+// are upstream: cards edited upstream overwrite their copy, cards added
+// upstream are copied and cards removed upstream are removed from the fork.
+// Nothing is pulled unless every card pulled in is valid.
+func (r *pgRepository) PullUpstreamChanges(ctx context.Context, forkID uuid.UUID, upstreamCardIDs []uuid.UUID, authorID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	defer tx.Rollback()
+
+	changes, err := upstreamChanges(ctx, tx, forkID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	byUpstreamID := make(map[uuid.UUID]UpstreamChange, len(changes))
+	for _, change := range changes {
+		byUpstreamID[change.UpstreamCardID] = change
+	}
+
+	for _, id := range upstreamCardIDs {
+		change, ok := byUpstreamID[id]
+		if !ok {
+			return errors.Trace(ErrNoUpstreamChanges)
+		}
+
+		switch change.Status {
+		case UpstreamCardAdded:
+			err = pullAddedCard(ctx, tx, forkID, change, authorID)
+		case UpstreamCardModified:
+			err = pullModifiedCard(ctx, tx, change, authorID)
+		case UpstreamCardRemoved:
+			err = pullRemovedCard(ctx, tx, change, authorID)
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		delete(byUpstreamID, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+func pullAddedCard(ctx context.Context, tx *sql.Tx, forkID uuid.UUID, change UpstreamChange, authorID uuid.UUID) error {
+	if err := validateCard(change.Card); err != nil {
+		return errors.Trace(err)
+	}
+
+	// The fork deleted its copy of the card, which is brought back
+	if change.CardID != uuid.Nil {
+		_, err := tx.ExecContext(ctx, `UPDATE cards SET deleted_at = NULL WHERE id = $1`, change.CardID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		return pullModifiedCard(ctx, tx, change, authorID)
+	}
+
+	c := change.Card
+	c.PossibleAnswers = slices.Clone(c.PossibleAnswers)
+	c.GenerateUUIDs()
+
+	links := map[uuid.UUID]uuid.UUID{c.ID: change.UpstreamCardID}
+	for i, a := range c.PossibleAnswers {
+		links[a.ID] = change.Card.PossibleAnswers[i].ID
+	}
+
+	if err := storeCard(ctx, tx, c, forkID, authorID); err != nil {
+		return errors.Trace(err)
+	}
+
+	return linkCard(ctx, tx, c, links)
+}
+
+// pullModifiedCard overwrites the copy of the card with the upstream one,
+// answers the fork added itself included.
+func pullModifiedCard(ctx context.Context, tx *sql.Tx, change UpstreamChange, authorID uuid.UUID) error {
+	up := change.Card
+	if err := validateCard(up); err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE cards SET
+			title = $2,
+			explanation = $3,
+			kind = $4,
+			upstream_revision = $5,
+			updated_at = NOW()
+		WHERE id = $1`,
+		change.CardID, up.Title, up.Explanation, up.Kind, change.Revision,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Deleted answers are brought back if they are still upstream
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id, upstream_answer_id FROM answers WHERE card_id = $1 AND upstream_answer_id IS NOT NULL`,
+		change.CardID,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	defer rows.Close()
+
+	copies := make(map[uuid.UUID]uuid.UUID)
+	for rows.Next() {
+		var id, upstreamID uuid.UUID
+		if err := rows.Scan(&id, &upstreamID); err != nil {
+			return errors.Trace(err)
+		}
+
+		copies[upstreamID] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.Trace(err)
+	}
+
+	kept := make([]uuid.UUID, 0, len(up.PossibleAnswers))
+	for _, a := range up.PossibleAnswers {
+		id, ok := copies[a.ID]
+		if !ok {
+			id = uuid.New()
+		}
+		kept = append(kept, id)
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO answers (id, card_id, "text", is_correct, blank, tolerance, position, side, upstream_answer_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+			ON CONFLICT (id) DO UPDATE SET
+				"text" = EXCLUDED."text",
+				is_correct = EXCLUDED.is_correct,
+				blank = EXCLUDED.blank,
+				tolerance = EXCLUDED.tolerance,
+				position = EXCLUDED.position,
+				side = EXCLUDED.side,
+				updated_at = NOW(),
+				deleted_at = NULL`,
+			id, change.CardID, a.Text, a.IsCorrect, a.Blank, a.Tolerance, a.Position, a.Side, a.ID,
+		)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE answers SET deleted_at = NOW(), updated_at = NOW()
+		WHERE card_id = $1 AND deleted_at IS NULL AND NOT (id = ANY($2))`,
+		change.CardID, pq.Array(kept),
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	c, err := readCard(ctx, tx, change.CardID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return recordCardRevision(ctx, tx, &c, authorID)
+}
+
+func pullRemovedCard(ctx context.Context, tx *sql.Tx, change UpstreamChange, authorID uuid.UUID) error {
+	if err := recordCardRemoval(ctx, tx, change.CardID, authorID); err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err := tx.ExecContext(ctx,
+		`UPDATE cards SET deleted_at = NOW(), upstream_revision = $2 WHERE id = $1`,
+		change.CardID, change.Revision,
+	)
+
+	return errors.Trace(err)
+}
+
+// deckTagsQuery selects the tags of the deck in the enclosing query, which
+// must read from the decks table.
+const deckTagsQuery = `
+	SELECT array_agg(t."name" ORDER BY t."name")
+	FROM deck_tags dt
+	JOIN tags t ON t.id = dt.tag_id
+	WHERE dt.deck_id = decks.id`
+
+// taggedWith returns a condition matching the decks whose ID is in column
+// and that carry every one of the tags.
+func taggedWith(arger *db.Argumenter, column string, tags []string) string {
+	return fmt.Sprintf(`%s IN (
+		SELECT dt.deck_id
+		FROM deck_tags dt
+		JOIN tags t ON t.id = dt.tag_id
+		WHERE t."name" = ANY(%s)
+		GROUP BY dt.deck_id
+		HAVING COUNT(*) = %s)`,
+		column,
+		arger.Add(pq.Array(tags)),
+		arger.Add(len(tags)),
+	)
+}
+
+// visibleTo returns a condition matching the decks, aliased as table, that
+// are public or that the user wrote or is a member of.
+func visibleTo(arger *db.Argumenter, table string, userID uuid.UUID) string {
+	user := arger.Add(userID)
+
+	return fmt.Sprintf(`(%[1]s.is_public = true OR %[1]s.author_id = %[2]s OR EXISTS (
+		SELECT 1 FROM deck_members m WHERE m.deck_id = %[1]s.id AND m.user_id = %[2]s))`,
+		table,
+		user,
+	)
+}
+
+// storeDeckTags replaces the tags of a deck, creating the ones that don't
+// exist yet.
+func storeDeckTags(ctx context.Context, tx *sql.Tx, deckID uuid.UUID, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM deck_tags WHERE deck_id = $1`, deckID); err != nil {
+		return errors.Trace(err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO tags ("name")
+		SELECT unnest($1::text[])
+		ON CONFLICT ("name") DO NOTHING`,
+		pq.Array(tags),
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO deck_tags (deck_id, tag_id)
+		SELECT $1, id FROM tags WHERE "name" = ANY($2)`,
+		deckID, pq.Array(tags),
+	)
+
+	return errors.Trace(err)
+}
+
+// AutocompleteTags returns the tags starting with prefix, the ones on the
+// most public decks first.
+func (r *pgRepository) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]Tag, error) {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT t."name", t.topic_id, COUNT(d.id)
+		FROM tags t
+		LEFT JOIN deck_tags dt ON dt.tag_id = t.id
+		LEFT JOIN decks d ON d.id = dt.deck_id AND d.is_public = true AND d.deleted_at IS NULL
+		WHERE t."name" LIKE $1 || '%'
+		GROUP BY t.id
+		ORDER BY COUNT(d.id) DESC, t."name"
+		LIMIT $2`,
+		escaped, limit,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	var out []Tag
+	for rows.Next() {
+		var (
+			t       Tag
+			topicID uuid.NullUUID
+		)
+
+		if err := rows.Scan(&t.Name, &topicID, &t.DeckCount); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		t.TopicID = topicID.UUID
+		out = append(out, t)
+	}
+
+	return out, errors.Trace(rows.Err())
+}
+
+// GetTopics returns every topic, each with the tags filed under it. Use
+// TopicTree to nest them.
+func (r *pgRepository) GetTopics(ctx context.Context) ([]Topic, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			tp.id,
+			tp.parent_id,
+			tp."name",
+			(SELECT array_agg(t."name" ORDER BY t."name") FROM tags t WHERE t.topic_id = tp.id)
+		FROM topics tp
+		ORDER BY lower(tp."name")`,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	var out []Topic
+	for rows.Next() {
+		var (
+			t        Topic
+			parentID uuid.NullUUID
+		)
+
+		if err := rows.Scan(&t.ID, &parentID, &t.Name, pq.Array(&t.Tags)); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		t.ParentID = parentID.UUID
+		out = append(out, t)
+	}
+
+	return out, errors.Trace(rows.Err())
+}
+
+func (r *pgRepository) StoreTopic(ctx context.Context, t Topic) error {
+	parentID := uuid.NullUUID{UUID: t.ParentID, Valid: t.ParentID != uuid.Nil}
+
+	_, err := r.db.ExecContext(ctx, `INSERT INTO topics (id, parent_id, "name") VALUES ($1, $2, $3)`, t.ID, parentID, t.Name)
+	if db.IsConstraintError(err, "topics_parent_id_name_key") {
+		return ErrTopicAlreadyExists
+	}
+	if db.IsConstraintError(err, "topics_parent_id_fkey") {
+		return ErrTopicNotFound
+	}
+
+	return errors.Trace(err)
+}
+
+// DeleteTopic deletes a topic along with its subtopics. Their tags are left
+// without a topic.
+func (r *pgRepository) DeleteTopic(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM topics WHERE id = $1`, id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if n == 0 {
+		return ErrTopicNotFound
+	}
+
+	return nil
+}
+
+// SetTagTopic files a tag under a topic, or under none when topicID is
+// uuid.Nil. Tags that no deck carries yet are created, so the taxonomy can
+// be laid out ahead of the decks.
+func (r *pgRepository) SetTagTopic(ctx context.Context, tag string, topicID uuid.UUID) (Tag, error) {
+	out := Tag{Name: tag, TopicID: topicID}
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO tags ("name", topic_id) VALUES ($1, $2)
+		ON CONFLICT ("name") DO UPDATE SET topic_id = EXCLUDED.topic_id
+		RETURNING (
+			SELECT COUNT(*)
+			FROM deck_tags dt
+			JOIN decks d ON d.id = dt.deck_id
+			WHERE dt.tag_id = tags.id AND d.is_public = true AND d.deleted_at IS NULL
+		)`,
+		tag, uuid.NullUUID{UUID: topicID, Valid: topicID != uuid.Nil},
+	).Scan(&out.DeckCount)
+	if db.IsConstraintError(err, "tags_topic_id_fkey") {
+		return Tag{}, ErrTopicNotFound
+	}
+	if err != nil {
+		return Tag{}, errors.Trace(err)
+	}
+
+	return out, nil
+}
+
+func (r *pgRepository) GetDeckMember(ctx context.Context, deckID, userID uuid.UUID) (Member, error) {
+	m := Member{DeckID: deckID, UserID: userID}
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT role, created_at
+		FROM deck_members
+		WHERE deck_id = $1 AND user_id = $2`,
+		deckID, userID,
+	).Scan(&m.Role, &m.CreatedAt)
+	if err == sql.ErrNoRows {
+		return Member{}, ErrMemberNotFound
+	}
+	if err != nil {
+		return Member{}, errors.Trace(err)
+	}
+
+	return m, nil
+}
+
+// GetDeckMembers returns the members of a deck in the order they were
+// invited.
+func (r *pgRepository) GetDeckMembers(ctx context.Context, deckID uuid.UUID) ([]Member, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id, role, created_at
+		FROM deck_members
+		WHERE deck_id = $1
+		ORDER BY created_at, user_id`,
+		deckID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	var out []Member
+	for rows.Next() {
+		m := Member{DeckID: deckID}
+		if err := rows.Scan(&m.UserID, &m.Role, &m.CreatedAt); err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		out = append(out, m)
+	}
+
+	return out, errors.Trace(rows.Err())
+}
+
+func (r *pgRepository) StoreDeckMember(ctx context.Context, m Member) (Member, error) {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO deck_members (deck_id, user_id, role)
+		VALUES ($1, $2, $3)
+		RETURNING created_at`,
+		m.DeckID, m.UserID, m.Role,
+	).Scan(&m.CreatedAt)
+	if db.IsConstraintError(err, "deck_members_pkey") {
+		return Member{}, ErrMemberAlreadyExists
+	}
+	if db.IsConstraintError(err, "deck_members_deck_id_fkey") {
+		return Member{}, ErrDeckNotFound
+	}
+	if err != nil {
+		return Member{}, errors.Trace(err)
+	}
+
+	return m, nil
+}
+
+func (r *pgRepository) UpdateDeckMember(ctx context.Context, deckID, userID uuid.UUID, role string) (Member, error) {
+	m := Member{DeckID: deckID, UserID: userID, Role: role}
+
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE deck_members
+		SET role = $3, updated_at = NOW()
+		WHERE deck_id = $1 AND user_id = $2
+		RETURNING created_at`,
+		deckID, userID, role,
+	).Scan(&m.CreatedAt)
+	if err == sql.ErrNoRows {
+		return Member{}, ErrMemberNotFound
+	}
+	if err != nil {
+		return Member{}, errors.Trace(err)
+	}
+
+	return m, nil
+}
+
+func (r *pgRepository) DeleteDeckMember(ctx context.Context, deckID, userID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM deck_members WHERE deck_id = $1 AND user_id = $2`, deckID, userID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if n == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// GetSharedDecks pages through the decks shared with a user, the ones
+// shared most recently first.
+func (r *pgRepository) GetSharedDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (SharedDecksConnection, error) {
+	var (
+		out   SharedDecksConnection
+		arger db.Argumenter
+	)
+
+	whereConditions := []string{
+		"d.deleted_at IS NULL",
+		fmt.Sprintf("m.user_id = %s", arger.Add(userID)),
+	}
+
+	if !p.Cursor().IsEmpty() {
+		var cursor SharedDeckCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, ErrInvalidCursor
+		}
+
+		whereConditions = append(
+			whereConditions,
+			fmt.Sprintf(
+				"(m.created_at, m.deck_id) %s (%s, %s)",
+				p.Comparator(),
+				arger.Add(cursor.SharedAt),
+				arger.Add(cursor.DeckID),
+			),
+		)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT m.deck_id, m.role, m.created_at
+		FROM deck_members m
+		JOIN decks d ON d.id = m.deck_id
+		WHERE
+			%s
+		ORDER BY m.created_at %s, m.deck_id %s
+		LIMIT %s`,
+		strings.Join(whereConditions, " AND "),
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			c    SharedDeckCursor
+			role string
+		)
+
+		if err := rows.Scan(&c.DeckID, &role, &c.SharedAt); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(c)
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, SharedDeckEdge{
+			DeckID: c.DeckID,
+			Role:   role,
+			Cursor: cursor,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	if !p.IsForward() {
+		slices.Reverse(out.Edges)
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
+// RateDeck stores the rating of a user for a deck, replacing the one they
+// gave before if any, and recomputes the rating average and count of the
+// deck. The deck row is locked so concurrent ratings can't leave the
+// aggregates behind.
+func (r *pgRepository) RateDeck(ctx context.Context, rating Rating) (Rating, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Rating{}, errors.Trace(err)
+	}
+	defer tx.Rollback()
+
+	var id uuid.UUID
+	err = tx.QueryRowContext(ctx, `
+		SELECT id
+		FROM decks
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE`,
+		rating.DeckID,
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		return Rating{}, ErrDeckNotFound
+	}
+	if err != nil {
+		return Rating{}, errors.Trace(err)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO deck_ratings (deck_id, user_id, stars, review)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (deck_id, user_id) DO UPDATE
+		SET stars = EXCLUDED.stars, review = EXCLUDED.review, updated_at = NOW()
+		RETURNING created_at, updated_at`,
+		rating.DeckID, rating.UserID, rating.Stars, rating.Review,
+	).Scan(&rating.CreatedAt, &rating.UpdatedAt)
+	if err != nil {
+		return Rating{}, errors.Trace(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE decks d
+		SET rating_count = r.count, rating_average = r.average
+		FROM (
+			SELECT COUNT(*) AS count, COALESCE(AVG(stars), 0)::DOUBLE PRECISION AS average
+			FROM deck_ratings
+			WHERE deck_id = $1
+		) r
+		WHERE d.id = $1`,
+		rating.DeckID,
+	)
+	if err != nil {
+		return Rating{}, errors.Trace(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Rating{}, errors.Trace(err)
+	}
+
+	return rating, nil
+}
+
+// GetDeckReviews pages through the ratings of a deck that come with a
+// review, the most recently changed first.
+func (r *pgRepository) GetDeckReviews(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (ReviewsConnection, error) {
+	var (
+		out   ReviewsConnection
+		arger db.Argumenter
+	)
+
+	whereConditions := []string{
+		fmt.Sprintf("deck_id = %s", arger.Add(deckID)),
+		"review <> ''",
+	}
+
+	if !p.Cursor().IsEmpty() {
+		var cursor ReviewCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, ErrInvalidCursor
+		}
+
+		whereConditions = append(
+			whereConditions,
+			fmt.Sprintf(
+				"(updated_at, user_id) %s (%s, %s)",
+				p.Comparator(),
+				arger.Add(cursor.UpdatedAt),
+				arger.Add(cursor.UserID),
+			),
+		)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT deck_id, user_id, stars, review, created_at, updated_at
+		FROM deck_ratings
+		WHERE
+			%s
+		ORDER BY updated_at %s, user_id %s
+		LIMIT %s`,
+		strings.Join(whereConditions, " AND "),
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rating Rating
+
+		if err := rows.Scan(&rating.DeckID, &rating.UserID, &rating.Stars, &rating.Review, &rating.CreatedAt, &rating.UpdatedAt); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(ReviewCursor{UpdatedAt: rating.UpdatedAt, UserID: rating.UserID})
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, ReviewEdge{Rating: rating, Cursor: cursor})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	if !p.IsForward() {
+		slices.Reverse(out.Edges)
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
+// AddToLibrary adds a deck to the library of a user. Adding a deck that is
+// already there leaves it as it was. Deleted decks can't be added.
+func (r *pgRepository) AddToLibrary(ctx context.Context, userID, deckID uuid.UUID) (LibraryEntry, error) {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_deck (user_id, deck_id)
+		SELECT $1, id
+		FROM decks
+		WHERE id = $2 AND deleted_at IS NULL
+		ON CONFLICT (deck_id, user_id) DO NOTHING`,
+		userID, deckID,
+	)
+	if err != nil {
+		return LibraryEntry{}, errors.Trace(err)
+	}
+
+	var arger db.Argumenter
+
+	query := withLibraryStats(&arger, userID, libraryEntriesQuery(&arger, userID, fmt.Sprintf("ud.deck_id = %s", arger.Add(deckID))))
+
+	var e LibraryEntry
+	err = r.db.QueryRowContext(ctx, query, arger.Values()...).Scan(&e.DeckID, &e.AddedAt, &e.LastPracticedAt, &e.CardCount, &e.Mastery)
+	if err == sql.ErrNoRows {
+		return LibraryEntry{}, ErrDeckNotFound
+	}
+	if err != nil {
+		return LibraryEntry{}, errors.Trace(err)
+	}
+
+	return e, nil
+}
+
+func (r *pgRepository) RemoveFromLibrary(ctx context.Context, userID, deckID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM user_deck WHERE user_id = $1 AND deck_id = $2`, userID, deckID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if n == 0 {
+		return ErrNotInLibrary
+	}
+
+	return nil
+}
+
+// GetLibrary pages through the library of a user, the most recent first by
+// the given order. Decks deleted or no longer visible to the user are left
+// out. The entries are paged before their cards are counted, so only the
+// decks of the page are.
+func (r *pgRepository) GetLibrary(ctx context.Context, userID uuid.UUID, order LibraryOrder, p pagination.Pagination) (LibraryConnection, error) {
+	var (
+		out   LibraryConnection
+		arger db.Argumenter
+	)
+
+	cursorCondition := "TRUE"
+	if !p.Cursor().IsEmpty() {
+		var cursor LibraryCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil || cursor.Order != order {
+			return out, ErrInvalidCursor
+		}
+
+		cursorCondition = fmt.Sprintf(
+			"(%s, deck_id) %s (%s, %s)",
+			order.column(),
+			p.Comparator(),
+			arger.Add(cursor.At),
+			arger.Add(cursor.DeckID),
+		)
+	}
+
+	page := fmt.Sprintf(`
+		SELECT *
+		FROM (%s) library
+		WHERE %s
+		ORDER BY %s %s, deck_id %s
+		LIMIT %s`,
+		libraryEntriesQuery(&arger, userID, "TRUE"),
+		cursorCondition,
+		order.column(),
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	query := fmt.Sprintf(`
+		%s
+		ORDER BY e.%s %s, e.deck_id %s`,
+		withLibraryStats(&arger, userID, page),
+		order.column(),
+		p.OrderBy(),
+		p.OrderBy(),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e LibraryEntry
+
+		if err := rows.Scan(&e.DeckID, &e.AddedAt, &e.LastPracticedAt, &e.CardCount, &e.Mastery); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		c := LibraryCursor{Order: order, At: e.LastPracticedAt, DeckID: e.DeckID}
+		if order == LibraryOrderAdded {
+			c.At = e.AddedAt
+		}
+
+		cursor, err := pagination.ToCursor(c)
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, LibraryEdge{Entry: e, Cursor: cursor})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	if !p.IsForward() {
+		slices.Reverse(out.Edges)
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
+// libraryEntriesQuery returns the query listing the library entries of a
+// user that match condition, with when they were added and last practised.
+// A deck was last practised when the user last answered one of its cards,
+// or when it was added if they never did.
+func libraryEntriesQuery(arger *db.Argumenter, userID uuid.UUID, condition string) string {
+	return fmt.Sprintf(`
+		SELECT
+			ud.deck_id,
+			ud.created_at AS added_at,
+			GREATEST(ud.last_practiced, p.last_practiced_at) AS last_practiced_at
+		FROM user_deck ud
+		JOIN decks d ON d.id = ud.deck_id
+		LEFT JOIN LATERAL (
+			SELECT MAX(cp.created_at) AS last_practiced_at
+			FROM card_practice cp
+			JOIN answers a ON a.id = cp.answer_id
+			JOIN cards ca ON ca.id = a.card_id
+			WHERE cp.user_id = ud.user_id AND ca.deck_id = ud.deck_id
+		) p ON TRUE
+		WHERE
+			ud.user_id = %s
+			AND d.deleted_at IS NULL
+			AND %s
+			AND %s`,
+		arger.Add(userID),
+		visibleTo(arger, "d", userID),
+		condition,
+	)
+}
+
+// withLibraryStats adds to the library entries listed by the entries query
+// the number of cards of their decks and the mastery of the user over
+// them: the share of the top level their cards reached for the user, cards
+// never answered correctly counting as level 0.
+func withLibraryStats(arger *db.Argumenter, userID uuid.UUID, entries string) string {
+	return fmt.Sprintf(`
+		SELECT e.deck_id, e.added_at, e.last_practiced_at, c.card_count, c.mastery
+		FROM (%s) e
+		CROSS JOIN LATERAL (
+			SELECT
+				COUNT(*) AS card_count,
+				COALESCE(SUM(ucl.lvl)::DOUBLE PRECISION / NULLIF(COUNT(*) * %d, 0), 0) AS mastery
+			FROM cards ca
+			LEFT JOIN user_card_level ucl ON ucl.card_id = ca.id AND ucl.user_id = %s
+			WHERE ca.deck_id = e.deck_id AND ca.deleted_at IS NULL
+		) c`,
+		entries,
+		maxCardLevel,
+		arger.Add(userID),
+	)
+}
+
+// GetAuthoredDecks pages through the decks a user authored, private ones
+// included, the most recently created first.
+func (r *pgRepository) GetAuthoredDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (AuthoredDecksConnection, error) {
+	var (
+		out   AuthoredDecksConnection
+		arger db.Argumenter
+	)
+
+	whereConditions := []string{
+		"deleted_at IS NULL",
+		fmt.Sprintf("author_id = %s", arger.Add(userID)),
+	}
+
+	if !p.Cursor().IsEmpty() {
+		var cursor AuthoredDeckCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, ErrInvalidCursor
+		}
+
+		whereConditions = append(
+			whereConditions,
+			fmt.Sprintf(
+				"(created_at, id) %s (%s, %s)",
+				p.Comparator(),
+				arger.Add(cursor.CreatedAt),
+				arger.Add(cursor.DeckID),
+			),
+		)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, created_at
+		FROM decks
+		WHERE
+			%s
+		ORDER BY created_at %s, id %s
+		LIMIT %s`,
+		strings.Join(whereConditions, " AND "),
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c AuthoredDeckCursor
+
+		if err := rows.Scan(&c.DeckID, &c.CreatedAt); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(c)
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, AuthoredDeckEdge{DeckID: c.DeckID, Cursor: cursor})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	if !p.IsForward() {
+		slices.Reverse(out.Edges)
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
+// GetDeckCardsPage pages through the cards of a deck, in the order they were
+// created.
+func (r *pgRepository) GetDeckCardsPage(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (DeckCardsConnection, error) {
+	var (
+		out   DeckCardsConnection
+		arger db.Argumenter
+	)
+
+	p.Kind = pagination.PaginationKindOldestFirst
+
+	whereConditions := []string{
+		"deleted_at IS NULL",
+		fmt.Sprintf("deck_id = %s", arger.Add(deckID)),
+	}
+
+	if !p.Cursor().IsEmpty() {
+		var cursor DeckCardCursor
+		if err := pagination.FromCursor(p.Cursor(), &cursor); err != nil {
+			return out, ErrInvalidCursor
+		}
+
+		whereConditions = append(
+			whereConditions,
+			fmt.Sprintf(
+				"(created_at, id) %s (%s, %s)",
+				p.Comparator(),
+				arger.Add(cursor.CreatedAt),
+				arger.Add(cursor.CardID),
+			),
+		)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, created_at
+		FROM cards
+		WHERE
+			%s
+		ORDER BY created_at %s, id %s
+		LIMIT %s`,
+		strings.Join(whereConditions, " AND "),
+		p.OrderBy(),
+		p.OrderBy(),
+		arger.Add(p.Limit()+1),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, arger.Values()...)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c DeckCardCursor
+
+		if err := rows.Scan(&c.CardID, &c.CreatedAt); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(c)
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		out.Edges = append(out.Edges, DeckCardEdge{CardID: c.CardID, Cursor: cursor})
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	hasMore := len(out.Edges) > p.Limit()
+
+	pageInfo := pagination.PageInfo{
+		HasPreviousPage: hasMore && !p.IsForward(),
+		HasNextPage:     hasMore && p.IsForward(),
+	}
+
+	if hasMore {
+		out.Edges = out.Edges[:len(out.Edges)-1]
+	}
+
+	if !p.IsForward() {
+		slices.Reverse(out.Edges)
+	}
+
+	if len(out.Edges) > 0 {
+		pageInfo.StartCursor = out.Edges[0].Cursor
+		pageInfo.EndCursor = out.Edges[len(out.Edges)-1].Cursor
+	}
+
+	out.PageInfo = pageInfo
+
+	return out, nil
+}
+
+// GetDecksFirstCards numbers the cards of each deck in the order they were
+// created and keeps the first ones, one more than asked for to tell whether
+// there is a next page.
+func (r *pgRepository) GetDecksFirstCards(ctx context.Context, deckIDs []uuid.UUID, first int) (map[uuid.UUID]DeckCardsConnection, error) {
+	out := make(map[uuid.UUID]DeckCardsConnection, len(deckIDs))
+	if len(deckIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT deck_id, id, created_at
+		FROM (
+			SELECT
+				deck_id,
+				id,
+				created_at,
+				ROW_NUMBER() OVER (PARTITION BY deck_id ORDER BY created_at, id) AS position
+			FROM cards
+			WHERE
+				deleted_at IS NULL
+				AND deck_id = ANY($1)
+		) numbered
+		WHERE position <= $2
+		ORDER BY deck_id, position`,
+		pq.Array(deckIDs),
+		first+1,
+	)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			deckID uuid.UUID
+			c      DeckCardCursor
+		)
+
+		if err := rows.Scan(&deckID, &c.CardID, &c.CreatedAt); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		cursor, err := pagination.ToCursor(c)
+		if err != nil {
+			return out, errors.Trace(err)
+		}
+
+		conn := out[deckID]
+		conn.Edges = append(conn.Edges, DeckCardEdge{CardID: c.CardID, Cursor: cursor})
+		out[deckID] = conn
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	for deckID, conn := range out {
+		if len(conn.Edges) > first {
+			conn.Edges = conn.Edges[:first]
+			conn.PageInfo.HasNextPage = true
+		}
+
+		conn.PageInfo.StartCursor = conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = conn.Edges[len(conn.Edges)-1].Cursor
+		out[deckID] = conn
+	}
+
+	return out, nil
+}
+
+// GetDeckAnalytics computes the statistics of every card of a deck over a
+// range. The answers a learner chose for a card at once are stored
+// together, so they share their creation time, which tells attempts apart.
+// Attempts are graded by the rules of the kind of their card.
+func (r *pgRepository) GetDeckAnalytics(ctx context.Context, deckID uuid.UUID, ar AnalyticsRange) (DeckAnalytics, error) {
+	out := DeckAnalytics{DeckID: deckID, Range: ar}
+	from, to := ar.bounds()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.id, COALESCE(AVG(ucl.lvl), 0)::DOUBLE PRECISION
+		FROM cards c
+		LEFT JOIN user_card_level ucl ON ucl.card_id = c.id
+		WHERE c.deck_id = $1 AND c.deleted_at IS NULL
+		GROUP BY c.id
+		ORDER BY c.created_at, c.id`,
+		deckID,
+	)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	positions := map[uuid.UUID]int{}
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var s CardStats
+
+		if err := rows.Scan(&s.CardID, &s.AverageLevel); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		positions[s.CardID] = len(out.Cards)
+		ids = append(ids, s.CardID)
+		out.Cards = append(out.Cards, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	cards, err := r.GetCards(ctx, ids)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+
+	// Only the attempts within the range are read. Whether one is the
+	// first try of the learner is told by the lack of earlier ones.
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT
+			a.card_id,
+			ARRAY_AGG(a.id),
+			NOT EXISTS (
+				SELECT 1
+				FROM card_practice earlier
+				JOIN answers ea ON ea.id = earlier.answer_id
+				WHERE
+					ea.card_id = a.card_id
+					AND earlier.user_id = cp.user_id
+					AND earlier.created_at < cp.created_at
+			)
+		FROM card_practice cp
+		JOIN answers a ON a.id = cp.answer_id
+		JOIN cards c ON c.id = a.card_id
+		WHERE
+			c.deck_id = $1
+			AND c.deleted_at IS NULL
+			AND cp.created_at >= $2
+			AND cp.created_at < $3
+		GROUP BY a.card_id, cp.user_id, cp.created_at`,
+		deckID, from, to,
+	)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cardID   uuid.UUID
+			chosen   []uuid.UUID
+			firstTry bool
+		)
+
+		if err := rows.Scan(&cardID, pq.Array(&chosen), &firstTry); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		i, ok := positions[cardID]
+		if !ok {
+			continue
+		}
+
+		out.Cards[i].Attempts++
+
+		if !firstTry {
+			continue
+		}
+
+		c := cards[cardID]
+		a, ok := choiceAnswer(c, chosen)
+		if !ok {
+			continue
+		}
+
+		out.Cards[i].FirstTries++
+		if c.Grade(a).IsCorrect {
+			out.Cards[i].FirstTryCorrect++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT a.card_id, a.id, a.is_correct, COUNT(cp.answer_id)
+		FROM answers a
+		JOIN cards c ON c.id = a.card_id
+		LEFT JOIN card_practice cp ON cp.answer_id = a.id AND cp.created_at >= $2 AND cp.created_at < $3
+		WHERE c.deck_id = $1 AND c.deleted_at IS NULL AND a.deleted_at IS NULL
+		GROUP BY a.card_id, a.id, a.is_correct, a.blank, a.position, a.created_at
+		ORDER BY a.card_id, a.blank, a.position, a.created_at, a.id`,
+		deckID, from, to,
+	)
+	if err != nil {
+		return out, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cardID uuid.UUID
+			a      AnswerStats
+		)
+
+		if err := rows.Scan(&cardID, &a.AnswerID, &a.IsCorrect, &a.Chosen); err != nil {
+			return out, errors.Trace(err)
+		}
+
+		if i, ok := positions[cardID]; ok {
+			out.Cards[i].Answers = append(out.Cards[i].Answers, a)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	for i := range out.Cards {
+		out.Cards[i].Flags = out.Cards[i].flag()
+	}
+
+	return out, nil
+}
+
+func (r *redisRepository) getDeckCacheKey(id uuid.UUID) string {
+	return fmt.Sprintf("cache:deck:%s", id.String())
+}
+
+// getDeckMetaCacheKey is where the deck is cached without its cards, as
+// GetDecks returns it.
+func (r *redisRepository) getDeckMetaCacheKey(id uuid.UUID) string {
+	return fmt.Sprintf("cache:deck:%s:meta", id.String())
+}
+
+func (r *redisRepository) getDeckCardsCacheKey(id uuid.UUID) string {
+	return fmt.Sprintf("cache:deck:%s:cards", id.String())
+}
+
+func (r *redisRepository) getCardCacheKey(id uuid.UUID) string {
+	return fmt.Sprintf("cache:card:%s", id.String())
+}
+
+func (r *redisRepository) getCardAnswersCacheKey(id uuid.UUID) string {
+	return fmt.Sprintf("cache:card:%s:answers", id.String())
 }
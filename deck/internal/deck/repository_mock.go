@@ -49,18 +49,30 @@ func (m *RepositoryMock) GetCardAnswers(ctx context.Context, id uuid.UUID) ([]An
 	return args[0].([]Answer), args.Error(1)
 }
 
-func (m *RepositoryMock) GetPopularDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (PopularDecksConnection, error) {
-	args := m.Called(ctx, userID, p)
+func (m *RepositoryMock) GetPopularDecks(ctx context.Context, userID uuid.UUID, period Period, tags []string, p pagination.Pagination) (PopularDecksConnection, error) {
+	args := m.Called(ctx, userID, period, tags, p)
 
 	return args.Get(0).(PopularDecksConnection), args.Error(1)
 }
 
+func (m *RepositoryMock) SearchDecks(ctx context.Context, userID uuid.UUID, query, language string, tags []string, p pagination.Pagination) (SearchDecksConnection, error) {
+	args := m.Called(ctx, userID, query, language, tags, p)
+
+	return args.Get(0).(SearchDecksConnection), args.Error(1)
+}
+
 func (m *RepositoryMock) GetCards(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Card, error) {
 	args := m.Called(ctx, ids)
 
 	return args[0].(map[uuid.UUID]Card), args.Error(1)
 }
 
+func (m *RepositoryMock) GetCardDecks(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	args := m.Called(ctx, ids)
+
+	return args.Get(0).(map[uuid.UUID]uuid.UUID), args.Error(1)
+}
+
 func (m *RepositoryMock) UpdateDeck(ctx context.Context, id uuid.UUID, updates DeckUpdates) (Deck, error) {
 	args := m.Called(ctx, id, updates)
 
@@ -78,3 +90,173 @@ func (m *RepositoryMock) UpdateAnswer(ctx context.Context, deckID, cardID, answe
 
 	return args.Get(0).(Answer), args.Error(1)
 }
+
+func (m *RepositoryMock) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]Tag, error) {
+	args := m.Called(ctx, prefix, limit)
+
+	return args.Get(0).([]Tag), args.Error(1)
+}
+
+func (m *RepositoryMock) GetTopics(ctx context.Context) ([]Topic, error) {
+	args := m.Called(ctx)
+
+	return args.Get(0).([]Topic), args.Error(1)
+}
+
+func (m *RepositoryMock) StoreTopic(ctx context.Context, t Topic) error {
+	return m.Called(ctx, t).Error(0)
+}
+
+func (m *RepositoryMock) DeleteTopic(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *RepositoryMock) SetTagTopic(ctx context.Context, tag string, topicID uuid.UUID) (Tag, error) {
+	args := m.Called(ctx, tag, topicID)
+
+	return args.Get(0).(Tag), args.Error(1)
+}
+
+func (m *RepositoryMock) GetDeckMember(ctx context.Context, deckID, userID uuid.UUID) (Member, error) {
+	args := m.Called(ctx, deckID, userID)
+
+	return args.Get(0).(Member), args.Error(1)
+}
+
+func (m *RepositoryMock) GetDeckMembers(ctx context.Context, deckID uuid.UUID) ([]Member, error) {
+	args := m.Called(ctx, deckID)
+
+	return args.Get(0).([]Member), args.Error(1)
+}
+
+func (m *RepositoryMock) StoreDeckMember(ctx context.Context, member Member) (Member, error) {
+	args := m.Called(ctx, member)
+
+	return args.Get(0).(Member), args.Error(1)
+}
+
+func (m *RepositoryMock) UpdateDeckMember(ctx context.Context, deckID, userID uuid.UUID, role string) (Member, error) {
+	args := m.Called(ctx, deckID, userID, role)
+
+	return args.Get(0).(Member), args.Error(1)
+}
+
+func (m *RepositoryMock) DeleteDeckMember(ctx context.Context, deckID, userID uuid.UUID) error {
+	return m.Called(ctx, deckID, userID).Error(0)
+}
+
+func (m *RepositoryMock) GetSharedDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (SharedDecksConnection, error) {
+	args := m.Called(ctx, userID, p)
+
+	return args.Get(0).(SharedDecksConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) RefreshPopularity(ctx context.Context) error {
+	args := m.Called(ctx)
+
+	return args.Error(0)
+}
+
+func (m *RepositoryMock) RateDeck(ctx context.Context, r Rating) (Rating, error) {
+	args := m.Called(ctx, r)
+
+	return args.Get(0).(Rating), args.Error(1)
+}
+
+func (m *RepositoryMock) AddToLibrary(ctx context.Context, userID, deckID uuid.UUID) (LibraryEntry, error) {
+	args := m.Called(ctx, userID, deckID)
+
+	return args.Get(0).(LibraryEntry), args.Error(1)
+}
+
+func (m *RepositoryMock) RemoveFromLibrary(ctx context.Context, userID, deckID uuid.UUID) error {
+	args := m.Called(ctx, userID, deckID)
+
+	return args.Error(0)
+}
+
+func (m *RepositoryMock) GetLibrary(ctx context.Context, userID uuid.UUID, order LibraryOrder, p pagination.Pagination) (LibraryConnection, error) {
+	args := m.Called(ctx, userID, order, p)
+
+	return args.Get(0).(LibraryConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) GetDeckCardsPage(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (DeckCardsConnection, error) {
+	args := m.Called(ctx, deckID, p)
+
+	return args.Get(0).(DeckCardsConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) GetDecksFirstCards(ctx context.Context, deckIDs []uuid.UUID, first int) (map[uuid.UUID]DeckCardsConnection, error) {
+	args := m.Called(ctx, deckIDs, first)
+
+	return args.Get(0).(map[uuid.UUID]DeckCardsConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) GetAuthoredDecks(ctx context.Context, userID uuid.UUID, p pagination.Pagination) (AuthoredDecksConnection, error) {
+	args := m.Called(ctx, userID, p)
+
+	return args.Get(0).(AuthoredDecksConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) GetDeckAnalytics(ctx context.Context, deckID uuid.UUID, r AnalyticsRange) (DeckAnalytics, error) {
+	args := m.Called(ctx, deckID, r)
+
+	return args.Get(0).(DeckAnalytics), args.Error(1)
+}
+
+func (m *RepositoryMock) GetDeckReviews(ctx context.Context, deckID uuid.UUID, p pagination.Pagination) (ReviewsConnection, error) {
+	args := m.Called(ctx, deckID, p)
+
+	return args.Get(0).(ReviewsConnection), args.Error(1)
+}
+
+func (m *RepositoryMock) GetCardRevisions(ctx context.Context, deckID, cardID uuid.UUID) ([]CardRevision, error) {
+	args := m.Called(ctx, deckID, cardID)
+
+	return args[0].([]CardRevision), args.Error(1)
+}
+
+func (m *RepositoryMock) GetCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int) (CardRevision, error) {
+	args := m.Called(ctx, deckID, cardID, revision)
+
+	return args[0].(CardRevision), args.Error(1)
+}
+
+func (m *RepositoryMock) RestoreCardRevision(ctx context.Context, deckID, cardID uuid.UUID, revision int, authorID uuid.UUID) (Card, error) {
+	args := m.Called(ctx, deckID, cardID, revision, authorID)
+
+	return args[0].(Card), args.Error(1)
+}
+
+func (m *RepositoryMock) ForkDeck(ctx context.Context, upstreamID, authorID uuid.UUID) (Deck, error) {
+	args := m.Called(ctx, upstreamID, authorID)
+
+	return args[0].(Deck), args.Error(1)
+}
+
+func (m *RepositoryMock) GetUpstreamChanges(ctx context.Context, forkID uuid.UUID) ([]UpstreamChange, error) {
+	args := m.Called(ctx, forkID)
+
+	return args[0].([]UpstreamChange), args.Error(1)
+}
+
+func (m *RepositoryMock) PullUpstreamChanges(ctx context.Context, forkID uuid.UUID, upstreamCardIDs []uuid.UUID, authorID uuid.UUID) error {
+	return m.Called(ctx, forkID, upstreamCardIDs, authorID).Error(0)
+}
+
+func (m *RepositoryMock) DeleteCard(ctx context.Context, deckID, cardID, authorID uuid.UUID) error {
+	return m.Called(ctx, deckID, cardID, authorID).Error(0)
+}
+
+func (m *RepositoryMock) CreateAnswer(ctx context.Context, deckID, cardID uuid.UUID, a Answer, authorID uuid.UUID) (Card, error) {
+	args := m.Called(ctx, deckID, cardID, a, authorID)
+
+	return args[0].(Card), args.Error(1)
+}
+
+func (m *RepositoryMock) DeleteAnswer(ctx context.Context, deckID, cardID, answerID, authorID uuid.UUID) (Card, error) {
+	args := m.Called(ctx, deckID, cardID, answerID, authorID)
+
+	return args[0].(Card), args.Error(1)
+}
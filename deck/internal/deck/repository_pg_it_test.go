@@ -11,7 +11,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
 	"github.com/juju/errors"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/XaviFP/toshokan/common/config"
@@ -136,6 +136,9 @@ func TestRepository_GetDeck(t *testing.T) {
 			Title:       "Programming languages",
 			Description: "Compiled or interpreted?",
 			Public:      true,
+			Language:    DefaultSearchLanguage,
+			Revision:    1,
+			Version:     1,
 		}, d)
 	})
 
@@ -161,6 +164,9 @@ func TestRepository_GetDecks(t *testing.T) {
 				Title:       "Programming languages",
 				Description: "Compiled or interpreted?",
 				Public:      true,
+				Language:    DefaultSearchLanguage,
+				Revision:    1,
+				Version:     1,
 			},
 			uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c"): {
 				ID:          uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c"),
@@ -168,6 +174,9 @@ func TestRepository_GetDecks(t *testing.T) {
 				Title:       "Greek Mythology",
 				Description: "Bits of Greek Mythology",
 				Public:      true,
+				Language:    DefaultSearchLanguage,
+				Revision:    1,
+				Version:     1,
 			},
 		}
 
@@ -221,16 +230,19 @@ func TestRepository_GetDeckCards(t *testing.T) {
 				Title:       "Golang",
 				Explanation: "Go code is compiled directly to machine code, not interpreted at runtime.",
 				Kind:        "single_choice",
+				Version:     1,
 			},
 			{
-				ID:    uuid.MustParse("c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"),
-				Title: "Rust",
-				Kind:  "single_choice",
+				ID:      uuid.MustParse("c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"),
+				Title:   "Rust",
+				Kind:    "single_choice",
+				Version: 1,
 			},
 			{
-				ID:    uuid.MustParse("d42a90dd-818c-4eed-8e9f-9e8af1a654f4"),
-				Title: "Lua",
-				Kind:  "single_choice",
+				ID:      uuid.MustParse("d42a90dd-818c-4eed-8e9f-9e8af1a654f4"),
+				Title:   "Lua",
+				Kind:    "single_choice",
+				Version: 1,
 			},
 		}
 
@@ -239,6 +251,48 @@ func TestRepository_GetDeckCards(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("page", func(t *testing.T) {
+		conn, err := repo.GetDeckCardsPage(context.Background(), id, pagination.Pagination{First: 2})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 2)
+		assert.Equal(t, uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33"), conn.Edges[0].CardID)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.GetDeckCardsPage(context.Background(), id, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, uuid.MustParse("d42a90dd-818c-4eed-8e9f-9e8af1a654f4"), conn.Edges[0].CardID)
+		assert.False(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.GetDeckCardsPage(context.Background(), id, pagination.Pagination{Last: 1, Before: conn.PageInfo.StartCursor})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, uuid.MustParse("c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"), conn.Edges[0].CardID)
+		assert.True(t, conn.PageInfo.HasPreviousPage)
+
+		_, err = repo.GetDeckCardsPage(context.Background(), id, pagination.Pagination{First: 2, After: "nope"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("first_cards", func(t *testing.T) {
+		missing := uuid.MustParse("2e4e5d1c-1c3f-4b8e-9c0a-0d6f1f3c9a11")
+
+		conns, err := repo.GetDecksFirstCards(context.Background(), []uuid.UUID{id, missing}, 2)
+		assert.NoError(t, err)
+		assert.Len(t, conns, 1)
+
+		conn := conns[id]
+		assert.Len(t, conn.Edges, 2)
+		assert.Equal(t, uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33"), conn.Edges[0].CardID)
+		assert.Equal(t, uuid.MustParse("c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"), conn.Edges[1].CardID)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		page, err := repo.GetDeckCardsPage(context.Background(), id, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Len(t, page.Edges, 1)
+		assert.Equal(t, uuid.MustParse("d42a90dd-818c-4eed-8e9f-9e8af1a654f4"), page.Edges[0].CardID)
+	})
+
 	t.Run("empty", func(t *testing.T) {
 		_, err := h.db.Exec(`UPDATE cards SET deleted_at = NOW() WHERE deck_id = $1`, id)
 		assert.NoError(t, err)
@@ -312,7 +366,7 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 	userID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
 
 	t.Run("forward_pagination", func(t *testing.T) {
-		conn, err := repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{First: 2})
+		conn, err := repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{First: 2})
 		assert.NoError(t, err)
 
 		assert.Equal(t, PopularDecksConnection{
@@ -333,7 +387,7 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 			},
 		}, conn)
 
-		conn, err = repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
+		conn, err = repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
 		assert.NoError(t, err)
 
 		assert.Equal(t, PopularDecksConnection{
@@ -354,7 +408,7 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 			},
 		}, conn)
 
-		conn, err = repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
+		conn, err = repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
 		assert.NoError(t, err)
 
 		assert.Equal(t, PopularDecksConnection{
@@ -371,7 +425,7 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 	})
 
 	t.Run("backward_pagination", func(t *testing.T) {
-		conn, err := repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{Last: 2})
+		conn, err := repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{Last: 2})
 		assert.NoError(t, err)
 
 		assert.Equal(t, PopularDecksConnection{
@@ -392,7 +446,7 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 			},
 		}, conn)
 
-		conn, err = repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{Last: 2, Before: conn.PageInfo.StartCursor})
+		conn, err = repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{Last: 2, Before: conn.PageInfo.StartCursor})
 		assert.NoError(t, err)
 
 		assert.Equal(t, PopularDecksConnection{
@@ -413,7 +467,7 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 			},
 		}, conn)
 
-		conn, err = repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{Last: 2, Before: conn.PageInfo.StartCursor})
+		conn, err = repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{Last: 2, Before: conn.PageInfo.StartCursor})
 		assert.NoError(t, err)
 
 		assert.Equal(t, PopularDecksConnection{
@@ -430,11 +484,330 @@ func TestRepository_GetPopularDecks(t *testing.T) {
 	})
 
 	t.Run("ErrInvalidCursor", func(t *testing.T) {
-		_, err := repo.GetPopularDecks(context.Background(), userID, pagination.Pagination{After: pagination.Cursor("This Cursor is not valid")})
+		_, err := repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, nil, pagination.Pagination{After: pagination.Cursor("This Cursor is not valid")})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestRepository_RefreshPopularity(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	userID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	languagesID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	mythologyID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+	monthAgo := time.Now().AddDate(0, -1, 0)
+
+	// Two learners practised the programming languages deck this week, and
+	// the first one answered every card of it correctly
+	learner := uuid.New()
+	for _, answerID := range []string{"7e6926da-82b2-4ae8-99b4-1b803ebf1877", "06be1892-4765-4f60-9d47-1489419dc316", "3b1bbdb3-b84a-4f59-8f02-2a21586cf6ca"} {
+		_, err := h.db.Exec(`INSERT INTO card_practice (user_id, answer_id) VALUES ($1, $2)`, learner, answerID)
+		assert.NoError(t, err)
+	}
+	for _, cardID := range []string{"72bdff92-5bc8-4e1d-9217-d0b23e22ff33", "c924f7e0-efd8-4c2d-9c43-8eafb7102ebc", "d42a90dd-818c-4eed-8e9f-9e8af1a654f4"} {
+		_, err := h.db.Exec(`INSERT INTO user_card_level (user_id, card_id) VALUES ($1, $2)`, learner, cardID)
+		assert.NoError(t, err)
+	}
+	_, err := h.db.Exec(`INSERT INTO card_practice (user_id, answer_id) VALUES ($1, $2)`, uuid.New(), "dfcb1c81-f590-486e-9b7e-a44f0c436933")
+	assert.NoError(t, err)
+
+	// Many more learners added the mythology deck a month ago
+	for i := 0; i < 7; i++ {
+		_, err := h.db.Exec(`INSERT INTO user_deck (user_id, deck_id, created_at, last_practiced) VALUES ($1, $2, $3, $3)`, uuid.New(), mythologyID, monthAgo)
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, repo.RefreshPopularity(ctx))
+
+	var learners, answers int
+	var completionRate float64
+	err = h.db.QueryRow(`SELECT learners, answers, completion_rate FROM deck_popularity WHERE deck_id = $1 AND period = 'week'`, languagesID).Scan(&learners, &answers, &completionRate)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, learners)
+	assert.Equal(t, 4, answers)
+	assert.Equal(t, 0.5, completionRate)
+
+	deckIDs := func(conn PopularDecksConnection) []uuid.UUID {
+		var out []uuid.UUID
+		for _, e := range conn.Edges {
+			out = append(out, e.DeckID)
+		}
+
+		return out
+	}
+
+	t.Run("trending", func(t *testing.T) {
+		conn, err := repo.GetPopularDecks(ctx, userID, PeriodWeek, nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{
+			languagesID,
+			uuid.MustParse("f79aea77-9aa0-4a84-b4c8-d000a27d2c52"),
+			uuid.MustParse("6363e2c6-d89e-4610-92e8-1e1d2fea49ec"),
+			uuid.MustParse("60766223-ff9f-4871-a497-f765c05a0c5e"),
+			mythologyID,
+		}, deckIDs(conn))
+	})
+
+	t.Run("all_time", func(t *testing.T) {
+		all, err := repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{mythologyID, languagesID}, deckIDs(all)[:2])
+
+		conn, err := repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[:2], deckIDs(conn))
+
+		conn, err = repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[2:4], deckIDs(conn))
+
+		conn, err = repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{Last: 2, Before: conn.PageInfo.StartCursor})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[:2], deckIDs(conn))
+	})
+
+	t.Run("refresh_replaces_scores", func(t *testing.T) {
+		_, err := h.db.Exec(`DELETE FROM user_deck WHERE deck_id = $1`, mythologyID)
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.RefreshPopularity(ctx))
+
+		conn, err := repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{languagesID}, deckIDs(conn))
+	})
+
+	t.Run("cursor_keeps_generation", func(t *testing.T) {
+		all, err := repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+
+		first, err := repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 2})
+		assert.NoError(t, err)
+
+		// The mythology deck becomes the most popular one between pages
+		for i := 0; i < 7; i++ {
+			_, err := h.db.Exec(`INSERT INTO user_deck (user_id, deck_id, created_at, last_practiced) VALUES ($1, $2, $3, $3)`, uuid.New(), mythologyID, monthAgo)
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, repo.RefreshPopularity(ctx))
+
+		conn, err := repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 10, After: first.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[2:], deckIDs(conn))
+
+		// Old generations are pruned on the next refresh
+		_, err = h.db.Exec(`UPDATE deck_popularity_refreshes SET computed_at = NOW() - INTERVAL '1 day'`)
+		assert.NoError(t, err)
+		assert.NoError(t, repo.RefreshPopularity(ctx))
+
+		_, err = repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 10, After: first.PageInfo.EndCursor})
+		assert.ErrorIs(t, err, ErrExpiredCursor)
+
+		conn, err = repo.GetPopularDecks(ctx, userID, PeriodAllTime, nil, pagination.Pagination{First: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{mythologyID}, deckIDs(conn))
+	})
+}
+
+func TestRepository_SearchDecks(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+
+	userID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	privateID := uuid.MustParse("ebcfffa0-a96f-450b-a0f3-a2e47263855d")
+
+	err := repo.StoreDeck(context.Background(), Deck{
+		ID:          privateID,
+		AuthorID:    authorID,
+		Title:       "Norse Mythology",
+		Description: "Odin, Thor and friends",
+	})
+	assert.NoError(t, err)
+
+	deckIDs := func(conn SearchDecksConnection) []uuid.UUID {
+		var out []uuid.UUID
+		for _, e := range conn.Edges {
+			out = append(out, e.DeckID)
+		}
+
+		return out
+	}
+
+	t.Run("matches_cards", func(t *testing.T) {
+		conn, err := repo.SearchDecks(context.Background(), userID, "machine code", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")}, deckIDs(conn))
+	})
+
+	t.Run("private_decks", func(t *testing.T) {
+		conn, err := repo.SearchDecks(context.Background(), userID, "mythology", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")}, deckIDs(conn))
+
+		conn, err = repo.SearchDecks(context.Background(), authorID, "mythology", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c"), privateID}, deckIDs(conn))
+	})
+
+	t.Run("ranking", func(t *testing.T) {
+		// Matching both the title and the description ranks higher than
+		// matching the title only
+		conn, err := repo.SearchDecks(context.Background(), userID, "philosophy or music", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{
+			uuid.MustParse("6363e2c6-d89e-4610-92e8-1e1d2fea49ec"),
+			uuid.MustParse("f79aea77-9aa0-4a84-b4c8-d000a27d2c52"),
+		}, deckIDs(conn))
+		assert.Greater(t, conn.Edges[0].Rank, conn.Edges[1].Rank)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		query := "biology or philosophy or mythology"
+
+		all, err := repo.SearchDecks(context.Background(), userID, query, "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Len(t, all.Edges, 3)
+
+		conn, err := repo.SearchDecks(context.Background(), userID, query, "", nil, pagination.Pagination{First: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[:2], deckIDs(conn))
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.SearchDecks(context.Background(), userID, query, "", nil, pagination.Pagination{First: 2, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[2:], deckIDs(conn))
+		assert.False(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.SearchDecks(context.Background(), userID, query, "", nil, pagination.Pagination{Last: 2, Before: conn.PageInfo.StartCursor})
+		assert.NoError(t, err)
+		assert.Equal(t, deckIDs(all)[:2], deckIDs(conn))
+		assert.False(t, conn.PageInfo.HasPreviousPage)
+	})
+
+	t.Run("stemming", func(t *testing.T) {
+		conn, err := repo.SearchDecks(context.Background(), userID, "mythologies", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, conn.Edges)
+
+		english := "english"
+		_, err = repo.UpdateDeck(context.Background(), uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c"), DeckUpdates{Language: &english})
+		assert.NoError(t, err)
+
+		conn, err = repo.SearchDecks(context.Background(), userID, "mythologies", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")}, deckIDs(conn))
+
+		conn, err = repo.SearchDecks(context.Background(), userID, "mythologies", "spanish", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, conn.Edges)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		_, err := repo.SearchDecks(context.Background(), userID, "go", "", nil, pagination.Pagination{After: pagination.Cursor("This Cursor is not valid")})
 		assert.ErrorIs(t, err, ErrInvalidCursor)
 	})
 }
 
+func TestRepository_Tags(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+
+	userID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	deckID := uuid.MustParse("ebcfffa0-a96f-450b-a0f3-a2e47263855d")
+
+	err := repo.StoreDeck(context.Background(), Deck{
+		ID:          deckID,
+		AuthorID:    userID,
+		Title:       "Go concurrency",
+		Description: "Goroutines and channels",
+		Public:      true,
+		Tags:        []string{"go", "concurrency"},
+	})
+	assert.NoError(t, err)
+
+	t.Run("get_deck", func(t *testing.T) {
+		d, err := repo.GetDeck(context.Background(), deckID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"concurrency", "go"}, d.Tags)
+	})
+
+	t.Run("update_deck", func(t *testing.T) {
+		tags := []string{"go", "golang"}
+
+		d, err := repo.UpdateDeck(context.Background(), uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"), DeckUpdates{Tags: &tags})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"go", "golang"}, d.Tags)
+
+		title := "Programming languages"
+		d, err = repo.UpdateDeck(context.Background(), uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"), DeckUpdates{Title: &title})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"go", "golang"}, d.Tags)
+	})
+
+	t.Run("filter_popular_decks", func(t *testing.T) {
+		conn, err := repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, []string{"go"}, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 2)
+
+		conn, err = repo.GetPopularDecks(context.Background(), userID, PeriodAllTime, []string{"go", "concurrency"}, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, deckID, conn.Edges[0].DeckID)
+	})
+
+	t.Run("filter_search", func(t *testing.T) {
+		conn, err := repo.SearchDecks(context.Background(), userID, "compiled or goroutines", "", []string{"concurrency"}, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, deckID, conn.Edges[0].DeckID)
+	})
+
+	t.Run("autocomplete", func(t *testing.T) {
+		tags, err := repo.AutocompleteTags(context.Background(), "go", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []Tag{{Name: "go", DeckCount: 2}, {Name: "golang", DeckCount: 1}}, tags)
+
+		tags, err = repo.AutocompleteTags(context.Background(), "%", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+	})
+
+	t.Run("topics", func(t *testing.T) {
+		programming := Topic{ID: uuid.New(), Name: "Programming"}
+		languages := Topic{ID: uuid.New(), ParentID: programming.ID, Name: "Languages"}
+
+		assert.NoError(t, repo.StoreTopic(context.Background(), programming))
+		assert.NoError(t, repo.StoreTopic(context.Background(), languages))
+		assert.ErrorIs(t, repo.StoreTopic(context.Background(), Topic{ID: uuid.New(), Name: "programming"}), ErrTopicAlreadyExists)
+		assert.ErrorIs(t, repo.StoreTopic(context.Background(), Topic{ID: uuid.New(), ParentID: uuid.New(), Name: "Orphan"}), ErrTopicNotFound)
+
+		tag, err := repo.SetTagTopic(context.Background(), "go", languages.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, Tag{Name: "go", TopicID: languages.ID, DeckCount: 2}, tag)
+
+		tag, err = repo.SetTagTopic(context.Background(), "rust", languages.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, tag.DeckCount)
+
+		topics, err := repo.GetTopics(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []Topic{
+			{ID: languages.ID, ParentID: programming.ID, Name: "Languages", Tags: []string{"go", "rust"}},
+			{ID: programming.ID, Name: "Programming"},
+		}, topics)
+
+		assert.NoError(t, repo.DeleteTopic(context.Background(), programming.ID))
+		assert.ErrorIs(t, repo.DeleteTopic(context.Background(), programming.ID), ErrTopicNotFound)
+
+		topics, err = repo.GetTopics(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, topics)
+	})
+}
+
 func TestPGRepository_GetCards(t *testing.T) {
 	h := newTestHarness(t)
 	repo := NewPGRepository(h.db)
@@ -459,7 +832,8 @@ func TestPGRepository_GetCards(t *testing.T) {
 						IsCorrect: false,
 					},
 				},
-				Kind: "single_choice",
+				Kind:    "single_choice",
+				Version: 1,
 			},
 		}
 
@@ -482,6 +856,18 @@ func TestPGRepository_GetCards(t *testing.T) {
 	})
 }
 
+func TestPGRepository_GetCardDecks(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+
+	cardID := uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
+	missingID := uuid.MustParse("dfcb1c81-f590-486e-9b7e-a44f0c436933")
+
+	decks, err := repo.GetCardDecks(context.Background(), []uuid.UUID{cardID, missingID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]uuid.UUID{cardID: uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")}, decks)
+}
+
 func TestRepository_UpdateDeck(t *testing.T) {
 	h := newTestHarness(t)
 	repo := NewPGRepository(h.db)
@@ -524,6 +910,40 @@ func TestRepository_UpdateDeck(t *testing.T) {
 		assert.Equal(t, isPublic, d.Public)
 	})
 
+	t.Run("success_update_language", func(t *testing.T) {
+		language := "english"
+
+		d, err := repo.UpdateDeck(context.Background(), deckID, DeckUpdates{Language: &language})
+		assert.NoError(t, err)
+		assert.Equal(t, language, d.Language)
+	})
+
+	t.Run("success_expected_version", func(t *testing.T) {
+		current, err := repo.GetDeck(context.Background(), deckID)
+		assert.NoError(t, err)
+
+		newTitle := "Versioned Title"
+		d, err := repo.UpdateDeck(context.Background(), deckID, DeckUpdates{Title: &newTitle, ExpectedVersion: &current.Version})
+		assert.NoError(t, err)
+		assert.Equal(t, current.Version+1, d.Version)
+	})
+
+	t.Run("failure_version_conflict", func(t *testing.T) {
+		current, err := repo.GetDeck(context.Background(), deckID)
+		assert.NoError(t, err)
+
+		stale := current.Version - 1
+		newTitle := "Won't work"
+
+		_, err = repo.UpdateDeck(context.Background(), deckID, DeckUpdates{Title: &newTitle, ExpectedVersion: &stale})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+
+		fetched, err := repo.GetDeck(context.Background(), deckID)
+		assert.NoError(t, err)
+		assert.Equal(t, current.Version, fetched.Version)
+		assert.Equal(t, current.Title, fetched.Title)
+	})
+
 	t.Run("failure_deck_not_found", func(t *testing.T) {
 		nonExistentID := uuid.MustParse("00000000-0000-0000-0000-000000000000")
 		newTitle := "Won't work"
@@ -573,6 +993,21 @@ func TestRepository_UpdateCard(t *testing.T) {
 		assert.Equal(t, newKind, c.Kind)
 	})
 
+	t.Run("failure_kind_does_not_fit_answers", func(t *testing.T) {
+		before, err := repo.GetCards(context.Background(), []uuid.UUID{cardID})
+		assert.NoError(t, err)
+
+		// The card's answers sit on no side, so they can't be matched
+		newKind := "matching"
+		_, err = repo.UpdateCard(context.Background(), deckID, cardID, CardUpdates{Kind: &newKind})
+		assert.ErrorIs(t, err, ErrInvalidPairs)
+
+		after, err := repo.GetCards(context.Background(), []uuid.UUID{cardID})
+		assert.NoError(t, err)
+		assert.Equal(t, before[cardID].Kind, after[cardID].Kind)
+		assert.Equal(t, before[cardID].Version, after[cardID].Version)
+	})
+
 	t.Run("success_update_multiple_fields", func(t *testing.T) {
 		newTitle := "Final Card Title"
 		newExplanation := "Final explanation"
@@ -586,6 +1021,36 @@ func TestRepository_UpdateCard(t *testing.T) {
 		assert.Equal(t, newKind, c.Kind)
 	})
 
+	t.Run("success_expected_version", func(t *testing.T) {
+		cards, err := repo.GetCards(context.Background(), []uuid.UUID{cardID})
+		assert.NoError(t, err)
+		current := cards[cardID]
+
+		d, err := repo.GetDeck(context.Background(), deckID)
+		assert.NoError(t, err)
+
+		newTitle := "Versioned Card Title"
+		c, err := repo.UpdateCard(context.Background(), deckID, cardID, CardUpdates{Title: &newTitle, ExpectedVersion: &current.Version})
+		assert.NoError(t, err)
+		assert.Equal(t, current.Version+1, c.Version)
+
+		// The deck changes along with its cards
+		updated, err := repo.GetDeck(context.Background(), deckID)
+		assert.NoError(t, err)
+		assert.Equal(t, d.Version+1, updated.Version)
+	})
+
+	t.Run("failure_version_conflict", func(t *testing.T) {
+		cards, err := repo.GetCards(context.Background(), []uuid.UUID{cardID})
+		assert.NoError(t, err)
+
+		stale := cards[cardID].Version - 1
+		newTitle := "Won't work"
+
+		_, err = repo.UpdateCard(context.Background(), deckID, cardID, CardUpdates{Title: &newTitle, ExpectedVersion: &stale})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+	})
+
 	t.Run("failure_card_not_found", func(t *testing.T) {
 		nonExistentID := uuid.MustParse("00000000-0000-0000-0000-000000000000")
 		newTitle := "Won't work"
@@ -635,13 +1100,21 @@ func TestRepository_UpdateAnswer(t *testing.T) {
 		assert.True(t, found, "Answer should be found in card answers")
 	})
 
-	t.Run("success_update_is_correct", func(t *testing.T) {
+	t.Run("failure_no_correct_answer_left", func(t *testing.T) {
 		isCorrect := false
 		updates := AnswerUpdates{IsCorrect: &isCorrect}
 
-		a, err := repo.UpdateAnswer(context.Background(), deckID, cardID, answerID, updates)
+		_, err := repo.UpdateAnswer(context.Background(), deckID, cardID, answerID, updates)
+		assert.Equal(t, ErrNoCorrectAnswer, errors.Cause(err))
+
+		// The answer is left as it was
+		answers, err := repo.GetCardAnswers(context.Background(), cardID)
 		assert.NoError(t, err)
-		assert.False(t, a.IsCorrect)
+		for _, ans := range answers {
+			if ans.ID == answerID {
+				assert.True(t, ans.IsCorrect)
+			}
+		}
 	})
 
 	t.Run("success_update_multiple_fields", func(t *testing.T) {
@@ -674,45 +1147,554 @@ func TestRepository_UpdateAnswer(t *testing.T) {
 	})
 }
 
-type testHarness struct {
-	db *sql.DB
-}
-
-func newTestHarness(t *testing.T) testHarness {
-	db, err := db.InitDB(config.DBConfig{User: "toshokan", Password: "t.o.s.h.o.k.a.n.", Name: "test_deck", Host: "localhost", Port: "5432"})
-	if err != nil {
-		t.Fatal(err)
-	}
+func TestRepository_DeleteCard(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
 
-	t.Cleanup(func() {
-		db.Close()
-	})
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("d42a90dd-818c-4eed-8e9f-9e8af1a654f4")
+	authorID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
 
-	driver, err := postgres.WithInstance(db, &postgres.Config{})
-	if err != nil {
-		t.Fatal(err)
-	}
+	t.Run("success", func(t *testing.T) {
+		err := repo.DeleteCard(ctx, deckID, cardID, authorID)
+		assert.NoError(t, err)
 
-	m, err := migrate.NewWithDatabaseInstance("file://../../cmd/migrate/migrations", "toshokan", driver)
-	if err != nil {
-		t.Fatal(err)
-	}
+		cards, err := repo.GetDeckCards(ctx, deckID)
+		assert.NoError(t, err)
+		assert.Len(t, cards, 2)
 
-	err = m.Down()
-	if err != nil && err != migrate.ErrNoChange {
-		t.Fatal(err)
-	}
+		d, err := repo.GetDeck(ctx, deckID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, d.Revision)
 
-	err = m.Up()
-	if err != nil && err != migrate.ErrNoChange {
-		t.Fatal(err)
-	}
+		revisions, err := repo.GetCardRevisions(ctx, deckID, cardID)
+		assert.NoError(t, err)
+		latest := revisions[len(revisions)-1]
+		assert.Equal(t, []string{"deleted"}, latest.ChangedFields)
+		assert.Equal(t, authorID, latest.AuthorID)
+		assert.Equal(t, cardID, latest.Card.ID)
+	})
 
-	if err := populateTestDB(db); err != nil {
-		t.Fatal(err)
-	}
+	t.Run("failure_already_deleted", func(t *testing.T) {
+		err := repo.DeleteCard(ctx, deckID, cardID, authorID)
+		assert.ErrorIs(t, err, ErrCardNotFound)
+	})
 
-	return testHarness{db: db}
+	t.Run("failure_other_deck", func(t *testing.T) {
+		err := repo.DeleteCard(ctx, uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c"), uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33"), authorID)
+		assert.ErrorIs(t, err, ErrCardNotFound)
+	})
+}
+
+func TestRepository_CreateAndDeleteAnswer(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
+	correctID := uuid.MustParse("7e6926da-82b2-4ae8-99b4-1b803ebf1877")
+	incorrectID := uuid.MustParse("dfcb1c81-f590-486e-9b7e-a44f0c436933")
+	authorID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+
+	added := Answer{ID: uuid.MustParse("c5b5a0a1-64d3-4f5e-9a43-2b1f0b6a8e77"), Text: "Transpiled"}
+
+	t.Run("create", func(t *testing.T) {
+		c, err := repo.CreateAnswer(ctx, deckID, cardID, added, authorID)
+		assert.NoError(t, err)
+		assert.Len(t, c.PossibleAnswers, 3)
+		assert.Contains(t, c.PossibleAnswers, added)
+
+		revisions, err := repo.GetCardRevisions(ctx, deckID, cardID)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 1)
+		assert.Equal(t, authorID, revisions[0].AuthorID)
+	})
+
+	t.Run("create_invalid", func(t *testing.T) {
+		_, err := repo.CreateAnswer(ctx, deckID, cardID, Answer{ID: uuid.New()}, authorID)
+		assert.ErrorIs(t, err, ErrNoTextAnswer)
+
+		_, err = repo.CreateAnswer(ctx, deckID, uuid.New(), Answer{ID: uuid.New(), Text: "Assembled"}, authorID)
+		assert.ErrorIs(t, err, ErrCardNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		c, err := repo.DeleteAnswer(ctx, deckID, cardID, incorrectID, authorID)
+		assert.NoError(t, err)
+		assert.Len(t, c.PossibleAnswers, 2)
+
+		answers, err := repo.GetCardAnswers(ctx, cardID)
+		assert.NoError(t, err)
+		assert.NotContains(t, answers, Answer{ID: incorrectID, Text: "Interpreted"})
+	})
+
+	t.Run("delete_last_correct_answer", func(t *testing.T) {
+		_, err := repo.DeleteAnswer(ctx, deckID, cardID, correctID, authorID)
+		assert.ErrorIs(t, err, ErrNoCorrectAnswer)
+
+		answers, err := repo.GetCardAnswers(ctx, cardID)
+		assert.NoError(t, err)
+		assert.Len(t, answers, 2)
+	})
+
+	t.Run("delete_missing_answer", func(t *testing.T) {
+		_, err := repo.DeleteAnswer(ctx, deckID, cardID, incorrectID, authorID)
+		assert.ErrorIs(t, err, ErrAnswerNotFound)
+	})
+
+	t.Run("delete_ordering_item", func(t *testing.T) {
+		ordering := Card{
+			Title: "Order the releases",
+			Kind:  CardKindOrdering,
+			PossibleAnswers: []Answer{
+				{Text: "Go 1.0", IsCorrect: true, Position: 0},
+				{Text: "Go 1.11", IsCorrect: true, Position: 1},
+				{Text: "Go 1.18", IsCorrect: true, Position: 2},
+			},
+		}
+		ordering.GenerateUUIDs()
+		assert.NoError(t, repo.StoreCard(ctx, ordering, deckID))
+
+		c, err := repo.DeleteAnswer(ctx, deckID, ordering.ID, ordering.PossibleAnswers[1].ID, authorID)
+		assert.NoError(t, err)
+
+		answers, err := repo.GetCardAnswers(ctx, ordering.ID)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, c.PossibleAnswers, answers)
+
+		positions := map[string]int{}
+		for _, a := range answers {
+			positions[a.Text] = a.Position
+		}
+		assert.Equal(t, map[string]int{"Go 1.0": 0, "Go 1.18": 1}, positions)
+	})
+}
+
+func TestRepository_CardRevisions(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	editorID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+
+	c := Card{
+		Title: "Zig",
+		Kind:  CardKindSingleChoice,
+		PossibleAnswers: []Answer{
+			{Text: "Compiled", IsCorrect: true},
+			{Text: "Interpreted"},
+		},
+	}
+	c.GenerateUUIDs()
+	answerID := c.PossibleAnswers[0].ID
+
+	assert.NoError(t, repo.StoreCard(ctx, c, deckID))
+
+	title := "Zig language"
+	_, err := repo.UpdateCard(ctx, deckID, c.ID, CardUpdates{Title: &title, AuthorID: editorID})
+	assert.NoError(t, err)
+
+	// Nothing changes, so nothing is recorded
+	_, err = repo.UpdateCard(ctx, deckID, c.ID, CardUpdates{Title: &title, AuthorID: editorID})
+	assert.NoError(t, err)
+
+	text := "Compiled ahead of time"
+	_, err = repo.UpdateAnswer(ctx, deckID, c.ID, answerID, AnswerUpdates{Text: &text, AuthorID: editorID})
+	assert.NoError(t, err)
+
+	_, err = h.db.Exec(`INSERT INTO card_practice (user_id, answer_id) VALUES ($1, $2)`, uuid.New(), answerID)
+	assert.NoError(t, err)
+
+	t.Run("list", func(t *testing.T) {
+		revisions, err := repo.GetCardRevisions(ctx, deckID, c.ID)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 3)
+
+		assert.Equal(t, 3, revisions[0].Revision)
+		assert.Equal(t, editorID, revisions[0].AuthorID)
+		assert.Equal(t, []string{"answers." + answerID.String() + ".text"}, revisions[0].ChangedFields)
+		assert.Equal(t, 1, revisions[0].LearnerCount)
+
+		assert.Equal(t, 2, revisions[1].Revision)
+		assert.Equal(t, []string{"title"}, revisions[1].ChangedFields)
+		assert.Equal(t, 0, revisions[1].LearnerCount)
+
+		assert.Equal(t, 1, revisions[2].Revision)
+		assert.Equal(t, authorID, revisions[2].AuthorID)
+		assert.Equal(t, "Zig", revisions[2].Card.Title)
+		assert.Len(t, revisions[2].Card.PossibleAnswers, 2)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		rev, err := repo.GetCardRevision(ctx, deckID, c.ID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, "Zig language", rev.Card.Title)
+
+		_, err = repo.GetCardRevision(ctx, deckID, c.ID, 42)
+		assert.ErrorIs(t, err, ErrRevisionNotFound)
+
+		_, err = repo.GetCardRevision(ctx, uuid.New(), c.ID, 1)
+		assert.ErrorIs(t, err, ErrCardNotFound)
+	})
+
+	t.Run("no_history", func(t *testing.T) {
+		// Cards stored before revisions were recorded start without any
+		revisions, err := repo.GetCardRevisions(ctx, deckID, uuid.MustParse("c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"))
+		assert.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err := repo.RestoreCardRevision(ctx, deckID, c.ID, 1, editorID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Zig", restored.Title)
+
+		answers, err := repo.GetCardAnswers(ctx, c.ID)
+		assert.NoError(t, err)
+		assert.Len(t, answers, 2)
+		assert.Contains(t, answers, Answer{ID: answerID, Text: "Compiled", IsCorrect: true})
+
+		revisions, err := repo.GetCardRevisions(ctx, deckID, c.ID)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 4)
+		assert.Equal(t, []string{"title", "answers." + answerID.String() + ".text"}, revisions[0].ChangedFields)
+		assert.Equal(t, 1, revisions[1].LearnerCount)
+	})
+
+	t.Run("restore_failure", func(t *testing.T) {
+		_, err := repo.RestoreCardRevision(ctx, deckID, c.ID, 42, editorID)
+		assert.ErrorIs(t, err, ErrRevisionNotFound)
+
+		_, err = repo.RestoreCardRevision(ctx, uuid.New(), c.ID, 1, editorID)
+		assert.ErrorIs(t, err, ErrCardNotFound)
+	})
+
+	t.Run("immutable", func(t *testing.T) {
+		_, err := h.db.Exec(`UPDATE card_revisions SET revision = 9 WHERE card_id = $1`, c.ID)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_ForkDeck(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	upstreamID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	forkerID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+
+	zig := Card{
+		Title: "Zig",
+		Kind:  CardKindSingleChoice,
+		PossibleAnswers: []Answer{
+			{Text: "Compiled", IsCorrect: true},
+			{Text: "Interpreted"},
+		},
+	}
+	zig.GenerateUUIDs()
+	assert.NoError(t, repo.StoreCard(ctx, zig, upstreamID))
+
+	fork, err := repo.ForkDeck(ctx, upstreamID, forkerID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, upstreamID, fork.ID)
+	assert.Equal(t, forkerID, fork.AuthorID)
+	assert.False(t, fork.Public)
+	assert.Equal(t, upstreamID, fork.UpstreamDeckID)
+	assert.Equal(t, 2, fork.UpstreamRevision)
+	assert.Len(t, fork.Cards, 4)
+
+	t.Run("fork_of_missing_deck", func(t *testing.T) {
+		_, err := repo.ForkDeck(ctx, uuid.New(), forkerID)
+		assert.ErrorIs(t, err, ErrDeckNotFound)
+	})
+
+	t.Run("no_changes", func(t *testing.T) {
+		changes, err := repo.GetUpstreamChanges(ctx, fork.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("not_a_fork", func(t *testing.T) {
+		_, err := repo.GetUpstreamChanges(ctx, upstreamID)
+		assert.ErrorIs(t, err, ErrNotAFork)
+
+		_, err = repo.GetUpstreamChanges(ctx, uuid.New())
+		assert.ErrorIs(t, err, ErrDeckNotFound)
+	})
+
+	title := "Zig language"
+	_, err = repo.UpdateCard(ctx, upstreamID, zig.ID, CardUpdates{Title: &title})
+	assert.NoError(t, err)
+
+	kotlin := Card{
+		Title: "Kotlin",
+		Kind:  CardKindSingleChoice,
+		PossibleAnswers: []Answer{
+			{Text: "Compiled", IsCorrect: true},
+			{Text: "Interpreted"},
+		},
+	}
+	kotlin.GenerateUUIDs()
+	assert.NoError(t, repo.StoreCard(ctx, kotlin, upstreamID))
+
+	lua := uuid.MustParse("d42a90dd-818c-4eed-8e9f-9e8af1a654f4")
+	_, err = h.db.Exec(`UPDATE cards SET deleted_at = NOW() WHERE id = $1`, lua)
+	assert.NoError(t, err)
+
+	t.Run("changes", func(t *testing.T) {
+		changes, err := repo.GetUpstreamChanges(ctx, fork.ID)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 3)
+
+		byCard := make(map[uuid.UUID]UpstreamChange, len(changes))
+		for _, c := range changes {
+			byCard[c.UpstreamCardID] = c
+		}
+
+		assert.Equal(t, UpstreamCardModified, byCard[zig.ID].Status)
+		assert.Equal(t, 2, byCard[zig.ID].Revision)
+		assert.Equal(t, []FieldChange{{Field: "title", From: "Zig", To: "Zig language"}}, byCard[zig.ID].Changes)
+
+		assert.Equal(t, UpstreamCardAdded, byCard[kotlin.ID].Status)
+		assert.Equal(t, uuid.Nil, byCard[kotlin.ID].CardID)
+		assert.Equal(t, "Kotlin", byCard[kotlin.ID].Card.Title)
+
+		assert.Equal(t, UpstreamCardRemoved, byCard[lua].Status)
+		assert.NotEqual(t, uuid.Nil, byCard[lua].CardID)
+	})
+
+	t.Run("pull", func(t *testing.T) {
+		err := repo.PullUpstreamChanges(ctx, fork.ID, []uuid.UUID{zig.ID, kotlin.ID, lua}, forkerID)
+		assert.NoError(t, err)
+
+		changes, err := repo.GetUpstreamChanges(ctx, fork.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+
+		cards, err := repo.GetDeckCards(ctx, fork.ID)
+		assert.NoError(t, err)
+
+		var titles []string
+		for _, c := range cards {
+			titles = append(titles, c.Title)
+		}
+		assert.ElementsMatch(t, []string{"Golang", "Rust", "Zig language", "Kotlin"}, titles)
+
+		d, err := repo.GetDeck(ctx, fork.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, d.Revision)
+
+		var changed []string
+		err = h.db.QueryRow(`
+			SELECT r.changed_fields
+			FROM card_revisions r
+			JOIN cards c ON c.id = r.card_id
+			WHERE c.deck_id = $1 AND c.upstream_card_id = $2
+			ORDER BY r.revision DESC
+			LIMIT 1`,
+			fork.ID, lua,
+		).Scan(pq.Array(&changed))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"deleted"}, changed)
+	})
+
+	t.Run("pull_failure", func(t *testing.T) {
+		err := repo.PullUpstreamChanges(ctx, fork.ID, []uuid.UUID{zig.ID}, forkerID)
+		assert.ErrorIs(t, err, ErrNoUpstreamChanges)
+
+		err = repo.PullUpstreamChanges(ctx, upstreamID, []uuid.UUID{zig.ID}, forkerID)
+		assert.ErrorIs(t, err, ErrNotAFork)
+	})
+
+	t.Run("readded", func(t *testing.T) {
+		var copyID uuid.UUID
+		err := h.db.QueryRow(
+			`SELECT id FROM cards WHERE deck_id = $1 AND upstream_card_id = $2`,
+			fork.ID, kotlin.ID,
+		).Scan(&copyID)
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.DeleteCard(ctx, fork.ID, copyID, forkerID))
+
+		changes, err := repo.GetUpstreamChanges(ctx, fork.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+
+		title := "Kotlin language"
+		_, err = repo.UpdateCard(ctx, upstreamID, kotlin.ID, CardUpdates{Title: &title})
+		assert.NoError(t, err)
+
+		changes, err = repo.GetUpstreamChanges(ctx, fork.ID)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, UpstreamCardAdded, changes[0].Status)
+		assert.Equal(t, copyID, changes[0].CardID)
+
+		err = repo.PullUpstreamChanges(ctx, fork.ID, []uuid.UUID{kotlin.ID}, forkerID)
+		assert.NoError(t, err)
+
+		cards, err := repo.GetCards(ctx, []uuid.UUID{copyID})
+		assert.NoError(t, err)
+		assert.Equal(t, "Kotlin language", cards[copyID].Title)
+		assert.Len(t, cards[copyID].PossibleAnswers, 2)
+	})
+
+	t.Run("answer_key", func(t *testing.T) {
+		for _, c := range fork.Cards {
+			if c.Title != "Zig" {
+				continue
+			}
+
+			for _, a := range c.PossibleAnswers {
+				assert.Equal(t, a.Text == "Compiled", a.IsCorrect)
+			}
+		}
+	})
+
+	t.Run("invalid_card", func(t *testing.T) {
+		// Cards stored before validation covered them may be invalid
+		_, err := h.db.Exec(`UPDATE answers SET is_correct = false WHERE card_id = $1`, kotlin.ID)
+		assert.NoError(t, err)
+
+		_, err = repo.ForkDeck(ctx, upstreamID, forkerID)
+		assert.ErrorIs(t, err, ErrNoCorrectAnswer)
+	})
+}
+
+type testHarness struct {
+	db *sql.DB
+}
+
+func TestRepository_DeckMembers(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	memberID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+	publicID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	privateID := uuid.MustParse("ebcfffa0-a96f-450b-a0f3-a2e47263855d")
+
+	err := repo.StoreDeck(ctx, Deck{
+		ID:          privateID,
+		AuthorID:    authorID,
+		Title:       "Norse Mythology",
+		Description: "Odin, Thor and friends",
+	})
+	assert.NoError(t, err)
+
+	t.Run("store", func(t *testing.T) {
+		m, err := repo.StoreDeckMember(ctx, Member{DeckID: privateID, UserID: memberID, Role: RoleViewer})
+		assert.NoError(t, err)
+		assert.False(t, m.CreatedAt.IsZero())
+
+		_, err = repo.StoreDeckMember(ctx, Member{DeckID: privateID, UserID: memberID, Role: RoleEditor})
+		assert.ErrorIs(t, err, ErrMemberAlreadyExists)
+
+		_, err = repo.StoreDeckMember(ctx, Member{DeckID: uuid.New(), UserID: memberID, Role: RoleEditor})
+		assert.ErrorIs(t, err, ErrDeckNotFound)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		m, err := repo.GetDeckMember(ctx, privateID, memberID)
+		assert.NoError(t, err)
+		assert.Equal(t, RoleViewer, m.Role)
+
+		_, err = repo.GetDeckMember(ctx, publicID, memberID)
+		assert.ErrorIs(t, err, ErrMemberNotFound)
+
+		members, err := repo.GetDeckMembers(ctx, privateID)
+		assert.NoError(t, err)
+		assert.Len(t, members, 1)
+		assert.Equal(t, memberID, members[0].UserID)
+	})
+
+	t.Run("private_deck_visible_to_members", func(t *testing.T) {
+		conn, err := repo.SearchDecks(ctx, memberID, "mythology", "", nil, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+
+		var ids []uuid.UUID
+		for _, e := range conn.Edges {
+			ids = append(ids, e.DeckID)
+		}
+		assert.Contains(t, ids, privateID)
+	})
+
+	t.Run("shared_decks", func(t *testing.T) {
+		_, err := repo.StoreDeckMember(ctx, Member{DeckID: publicID, UserID: memberID, Role: RoleEditor})
+		assert.NoError(t, err)
+
+		conn, err := repo.GetSharedDecks(ctx, memberID, pagination.Pagination{First: 1})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, publicID, conn.Edges[0].DeckID)
+		assert.Equal(t, RoleEditor, conn.Edges[0].Role)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.GetSharedDecks(ctx, memberID, pagination.Pagination{First: 1, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, privateID, conn.Edges[0].DeckID)
+		assert.False(t, conn.PageInfo.HasNextPage)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		m, err := repo.UpdateDeckMember(ctx, privateID, memberID, RoleOwner)
+		assert.NoError(t, err)
+		assert.Equal(t, RoleOwner, m.Role)
+
+		_, err = repo.UpdateDeckMember(ctx, privateID, authorID, RoleOwner)
+		assert.ErrorIs(t, err, ErrMemberNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert.NoError(t, repo.DeleteDeckMember(ctx, privateID, memberID))
+		assert.ErrorIs(t, repo.DeleteDeckMember(ctx, privateID, memberID), ErrMemberNotFound)
+
+		_, err := repo.GetDeckMember(ctx, privateID, memberID)
+		assert.ErrorIs(t, err, ErrMemberNotFound)
+	})
+}
+
+func newTestHarness(t *testing.T) testHarness {
+	db, err := db.InitDB(config.DBConfig{User: "toshokan", Password: "t.o.s.h.o.k.a.n.", Name: "test_deck", Host: "localhost", Port: "5432"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := migrate.NewWithDatabaseInstance("file://../../cmd/migrate/migrations", "toshokan", driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = m.Down()
+	if err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+
+	err = m.Up()
+	if err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+
+	if err := populateTestDB(db); err != nil {
+		t.Fatal(err)
+	}
+
+	return testHarness{db: db}
 }
 
 func populateTestDB(pg *sql.DB) error {
@@ -833,3 +1815,211 @@ func mustToCursor(t *testing.T, v any) pagination.Cursor {
 
 	return out
 }
+
+func TestRepository_Ratings(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	firstID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+	secondID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+
+	t.Run("rate", func(t *testing.T) {
+		r, err := repo.RateDeck(ctx, Rating{DeckID: deckID, UserID: firstID, Stars: 5, Review: "Loved it"})
+		assert.NoError(t, err)
+		assert.False(t, r.CreatedAt.IsZero())
+
+		_, err = repo.RateDeck(ctx, Rating{DeckID: deckID, UserID: secondID, Stars: 2})
+		assert.NoError(t, err)
+
+		d, err := repo.GetDeck(ctx, deckID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, d.RatingCount)
+		assert.InDelta(t, 3.5, d.RatingAverage, 0.001)
+	})
+
+	t.Run("change_rating", func(t *testing.T) {
+		r, err := repo.RateDeck(ctx, Rating{DeckID: deckID, UserID: secondID, Stars: 4, Review: "Better on a second look"})
+		assert.NoError(t, err)
+		assert.True(t, r.UpdatedAt.After(r.CreatedAt))
+
+		d, err := repo.GetDeck(ctx, deckID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, d.RatingCount)
+		assert.InDelta(t, 4.5, d.RatingAverage, 0.001)
+	})
+
+	t.Run("deck_not_found", func(t *testing.T) {
+		_, err := repo.RateDeck(ctx, Rating{DeckID: uuid.New(), UserID: firstID, Stars: 3})
+		assert.ErrorIs(t, err, ErrDeckNotFound)
+	})
+
+	t.Run("reviews", func(t *testing.T) {
+		conn, err := repo.GetDeckReviews(ctx, deckID, pagination.Pagination{First: 1})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, secondID, conn.Edges[0].Rating.UserID)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.GetDeckReviews(ctx, deckID, pagination.Pagination{First: 1, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, firstID, conn.Edges[0].Rating.UserID)
+		assert.False(t, conn.PageInfo.HasNextPage)
+	})
+}
+
+func TestRepository_Library(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	learnerID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+	programmingID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	mythologyID := uuid.MustParse("334ddbf8-1acc-405b-86d8-49f0d1ca636c")
+
+	t.Run("add", func(t *testing.T) {
+		e, err := repo.AddToLibrary(ctx, learnerID, programmingID)
+		assert.NoError(t, err)
+		assert.Equal(t, programmingID, e.DeckID)
+		assert.Equal(t, 3, e.CardCount)
+		assert.Equal(t, 0.0, e.Mastery)
+		assert.Equal(t, e.AddedAt, e.LastPracticedAt)
+
+		again, err := repo.AddToLibrary(ctx, learnerID, programmingID)
+		assert.NoError(t, err)
+		assert.Equal(t, e.AddedAt, again.AddedAt)
+
+		_, err = repo.AddToLibrary(ctx, learnerID, mythologyID)
+		assert.NoError(t, err)
+
+		_, err = repo.AddToLibrary(ctx, learnerID, uuid.New())
+		assert.ErrorIs(t, err, ErrDeckNotFound)
+	})
+
+	t.Run("practice", func(t *testing.T) {
+		// Answering a card of the oldest deck brings it to the top when
+		// sorting by last practiced
+		_, err := h.db.Exec(`INSERT INTO card_practice (user_id, answer_id, created_at) VALUES ($1, $2, NOW() + INTERVAL '1 minute')`, learnerID, "7e6926da-82b2-4ae8-99b4-1b803ebf1877")
+		assert.NoError(t, err)
+		_, err = h.db.Exec(`INSERT INTO user_card_level (user_id, card_id, lvl) VALUES ($1, $2, 3)`, learnerID, "72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
+		assert.NoError(t, err)
+
+		conn, err := repo.GetLibrary(ctx, learnerID, LibraryOrderLastPracticed, pagination.Pagination{First: 1})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, programmingID, conn.Edges[0].Entry.DeckID)
+		assert.InDelta(t, 3.0/15.0, conn.Edges[0].Entry.Mastery, 0.001)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.GetLibrary(ctx, learnerID, LibraryOrderLastPracticed, pagination.Pagination{First: 1, After: conn.PageInfo.EndCursor})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 1)
+		assert.Equal(t, mythologyID, conn.Edges[0].Entry.DeckID)
+		assert.False(t, conn.PageInfo.HasNextPage)
+	})
+
+	t.Run("order_by_added", func(t *testing.T) {
+		conn, err := repo.GetLibrary(ctx, learnerID, LibraryOrderAdded, pagination.Pagination{First: 10})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 2)
+		assert.Equal(t, mythologyID, conn.Edges[0].Entry.DeckID)
+
+		_, err = repo.GetLibrary(ctx, learnerID, LibraryOrderLastPracticed, pagination.Pagination{First: 10, After: conn.PageInfo.EndCursor})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := repo.RemoveFromLibrary(ctx, learnerID, mythologyID)
+		assert.NoError(t, err)
+
+		err = repo.RemoveFromLibrary(ctx, learnerID, mythologyID)
+		assert.ErrorIs(t, err, ErrNotInLibrary)
+	})
+
+	t.Run("authored", func(t *testing.T) {
+		conn, err := repo.GetAuthoredDecks(ctx, authorID, pagination.Pagination{First: 2})
+		assert.NoError(t, err)
+		assert.Len(t, conn.Edges, 2)
+		assert.Equal(t, uuid.MustParse("f79aea77-9aa0-4a84-b4c8-d000a27d2c52"), conn.Edges[0].DeckID)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		conn, err = repo.GetAuthoredDecks(ctx, learnerID, pagination.Pagination{First: 2})
+		assert.NoError(t, err)
+		assert.Empty(t, conn.Edges)
+	})
+}
+
+func TestRepository_DeckAnalytics(t *testing.T) {
+	h := newTestHarness(t)
+	repo := NewPGRepository(h.db)
+	ctx := context.Background()
+
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
+	correctID := uuid.MustParse("7e6926da-82b2-4ae8-99b4-1b803ebf1877")
+	incorrectID := uuid.MustParse("dfcb1c81-f590-486e-9b7e-a44f0c436933")
+
+	// The first learner got the card wrong two days ago and right
+	// yesterday, the second one got it right at once two days ago
+	first, second := uuid.New(), uuid.New()
+	for _, p := range []struct {
+		userID   uuid.UUID
+		answerID uuid.UUID
+		daysAgo  int
+	}{
+		{first, incorrectID, 2},
+		{first, correctID, 1},
+		{second, correctID, 2},
+	} {
+		_, err := h.db.Exec(`INSERT INTO card_practice (user_id, answer_id, created_at) VALUES ($1, $2, NOW() - make_interval(days => $3))`, p.userID, p.answerID, p.daysAgo)
+		assert.NoError(t, err)
+	}
+	_, err := h.db.Exec(`INSERT INTO user_card_level (user_id, card_id, lvl) VALUES ($1, $3, 2), ($2, $3, 1)`, first, second, cardID)
+	assert.NoError(t, err)
+
+	statsOf := func(t *testing.T, a DeckAnalytics) CardStats {
+		for _, c := range a.Cards {
+			if c.CardID == cardID {
+				return c
+			}
+		}
+
+		t.Fatalf("card %s missing from the analytics", cardID)
+
+		return CardStats{}
+	}
+
+	t.Run("all_time", func(t *testing.T) {
+		a, err := repo.GetDeckAnalytics(ctx, deckID, AnalyticsRange{})
+		assert.NoError(t, err)
+		assert.Len(t, a.Cards, 3)
+
+		s := statsOf(t, a)
+		assert.Equal(t, 3, s.Attempts)
+		assert.Equal(t, 2, s.FirstTries)
+		assert.Equal(t, 1, s.FirstTryCorrect)
+		assert.Equal(t, 1.5, s.AverageLevel)
+		assert.Equal(t, []AnswerStats{
+			{AnswerID: correctID, IsCorrect: true, Chosen: 2},
+			{AnswerID: incorrectID, IsCorrect: false, Chosen: 1},
+		}, s.Answers)
+		assert.Empty(t, s.Flags)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		// Only the second try of the first learner happened since
+		a, err := repo.GetDeckAnalytics(ctx, deckID, AnalyticsRange{From: time.Now().Add(-36 * time.Hour)})
+		assert.NoError(t, err)
+
+		s := statsOf(t, a)
+		assert.Equal(t, 1, s.Attempts)
+		assert.Equal(t, 0, s.FirstTries)
+		assert.Equal(t, []AnswerStats{
+			{AnswerID: correctID, IsCorrect: true, Chosen: 1},
+			{AnswerID: incorrectID, IsCorrect: false, Chosen: 0},
+		}, s.Answers)
+	})
+}
@@ -3,11 +3,14 @@ package deck
 import (
 	"context"
 	"encoding/json"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mediocregopher/radix/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/XaviFP/toshokan/common/pagination"
 )
@@ -53,8 +56,8 @@ func TestRedisRepository_GetDeck_CacheMiss(t *testing.T) {
 		Description: "Polish your Go skills",
 	}
 
-	mockDB.On("GetDeck", ctx, deckID).Return(expectedDeck, nil)
-	mockDB.On("GetDeckCards", ctx, deckID).Return([]Card{}, nil)
+	mockDB.On("GetDeck", mock.Anything, deckID).Return(expectedDeck, nil)
+	mockDB.On("GetDeckCards", mock.Anything, deckID).Return([]Card{}, nil)
 
 	result, err := repo.GetDeck(ctx, deckID)
 	assert.NoError(t, err)
@@ -83,7 +86,7 @@ func TestRedisRepository_GetDeck_DBError(t *testing.T) {
 	ctx := context.Background()
 	deckID := uuid.New()
 
-	mockDB.On("GetDeck", ctx, deckID).Return(Deck{}, assert.AnError)
+	mockDB.On("GetDeck", mock.Anything, deckID).Return(Deck{}, assert.AnError)
 
 	result, err := repo.GetDeck(ctx, deckID)
 	assert.ErrorIs(t, err, assert.AnError)
@@ -136,22 +139,30 @@ func TestRedisRepository_DeleteDeck_InvalidatesCache(t *testing.T) {
 	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
 	assert.NoError(t, err)
 
+	cardID := uuid.New()
+	cardKey := "cache:card:" + cardID.String() + ":answers"
+	err = h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", cardKey, 3600, "[]"))
+	assert.NoError(t, err)
+
+	mockDB.On("GetDeckCards", ctx, deckID).Return([]Card{{ID: cardID}}, nil)
 	mockDB.On("DeleteDeck", ctx, deckID).Return(nil)
 
 	err = repo.DeleteDeck(ctx, deckID)
 	assert.NoError(t, err)
 
-	var cached string
-	mb := radix.Maybe{Rcv: &cached}
-	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
-	assert.NoError(t, err)
-
-	assert.True(t, mb.Null)
+	// The deck goes along with the keys of its cards
+	for _, k := range []string{key, cardKey} {
+		var cached string
+		mb := radix.Maybe{Rcv: &cached}
+		err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", k))
+		assert.NoError(t, err)
+		assert.True(t, mb.Null, k)
+	}
 
 	mockDB.AssertExpectations(t)
 }
 
-func TestRedisRepository_StoreCard_RefreshesCache(t *testing.T) {
+func TestRedisRepository_StoreCard_InvalidatesCache(t *testing.T) {
 	h := newRedisTestHarness(t)
 	mockDB := new(RepositoryMock)
 
@@ -164,17 +175,21 @@ func TestRedisRepository_StoreCard_RefreshesCache(t *testing.T) {
 		Title: "Test Card",
 		Kind:  "single_choice",
 	}
-	answers := []Answer{{ID: uuid.New(), Text: "Answer 1", IsCorrect: true}}
 
-	deck := Deck{ID: deckID, Title: "Test Deck"}
+	key := "cache:deck:" + deckID.String() + ":cards"
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, "[]"))
+	assert.NoError(t, err)
 
 	mockDB.On("StoreCard", ctx, card, deckID).Return(nil)
-	mockDB.On("GetDeck", ctx, deckID).Return(deck, nil)
-	mockDB.On("GetDeckCards", ctx, deckID).Return([]Card{card}, nil)
-	mockDB.On("GetCardAnswers", ctx, card.ID).Return(answers, nil)
 
-	err := repo.StoreCard(ctx, card, deckID)
+	err = repo.StoreCard(ctx, card, deckID)
+	assert.NoError(t, err)
+
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
 	assert.NoError(t, err)
+	assert.True(t, mb.Null)
 
 	mockDB.AssertExpectations(t)
 }
@@ -372,7 +387,7 @@ func TestRedisRepository_UpdateAnswer_DBError(t *testing.T) {
 	mockDB.AssertExpectations(t)
 }
 
-func TestRedisRepository_GetDecks_PassThrough(t *testing.T) {
+func TestRedisRepository_DeleteCard_InvalidatesCache(t *testing.T) {
 	h := newRedisTestHarness(t)
 	mockDB := new(RepositoryMock)
 
@@ -380,22 +395,238 @@ func TestRedisRepository_GetDecks_PassThrough(t *testing.T) {
 
 	ctx := context.Background()
 	deckID := uuid.New()
-	ids := []uuid.UUID{deckID}
-	expectedDecks := map[uuid.UUID]Deck{
-		deckID: {ID: deckID, Title: "Test Deck"},
-	}
+	cardID := uuid.New()
+
+	deckJSON, _ := json.Marshal(Deck{ID: deckID, Title: "Test Deck", Cards: []Card{{ID: cardID, Title: "Removed"}}})
+	key := "cache:deck:" + deckID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
+	assert.NoError(t, err)
+
+	mockDB.On("DeleteCard", ctx, deckID, cardID, uuid.Nil).Return(nil)
+
+	err = repo.DeleteCard(ctx, deckID, cardID, uuid.Nil)
+	assert.NoError(t, err)
+
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_DeleteAnswer_InvalidatesCache(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	deckID := uuid.New()
+	cardID := uuid.New()
+	answerID := uuid.New()
+	authorID := uuid.New()
+	card := Card{ID: cardID, Title: "Test Card", PossibleAnswers: []Answer{{ID: uuid.New(), Text: "Kept", IsCorrect: true}}}
+
+	deckJSON, _ := json.Marshal(Deck{ID: deckID, Title: "Test Deck"})
+	key := "cache:deck:" + deckID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
+	assert.NoError(t, err)
+
+	mockDB.On("DeleteAnswer", ctx, deckID, cardID, answerID, authorID).Return(card, nil)
+
+	result, err := repo.DeleteAnswer(ctx, deckID, cardID, answerID, authorID)
+	assert.NoError(t, err)
+	assert.Equal(t, card, result)
+
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null)
 
-	mockDB.On("GetDecks", ctx, ids).Return(expectedDecks, nil)
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_RestoreCardRevision_InvalidatesCache(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	deckID := uuid.New()
+	cardID := uuid.New()
+	authorID := uuid.New()
+	restored := Card{ID: cardID, Title: "Original Title", Kind: "single_choice"}
+
+	deckJSON, _ := json.Marshal(Deck{ID: deckID, Title: "Test Deck", Cards: []Card{{ID: cardID, Title: "Broken Title"}}})
+	key := "cache:deck:" + deckID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
+	assert.NoError(t, err)
 
-	result, err := repo.GetDecks(ctx, ids)
+	mockDB.On("RestoreCardRevision", ctx, deckID, cardID, 1, authorID).Return(restored, nil)
+
+	result, err := repo.RestoreCardRevision(ctx, deckID, cardID, 1, authorID)
 	assert.NoError(t, err)
+	assert.Equal(t, "Original Title", result.Title)
 
-	assert.Equal(t, expectedDecks, result)
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null)
 
 	mockDB.AssertExpectations(t)
 }
 
-func TestRedisRepository_GetDeckCards_PassThrough(t *testing.T) {
+func TestRedisRepository_RestoreCardRevision_DBError(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	deckID := uuid.New()
+	cardID := uuid.New()
+
+	deckJSON, _ := json.Marshal(Deck{ID: deckID, Title: "Test Deck"})
+	key := "cache:deck:" + deckID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
+	assert.NoError(t, err)
+
+	mockDB.On("RestoreCardRevision", ctx, deckID, cardID, 7, uuid.Nil).Return(Card{}, ErrRevisionNotFound)
+
+	_, err = repo.RestoreCardRevision(ctx, deckID, cardID, 7, uuid.Nil)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+
+	// Nothing was restored, so the cached deck is still good
+	var cached string
+	err = h.redisClient.Do(ctx, radix.Cmd(&cached, "GET", key))
+	assert.NoError(t, err)
+	assert.Equal(t, string(deckJSON), cached)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_PullUpstreamChanges_InvalidatesCache(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	forkID := uuid.New()
+	upstreamCardID := uuid.New()
+	authorID := uuid.New()
+
+	deckJSON, _ := json.Marshal(Deck{ID: forkID, Title: "Test Fork"})
+	key := "cache:deck:" + forkID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
+	assert.NoError(t, err)
+
+	forkCardID := uuid.New()
+	cardKey := "cache:card:" + forkCardID.String()
+	err = h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", cardKey, 3600, "{}"))
+	assert.NoError(t, err)
+
+	mockDB.On("GetDeckCards", ctx, forkID).Return([]Card{{ID: forkCardID}}, nil)
+	mockDB.On("PullUpstreamChanges", ctx, forkID, []uuid.UUID{upstreamCardID}, authorID).Return(nil)
+
+	err = repo.PullUpstreamChanges(ctx, forkID, []uuid.UUID{upstreamCardID}, authorID)
+	assert.NoError(t, err)
+
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null)
+
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", cardKey))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_RateDeck_InvalidatesCache(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	deckID := uuid.New()
+	rating := Rating{DeckID: deckID, UserID: uuid.New(), Stars: 4}
+
+	deckJSON, _ := json.Marshal(Deck{ID: deckID, Title: "Test Deck"})
+	key := "cache:deck:" + deckID.String()
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, string(deckJSON)))
+	assert.NoError(t, err)
+
+	mockDB.On("RateDeck", ctx, rating).Return(rating, nil)
+
+	_, err = repo.RateDeck(ctx, rating)
+	assert.NoError(t, err)
+
+	var cached string
+	mb := radix.Maybe{Rcv: &cached}
+	err = h.redisClient.Do(ctx, radix.Cmd(&mb, "GET", key))
+	assert.NoError(t, err)
+	assert.True(t, mb.Null)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_GetDecks_CachesMisses(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	cachedID := uuid.New()
+	missingID := uuid.New()
+	deletedID := uuid.New()
+
+	cachedJSON, _ := json.Marshal(Deck{ID: cachedID, Title: "Cached Deck"})
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", "cache:deck:"+cachedID.String()+":meta", 3600, string(cachedJSON)))
+	assert.NoError(t, err)
+
+	// Only the decks missing from the cache are loaded, in a single call
+	mockDB.On("GetDecks", mock.Anything, []uuid.UUID{missingID, deletedID}).Return(map[uuid.UUID]Deck{
+		missingID: {ID: missingID, Title: "Missing Deck"},
+	}, nil).Once()
+
+	result, err := repo.GetDecks(ctx, []uuid.UUID{cachedID, missingID, deletedID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]Deck{
+		cachedID:  {ID: cachedID, Title: "Cached Deck"},
+		missingID: {ID: missingID, Title: "Missing Deck"},
+	}, result)
+
+	var cached string
+	err = h.redisClient.Do(ctx, radix.Cmd(&cached, "GET", "cache:deck:"+missingID.String()+":meta"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, cached)
+
+	// Loaded values expire
+	var ttl int
+	err = h.redisClient.Do(ctx, radix.Cmd(&ttl, "TTL", "cache:deck:"+missingID.String()+":meta"))
+	assert.NoError(t, err)
+	assert.Greater(t, ttl, 0)
+	assert.LessOrEqual(t, ttl, int(cacheTTL.Seconds()))
+
+	// Both are cached now
+	result, err = repo.GetDecks(ctx, []uuid.UUID{cachedID, missingID})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_GetDeckCards_CachesMiss(t *testing.T) {
 	h := newRedisTestHarness(t)
 	mockDB := new(RepositoryMock)
 
@@ -407,17 +638,20 @@ func TestRedisRepository_GetDeckCards_PassThrough(t *testing.T) {
 		{ID: uuid.New(), Title: "Card 1"},
 	}
 
-	mockDB.On("GetDeckCards", ctx, deckID).Return(expectedCards, nil)
+	mockDB.On("GetDeckCards", mock.Anything, deckID).Return(expectedCards, nil).Once()
 
 	result, err := repo.GetDeckCards(ctx, deckID)
 	assert.NoError(t, err)
+	assert.Equal(t, expectedCards, result)
 
+	result, err = repo.GetDeckCards(ctx, deckID)
+	assert.NoError(t, err)
 	assert.Equal(t, expectedCards, result)
 
 	mockDB.AssertExpectations(t)
 }
 
-func TestRedisRepository_GetCardAnswers_PassThrough(t *testing.T) {
+func TestRedisRepository_GetCardAnswers_CachesMiss(t *testing.T) {
 	h := newRedisTestHarness(t)
 	mockDB := new(RepositoryMock)
 
@@ -429,11 +663,14 @@ func TestRedisRepository_GetCardAnswers_PassThrough(t *testing.T) {
 		{ID: uuid.New(), Text: "Answer 1", IsCorrect: true},
 	}
 
-	mockDB.On("GetCardAnswers", ctx, cardID).Return(expectedAnswers, nil)
+	mockDB.On("GetCardAnswers", mock.Anything, cardID).Return(expectedAnswers, nil).Once()
 
 	result, err := repo.GetCardAnswers(ctx, cardID)
 	assert.NoError(t, err)
+	assert.Equal(t, expectedAnswers, result)
 
+	result, err = repo.GetCardAnswers(ctx, cardID)
+	assert.NoError(t, err)
 	assert.Equal(t, expectedAnswers, result)
 
 	mockDB.AssertExpectations(t)
@@ -454,9 +691,11 @@ func TestRedisRepository_GetPopularDecks_PassThrough(t *testing.T) {
 		},
 	}
 
-	mockDB.On("GetPopularDecks", ctx, userID, pag).Return(expectedConn, nil)
+	tags := []string{"go"}
 
-	result, err := repo.GetPopularDecks(ctx, userID, pag)
+	mockDB.On("GetPopularDecks", ctx, userID, PeriodWeek, tags, pag).Return(expectedConn, nil)
+
+	result, err := repo.GetPopularDecks(ctx, userID, PeriodWeek, tags, pag)
 	assert.NoError(t, err)
 
 	assert.Equal(t, 1, len(result.Edges))
@@ -464,25 +703,146 @@ func TestRedisRepository_GetPopularDecks_PassThrough(t *testing.T) {
 	mockDB.AssertExpectations(t)
 }
 
-func TestRedisRepository_GetCards_PassThrough(t *testing.T) {
+func TestRedisRepository_GetCards_CachesMisses(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	cachedID := uuid.New()
+	missingID := uuid.New()
+
+	cachedCard := Card{ID: cachedID, Title: "Cached Card", PossibleAnswers: []Answer{{ID: uuid.New(), Text: "Yes", IsCorrect: true}}}
+	cardJSON, _ := json.Marshal(cachedCard)
+	err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", "cache:card:"+cachedID.String(), 3600, string(cardJSON)))
+	assert.NoError(t, err)
+
+	missingCard := Card{ID: missingID, Title: "Missing Card", PossibleAnswers: []Answer{{ID: uuid.New(), Text: "No"}}}
+	mockDB.On("GetCards", mock.Anything, []uuid.UUID{missingID}).Return(map[uuid.UUID]Card{missingID: missingCard}, nil).Once()
+
+	expectedCards := map[uuid.UUID]Card{cachedID: cachedCard, missingID: missingCard}
+
+	result, err := repo.GetCards(ctx, []uuid.UUID{cachedID, missingID})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCards, result)
+
+	result, err = repo.GetCards(ctx, []uuid.UUID{cachedID, missingID})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCards, result)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_GetCards_ConcurrentMissesLoadOnce(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	cardID := uuid.New()
+	card := Card{ID: cardID, Title: "Popular Card"}
+
+	release := make(chan struct{})
+	mockDB.On("GetCards", mock.Anything, []uuid.UUID{cardID}).
+		Run(func(mock.Arguments) { <-release }).
+		Return(map[uuid.UUID]Card{cardID: card}, nil).
+		Once()
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+	results := make([]map[uuid.UUID]Card, callers)
+	errs := make([]error, callers)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = repo.GetCards(ctx, []uuid.UUID{cardID})
+		}(i)
+	}
+
+	// Give every caller the time to miss the cache before the load ends
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i := 0; i < callers; i++ {
+		assert.NoError(t, errs[i])
+		assert.Equal(t, map[uuid.UUID]Card{cardID: card}, results[i])
+	}
+
+	mockDB.AssertNumberOfCalls(t, "GetCards", 1)
+}
+
+func TestRedisRepository_GetCards_LoadRacingInvalidationIsNotCached(t *testing.T) {
+	h := newRedisTestHarness(t)
+	mockDB := new(RepositoryMock)
+
+	repo := NewRedisRepository(h.redisClient, mockDB)
+
+	ctx := context.Background()
+	deckID := uuid.New()
+	cardID := uuid.New()
+	newTitle := "Updated Card Title"
+	updates := CardUpdates{Title: &newTitle}
+
+	// The card changes while it is being loaded, after the load read it
+	mockDB.On("UpdateCard", ctx, deckID, cardID, updates).Return(Card{ID: cardID, Title: newTitle}, nil)
+	mockDB.On("GetCards", mock.Anything, []uuid.UUID{cardID}).
+		Run(func(mock.Arguments) {
+			_, err := repo.UpdateCard(ctx, deckID, cardID, updates)
+			assert.NoError(t, err)
+		}).
+		Return(map[uuid.UUID]Card{cardID: {ID: cardID, Title: "Stale Card Title"}}, nil).
+		Once()
+
+	_, err := repo.GetCards(ctx, []uuid.UUID{cardID})
+	assert.NoError(t, err)
+
+	var cached int
+	err = h.redisClient.Do(ctx, radix.Cmd(&cached, "EXISTS", "cache:card:"+cardID.String()))
+	assert.NoError(t, err)
+	assert.Zero(t, cached)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRedisRepository_UpdateCard_InvalidatesCardKeys(t *testing.T) {
 	h := newRedisTestHarness(t)
 	mockDB := new(RepositoryMock)
 
 	repo := NewRedisRepository(h.redisClient, mockDB)
 
 	ctx := context.Background()
+	deckID := uuid.New()
 	cardID := uuid.New()
-	ids := []uuid.UUID{cardID}
-	expectedCards := map[uuid.UUID]Card{
-		cardID: {ID: cardID, Title: "Test Card"},
+	newTitle := "Updated Card Title"
+	updates := CardUpdates{Title: &newTitle}
+
+	keys := []string{
+		"cache:deck:" + deckID.String(),
+		"cache:deck:" + deckID.String() + ":meta",
+		"cache:deck:" + deckID.String() + ":cards",
+		"cache:card:" + cardID.String(),
+		"cache:card:" + cardID.String() + ":answers",
+	}
+	for _, key := range keys {
+		err := h.redisClient.Do(ctx, radix.FlatCmd(nil, "SETEX", key, 3600, "{}"))
+		assert.NoError(t, err)
 	}
 
-	mockDB.On("GetCards", ctx, ids).Return(expectedCards, nil)
+	mockDB.On("UpdateCard", ctx, deckID, cardID, updates).Return(Card{ID: cardID, Title: newTitle}, nil)
 
-	result, err := repo.GetCards(ctx, ids)
+	_, err := repo.UpdateCard(ctx, deckID, cardID, updates)
 	assert.NoError(t, err)
 
-	assert.Equal(t, expectedCards, result)
+	var left int
+	err = h.redisClient.Do(ctx, radix.Cmd(&left, "EXISTS", keys...))
+	assert.NoError(t, err)
+	assert.Zero(t, left)
 
 	mockDB.AssertExpectations(t)
 }
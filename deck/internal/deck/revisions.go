@@ -0,0 +1,91 @@
+package deck
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardRevision is an immutable snapshot of a card, answers included, taken
+// every time the card or one of its answers changes.
+type CardRevision struct {
+	CardID uuid.UUID
+	// Revision numbers start at 1 and grow with every change of the card
+	Revision int
+	// AuthorID is who made the change
+	AuthorID      uuid.UUID
+	ChangedFields []string
+	Card          Card
+	CreatedAt     time.Time
+	// LearnerCount is the number of learners who practiced the card while
+	// this revision was its latest one
+	LearnerCount int
+}
+
+// FieldChange is a field that differs between two versions of a card.
+// Answer fields are named after the answer, as in "answers.<id>.text", and
+// answers that were added or removed as a whole as "answers.<id>".
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// DiffCards lists the fields that changed from one version of a card to
+// another. Answers are matched by ID.
+func DiffCards(from, to Card) []FieldChange {
+	var changes []FieldChange
+
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	add("title", from.Title, to.Title)
+	add("explanation", from.Explanation, to.Explanation)
+	add("kind", from.Kind, to.Kind)
+
+	previous := make(map[uuid.UUID]Answer, len(from.PossibleAnswers))
+	for _, a := range from.PossibleAnswers {
+		previous[a.ID] = a
+	}
+
+	current := make(map[uuid.UUID]bool, len(to.PossibleAnswers))
+	for _, a := range to.PossibleAnswers {
+		current[a.ID] = true
+		field := "answers." + a.ID.String()
+
+		old, ok := previous[a.ID]
+		if !ok {
+			changes = append(changes, FieldChange{Field: field, To: a.Text})
+			continue
+		}
+
+		add(field+".text", old.Text, a.Text)
+		add(field+".is_correct", strconv.FormatBool(old.IsCorrect), strconv.FormatBool(a.IsCorrect))
+		add(field+".blank", strconv.Itoa(old.Blank), strconv.Itoa(a.Blank))
+		add(field+".tolerance", strconv.Itoa(old.Tolerance), strconv.Itoa(a.Tolerance))
+		add(field+".position", strconv.Itoa(old.Position), strconv.Itoa(a.Position))
+		add(field+".side", old.Side, a.Side)
+	}
+
+	for _, a := range from.PossibleAnswers {
+		if !current[a.ID] {
+			changes = append(changes, FieldChange{Field: "answers." + a.ID.String(), From: a.Text})
+		}
+	}
+
+	return changes
+}
+
+// ChangedFields returns the names of the fields in changes.
+func ChangedFields(changes []FieldChange) []string {
+	out := make([]string, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, c.Field)
+	}
+
+	return out
+}
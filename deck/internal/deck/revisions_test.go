@@ -0,0 +1,49 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisions_DiffCards(t *testing.T) {
+	kept := uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598")
+	removed := uuid.MustParse("8b1c7f7e-8a8c-4b8f-9c1e-1d4b0f6c0f5a")
+	added := uuid.MustParse("c5b5a0a1-64d3-4f5e-9a43-2b1f0b6a8e77")
+
+	from := Card{
+		Title: "What does CSP stand for?",
+		Kind:  CardKindSingleChoice,
+		PossibleAnswers: []Answer{
+			{ID: kept, Text: "Communicating Sequential Processes"},
+			{ID: removed, Text: "Concurrent Shared Processes", IsCorrect: true},
+		},
+	}
+	to := Card{
+		Title:       "What does CSP stand for?",
+		Explanation: "Hoare, 1978",
+		Kind:        CardKindSingleChoice,
+		PossibleAnswers: []Answer{
+			{ID: kept, Text: "Communicating Sequential Processes", IsCorrect: true},
+			{ID: added, Text: "Cooperative Scheduling Protocol"},
+		},
+	}
+
+	changes := DiffCards(from, to)
+	assert.Equal(t, []FieldChange{
+		{Field: "explanation", From: "", To: "Hoare, 1978"},
+		{Field: "answers." + kept.String() + ".is_correct", From: "false", To: "true"},
+		{Field: "answers." + added.String(), To: "Cooperative Scheduling Protocol"},
+		{Field: "answers." + removed.String(), From: "Concurrent Shared Processes"},
+	}, changes)
+
+	assert.Equal(t, []string{
+		"explanation",
+		"answers." + kept.String() + ".is_correct",
+		"answers." + added.String(),
+		"answers." + removed.String(),
+	}, ChangedFields(changes))
+
+	assert.Empty(t, DiffCards(to, to))
+}
@@ -0,0 +1,75 @@
+package deck
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/XaviFP/toshokan/common/pagination"
+)
+
+// DefaultSearchLanguage indexes text word by word, without stemming, and is
+// used for decks that don't set a language.
+const DefaultSearchLanguage = "simple"
+
+// searchLanguages are the text search configurations Postgres ships with.
+var searchLanguages = map[string]bool{
+	DefaultSearchLanguage: true,
+	"arabic":              true,
+	"danish":              true,
+	"dutch":               true,
+	"english":             true,
+	"finnish":             true,
+	"french":              true,
+	"german":              true,
+	"greek":               true,
+	"hungarian":           true,
+	"indonesian":          true,
+	"irish":               true,
+	"italian":             true,
+	"lithuanian":          true,
+	"nepali":              true,
+	"norwegian":           true,
+	"portuguese":          true,
+	"romanian":            true,
+	"russian":             true,
+	"spanish":             true,
+	"swedish":             true,
+	"tamil":               true,
+	"turkish":             true,
+}
+
+// IsSearchLanguage reports whether decks can be stemmed in the given language.
+func IsSearchLanguage(language string) bool {
+	return searchLanguages[language]
+}
+
+// searchLanguageNames returns the search languages, sorted.
+func searchLanguageNames() []string {
+	out := make([]string, 0, len(searchLanguages))
+	for l := range searchLanguages {
+		out = append(out, l)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// SearchCursor points at a search result. Results are sorted by rank, and
+// by ID among decks ranking the same.
+type SearchCursor struct {
+	Rank float32
+	ID   uuid.UUID
+}
+
+type SearchDeckEdge struct {
+	DeckID uuid.UUID
+	Rank   float32
+	Cursor pagination.Cursor
+}
+
+type SearchDecksConnection struct {
+	Edges    []SearchDeckEdge
+	PageInfo pagination.PageInfo
+}
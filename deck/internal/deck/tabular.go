@@ -0,0 +1,417 @@
+package deck
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Spreadsheet formats decks can be imported from and exported to
+const (
+	FormatCSV = "csv"
+	FormatTSV = "tsv"
+)
+
+// ColumnMapping tells which column of a CSV or TSV file holds each card
+// field. Columns are zero based and a negative index means the file has no
+// such column.
+//
+// The correct column lists the 1-based numbers of the correct answers among
+// the Answers columns, e.g. "2" or "1;3". For ordering cards the numbers are
+// given in the right order, and for matching cards the n-th left answer
+// listed pairs with the n-th right one. Without a correct column the first
+// answer is taken as the correct one, which suits plain front/back
+// vocabulary lists. Rows without a kind are single_choice cards, or
+// fill_in_the_blanks ones, typed in by the learner, when they only have one
+// answer.
+//
+// The blanks, tolerances and sides columns hold a value per answer column,
+// separated by semicolons, e.g. "0;0;1" or "left;right". Empty values are
+// taken as zero, or no side.
+type ColumnMapping struct {
+	Title       int
+	Answers     []int
+	Correct     int
+	Explanation int
+	Kind        int
+	Blanks      int
+	Tolerances  int
+	Sides       int
+	HasHeader   bool
+}
+
+// DefaultColumnMapping reads a title and a single correct answer per row.
+func DefaultColumnMapping() ColumnMapping {
+	return ColumnMapping{
+		Title:       0,
+		Answers:     []int{1},
+		Correct:     -1,
+		Explanation: -1,
+		Kind:        -1,
+		Blanks:      -1,
+		Tolerances:  -1,
+		Sides:       -1,
+	}
+}
+
+// ExportColumnMapping lays out the title, the given number of answer
+// columns, and then the correct, explanation, kind, blanks, tolerances and
+// sides columns, with a header row.
+func ExportColumnMapping(answers int) ColumnMapping {
+	m := ColumnMapping{Title: 0, HasHeader: true}
+
+	for i := 0; i < answers; i++ {
+		m.Answers = append(m.Answers, i+1)
+	}
+
+	m.Correct = answers + 1
+	m.Explanation = answers + 2
+	m.Kind = answers + 3
+	m.Blanks = answers + 4
+	m.Tolerances = answers + 5
+	m.Sides = answers + 6
+
+	return m
+}
+
+// Validate checks the mapping names at least a title and an answer column
+// and does not use a column twice.
+func (m ColumnMapping) Validate() error {
+	if m.Title < 0 || len(m.Answers) == 0 {
+		return ErrInvalidMapping
+	}
+
+	seen := map[int]bool{}
+	for _, col := range m.columns() {
+		if col < 0 {
+			continue
+		}
+		if seen[col] {
+			return ErrInvalidMapping
+		}
+		seen[col] = true
+	}
+
+	for _, col := range m.Answers {
+		if col < 0 {
+			return ErrInvalidMapping
+		}
+	}
+
+	return nil
+}
+
+func (m ColumnMapping) columns() []int {
+	return append([]int{m.Title, m.Correct, m.Explanation, m.Kind, m.Blanks, m.Tolerances, m.Sides}, m.Answers...)
+}
+
+func (m ColumnMapping) width() int {
+	width := 0
+	for _, col := range m.columns() {
+		if col+1 > width {
+			width = col + 1
+		}
+	}
+
+	return width
+}
+
+// ImportTabular fills d with the cards read from a CSV or TSV file.
+// Rows that don't make a valid card end up in the returned ErroredDeck,
+// each ErroredCard carrying the line it was read from; the deck should
+// only be stored when the ErroredDeck has no errors.
+func ImportTabular(d Deck, r io.Reader, format string, m ColumnMapping) (Deck, ErroredDeck, error) {
+	reader, err := newTabularReader(r, format)
+	if err != nil {
+		return d, ErroredDeck{}, err
+	}
+
+	if err := m.Validate(); err != nil {
+		return d, ErroredDeck{}, err
+	}
+
+	d.Cards = nil
+	erroredCards := []ErroredCard{}
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return d, ErroredDeck{}, errors.Annotate(ErrUnreadableFile, err.Error())
+		}
+
+		if first && m.HasHeader {
+			continue
+		}
+
+		row, _ := reader.FieldPos(0)
+
+		card, errs := cardFromRecord(record, m)
+		if _, ec := ValidateCard(card); len(ec.Errs) > 0 {
+			errs = append(errs, ec.Errs...)
+		}
+
+		if len(errs) > 0 {
+			erroredCards = append(erroredCards, ErroredCard{C: card, Row: row, Errs: errs})
+			continue
+		}
+
+		d.Cards = append(d.Cards, card)
+	}
+
+	return d, importErrors(d, erroredCards), nil
+}
+
+// importErrors validates a deck once its cards have been read from a file,
+// adding the cards that could not be read to its errors.
+func importErrors(d Deck, erroredCards []ErroredCard) ErroredDeck {
+	_, ed := d.Validate()
+
+	if len(d.Cards) == 0 && len(erroredCards) == 0 {
+		ed.Errs = append(ed.Errs, ErrNoRows)
+	}
+
+	if len(erroredCards) > 0 {
+		ed.ErroredCards = erroredCards
+		ed.Errs = append(ed.Errs, ErrCards)
+	}
+
+	return ed
+}
+
+func cardFromRecord(record []string, m ColumnMapping) (Card, []error) {
+	cell := func(col int) string {
+		if col < 0 || col >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[col])
+	}
+
+	card := Card{
+		Title:       cell(m.Title),
+		Explanation: cell(m.Explanation),
+		Kind:        cell(m.Kind),
+	}
+
+	blanks := strings.Split(cell(m.Blanks), ";")
+	tolerances := strings.Split(cell(m.Tolerances), ";")
+	sides := strings.Split(cell(m.Sides), ";")
+
+	// Answers keyed by their 1-based number among the answer columns, so
+	// that the correct column can point at them even when some are empty
+	byNumber := map[int]int{}
+	for i, col := range m.Answers {
+		text := cell(col)
+		if text == "" {
+			continue
+		}
+
+		a := Answer{Text: text, Side: listValue(sides, i)}
+
+		var err error
+		if a.Blank, err = listNumber(blanks, i); err != nil {
+			return card, []error{ErrInvalidAnswerColumns}
+		}
+		if a.Tolerance, err = listNumber(tolerances, i); err != nil {
+			return card, []error{ErrInvalidAnswerColumns}
+		}
+
+		byNumber[i+1] = len(card.PossibleAnswers)
+		card.PossibleAnswers = append(card.PossibleAnswers, a)
+	}
+
+	if card.Kind == "" {
+		card.Kind = CardKindSingleChoice
+		if len(card.PossibleAnswers) == 1 {
+			card.Kind = CardKindFillInTheBlanks
+		}
+	}
+
+	if m.Correct < 0 {
+		if len(card.PossibleAnswers) > 0 {
+			card.PossibleAnswers[0].IsCorrect = true
+		}
+
+		return card, nil
+	}
+
+	numbers := strings.FieldsFunc(cell(m.Correct), func(r rune) bool {
+		return r == ';' || r == ',' || r == '|' || r == ' '
+	})
+
+	// Positions only mean something to ordering and matching cards, where
+	// they follow the order of the correct column, side by side for pairs
+	positions := map[string]int{}
+	for _, n := range numbers {
+		number, err := strconv.Atoi(n)
+		if err != nil {
+			return card, []error{ErrInvalidCorrectColumn}
+		}
+
+		i, ok := byNumber[number]
+		if !ok || card.PossibleAnswers[i].IsCorrect {
+			return card, []error{ErrInvalidCorrectColumn}
+		}
+
+		a := &card.PossibleAnswers[i]
+		a.IsCorrect = true
+
+		switch card.Kind {
+		case CardKindOrdering:
+			a.Position = positions[""]
+			positions[""]++
+		case CardKindMatching:
+			a.Position = positions[a.Side]
+			positions[a.Side]++
+		}
+	}
+
+	return card, nil
+}
+
+// listValue returns the i-th value of a column holding one per answer.
+func listValue(values []string, i int) string {
+	if i >= len(values) {
+		return ""
+	}
+
+	return strings.TrimSpace(values[i])
+}
+
+// listNumber is listValue for numbers, zero when left empty.
+func listNumber(values []string, i int) (int, error) {
+	v := listValue(values, i)
+	if v == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, ErrInvalidAnswerColumns
+	}
+
+	return n, nil
+}
+
+// ExportTabular writes the cards of d as CSV or TSV rows laid out
+// following m, so that importing them with the same mapping gives the same
+// cards back.
+func ExportTabular(w io.Writer, d Deck, format string, m ColumnMapping) error {
+	writer, err := newTabularWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	width := m.width()
+
+	if m.HasHeader {
+		header := make([]string, width)
+		header[m.Title] = "title"
+		for i, col := range m.Answers {
+			header[col] = "answer " + strconv.Itoa(i+1)
+		}
+		setCell(header, m.Correct, "correct")
+		setCell(header, m.Explanation, "explanation")
+		setCell(header, m.Kind, "kind")
+		setCell(header, m.Blanks, "blanks")
+		setCell(header, m.Tolerances, "tolerances")
+		setCell(header, m.Sides, "sides")
+
+		if err := writer.Write(header); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	for _, c := range d.Cards {
+		if len(c.PossibleAnswers) > len(m.Answers) {
+			return errors.Annotatef(ErrTooManyAnswers, "card %q", c.Title)
+		}
+
+		record := make([]string, width)
+		record[m.Title] = c.Title
+		setCell(record, m.Explanation, c.Explanation)
+		setCell(record, m.Kind, c.Kind)
+
+		var correct []int
+		var blanks, tolerances, sides []string
+		for i, a := range c.PossibleAnswers {
+			record[m.Answers[i]] = a.Text
+			if a.IsCorrect {
+				correct = append(correct, i)
+			}
+
+			blanks = append(blanks, strconv.Itoa(a.Blank))
+			tolerances = append(tolerances, strconv.Itoa(a.Tolerance))
+			sides = append(sides, a.Side)
+		}
+		setCell(record, m.Blanks, strings.Join(blanks, ";"))
+		setCell(record, m.Tolerances, strings.Join(tolerances, ";"))
+		setCell(record, m.Sides, strings.Join(sides, ";"))
+
+		sort.SliceStable(correct, func(i, j int) bool {
+			return c.PossibleAnswers[correct[i]].Position < c.PossibleAnswers[correct[j]].Position
+		})
+
+		numbers := make([]string, 0, len(correct))
+		for _, i := range correct {
+			numbers = append(numbers, strconv.Itoa(i+1))
+		}
+		setCell(record, m.Correct, strings.Join(numbers, ";"))
+
+		if err := writer.Write(record); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	writer.Flush()
+
+	return errors.Trace(writer.Error())
+}
+
+func setCell(record []string, col int, value string) {
+	if col >= 0 {
+		record[col] = value
+	}
+}
+
+func newTabularReader(r io.Reader, format string) (*csv.Reader, error) {
+	reader := csv.NewReader(r)
+	// Spreadsheets leave trailing cells out when they are empty
+	reader.FieldsPerRecord = -1
+
+	switch format {
+	case FormatCSV:
+	case FormatTSV:
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	default:
+		return nil, ErrInvalidFormat
+	}
+
+	return reader, nil
+}
+
+func newTabularWriter(w io.Writer, format string) (*csv.Writer, error) {
+	writer := csv.NewWriter(w)
+
+	switch format {
+	case FormatCSV:
+	case FormatTSV:
+		writer.Comma = '\t'
+	default:
+		return nil, ErrInvalidFormat
+	}
+
+	return writer, nil
+}
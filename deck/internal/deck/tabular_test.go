@@ -0,0 +1,192 @@
+package deck
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTabular_ImportTabular(t *testing.T) {
+	d := Deck{Title: "Japanese vocabulary", Description: "JLPT N5 words"}
+
+	t.Run("default mapping", func(t *testing.T) {
+		data := "本\tbook\n水\twater\n"
+
+		out, ed, err := ImportTabular(d, strings.NewReader(data), FormatTSV, DefaultColumnMapping())
+		require.NoError(t, err)
+		assert.Empty(t, ed.Errs)
+		assert.Equal(t, []Card{
+			{Title: "本", Kind: CardKindFillInTheBlanks, PossibleAnswers: []Answer{{Text: "book", IsCorrect: true}}},
+			{Title: "水", Kind: CardKindFillInTheBlanks, PossibleAnswers: []Answer{{Text: "water", IsCorrect: true}}},
+		}, out.Cards)
+	})
+
+	t.Run("custom mapping", func(t *testing.T) {
+		data := strings.Join([]string{
+			"kind,question,a,b,c,correct,why",
+			`multiple_choice,"Which are prime?",2,4,3,1;3,`,
+			`ordering,"Sort by size",medium,small,large,2 1 3,"small, medium, large"`,
+		}, "\n")
+
+		m := ColumnMapping{Title: 1, Answers: []int{2, 3, 4}, Correct: 5, Explanation: 6, Kind: 0, Blanks: -1, Tolerances: -1, Sides: -1, HasHeader: true}
+
+		out, ed, err := ImportTabular(d, strings.NewReader(data), FormatCSV, m)
+		require.NoError(t, err)
+		assert.Empty(t, ed.Errs)
+		require.Len(t, out.Cards, 2)
+
+		assert.Equal(t, Card{
+			Title: "Which are prime?",
+			Kind:  CardKindMultipleChoice,
+			PossibleAnswers: []Answer{
+				{Text: "2", IsCorrect: true},
+				{Text: "4"},
+				{Text: "3", IsCorrect: true},
+			},
+		}, out.Cards[0])
+
+		assert.Equal(t, Card{
+			Title:       "Sort by size",
+			Kind:        CardKindOrdering,
+			Explanation: "small, medium, large",
+			PossibleAnswers: []Answer{
+				{Text: "medium", IsCorrect: true, Position: 1},
+				{Text: "small", IsCorrect: true, Position: 0},
+				{Text: "large", IsCorrect: true, Position: 2},
+			},
+		}, out.Cards[1])
+	})
+
+	t.Run("row errors", func(t *testing.T) {
+		data := strings.Join([]string{
+			"title,a,b,correct",
+			"Good,yes,no,1",
+			",yes,no,1",
+			"Bad correct,yes,,2",
+			"Unknown kind,yes,no,x",
+		}, "\n")
+
+		m := ColumnMapping{Title: 0, Answers: []int{1, 2}, Correct: 3, Explanation: -1, Kind: -1, Blanks: -1, Tolerances: -1, Sides: -1, HasHeader: true}
+
+		out, ed, err := ImportTabular(d, strings.NewReader(data), FormatCSV, m)
+		require.NoError(t, err)
+		assert.Len(t, out.Cards, 1)
+		assert.Equal(t, []error{ErrCards}, ed.Errs)
+
+		require.Len(t, ed.ErroredCards, 3)
+		assert.Equal(t, 3, ed.ErroredCards[0].Row)
+		assert.Equal(t, []error{ErrNoTitle}, ed.ErroredCards[0].Errs)
+		assert.Equal(t, 4, ed.ErroredCards[1].Row)
+		assert.Contains(t, ed.ErroredCards[1].Errs, ErrInvalidCorrectColumn)
+		assert.Equal(t, 5, ed.ErroredCards[2].Row)
+		assert.Contains(t, ed.ErroredCards[2].Errs, ErrInvalidCorrectColumn)
+	})
+
+	t.Run("answer columns", func(t *testing.T) {
+		data := strings.Join([]string{
+			"title,a,b,c,correct,kind,blanks,tolerances,sides",
+			"___ was designed at ___,Go,Google,golang,1;2;3,fill_in_the_blanks,0;1;0,1;;2,",
+			"Bad blanks,Go,Google,,1;2,fill_in_the_blanks,0;x,,",
+		}, "\n")
+
+		m := ColumnMapping{Title: 0, Answers: []int{1, 2, 3}, Correct: 4, Explanation: -1, Kind: 5, Blanks: 6, Tolerances: 7, Sides: 8, HasHeader: true}
+
+		out, ed, err := ImportTabular(d, strings.NewReader(data), FormatCSV, m)
+		require.NoError(t, err)
+		require.Len(t, out.Cards, 1)
+		assert.Equal(t, []Answer{
+			{Text: "Go", IsCorrect: true, Blank: 0, Tolerance: 1},
+			{Text: "Google", IsCorrect: true, Blank: 1},
+			{Text: "golang", IsCorrect: true, Blank: 0, Tolerance: 2},
+		}, out.Cards[0].PossibleAnswers)
+
+		require.Len(t, ed.ErroredCards, 1)
+		assert.Contains(t, ed.ErroredCards[0].Errs, ErrInvalidAnswerColumns)
+	})
+
+	t.Run("deck errors", func(t *testing.T) {
+		_, ed, err := ImportTabular(Deck{}, strings.NewReader(""), FormatCSV, DefaultColumnMapping())
+		require.NoError(t, err)
+		assert.Equal(t, []error{ErrNoTitle, ErrNoDescription, ErrNoRows}, ed.Errs)
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		_, _, err := ImportTabular(d, strings.NewReader("a,b"), "xlsx", DefaultColumnMapping())
+		assert.Equal(t, ErrInvalidFormat, err)
+
+		_, _, err = ImportTabular(d, strings.NewReader("a,b"), FormatCSV, ColumnMapping{Title: 0, Answers: []int{0}, Correct: -1, Explanation: -1, Kind: -1, Blanks: -1, Tolerances: -1, Sides: -1})
+		assert.Equal(t, ErrInvalidMapping, err)
+
+		_, _, err = ImportTabular(d, strings.NewReader(`a,"b`), FormatCSV, DefaultColumnMapping())
+		assert.Error(t, err)
+	})
+}
+
+func TestTabular_ExportTabular(t *testing.T) {
+	d := Deck{
+		Title:       "Go",
+		Description: "Polish your Go skills",
+		Cards: []Card{
+			{
+				Title:       "What does CSP stand for?",
+				Explanation: "Hoare, 1978",
+				Kind:        CardKindSingleChoice,
+				PossibleAnswers: []Answer{
+					{Text: "Concurrent Shared Processes"},
+					{Text: "Communicating Sequential Processes", IsCorrect: true},
+				},
+			},
+			{
+				Title: "Sort by release",
+				Kind:  CardKindOrdering,
+				PossibleAnswers: []Answer{
+					{Text: "Go 1.18", IsCorrect: true, Position: 1},
+					{Text: "Go 1.0", IsCorrect: true, Position: 0},
+					{Text: "Go 1.21", IsCorrect: true, Position: 2},
+				},
+			},
+			{
+				Title: "___ was designed at ___",
+				Kind:  CardKindFillInTheBlanks,
+				PossibleAnswers: []Answer{
+					{Text: "Go", IsCorrect: true, Tolerance: 1},
+					{Text: "Google", IsCorrect: true, Blank: 1},
+				},
+			},
+			{
+				Title: "Match each language with where it was created",
+				Kind:  CardKindMatching,
+				PossibleAnswers: []Answer{
+					{Text: "Go", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+					{Text: "Mozilla", IsCorrect: true, Position: 1, Side: AnswerSideRight},
+					{Text: "Rust", IsCorrect: true, Position: 1, Side: AnswerSideLeft},
+					{Text: "Google", IsCorrect: true, Position: 0, Side: AnswerSideRight},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	m := ExportColumnMapping(4)
+
+	require.NoError(t, ExportTabular(&buf, d, FormatCSV, m))
+	assert.Equal(t, strings.Join([]string{
+		"title,answer 1,answer 2,answer 3,answer 4,correct,explanation,kind,blanks,tolerances,sides",
+		"What does CSP stand for?,Concurrent Shared Processes,Communicating Sequential Processes,,,2,\"Hoare, 1978\",single_choice,0;0,0;0,;",
+		"Sort by release,Go 1.18,Go 1.0,Go 1.21,,2;1;3,,ordering,0;0;0,0;0;0,;;",
+		"___ was designed at ___,Go,Google,,,1;2,,fill_in_the_blanks,0;1,1;0,;",
+		"Match each language with where it was created,Go,Mozilla,Rust,Google,1;4;2;3,,matching,0;0;0;0,0;0;0;0,left;right;left;right",
+		"",
+	}, "\n"), buf.String())
+
+	imported, ed, err := ImportTabular(Deck{Title: d.Title, Description: d.Description}, &buf, FormatCSV, m)
+	require.NoError(t, err)
+	assert.Empty(t, ed.Errs)
+	assert.Equal(t, d.Cards, imported.Cards)
+
+	err = ExportTabular(&buf, d, FormatTSV, ExportColumnMapping(3))
+	assert.ErrorIs(t, err, ErrTooManyAnswers)
+}
@@ -0,0 +1,118 @@
+package deck
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxTagsPerDeck = 10
+	MaxTagLength   = 50
+)
+
+// Tag labels decks, and can be filed under a topic of the taxonomy
+type Tag struct {
+	Name string
+	// TopicID is uuid.Nil for tags that aren't filed under any topic
+	TopicID uuid.UUID
+	// DeckCount is the number of public decks carrying the tag
+	DeckCount int
+}
+
+// Topic is a node of the admin curated taxonomy that tags hang off
+type Topic struct {
+	ID uuid.UUID
+	// ParentID is uuid.Nil for root topics
+	ParentID uuid.UUID
+	Name     string
+	Tags     []string
+	Children []Topic
+}
+
+// NormalizeTag lowercases a tag and collapses its whitespace, so that
+// "JLPT  N5" and "jlpt n5" end up being the same tag.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+// NormalizeTags normalizes every tag, dropping duplicates but keeping the
+// order they were given in.
+func NormalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(tags))
+	out := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		out = append(out, tag)
+	}
+
+	return out
+}
+
+// ValidateTags checks normalized tags fit on a deck.
+func ValidateTags(tags []string) []error {
+	var errs []error
+
+	if len(tags) > MaxTagsPerDeck {
+		errs = append(errs, ErrTooManyTags)
+	}
+
+	for _, tag := range tags {
+		if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength {
+			errs = append(errs, ErrInvalidTag)
+			break
+		}
+	}
+
+	return errs
+}
+
+// TopicTree nests topics under their parents and returns the roots. Sibling
+// topics are sorted by name. Topics whose parent is missing from the list
+// are treated as roots.
+func TopicTree(topics []Topic) []Topic {
+	children := make(map[uuid.UUID][]Topic, len(topics))
+	known := make(map[uuid.UUID]bool, len(topics))
+
+	for _, t := range topics {
+		known[t.ID] = true
+	}
+
+	for _, t := range topics {
+		parent := t.ParentID
+		if !known[parent] {
+			parent = uuid.Nil
+		}
+
+		children[parent] = append(children[parent], t)
+	}
+
+	var build func(parent uuid.UUID) []Topic
+	build = func(parent uuid.UUID) []Topic {
+		out := children[parent]
+
+		sort.SliceStable(out, func(i, j int) bool {
+			return strings.ToLower(out[i].Name) < strings.ToLower(out[j].Name)
+		})
+
+		for i := range out {
+			out[i].Children = build(out[i].ID)
+		}
+
+		return out
+	}
+
+	return build(uuid.Nil)
+}
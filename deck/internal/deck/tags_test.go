@@ -0,0 +1,21 @@
+package deck
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags_NormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"jlpt n5", "go"}, NormalizeTags([]string{" JLPT   N5", "Go", "jlpt n5", "GO "}))
+	assert.Nil(t, NormalizeTags(nil))
+}
+
+func TestTags_ValidateTags(t *testing.T) {
+	assert.Empty(t, ValidateTags([]string{"go", "concurrency"}))
+	assert.Equal(t, []error{ErrInvalidTag}, ValidateTags([]string{"go", ""}))
+	assert.Equal(t, []error{ErrInvalidTag}, ValidateTags([]string{strings.Repeat("日", MaxTagLength+1)}))
+	assert.Empty(t, ValidateTags([]string{strings.Repeat("日", MaxTagLength)}))
+	assert.Equal(t, []error{ErrTooManyTags}, ValidateTags(strings.Fields("a b c d e f g h i j k")))
+}
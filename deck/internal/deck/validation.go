@@ -1,5 +1,10 @@
 package deck
 
+import (
+	"slices"
+	"strings"
+)
+
 func ValidateDecks(ds []Deck) (bool, []ErroredDeck) {
 	erroredDecks := []ErroredDeck{}
 	for _, d := range ds {
@@ -22,6 +27,12 @@ func (d Deck) Validate() (bool, ErroredDeck) {
 		ed.Errs = append(ed.Errs, ErrNoDescription)
 	}
 
+	if d.Language != "" && !IsSearchLanguage(d.Language) {
+		ed.Errs = append(ed.Errs, ErrInvalidLanguage)
+	}
+
+	ed.Errs = append(ed.Errs, ValidateTags(d.Tags)...)
+
 	var ok bool
 
 	ok, ed.ErroredCards = ValidateCards(d.Cards)
@@ -34,8 +45,9 @@ func (d Deck) Validate() (bool, ErroredDeck) {
 
 func ValidateCards(cs []Card) (bool, []ErroredCard) {
 	erroredCards := []ErroredCard{}
-	for _, c := range cs {
+	for i, c := range cs {
 		if ok, ec := ValidateCard(c); !ok {
+			ec.Index = i
 			erroredCards = append(erroredCards, ec)
 		}
 	}
@@ -50,7 +62,8 @@ func ValidateCard(c Card) (bool, ErroredCard) {
 		eq.Errs = append(eq.Errs, ErrNoTitle)
 	}
 
-	if c.Kind != CardKindSingleChoice && c.Kind != CardKindFillInTheBlanks {
+	kind, knownKind := LookupCardKind(c.Kind)
+	if !knownKind {
 		eq.Errs = append(eq.Errs, ErrInvalidKind)
 	}
 
@@ -75,5 +88,104 @@ func ValidateCard(c Card) (bool, ErroredCard) {
 		eq.Errs = append(eq.Errs, ErrNoTextAnswer)
 	}
 
+	if knownKind {
+		eq.Errs = append(eq.Errs, kind.Validate(c)...)
+	}
+
 	return len(eq.Errs) == 0, eq
 }
+
+// validateBlanks checks that blanks are numbered from zero without gaps and
+// that each of them accepts at least one answer.
+func validateBlanks(c Card) []error {
+	var errs []error
+
+	for _, a := range c.PossibleAnswers {
+		if a.Blank < 0 || a.Tolerance < 0 {
+			errs = append(errs, ErrInvalidBlank)
+			break
+		}
+	}
+
+	for _, accepted := range c.acceptedByBlank() {
+		if len(accepted) == 0 {
+			errs = append(errs, ErrBlankNotAccepted)
+			break
+		}
+	}
+
+	return errs
+}
+
+// validateMultipleChoice checks that there is something to choose from.
+func validateMultipleChoice(c Card) []error {
+	if len(c.PossibleAnswers) < 2 {
+		return []error{ErrTooFewAnswers}
+	}
+
+	return nil
+}
+
+// validateTrueFalse checks that the card holds a true and a false answer,
+// exactly one of them being correct.
+func validateTrueFalse(c Card) []error {
+	if len(c.PossibleAnswers) != 2 || c.PossibleAnswers[0].IsCorrect == c.PossibleAnswers[1].IsCorrect {
+		return []error{ErrTrueFalseAnswers}
+	}
+
+	texts := []string{
+		strings.ToLower(strings.TrimSpace(c.PossibleAnswers[0].Text)),
+		strings.ToLower(strings.TrimSpace(c.PossibleAnswers[1].Text)),
+	}
+	slices.Sort(texts)
+	if texts[0] != "false" || texts[1] != "true" {
+		return []error{ErrTrueFalseAnswers}
+	}
+
+	return nil
+}
+
+// validateOrdering checks that there are at least two items to order and
+// that their positions go from zero up without gaps or repeats.
+func validateOrdering(c Card) []error {
+	items := c.orderedItems()
+	if len(items) < 2 {
+		return []error{ErrTooFewAnswers}
+	}
+
+	for i, item := range items {
+		if item.Position != i {
+			return []error{ErrInvalidOrder}
+		}
+	}
+
+	return nil
+}
+
+// validateMatching checks that every answer sits on a side and that the
+// correct ones form pairs of exactly one left and one right answer.
+func validateMatching(c Card) []error {
+	sides := map[int][]string{}
+
+	for _, a := range c.PossibleAnswers {
+		if a.Side != AnswerSideLeft && a.Side != AnswerSideRight {
+			return []error{ErrInvalidPairs}
+		}
+
+		if a.IsCorrect {
+			sides[a.Position] = append(sides[a.Position], a.Side)
+		}
+	}
+
+	if len(sides) < 2 {
+		return []error{ErrTooFewAnswers}
+	}
+
+	for _, s := range sides {
+		if len(s) != 2 || s[0] == s[1] {
+			return []error{ErrInvalidPairs}
+		}
+	}
+
+	return nil
+}
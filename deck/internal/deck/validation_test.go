@@ -3,6 +3,7 @@ package deck
 import (
 	"testing"
 
+	"github.com/juju/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,6 +41,21 @@ func TestValidation_ValidateDecks(t *testing.T) {
 	assert.Equal(t, []error{ErrNoTitle, ErrNoDescription}, erroredDecks[2].Errs)
 }
 
+func TestValidation_ValidateDeckLanguage(t *testing.T) {
+	d := Deck{Title: "Go Learning", Description: "Polish your Go skills"}
+
+	for _, language := range []string{"", DefaultSearchLanguage, "english", "japanese"} {
+		d.Language = language
+		_, ed := d.Validate()
+
+		if language == "japanese" {
+			assert.Equal(t, []error{ErrInvalidLanguage}, ed.Errs)
+			continue
+		}
+		assert.Empty(t, ed.Errs, language)
+	}
+}
+
 func TestValidation_ValidateCards(t *testing.T) {
 	testCards := []Card{
 		{
@@ -86,3 +102,141 @@ func TestValidation_ValidateCards(t *testing.T) {
 	assert.Equal(t, []error{ErrNoTitle, ErrInvalidKind, ErrNoAnswersProvided, ErrNoCorrectAnswer}, erroredCards[3].Errs)
 	assert.Equal(t, []error{ErrInvalidKind}, erroredCards[4].Errs)
 }
+
+func TestValidation_ValidateCards_Blanks(t *testing.T) {
+	testCards := []Card{
+		{
+			Title: "___ was designed at ___",
+			Kind:  CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Blank: 0},
+				{Text: "Golang", IsCorrect: true, Blank: 0},
+				{Text: "Google", IsCorrect: true, Blank: 1, Tolerance: 1},
+			},
+		},
+		{
+			Title: "___ was designed at ___",
+			Kind:  CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Blank: 0},
+				{Text: "Google", IsCorrect: true, Blank: 2},
+			},
+		},
+		{
+			Title: "___ was designed at Google",
+			Kind:  CardKindFillInTheBlanks,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Tolerance: -1},
+			},
+		},
+	}
+
+	isValid, erroredCards := ValidateCards(testCards)
+	assert.False(t, isValid)
+	assert.Len(t, erroredCards, 2)
+	assert.Equal(t, []error{ErrBlankNotAccepted}, erroredCards[0].Errs)
+	assert.Equal(t, []error{ErrInvalidBlank}, erroredCards[1].Errs)
+}
+
+func TestValidation_ValidateCards_Kinds(t *testing.T) {
+	testCards := []Card{
+		{
+			Title: "Which of these are reference types?",
+			Kind:  CardKindMultipleChoice,
+			PossibleAnswers: []Answer{
+				{Text: "map", IsCorrect: true},
+			},
+		},
+		{
+			Title: "Maps are safe for concurrent use",
+			Kind:  CardKindTrueFalse,
+			PossibleAnswers: []Answer{
+				{Text: "True", IsCorrect: true},
+				{Text: "False", IsCorrect: true},
+			},
+		},
+		{
+			Title: "Put the compiler phases in order",
+			Kind:  CardKindOrdering,
+			PossibleAnswers: []Answer{
+				{Text: "Lexing", IsCorrect: true, Position: 0},
+				{Text: "Parsing", IsCorrect: true, Position: 2},
+			},
+		},
+		{
+			Title: "Match each language with where it was created",
+			Kind:  CardKindMatching,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+				{Text: "Google", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+				{Text: "Rust", IsCorrect: true, Position: 1, Side: AnswerSideLeft},
+				{Text: "Mozilla", IsCorrect: true, Position: 1, Side: AnswerSideRight},
+			},
+		},
+		{
+			Title: "Match each language with where it was created",
+			Kind:  CardKindMatching,
+			PossibleAnswers: []Answer{
+				{Text: "Go", IsCorrect: true, Position: 0, Side: AnswerSideLeft},
+				{Text: "Google", IsCorrect: true, Position: 0, Side: AnswerSideRight},
+				{Text: "Rust", IsCorrect: true, Position: 1, Side: AnswerSideLeft},
+				{Text: "Mozilla", IsCorrect: true, Position: 1, Side: AnswerSideRight},
+				{Text: "Sun Microsystems", Side: AnswerSideRight},
+			},
+		},
+		{
+			Title: "Slices are reference types",
+			Kind:  CardKindTrueFalse,
+			PossibleAnswers: []Answer{
+				{Text: "Yes"},
+				{Text: "No", IsCorrect: true},
+			},
+		},
+		{
+			Title: "Strings are immutable",
+			Kind:  CardKindTrueFalse,
+			PossibleAnswers: []Answer{
+				{Text: " false "},
+				{Text: "TRUE", IsCorrect: true},
+			},
+		},
+	}
+
+	isValid, erroredCards := ValidateCards(testCards)
+	assert.False(t, isValid)
+	assert.Len(t, erroredCards, 5)
+	assert.Equal(t, []error{ErrTooFewAnswers}, erroredCards[0].Errs)
+	assert.Equal(t, []error{ErrTrueFalseAnswers}, erroredCards[1].Errs)
+	assert.Equal(t, []error{ErrInvalidOrder}, erroredCards[2].Errs)
+	assert.Equal(t, []error{ErrInvalidPairs}, erroredCards[3].Errs)
+	assert.Equal(t, []error{ErrTrueFalseAnswers}, erroredCards[4].Errs)
+}
+
+func TestValidation_Violations(t *testing.T) {
+	d := Deck{
+		Description: "Polish your Go skills",
+		Tags:        []string{""},
+		Cards: []Card{
+			{Title: "What does CSP stand for?", Kind: CardKindSingleChoice, PossibleAnswers: []Answer{{Text: "Communicating Sequential Processes", IsCorrect: true}}},
+			{Kind: "essay", PossibleAnswers: []Answer{{Text: "Yes", IsCorrect: true}}},
+		},
+	}
+
+	_, ed := d.Validate()
+
+	assert.Equal(t, []FieldViolation{
+		{Field: "title", Err: ErrNoTitle},
+		{Field: "tags", Err: ErrInvalidTag},
+		{Field: "cards[1].title", Err: ErrNoTitle},
+		{Field: "cards[1].kind", Err: ErrInvalidKind},
+	}, ed.Violations())
+}
+
+func TestValidation_KeyOf(t *testing.T) {
+	key, ok := KeyOf(errors.Annotate(ErrCardNotFound, "card 1"))
+	assert.True(t, ok)
+	assert.Equal(t, ErrorKey("CARD_NOT_FOUND"), key)
+
+	_, ok = KeyOf(errors.New("something else"))
+	assert.False(t, ok)
+}
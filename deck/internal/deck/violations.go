@@ -0,0 +1,179 @@
+package deck
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// ErrorKey is the stable code of an error. Messages may be reworded, keys
+// may not, so clients should tell errors apart by key.
+type ErrorKey string
+
+var errorKeys = map[error]ErrorKey{
+	ErrCards:                 "INVALID_CARDS",
+	ErrCardInvalid:           "INVALID_CARD",
+	ErrCardNotFound:          "CARD_NOT_FOUND",
+	ErrCardAlreadyExists:     "CARD_ALREADY_EXISTS",
+	ErrAnswerNotFound:        "ANSWER_NOT_FOUND",
+	ErrDeckNotFound:          "DECK_NOT_FOUND",
+	ErrNoTitle:               "NO_TITLE",
+	ErrNoDescription:         "NO_DESCRIPTION",
+	ErrNoAnswersProvided:     "NO_ANSWERS",
+	ErrNoCorrectAnswer:       "NO_CORRECT_ANSWER",
+	ErrNoTextAnswer:          "NO_ANSWER_TEXT",
+	ErrInvalidKind:           "INVALID_KIND",
+	ErrInvalidBlank:          "INVALID_BLANK",
+	ErrBlankNotAccepted:      "BLANK_NOT_ACCEPTED",
+	ErrTooFewAnswers:         "TOO_FEW_ANSWERS",
+	ErrTrueFalseAnswers:      "INVALID_TRUE_FALSE_ANSWERS",
+	ErrInvalidOrder:          "INVALID_ORDER",
+	ErrInvalidPairs:          "INVALID_PAIRS",
+	ErrInvalidFormat:         "INVALID_FORMAT",
+	ErrInvalidMapping:        "INVALID_MAPPING",
+	ErrInvalidCorrectColumn:  "INVALID_CORRECT_COLUMN",
+	ErrInvalidAnswerColumns:  "INVALID_ANSWER_COLUMNS",
+	ErrUnreadableFile:        "UNREADABLE_FILE",
+	ErrNoRows:                "NO_ROWS",
+	ErrUnsupportedNoteType:   "UNSUPPORTED_NOTE_TYPE",
+	ErrUnsupportedCollection: "UNSUPPORTED_COLLECTION",
+	ErrTooManyAnswers:        "TOO_MANY_ANSWERS",
+	ErrDeckAlreadyExists:     "DECK_ALREADY_EXISTS",
+	ErrDeckInvalid:           "INVALID_DECK",
+	ErrInvalidCursor:         "INVALID_CURSOR",
+	ErrInvalidLanguage:       "INVALID_LANGUAGE",
+	ErrEmptyQuery:            "EMPTY_QUERY",
+	ErrInvalidTag:            "INVALID_TAG",
+	ErrTooManyTags:           "TOO_MANY_TAGS",
+	ErrTopicNotFound:         "TOPIC_NOT_FOUND",
+	ErrTopicAlreadyExists:    "TOPIC_ALREADY_EXISTS",
+	ErrNoTopicName:           "NO_TOPIC_NAME",
+	ErrRevisionNotFound:      "REVISION_NOT_FOUND",
+	ErrNotAFork:              "NOT_A_FORK",
+	ErrNoUpstreamChanges:     "NO_UPSTREAM_CHANGES",
+	ErrNotAllowed:            "NOT_ALLOWED",
+	ErrMemberNotFound:        "MEMBER_NOT_FOUND",
+	ErrMemberAlreadyExists:   "MEMBER_ALREADY_EXISTS",
+	ErrInvalidRole:           "INVALID_ROLE",
+	ErrAuthorIsOwner:         "AUTHOR_IS_OWNER",
+	ErrInvalidPeriod:         "INVALID_PERIOD",
+	ErrExpiredCursor:         "EXPIRED_CURSOR",
+	ErrInvalidStars:          "INVALID_STARS",
+	ErrReviewTooLong:         "REVIEW_TOO_LONG",
+	ErrDeckNotRateable:       "DECK_NOT_RATEABLE",
+	ErrOwnDeckRating:         "OWN_DECK_RATING",
+	ErrInvalidLibraryOrder:   "INVALID_LIBRARY_ORDER",
+	ErrNotInLibrary:          "NOT_IN_LIBRARY",
+	ErrVersionConflict:       "VERSION_CONFLICT",
+	ErrInvalidRange:          "INVALID_RANGE",
+	ErrAuthorOnly:            "AUTHOR_ONLY",
+	ErrNoUpdates:             "NO_UPDATES",
+	ErrNoUpstreamCards:       "NO_UPSTREAM_CARDS",
+}
+
+// KeyOf returns the key of err, which may be traced or annotated. It returns
+// false for errors that don't come from this package.
+func KeyOf(err error) (ErrorKey, bool) {
+	if key, ok := errorKeys[errors.Cause(err)]; ok {
+		return key, true
+	}
+
+	for e, key := range errorKeys {
+		if errors.Is(err, e) {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+// FieldViolation is an error found in a single field of a request. Field is
+// the path to it, like cards[3].possible_answers.
+type FieldViolation struct {
+	Field string
+	Err   error
+}
+
+// cardFields are the fields of a card each validation error is about
+var cardFields = map[error]string{
+	ErrNoTitle:             "title",
+	ErrInvalidKind:         "kind",
+	ErrNoAnswersProvided:   "possible_answers",
+	ErrNoCorrectAnswer:     "possible_answers",
+	ErrNoTextAnswer:        "possible_answers",
+	ErrInvalidBlank:        "possible_answers",
+	ErrBlankNotAccepted:    "possible_answers",
+	ErrTooFewAnswers:       "possible_answers",
+	ErrTrueFalseAnswers:    "possible_answers",
+	ErrInvalidOrder:        "possible_answers",
+	ErrInvalidPairs:        "possible_answers",
+	ErrUnsupportedNoteType: "kind",
+	ErrTooManyAnswers:      "possible_answers",
+}
+
+// deckFields are the fields of a deck each validation error is about
+var deckFields = map[error]string{
+	ErrNoTitle:         "title",
+	ErrNoDescription:   "description",
+	ErrInvalidLanguage: "language",
+	ErrInvalidTag:      "tags",
+	ErrTooManyTags:     "tags",
+}
+
+// Violations returns the errors of the deck and its cards by field. Fields
+// of cards are prefixed by their position among the cards of the deck.
+func (ed ErroredDeck) Violations() []FieldViolation {
+	var vs []FieldViolation
+
+	for _, err := range ed.Errs {
+		// ErrCards only says that some card failed, which the violations of
+		// the cards below tell better
+		if err == ErrCards {
+			continue
+		}
+
+		vs = append(vs, FieldViolation{Field: deckFields[err], Err: err})
+	}
+
+	for _, ec := range ed.ErroredCards {
+		vs = append(vs, ec.Violations(fmt.Sprintf("cards[%d].", ec.Index))...)
+	}
+
+	return vs
+}
+
+// RowViolations is like Violations, but prefixes the fields of cards by the
+// row of the file they were imported from rather than by their position.
+func (ed ErroredDeck) RowViolations() []FieldViolation {
+	var vs []FieldViolation
+
+	for _, v := range ed.Violations() {
+		if !strings.HasPrefix(v.Field, "cards[") {
+			vs = append(vs, v)
+		}
+	}
+
+	for _, ec := range ed.ErroredCards {
+		vs = append(vs, ec.Violations(fmt.Sprintf("rows[%d].", ec.Row))...)
+	}
+
+	return vs
+}
+
+// Violations returns the errors of the card by field, each field path
+// prefixed by prefix.
+func (ec ErroredCard) Violations(prefix string) []FieldViolation {
+	vs := make([]FieldViolation, 0, len(ec.Errs))
+	for _, err := range ec.Errs {
+		// Errors about no field in particular are about the card as a whole
+		field := strings.TrimSuffix(prefix, ".")
+		if f, ok := cardFields[err]; ok {
+			field = prefix + f
+		}
+
+		vs = append(vs, FieldViolation{Field: field, Err: err})
+	}
+
+	return vs
+}
@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/XaviFP/toshokan/deck/api/proto/v1"
+	"github.com/XaviFP/toshokan/deck/internal/deck"
+)
+
+// userRequest is implemented by every request that carries the calling user.
+type userRequest interface {
+	GetUserId() string
+}
+
+// deckAccess is the role a method needs on the deck a request is about, and
+// how to find the ID of that deck in the request.
+type deckAccess struct {
+	role   string
+	deckID func(req any) string
+}
+
+// deckAccesses lists the methods checked by the authorize interceptor.
+// Methods that load the deck anyway, such as GetDeck or ExportDeck, check
+// the role of the user themselves with requireRole.
+var deckAccesses = map[string]deckAccess{
+	"/deck.v1.DecksAPI/DeleteDeck": {deck.RoleOwner, func(req any) string { return req.(*pb.DeleteDeckRequest).GetId() }},
+	"/deck.v1.DecksAPI/UpdateDeck": {deck.RoleEditor, func(req any) string { return req.(*pb.UpdateDeckRequest).GetId() }},
+
+	"/deck.v1.DecksAPI/CreateCard":   {deck.RoleEditor, func(req any) string { return req.(*pb.CreateCardRequest).GetCard().GetDeckId() }},
+	"/deck.v1.DecksAPI/UpdateCard":   {deck.RoleEditor, func(req any) string { return req.(*pb.UpdateCardRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/UpdateAnswer": {deck.RoleEditor, func(req any) string { return req.(*pb.UpdateAnswerRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/DeleteCard":   {deck.RoleEditor, func(req any) string { return req.(*pb.DeleteCardRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/CreateAnswer": {deck.RoleEditor, func(req any) string { return req.(*pb.CreateAnswerRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/DeleteAnswer": {deck.RoleEditor, func(req any) string { return req.(*pb.DeleteAnswerRequest).GetDeckId() }},
+
+	"/deck.v1.DecksAPI/RestoreCardRevision": {deck.RoleEditor, func(req any) string { return req.(*pb.RestoreCardRevisionRequest).GetDeckId() }},
+
+	"/deck.v1.DecksAPI/ListDeckMembers":      {deck.RoleViewer, func(req any) string { return req.(*pb.ListDeckMembersRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/InviteDeckMember":     {deck.RoleOwner, func(req any) string { return req.(*pb.InviteDeckMemberRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/ChangeDeckMemberRole": {deck.RoleOwner, func(req any) string { return req.(*pb.ChangeDeckMemberRoleRequest).GetDeckId() }},
+	"/deck.v1.DecksAPI/RemoveDeckMember":     {deck.RoleOwner, func(req any) string { return req.(*pb.RemoveDeckMemberRequest).GetDeckId() }},
+}
+
+// ownerChanges tells, for the methods deckAccesses lets editors call,
+// whether the request changes something only owners can change.
+var ownerChanges = map[string]func(req any) bool{
+	// Publishing a deck or making it private again is up to its owners
+	"/deck.v1.DecksAPI/UpdateDeck": func(req any) bool { return req.(*pb.UpdateDeckRequest).IsPublic != nil },
+}
+
+// authorize is a unary interceptor that checks the calling user has the
+// role deckAccesses asks for on the deck of the request, or the owner role
+// when ownerChanges says so. Other methods go through unchecked.
+func (s *Server) authorize(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	access, ok := deckAccesses[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	role := access.role
+	if ownerChange, ok := ownerChanges[info.FullMethod]; ok && ownerChange(req) {
+		role = deck.RoleOwner
+	}
+
+	var userID string
+	if r, ok := req.(userRequest); ok {
+		userID = r.GetUserId()
+	}
+
+	rawDeckID := access.deckID(req)
+	deckID, err := uuid.Parse(rawDeckID)
+	if err != nil {
+		slog.Error(info.FullMethod+": failed to parse deck ID", "error", err, "deckId", rawDeckID, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	d, err := s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error(info.FullMethod+": failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	if err := s.requireRole(ctx, info.FullMethod, d, userID, role); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// deckRole returns the role of the user on the deck: owner for its author,
+// the role they were given for its members, and "" for everyone else.
+func (s *Server) deckRole(ctx context.Context, d deck.Deck, userID string) (string, error) {
+	if userID == "" {
+		return "", nil
+	}
+
+	if d.AuthorID.String() == userID {
+		return deck.RoleOwner, nil
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return "", nil
+	}
+
+	m, err := s.Repository.GetDeckMember(ctx, d.ID, id)
+	if errors.Cause(err) == deck.ErrMemberNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	return m.Role, nil
+}
+
+// requireRole checks the user has at least the required role on the deck.
+func (s *Server) requireRole(ctx context.Context, method string, d deck.Deck, userID, required string) error {
+	role, err := s.deckRole(ctx, d, userID)
+	if err != nil {
+		slog.Error(method+": failed to get the role of the user", "error", err, "deckId", d.ID.String(), "userId", userID, "stack", errors.ErrorStack(err))
+		return errors.Trace(err)
+	}
+
+	if !deck.RoleAllows(role, required) {
+		slog.Error(method+": deck access denied", "deckId", d.ID.String(), "authorId", d.AuthorID.String(), "requestUserId", userID, "role", role, "required", required)
+		return statusError(codes.PermissionDenied, deck.ErrNotAllowed)
+	}
+
+	return nil
+}
+
+// authorizeDeck parses the deck ID of a request, reads the deck and checks
+// the user has at least the required role on it, anyone being a viewer of a
+// public deck. It returns the deck, cards included, and the role of the user.
+func (s *Server) authorizeDeck(ctx context.Context, method, rawDeckID, userID, required string) (deck.Deck, string, error) {
+	deckID, err := uuid.Parse(rawDeckID)
+	if err != nil {
+		slog.Error(method+": failed to parse deck ID", "error", err, "deckId", rawDeckID, "stack", errors.ErrorStack(err))
+		return deck.Deck{}, "", invalidIDError("deck_id")
+	}
+
+	d, err := s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return deck.Deck{}, "", statusError(codes.NotFound, err)
+		}
+		slog.Error(method+": failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return deck.Deck{}, "", errors.Trace(err)
+	}
+
+	role, err := s.deckRole(ctx, d, userID)
+	if err != nil {
+		slog.Error(method+": failed to get the role of the user", "error", err, "deckId", deckID.String(), "userId", userID, "stack", errors.ErrorStack(err))
+		return deck.Deck{}, "", errors.Trace(err)
+	}
+
+	if d.Public && required == deck.RoleViewer {
+		return d, role, nil
+	}
+
+	if !deck.RoleAllows(role, required) {
+		slog.Error(method+": deck access denied", "deckId", deckID.String(), "authorId", d.AuthorID.String(), "requestUserId", userID, "role", role, "required", required)
+		return deck.Deck{}, "", statusError(codes.PermissionDenied, deck.ErrNotAllowed)
+	}
+
+	return d, role, nil
+}
@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/XaviFP/toshokan/deck/api/proto/v1"
+	"github.com/XaviFP/toshokan/deck/internal/deck"
+)
+
+func TestServer_Authorize(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	otherID := uuid.MustParse("7ce8a8c0-3b8a-4d0e-8f6e-1b1d0f6a2c11")
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pb.DeleteDeckResponse{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/DeleteDeck"}
+
+	t.Run("author", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+
+		res, err := srv.authorize(context.Background(), &pb.DeleteDeckRequest{Id: deckID.String(), UserId: authorID.String()}, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.DeleteDeckResponse{}, res)
+	})
+
+	t.Run("other_user", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, otherID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.authorize(context.Background(), &pb.DeleteDeckRequest{Id: deckID.String(), UserId: otherID.String()}, info, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, deck.ErrNotAllowed.Error(), status.Convert(err).Message())
+	})
+
+	t.Run("missing_user", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+
+		_, err := srv.authorize(context.Background(), &pb.UpdateCardRequest{DeckId: deckID.String()}, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/UpdateCard"}, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("card_of_other_users_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, otherID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		req := &pb.CreateCardRequest{Card: &pb.Card{DeckId: deckID.String()}, UserId: otherID.String()}
+		_, err := srv.authorize(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/CreateCard"}, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("restore_revision_of_other_users_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID, Public: true}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, otherID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		req := &pb.RestoreCardRevisionRequest{DeckId: deckID.String(), CardId: uuid.NewString(), Revision: 1, UserId: otherID.String()}
+		_, err := srv.authorize(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/RestoreCardRevision"}, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("editor", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, otherID).Return(deck.Member{DeckID: deckID, UserID: otherID, Role: deck.RoleEditor}, nil)
+
+		req := &pb.CreateCardRequest{Card: &pb.Card{DeckId: deckID.String()}, UserId: otherID.String()}
+		_, err := srv.authorize(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/CreateCard"}, handler)
+		assert.NoError(t, err)
+
+		_, err = srv.authorize(context.Background(), &pb.DeleteDeckRequest{Id: deckID.String(), UserId: otherID.String()}, info, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("editor_changing_visibility", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, otherID).Return(deck.Member{DeckID: deckID, UserID: otherID, Role: deck.RoleEditor}, nil)
+		updateDeck := &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/UpdateDeck"}
+
+		title := "Norse Mythology"
+		_, err := srv.authorize(context.Background(), &pb.UpdateDeckRequest{Id: deckID.String(), Title: &title, UserId: otherID.String()}, updateDeck, handler)
+		assert.NoError(t, err)
+
+		public := true
+		_, err = srv.authorize(context.Background(), &pb.UpdateDeckRequest{Id: deckID.String(), IsPublic: &public, UserId: otherID.String()}, updateDeck, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = srv.authorize(context.Background(), &pb.UpdateDeckRequest{Id: deckID.String(), IsPublic: &public, UserId: authorID.String()}, updateDeck, handler)
+		assert.NoError(t, err)
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, otherID).Return(deck.Member{DeckID: deckID, UserID: otherID, Role: deck.RoleViewer}, nil)
+
+		req := &pb.ListDeckMembersRequest{DeckId: deckID.String(), UserId: otherID.String()}
+		_, err := srv.authorize(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/ListDeckMembers"}, handler)
+		assert.NoError(t, err)
+
+		update := &pb.UpdateCardRequest{DeckId: deckID.String(), UserId: otherID.String()}
+		_, err = srv.authorize(context.Background(), update, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/UpdateCard"}, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("deck_not_found", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{}, deck.ErrDeckNotFound)
+
+		_, err := srv.authorize(context.Background(), &pb.DeleteDeckRequest{Id: deckID.String(), UserId: authorID.String()}, info, handler)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("unchecked_method", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.authorize(context.Background(), &pb.GetDeckRequest{DeckId: deckID.String()}, &grpc.UnaryServerInfo{FullMethod: "/deck.v1.DecksAPI/GetDeck"}, handler)
+		assert.NoError(t, err)
+		repoMock.AssertNotCalled(t, "GetDeck", mock.Anything, mock.Anything)
+	})
+}
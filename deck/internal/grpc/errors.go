@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/XaviFP/toshokan/deck/internal/deck"
+)
+
+// errorDomain is the domain of the ErrorInfo details this service attaches
+// to its errors.
+const errorDomain = "deck.toshokan"
+
+// reasonInvalidID is the reason of the errors of malformed IDs, which don't
+// come from the deck package.
+const reasonInvalidID = "INVALID_ID"
+
+// statusError returns a status with code and the message of err. Errors of
+// the deck package carry their key as the reason of an ErrorInfo detail, and
+// violations, if any, go in a BadRequest detail, so clients can tell errors
+// apart without parsing their messages.
+func statusError(code codes.Code, err error, violations ...deck.FieldViolation) error {
+	key, _ := deck.KeyOf(err)
+
+	fields := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+	for _, v := range violations {
+		reason, _ := deck.KeyOf(v.Err)
+		fields = append(fields, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Err.Error(),
+			Reason:      string(reason),
+		})
+	}
+
+	return withDetails(status.New(code, err.Error()), string(key), fields)
+}
+
+// invalidIDError returns the error of a request whose field holds a
+// malformed ID, like "invalid deck id" for deck_id or for cards[2].deck_id.
+func invalidIDError(field string) error {
+	name := field[strings.LastIndex(field, ".")+1:]
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+
+	message := "invalid " + strings.ReplaceAll(name, "_", " ")
+
+	return withDetails(status.New(codes.InvalidArgument, message), reasonInvalidID, []*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: message, Reason: reasonInvalidID},
+	})
+}
+
+// withDetails attaches an ErrorInfo with reason, unless it's empty, and a
+// BadRequest with fields, unless there are none, to st.
+func withDetails(st *status.Status, reason string, fields []*errdetails.BadRequest_FieldViolation) error {
+	var details []protoadapt.MessageV1
+
+	if reason != "" {
+		details = append(details, &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain})
+	}
+
+	if len(fields) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: fields})
+	}
+
+	if len(details) == 0 {
+		return st.Err()
+	}
+
+	// Details only fail to be attached to OK statuses, which are never errors
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// fieldError returns an InvalidArgument status for err, found in field.
+func fieldError(err error, field string) error {
+	return statusError(codes.InvalidArgument, err, deck.FieldViolation{Field: field, Err: err})
+}
+
+// tagViolations returns errs, the errors of validating the tags of a deck,
+// as violations of its tags.
+func tagViolations(errs []error) []deck.FieldViolation {
+	vs := make([]deck.FieldViolation, 0, len(errs))
+	for _, err := range errs {
+		vs = append(vs, deck.FieldViolation{Field: "tags", Err: err})
+	}
+
+	return vs
+}
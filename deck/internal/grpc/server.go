@@ -1,15 +1,19 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"log/slog"
 	"net"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/juju/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/XaviFP/toshokan/common/pagination"
 	pb "github.com/XaviFP/toshokan/deck/api/proto/v1"
@@ -26,7 +30,11 @@ type Server struct {
 }
 
 func (s *Server) Start() error {
-	s.grpcServer = grpc.NewServer()
+	s.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(s.authorize),
+		grpc.MaxRecvMsgSize(pb.MaxMessageSize),
+		grpc.MaxSendMsgSize(pb.MaxMessageSize),
+	)
 	pb.RegisterDecksAPIServer(s.grpcServer, s)
 
 	listener, err := net.Listen(s.GRPCTransport, s.GRPCAddr)
@@ -47,30 +55,19 @@ func (s *Server) Stop() {
 }
 
 func (s *Server) GetDeck(ctx context.Context, req *pb.GetDeckRequest) (*pb.GetDeckResponse, error) {
-	deckID, err := uuid.Parse(req.DeckId)
-	if err != nil {
-		slog.Error("GetDeck: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
-		return &pb.GetDeckResponse{}, errors.Trace(err)
-	}
-
-	d, err := s.Repository.GetDeck(ctx, deckID)
+	// TODO: Provide an "internal" GetDeck that doesn't require authorization/ownership check
+	// For services that need to access decks regardless of public/private status
+	d, role, err := s.authorizeDeck(ctx, "GetDeck", req.DeckId, req.UserId, deck.RoleViewer)
 	if err != nil {
-		if errors.Cause(err) == deck.ErrDeckNotFound {
-			slog.Error("GetDeck: deck not found", "error", err, "deckId", deckID.String())
-			return &pb.GetDeckResponse{}, status.Error(codes.NotFound, errors.Trace(err).Error())
-		}
-		slog.Error("GetDeck: failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
-		return &pb.GetDeckResponse{}, errors.Trace(err)
+		return &pb.GetDeckResponse{}, err
 	}
 
-	// TODO: Provide an "internal" GetDeck that doesn't require authorization/ownership check
-	// For services that need to access decks regardless of public/private status
-	if (d.AuthorID.String() != req.UserId) && !d.Public {
-		slog.Error("GetDeck: private deck access denied", "deckId", deckID.String(), "authorId", d.AuthorID.String(), "requestUserId", req.UserId)
-		return nil, errors.New("private deck access denied")
+	// Correct answers are only shown to those who can edit the deck
+	if !deck.RoleAllows(role, deck.RoleEditor) {
+		d = d.LearnerView()
 	}
 
-	return &pb.GetDeckResponse{Deck: toGRPCDeck(d)}, nil
+	return &pb.GetDeckResponse{Deck: toGRPCDeck(d), Role: role}, nil
 }
 
 func (s *Server) GetDecks(ctx context.Context, req *pb.GetDecksRequest) (*pb.GetDecksResponse, error) {
@@ -80,7 +77,7 @@ func (s *Server) GetDecks(ctx context.Context, req *pb.GetDecksRequest) (*pb.Get
 		id, err := uuid.Parse(idStr)
 		if err != nil {
 			slog.Error("GetDecks: failed to parse deck ID", "error", err, "deckId", idStr, "stack", errors.ErrorStack(err))
-			return nil, errors.Trace(err)
+			return nil, invalidIDError("deck_ids")
 		}
 
 		ids = append(ids, id)
@@ -92,24 +89,72 @@ func (s *Server) GetDecks(ctx context.Context, req *pb.GetDecksRequest) (*pb.Get
 		return &pb.GetDecksResponse{}, errors.Trace(err)
 	}
 
-	out := make(map[string]*pb.Deck, len(decks))
+	visible, err := s.visibleDecks(ctx, "GetDecks", decks, req.UserId, req.WithAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*pb.Deck, len(visible))
+
+	for id, editable := range visible {
+		d := decks[id]
+		if !editable {
+			d = d.LearnerView()
+		}
 
-	for id, deck := range decks {
-		out[id.String()] = toGRPCDeck(deck)
+		out[id.String()] = toGRPCDeck(d)
 	}
 
 	return &pb.GetDecksResponse{Decks: out}, nil
 }
 
+// visibleDecks returns the decks the user can see, like GetDeck does, and
+// whether their answers are shown, which they are when asked for to those
+// who can edit the deck. Private decks the user isn't a member of are left
+// out.
+func (s *Server) visibleDecks(ctx context.Context, method string, decks map[uuid.UUID]deck.Deck, userID string, withAnswers bool) (map[uuid.UUID]bool, error) {
+	out := make(map[uuid.UUID]bool, len(decks))
+
+	for id, d := range decks {
+		// The role only matters to private decks and to answers
+		if d.Public && !withAnswers {
+			out[id] = false
+			continue
+		}
+
+		role, err := s.deckRole(ctx, d, userID)
+		if err != nil {
+			slog.Error(method+": failed to get the role of the user", "error", err, "deckId", id.String(), "userId", userID, "stack", errors.ErrorStack(err))
+			return nil, errors.Trace(err)
+		}
+
+		if !d.Public && !deck.RoleAllows(role, deck.RoleViewer) {
+			continue
+		}
+
+		out[id] = withAnswers && deck.RoleAllows(role, deck.RoleEditor)
+	}
+
+	return out, nil
+}
+
 func (s *Server) GetPopularDecks(ctx context.Context, req *pb.GetPopularDecksRequest) (*pb.GetPopularDecksResponse, error) {
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		slog.Error("GetPopularDecks: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		return nil, invalidIDError("user_id")
+	}
+
+	period, err := deck.ParsePeriod(req.Period)
+	if err != nil {
+		return nil, statusError(codes.InvalidArgument, err)
 	}
 
-	res, err := s.Repository.GetPopularDecks(ctx, userID, paginationFromProto(req.Pagination))
+	res, err := s.Repository.GetPopularDecks(ctx, userID, period, deck.NormalizeTags(req.Tags), paginationFromProto(req.Pagination))
 	if err != nil {
+		if cause := errors.Cause(err); cause == deck.ErrInvalidCursor || cause == deck.ErrExpiredCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
 		slog.Error("GetPopularDecks: failed to get popular decks", "error", err, "userId", userID.String(), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
@@ -119,257 +164,1803 @@ func (s *Server) GetPopularDecks(ctx context.Context, req *pb.GetPopularDecksReq
 	}, nil
 }
 
+func (s *Server) SearchDecks(ctx context.Context, req *pb.SearchDecksRequest) (*pb.SearchDecksResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("SearchDecks: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, fieldError(deck.ErrEmptyQuery, "query")
+	}
+
+	if req.Language != "" && !deck.IsSearchLanguage(req.Language) {
+		return nil, fieldError(deck.ErrInvalidLanguage, "language")
+	}
+
+	res, err := s.Repository.SearchDecks(ctx, userID, query, req.Language, deck.NormalizeTags(req.Tags), paginationFromProto(req.Pagination))
+	if err != nil {
+		if errors.Cause(err) == deck.ErrInvalidCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
+		slog.Error("SearchDecks: failed to search decks", "error", err, "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.SearchDecksResponse{
+		Connection: searchConnectionToProto(res),
+	}, nil
+}
+
 func (s *Server) CreateCard(ctx context.Context, req *pb.CreateCardRequest) (*pb.CreateCardResponse, error) {
 	deckID, err := uuid.Parse(req.Card.DeckId)
 	if err != nil {
 		slog.Error("CreateCard: failed to parse deck ID", "error", err, "deckId", req.Card.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card.deck_id")
+	}
+
+	card, err := fromGRPCCard(req.Card, "card")
+	if err != nil {
+		slog.Error("CreateCard: failed to convert card from gRPC", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, err
+	}
+
+	isValid, ec := deck.ValidateCard(card)
+	if !isValid {
+		return &pb.CreateCardResponse{}, statusError(codes.InvalidArgument, deck.ErrCardInvalid, ec.Violations("")...)
+	}
+
+	// Check if deck exists
+	_, err = s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			slog.Error("CreateCard: deck not found", "error", err, "deckId", deckID.String())
+			return &pb.CreateCardResponse{}, statusError(codes.NotFound, err)
+		}
+		slog.Error("CreateCard: failed to get deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return &pb.CreateCardResponse{}, errors.Trace(err)
+	}
+
+	card.GenerateUUIDs()
+
+	err = s.Repository.StoreCard(ctx, card, deckID)
+	if err != nil {
+		slog.Error("CreateCard: failed to store card", "error", err, "deckId", deckID.String(), "cardId", card.ID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.CreateCardResponse{Card: toGRPCCard(card)}, nil
+}
+
+func (s *Server) GetCards(ctx context.Context, req *pb.GetCardsRequest) (*pb.GetCardsResponse, error) {
+	var ids []uuid.UUID
+
+	for _, idStr := range req.CardIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			slog.Error("GetCards: failed to parse card ID", "error", err, "cardId", idStr, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError("card_ids")
+		}
+
+		ids = append(ids, id)
+	}
+
+	cards, err := s.Repository.GetCards(ctx, ids)
+	if err != nil {
+		slog.Error("GetCards: failed to get cards from repository", "error", err, "cardCount", len(ids), "stack", errors.ErrorStack(err))
+		return &pb.GetCardsResponse{}, errors.Trace(err)
+	}
+
+	visible, err := s.visibleCards(ctx, cards, req.UserId, req.WithAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*pb.Card, len(visible))
+
+	for id, editable := range visible {
+		c := cards[id]
+		if !editable {
+			c = c.LearnerView()
+		}
+
+		out[id.String()] = toGRPCCard(c)
+	}
+
+	return &pb.GetCardsResponse{Cards: out}, nil
+}
+
+// visibleCards returns the cards of the decks the user can see and whether
+// their answers are shown, as visibleDecks does for their decks.
+func (s *Server) visibleCards(ctx context.Context, cards map[uuid.UUID]deck.Card, userID string, withAnswers bool) (map[uuid.UUID]bool, error) {
+	out := make(map[uuid.UUID]bool, len(cards))
+	if len(cards) == 0 {
+		return out, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(cards))
+	for id := range cards {
+		ids = append(ids, id)
+	}
+
+	cardDecks, err := s.Repository.GetCardDecks(ctx, ids)
+	if err != nil {
+		slog.Error("GetCards: failed to get the decks of the cards", "error", err, "cardCount", len(ids), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	deckIDs := make([]uuid.UUID, 0, len(cardDecks))
+	seen := make(map[uuid.UUID]struct{}, len(cardDecks))
+	for _, deckID := range cardDecks {
+		if _, ok := seen[deckID]; ok {
+			continue
+		}
+
+		seen[deckID] = struct{}{}
+		deckIDs = append(deckIDs, deckID)
+	}
+
+	decks, err := s.Repository.GetDecks(ctx, deckIDs)
+	if err != nil {
+		slog.Error("GetCards: failed to get decks from repository", "error", err, "deckCount", len(deckIDs), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	visibleDecks, err := s.visibleDecks(ctx, "GetCards", decks, userID, withAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	for cardID, deckID := range cardDecks {
+		if editable, ok := visibleDecks[deckID]; ok {
+			out[cardID] = editable
+		}
+	}
+
+	return out, nil
+}
+
+// defaultCardBatchSize and maxCardBatchSize bound the cards StreamDeckCards
+// reads and sends at once.
+const (
+	defaultCardBatchSize = 100
+	maxCardBatchSize     = 500
+)
+
+// defaultCardPageSize and maxCardPageSize bound the pages of cards
+// GetDeckCards and GetDecksCards read.
+const (
+	defaultCardPageSize = 20
+	maxCardPageSize     = 100
+)
+
+func (s *Server) GetDeckCards(ctx context.Context, req *pb.GetDeckCardsRequest) (*pb.GetDeckCardsResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("GetDeckCards: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	learnerView, err := s.deckCardsView(ctx, "GetDeckCards", deckID, req.UserId, req.WithAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	p := paginationFromProto(req.Pagination)
+	if p.First <= 0 && p.Last <= 0 {
+		p.First = defaultCardPageSize
+	}
+	p.First = min(p.First, maxCardPageSize)
+	p.Last = min(p.Last, maxCardPageSize)
+
+	conn, err := s.deckCardsPage(ctx, "GetDeckCards", deckID, p, learnerView)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetDeckCardsResponse{Connection: conn}, nil
+}
+
+// GetDecksCards reads the first page of cards of each of the decks the user
+// can see with a query for all of them, and their cards with another.
+func (s *Server) GetDecksCards(ctx context.Context, req *pb.GetDecksCardsRequest) (*pb.GetDecksCardsResponse, error) {
+	ids := make([]uuid.UUID, 0, len(req.DeckIds))
+
+	for i, idStr := range req.DeckIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			slog.Error("GetDecksCards: failed to parse deck ID", "error", err, "deckId", idStr, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError(fmt.Sprintf("deck_ids[%d]", i))
+		}
+
+		ids = append(ids, id)
+	}
+
+	first := int(req.First)
+	if first <= 0 {
+		first = defaultCardPageSize
+	}
+	first = min(first, maxCardPageSize)
+
+	decks, err := s.Repository.GetDecks(ctx, ids)
+	if err != nil {
+		slog.Error("GetDecksCards: failed to get decks from repository", "error", err, "deckCount", len(ids), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	visible, err := s.visibleDecks(ctx, "GetDecksCards", decks, req.UserId, req.WithAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	visibleIDs := make([]uuid.UUID, 0, len(visible))
+	learnerViews := make(map[uuid.UUID]bool, len(visible))
+	for id, editable := range visible {
+		visibleIDs = append(visibleIDs, id)
+		learnerViews[id] = !editable
+	}
+
+	pages, err := s.Repository.GetDecksFirstCards(ctx, visibleIDs, first)
+	if err != nil {
+		slog.Error("GetDecksCards: failed to get pages of cards", "error", err, "deckCount", len(visibleIDs), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	conns, err := s.cardsConnections(ctx, "GetDecksCards", pages, learnerViews)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*pb.CardsConnection, len(visibleIDs))
+	for _, id := range visibleIDs {
+		conn, ok := conns[id]
+		if !ok {
+			// Decks without cards have no page
+			conn = &pb.CardsConnection{PageInfo: &pb.PageInfo{}}
+		}
+
+		out[id.String()] = conn
+	}
+
+	return &pb.GetDecksCardsResponse{Connections: out}, nil
+}
+
+// StreamDeckCards sends the cards of a deck in batches, reading a batch at a
+// time, so that neither the messages nor the memory used grow with the size
+// of the deck.
+func (s *Server) StreamDeckCards(req *pb.StreamDeckCardsRequest, stream pb.DecksAPI_StreamDeckCardsServer) error {
+	ctx := stream.Context()
+
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("StreamDeckCards: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return invalidIDError("deck_id")
+	}
+
+	learnerView, err := s.deckCardsView(ctx, "StreamDeckCards", deckID, req.UserId, req.WithAnswers)
+	if err != nil {
+		return err
+	}
+
+	batchSize := int(req.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultCardBatchSize
+	}
+	batchSize = min(batchSize, maxCardBatchSize)
+
+	p := pagination.Pagination{First: batchSize}
+	for {
+		conn, err := s.deckCardsPage(ctx, "StreamDeckCards", deckID, p, learnerView)
+		if err != nil {
+			return err
+		}
+
+		if len(conn.Edges) > 0 {
+			cards := make([]*pb.Card, 0, len(conn.Edges))
+			for _, e := range conn.Edges {
+				cards = append(cards, e.Node)
+			}
+
+			if err := stream.Send(&pb.StreamDeckCardsResponse{Cards: cards}); err != nil {
+				return errors.Trace(err)
+			}
+		}
+
+		if !conn.PageInfo.HasNextPage {
+			return nil
+		}
+
+		p.After = pagination.Cursor(conn.PageInfo.EndCursor)
+	}
+}
+
+// deckCardsView checks the user can read the cards of the deck, like
+// GetDeck does, and reports whether they only get to see the learner view
+// of them, which they do unless they ask for the answers and can edit the
+// deck. Only the deck itself is read, not its cards.
+func (s *Server) deckCardsView(ctx context.Context, method string, deckID uuid.UUID, userID string, withAnswers bool) (bool, error) {
+	decks, err := s.Repository.GetDecks(ctx, []uuid.UUID{deckID})
+	if err != nil {
+		slog.Error(method+": failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return false, errors.Trace(err)
+	}
+
+	d, ok := decks[deckID]
+	if !ok {
+		return false, statusError(codes.NotFound, deck.ErrDeckNotFound)
+	}
+
+	role, err := s.deckRole(ctx, d, userID)
+	if err != nil {
+		slog.Error(method+": failed to get the role of the user", "error", err, "deckId", deckID.String(), "userId", userID, "stack", errors.ErrorStack(err))
+		return false, errors.Trace(err)
+	}
+
+	if !d.Public && !deck.RoleAllows(role, deck.RoleViewer) {
+		slog.Error(method+": private deck access denied", "deckId", deckID.String(), "authorId", d.AuthorID.String(), "requestUserId", userID)
+		return false, statusError(codes.PermissionDenied, deck.ErrNotAllowed)
+	}
+
+	return !withAnswers || !deck.RoleAllows(role, deck.RoleEditor), nil
+}
+
+// deckCardsPage reads a page of the cards of a deck, answers included.
+func (s *Server) deckCardsPage(ctx context.Context, method string, deckID uuid.UUID, p pagination.Pagination, learnerView bool) (*pb.CardsConnection, error) {
+	page, err := s.Repository.GetDeckCardsPage(ctx, deckID, p)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrInvalidCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
+		slog.Error(method+": failed to get page of cards", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	conns, err := s.cardsConnections(ctx, method, map[uuid.UUID]deck.DeckCardsConnection{deckID: page}, map[uuid.UUID]bool{deckID: learnerView})
+	if err != nil {
+		return nil, err
+	}
+
+	return conns[deckID], nil
+}
+
+// cardsConnections reads the cards of pages of several decks at once, in
+// the learner view for the decks learnerViews says so.
+func (s *Server) cardsConnections(ctx context.Context, method string, pages map[uuid.UUID]deck.DeckCardsConnection, learnerViews map[uuid.UUID]bool) (map[uuid.UUID]*pb.CardsConnection, error) {
+	var ids []uuid.UUID
+	for _, page := range pages {
+		for _, e := range page.Edges {
+			ids = append(ids, e.CardID)
+		}
+	}
+
+	cards, err := s.Repository.GetCards(ctx, ids)
+	if err != nil {
+		slog.Error(method+": failed to get cards from repository", "error", err, "deckCount", len(pages), "cardCount", len(ids), "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
-	card, err := fromGRPCCard(req.Card)
+	out := make(map[uuid.UUID]*pb.CardsConnection, len(pages))
+
+	for deckID, page := range pages {
+		conn := &pb.CardsConnection{
+			PageInfo: &pb.PageInfo{
+				HasPreviousPage: page.PageInfo.HasPreviousPage,
+				HasNextPage:     page.PageInfo.HasNextPage,
+				StartCursor:     string(page.PageInfo.StartCursor),
+				EndCursor:       string(page.PageInfo.EndCursor),
+			},
+		}
+
+		for _, e := range page.Edges {
+			c, ok := cards[e.CardID]
+			if !ok {
+				// Deleted between reading the page and its cards
+				continue
+			}
+
+			if learnerViews[deckID] {
+				c = c.LearnerView()
+			}
+
+			conn.Edges = append(conn.Edges, &pb.CardsConnection_Edge{Node: toGRPCCard(c), Cursor: string(e.Cursor)})
+		}
+
+		out[deckID] = conn
+	}
+
+	return out, nil
+}
+
+func (s *Server) GradeAnswers(ctx context.Context, req *pb.GradeAnswersRequest) (*pb.GradeAnswersResponse, error) {
+	answers, err := fromGRPCCardAnswers(req.Answers)
+	if err != nil {
+		slog.Error("GradeAnswers: failed to convert answers from gRPC", "error", err, "answerCount", len(req.Answers), "stack", errors.ErrorStack(err))
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(answers))
+	ids := make([]uuid.UUID, 0, len(answers))
+
+	for _, a := range answers {
+		if _, ok := seen[a.CardID]; ok {
+			continue
+		}
+
+		seen[a.CardID] = struct{}{}
+		ids = append(ids, a.CardID)
+	}
+
+	cards, err := s.Repository.GetCards(ctx, ids)
+	if err != nil {
+		slog.Error("GradeAnswers: failed to get cards from repository", "error", err, "cardCount", len(ids), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	grades, err := deck.GradeAnswers(cards, answers)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrCardNotFound {
+			slog.Error("GradeAnswers: card not found", "error", err)
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("GradeAnswers: failed to grade answers", "error", err, "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	out := make([]*pb.CardGrade, 0, len(grades))
+	for _, g := range grades {
+		out = append(out, &pb.CardGrade{
+			CardId:      g.CardID.String(),
+			IsCorrect:   g.IsCorrect,
+			Explanation: g.Explanation,
+		})
+	}
+
+	return &pb.GradeAnswersResponse{Grades: out}, nil
+}
+
+func paginationFromProto(p *pb.Pagination) pagination.Pagination {
+	return pagination.Pagination{
+		Before: pagination.Cursor(p.GetBefore()),
+		After:  pagination.Cursor(p.GetAfter()),
+		First:  int(p.GetFirst()),
+		Last:   int(p.GetLast()),
+	}
+}
+
+func connectionToProto(conn deck.PopularDecksConnection) *pb.PopularDecksConnection {
+	var edges []*pb.PopularDecksConnection_Edge
+
+	for _, e := range conn.Edges {
+		edges = append(edges, &pb.PopularDecksConnection_Edge{
+			DeckId: e.DeckID.String(),
+			Cursor: string(e.Cursor),
+		})
+	}
+
+	return &pb.PopularDecksConnection{
+		Edges: edges,
+		PageInfo: &pb.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     string(conn.PageInfo.StartCursor),
+			EndCursor:       string(conn.PageInfo.EndCursor),
+		},
+	}
+}
+
+func searchConnectionToProto(conn deck.SearchDecksConnection) *pb.SearchDecksConnection {
+	var edges []*pb.SearchDecksConnection_Edge
+
+	for _, e := range conn.Edges {
+		edges = append(edges, &pb.SearchDecksConnection_Edge{
+			DeckId: e.DeckID.String(),
+			Cursor: string(e.Cursor),
+			Rank:   e.Rank,
+		})
+	}
+
+	return &pb.SearchDecksConnection{
+		Edges: edges,
+		PageInfo: &pb.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     string(conn.PageInfo.StartCursor),
+			EndCursor:       string(conn.PageInfo.EndCursor),
+		},
+	}
+}
+
+func sharedConnectionToProto(conn deck.SharedDecksConnection) *pb.SharedDecksConnection {
+	var edges []*pb.SharedDecksConnection_Edge
+
+	for _, e := range conn.Edges {
+		edges = append(edges, &pb.SharedDecksConnection_Edge{
+			DeckId: e.DeckID.String(),
+			Cursor: string(e.Cursor),
+			Role:   e.Role,
+		})
+	}
+
+	return &pb.SharedDecksConnection{
+		Edges: edges,
+		PageInfo: &pb.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     string(conn.PageInfo.StartCursor),
+			EndCursor:       string(conn.PageInfo.EndCursor),
+		},
+	}
+}
+
+func reviewsConnectionToProto(conn deck.ReviewsConnection) *pb.ReviewsConnection {
+	var edges []*pb.ReviewsConnection_Edge
+
+	for _, e := range conn.Edges {
+		edges = append(edges, &pb.ReviewsConnection_Edge{
+			Node:   toGRPCDeckRating(e.Rating),
+			Cursor: string(e.Cursor),
+		})
+	}
+
+	return &pb.ReviewsConnection{
+		Edges: edges,
+		PageInfo: &pb.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     string(conn.PageInfo.StartCursor),
+			EndCursor:       string(conn.PageInfo.EndCursor),
+		},
+	}
+}
+
+func libraryConnectionToProto(conn deck.LibraryConnection) *pb.LibraryConnection {
+	var edges []*pb.LibraryConnection_Edge
+
+	for _, e := range conn.Edges {
+		edges = append(edges, &pb.LibraryConnection_Edge{
+			Node:   toGRPCLibraryEntry(e.Entry),
+			Cursor: string(e.Cursor),
+		})
+	}
+
+	return &pb.LibraryConnection{
+		Edges: edges,
+		PageInfo: &pb.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     string(conn.PageInfo.StartCursor),
+			EndCursor:       string(conn.PageInfo.EndCursor),
+		},
+	}
+}
+
+func authoredConnectionToProto(conn deck.AuthoredDecksConnection) *pb.AuthoredDecksConnection {
+	var edges []*pb.AuthoredDecksConnection_Edge
+
+	for _, e := range conn.Edges {
+		edges = append(edges, &pb.AuthoredDecksConnection_Edge{
+			DeckId: e.DeckID.String(),
+			Cursor: string(e.Cursor),
+		})
+	}
+
+	return &pb.AuthoredDecksConnection{
+		Edges: edges,
+		PageInfo: &pb.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     string(conn.PageInfo.StartCursor),
+			EndCursor:       string(conn.PageInfo.EndCursor),
+		},
+	}
+}
+
+func (s *Server) CreateDeck(ctx context.Context, req *pb.CreateDeckRequest) (*pb.CreateDeckResponse, error) {
+	d, err := fromGRPCDeck(req.Deck, "deck")
+	if err != nil {
+		slog.Error("CreateDeck: failed to convert deck from gRPC", "error", err, "stack", errors.ErrorStack(err))
+		return nil, err
+	}
+
+	isValid, ed := d.Validate()
+	if !isValid {
+		return &pb.CreateDeckResponse{}, statusError(codes.InvalidArgument, deck.ErrDeckInvalid, ed.Violations()...)
+	}
+
+	d.GenerateUUIDs()
+
+	if err := s.Repository.StoreDeck(ctx, d); err != nil {
+		slog.Error("CreateDeck: failed to store deck", "error", err, "deckId", d.ID.String(), "stack", errors.ErrorStack(err))
+		return &pb.CreateDeckResponse{}, errors.Trace(err)
+	}
+
+	return &pb.CreateDeckResponse{Deck: toGRPCDeck(d)}, nil
+}
+
+func (s *Server) DeleteDeck(ctx context.Context, req *pb.DeleteDeckRequest) (*pb.DeleteDeckResponse, error) {
+	deckID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("DeleteDeck: failed to parse deck ID", "error", err, "deckId", req.Id, "stack", errors.ErrorStack(err))
+		return &pb.DeleteDeckResponse{}, invalidIDError("id")
+	}
+
+	err = s.Repository.DeleteDeck(ctx, deckID)
+	if err != nil {
+		slog.Error("DeleteDeck: failed to delete deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+	}
+
+	return &pb.DeleteDeckResponse{}, errors.Trace(err)
+}
+
+func (s *Server) UpdateDeck(ctx context.Context, req *pb.UpdateDeckRequest) (*pb.UpdateDeckResponse, error) {
+	deckID, err := uuid.Parse(req.Id)
+	if err != nil {
+		slog.Error("UpdateDeck: failed to parse deck ID", "error", err, "deckId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
+	}
+
+	updates := deck.DeckUpdates{
+		Title:       req.Title,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+		Language:    req.Language,
+	}
+
+	if req.Tags != nil {
+		tags := deck.NormalizeTags(req.Tags.Tags)
+		if errs := deck.ValidateTags(tags); len(errs) > 0 {
+			return nil, statusError(codes.InvalidArgument, errs[0], tagViolations(errs)...)
+		}
+
+		updates.Tags = &tags
+	}
+
+	if req.ExpectedVersion != nil {
+		version := int(*req.ExpectedVersion)
+		updates.ExpectedVersion = &version
+	}
+
+	if !updates.HasUpdates() {
+		return nil, statusError(codes.InvalidArgument, deck.ErrNoUpdates)
+	}
+
+	if updates.Language != nil && !deck.IsSearchLanguage(*updates.Language) {
+		return nil, fieldError(deck.ErrInvalidLanguage, "language")
+	}
+
+	d, err := s.Repository.UpdateDeck(ctx, deckID, updates)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			slog.Error("UpdateDeck: deck not found", "error", err, "deckId", deckID.String())
+			return nil, statusError(codes.NotFound, err)
+		}
+		if errors.Cause(err) == deck.ErrVersionConflict {
+			return nil, statusError(codes.FailedPrecondition, deck.ErrVersionConflict)
+		}
+		slog.Error("UpdateDeck: failed to update deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.UpdateDeckResponse{Deck: toGRPCDeck(d)}, nil
+}
+
+func (s *Server) UpdateCard(ctx context.Context, req *pb.UpdateCardRequest) (*pb.UpdateCardResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("UpdateCard: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	cardID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		slog.Error("UpdateCard: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card_id")
+	}
+
+	authorID, err := parseOptionalID(req.UserId)
+	if err != nil {
+		slog.Error("UpdateCard: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	updates := deck.CardUpdates{
+		Title:       req.Title,
+		Explanation: req.Explanation,
+		Kind:        req.Kind,
+		AuthorID:    authorID,
+	}
+
+	if req.ExpectedVersion != nil {
+		version := int(*req.ExpectedVersion)
+		updates.ExpectedVersion = &version
+	}
+
+	if !updates.HasUpdates() {
+		return nil, statusError(codes.InvalidArgument, deck.ErrNoUpdates)
+	}
+
+	// Validate kind if provided
+	if updates.Kind != nil && !deck.IsValidKind(*updates.Kind) {
+		return nil, fieldError(deck.ErrInvalidKind, "kind")
+	}
+
+	c, err := s.Repository.UpdateCard(ctx, deckID, cardID, updates)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrVersionConflict {
+			return nil, statusError(codes.FailedPrecondition, deck.ErrVersionConflict)
+		}
+		if err := cardChangeStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("UpdateCard: failed to update card", "error", err, "cardId", cardID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.UpdateCardResponse{Card: toGRPCCard(c)}, nil
+}
+
+func (s *Server) UpdateAnswer(ctx context.Context, req *pb.UpdateAnswerRequest) (*pb.UpdateAnswerResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("UpdateAnswer: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	cardID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		slog.Error("UpdateAnswer: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card_id")
+	}
+
+	answerID, err := uuid.Parse(req.AnswerId)
+	if err != nil {
+		slog.Error("UpdateAnswer: failed to parse answer ID", "error", err, "answerId", req.AnswerId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("answer_id")
+	}
+
+	authorID, err := parseOptionalID(req.UserId)
+	if err != nil {
+		slog.Error("UpdateAnswer: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	updates := deck.AnswerUpdates{
+		Text:      req.Text,
+		IsCorrect: req.IsCorrect,
+		Side:      req.Side,
+		AuthorID:  authorID,
+	}
+
+	if req.GetBlank() < 0 {
+		return nil, fieldError(deck.ErrInvalidBlank, "blank")
+	}
+
+	if req.GetTolerance() < 0 {
+		return nil, fieldError(deck.ErrInvalidBlank, "tolerance")
+	}
+
+	if req.GetPosition() < 0 {
+		return nil, fieldError(deck.ErrInvalidOrder, "position")
+	}
+
+	if side := req.GetSide(); side != "" && side != deck.AnswerSideLeft && side != deck.AnswerSideRight {
+		return nil, fieldError(deck.ErrInvalidPairs, "side")
+	}
+
+	if req.Position != nil {
+		position := int(*req.Position)
+		updates.Position = &position
+	}
+
+	if req.Blank != nil {
+		blank := int(*req.Blank)
+		updates.Blank = &blank
+	}
+
+	if req.Tolerance != nil {
+		tolerance := int(*req.Tolerance)
+		updates.Tolerance = &tolerance
+	}
+
+	if !updates.HasUpdates() {
+		return nil, statusError(codes.InvalidArgument, deck.ErrNoUpdates)
+	}
+
+	a, err := s.Repository.UpdateAnswer(ctx, deckID, cardID, answerID, updates)
+	if err != nil {
+		if err := cardChangeStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("UpdateAnswer: failed to update answer", "error", err, "answerId", answerID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.UpdateAnswerResponse{Answer: &pb.Answer{
+		Id:        a.ID.String(),
+		Text:      a.Text,
+		IsCorrect: a.IsCorrect,
+		Blank:     int32(a.Blank),
+		Tolerance: int32(a.Tolerance),
+		Position:  int32(a.Position),
+		Side:      a.Side,
+	}}, nil
+}
+
+func (s *Server) DeleteCard(ctx context.Context, req *pb.DeleteCardRequest) (*pb.DeleteCardResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("DeleteCard: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	cardID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		slog.Error("DeleteCard: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card_id")
+	}
+
+	authorID, err := parseOptionalID(req.UserId)
+	if err != nil {
+		slog.Error("DeleteCard: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	if err := s.Repository.DeleteCard(ctx, deckID, cardID, authorID); err != nil {
+		if errors.Cause(err) == deck.ErrCardNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("DeleteCard: failed to delete card", "error", err, "cardId", cardID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.DeleteCardResponse{}, nil
+}
+
+func (s *Server) CreateAnswer(ctx context.Context, req *pb.CreateAnswerRequest) (*pb.CreateAnswerResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("CreateAnswer: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	cardID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		slog.Error("CreateAnswer: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card_id")
+	}
+
+	authorID, err := parseOptionalID(req.UserId)
+	if err != nil {
+		slog.Error("CreateAnswer: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	if req.Answer == nil {
+		return nil, fieldError(deck.ErrNoAnswersProvided, "answer")
+	}
+
+	a := deck.Answer{
+		ID:        uuid.New(),
+		Text:      req.Answer.Text,
+		IsCorrect: req.Answer.IsCorrect,
+		Blank:     int(req.Answer.Blank),
+		Tolerance: int(req.Answer.Tolerance),
+		Position:  int(req.Answer.Position),
+		Side:      req.Answer.Side,
+	}
+
+	c, err := s.Repository.CreateAnswer(ctx, deckID, cardID, a, authorID)
+	if err != nil {
+		if err := cardChangeStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("CreateAnswer: failed to create answer", "error", err, "cardId", cardID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.CreateAnswerResponse{Card: toGRPCCard(c)}, nil
+}
+
+func (s *Server) DeleteAnswer(ctx context.Context, req *pb.DeleteAnswerRequest) (*pb.DeleteAnswerResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("DeleteAnswer: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	cardID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		slog.Error("DeleteAnswer: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card_id")
+	}
+
+	answerID, err := uuid.Parse(req.AnswerId)
+	if err != nil {
+		slog.Error("DeleteAnswer: failed to parse answer ID", "error", err, "answerId", req.AnswerId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("answer_id")
+	}
+
+	authorID, err := parseOptionalID(req.UserId)
+	if err != nil {
+		slog.Error("DeleteAnswer: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	c, err := s.Repository.DeleteAnswer(ctx, deckID, cardID, answerID, authorID)
+	if err != nil {
+		if err := cardChangeStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("DeleteAnswer: failed to delete answer", "error", err, "answerId", answerID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.DeleteAnswerResponse{Card: toGRPCCard(c)}, nil
+}
+
+// cardValidationErrors are the reasons a card is rejected for, and that
+// changing its kind or its answers can bring about.
+var cardValidationErrors = []error{
+	deck.ErrNoTitle,
+	deck.ErrInvalidKind,
+	deck.ErrNoAnswersProvided,
+	deck.ErrNoCorrectAnswer,
+	deck.ErrNoTextAnswer,
+	deck.ErrInvalidBlank,
+	deck.ErrBlankNotAccepted,
+	deck.ErrTooFewAnswers,
+	deck.ErrTrueFalseAnswers,
+	deck.ErrInvalidOrder,
+	deck.ErrInvalidPairs,
+}
+
+// cardChangeStatus maps the errors of changing the answers of a card to a
+// gRPC status, returning nil for unexpected ones.
+func cardChangeStatus(err error) error {
+	cause := errors.Cause(err)
+
+	switch {
+	case cause == deck.ErrCardNotFound, cause == deck.ErrAnswerNotFound:
+		return statusError(codes.NotFound, err)
+	case slices.Contains(cardValidationErrors, cause):
+		return statusError(codes.InvalidArgument, cause, deck.ErroredCard{Errs: []error{cause}}.Violations("")...)
+	}
+
+	return nil
+}
+
+func (s *Server) ListCardRevisions(ctx context.Context, req *pb.ListCardRevisionsRequest) (*pb.ListCardRevisionsResponse, error) {
+	deckID, cardID, err := s.authorizeRevisions(ctx, "ListCardRevisions", req.DeckId, req.CardId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.Repository.GetCardRevisions(ctx, deckID, cardID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrCardNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("ListCardRevisions: failed to get revisions from repository", "error", err, "cardId", cardID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	out := make([]*pb.CardRevision, 0, len(revisions))
+	for _, r := range revisions {
+		out = append(out, toGRPCCardRevision(r))
+	}
+
+	return &pb.ListCardRevisionsResponse{Revisions: out}, nil
+}
+
+func (s *Server) DiffCardRevisions(ctx context.Context, req *pb.DiffCardRevisionsRequest) (*pb.DiffCardRevisionsResponse, error) {
+	deckID, cardID, err := s.authorizeRevisions(ctx, "DiffCardRevisions", req.DeckId, req.CardId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards [2]deck.Card
+	for i, revision := range []int32{req.FromRevision, req.ToRevision} {
+		r, err := s.Repository.GetCardRevision(ctx, deckID, cardID, int(revision))
+		if err != nil {
+			if cause := errors.Cause(err); cause == deck.ErrCardNotFound || cause == deck.ErrRevisionNotFound {
+				return nil, statusError(codes.NotFound, err)
+			}
+			slog.Error("DiffCardRevisions: failed to get revision from repository", "error", err, "cardId", cardID.String(), "revision", revision, "stack", errors.ErrorStack(err))
+			return nil, errors.Trace(err)
+		}
+
+		cards[i] = r.Card
+	}
+
+	changes := deck.DiffCards(cards[0], cards[1])
+
+	return &pb.DiffCardRevisionsResponse{Changes: toGRPCFieldChanges(changes)}, nil
+}
+
+func (s *Server) RestoreCardRevision(ctx context.Context, req *pb.RestoreCardRevisionRequest) (*pb.RestoreCardRevisionResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("RestoreCardRevision: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	cardID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		slog.Error("RestoreCardRevision: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("card_id")
+	}
+
+	// Restores are always made by someone, unlike the changes made on
+	// behalf of the deck's author
+	authorID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("RestoreCardRevision: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	c, err := s.Repository.RestoreCardRevision(ctx, deckID, cardID, int(req.Revision), authorID)
+	if err != nil {
+		if cause := errors.Cause(err); cause == deck.ErrCardNotFound || cause == deck.ErrRevisionNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("RestoreCardRevision: failed to restore revision", "error", err, "cardId", cardID.String(), "revision", req.Revision, "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.RestoreCardRevisionResponse{Card: toGRPCCard(c)}, nil
+}
+
+func (s *Server) ForkDeck(ctx context.Context, req *pb.ForkDeckRequest) (*pb.ForkDeckResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("ForkDeck: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	// Anyone who can see the deck can fork it, answer key included, as the
+	// copy has to be a deck they can use
+	upstream, _, err := s.authorizeDeck(ctx, "ForkDeck", req.DeckId, req.UserId, deck.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	deckID := upstream.ID
+
+	fork, err := s.Repository.ForkDeck(ctx, deckID, userID)
+	if err != nil {
+		if err := forkStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("ForkDeck: failed to fork deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.ForkDeckResponse{Deck: toGRPCDeck(fork)}, nil
+}
+
+func (s *Server) GetUpstreamChanges(ctx context.Context, req *pb.GetUpstreamChangesRequest) (*pb.GetUpstreamChangesResponse, error) {
+	forkID, err := s.authorizeFork(ctx, "GetUpstreamChanges", req.DeckId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.Repository.GetUpstreamChanges(ctx, forkID)
+	if err != nil {
+		if err := forkStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("GetUpstreamChanges: failed to get upstream changes", "error", err, "deckId", forkID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	out := make([]*pb.UpstreamChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, toGRPCUpstreamChange(c))
+	}
+
+	return &pb.GetUpstreamChangesResponse{Changes: out}, nil
+}
+
+func (s *Server) PullUpstreamChanges(ctx context.Context, req *pb.PullUpstreamChangesRequest) (*pb.PullUpstreamChangesResponse, error) {
+	forkID, err := s.authorizeFork(ctx, "PullUpstreamChanges", req.DeckId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.UpstreamCardIds) == 0 {
+		return nil, fieldError(deck.ErrNoUpstreamCards, "upstream_card_ids")
+	}
+
+	cardIDs := make([]uuid.UUID, 0, len(req.UpstreamCardIds))
+	for i, id := range req.UpstreamCardIds {
+		cardID, err := uuid.Parse(id)
+		if err != nil {
+			slog.Error("PullUpstreamChanges: failed to parse card ID", "error", err, "cardId", id, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError(fmt.Sprintf("upstream_card_ids[%d]", i))
+		}
+
+		cardIDs = append(cardIDs, cardID)
+	}
+
+	userID, _ := uuid.Parse(req.UserId)
+
+	if err := s.Repository.PullUpstreamChanges(ctx, forkID, cardIDs, userID); err != nil {
+		if err := forkStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("PullUpstreamChanges: failed to pull upstream changes", "error", err, "deckId", forkID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.PullUpstreamChangesResponse{}, nil
+}
+
+func (s *Server) ListDeckMembers(ctx context.Context, req *pb.ListDeckMembersRequest) (*pb.ListDeckMembersResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("ListDeckMembers: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	members, err := s.Repository.GetDeckMembers(ctx, deckID)
+	if err != nil {
+		slog.Error("ListDeckMembers: failed to get members from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	out := make([]*pb.DeckMember, 0, len(members))
+	for _, m := range members {
+		out = append(out, toGRPCDeckMember(m))
+	}
+
+	return &pb.ListDeckMembersResponse{Members: out}, nil
+}
+
+func (s *Server) InviteDeckMember(ctx context.Context, req *pb.InviteDeckMemberRequest) (*pb.InviteDeckMemberResponse, error) {
+	deckID, memberID, err := s.parseMemberRequest(ctx, "InviteDeckMember", req.DeckId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deck.IsRole(req.Role) {
+		return nil, fieldError(deck.ErrInvalidRole, "role")
+	}
+
+	m, err := s.Repository.StoreDeckMember(ctx, deck.Member{DeckID: deckID, UserID: memberID, Role: req.Role})
+	if err != nil {
+		if err := memberStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("InviteDeckMember: failed to store member", "error", err, "deckId", deckID.String(), "memberId", memberID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.InviteDeckMemberResponse{Member: toGRPCDeckMember(m)}, nil
+}
+
+func (s *Server) ChangeDeckMemberRole(ctx context.Context, req *pb.ChangeDeckMemberRoleRequest) (*pb.ChangeDeckMemberRoleResponse, error) {
+	deckID, memberID, err := s.parseMemberRequest(ctx, "ChangeDeckMemberRole", req.DeckId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deck.IsRole(req.Role) {
+		return nil, fieldError(deck.ErrInvalidRole, "role")
+	}
+
+	m, err := s.Repository.UpdateDeckMember(ctx, deckID, memberID, req.Role)
+	if err != nil {
+		if err := memberStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("ChangeDeckMemberRole: failed to update member", "error", err, "deckId", deckID.String(), "memberId", memberID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.ChangeDeckMemberRoleResponse{Member: toGRPCDeckMember(m)}, nil
+}
+
+func (s *Server) RemoveDeckMember(ctx context.Context, req *pb.RemoveDeckMemberRequest) (*pb.RemoveDeckMemberResponse, error) {
+	deckID, memberID, err := s.parseMemberRequest(ctx, "RemoveDeckMember", req.DeckId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Repository.DeleteDeckMember(ctx, deckID, memberID); err != nil {
+		if err := memberStatus(err); err != nil {
+			return nil, err
+		}
+		slog.Error("RemoveDeckMember: failed to delete member", "error", err, "deckId", deckID.String(), "memberId", memberID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.RemoveDeckMemberResponse{}, nil
+}
+
+func (s *Server) GetSharedDecks(ctx context.Context, req *pb.GetSharedDecksRequest) (*pb.GetSharedDecksResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("GetSharedDecks: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	res, err := s.Repository.GetSharedDecks(ctx, userID, paginationFromProto(req.Pagination))
+	if err != nil {
+		if errors.Cause(err) == deck.ErrInvalidCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
+		slog.Error("GetSharedDecks: failed to get shared decks", "error", err, "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.GetSharedDecksResponse{Connection: sharedConnectionToProto(res)}, nil
+}
+
+// RateDeck stores the rating of a learner for a public deck. Authors can't
+// rate their own decks.
+func (s *Server) RateDeck(ctx context.Context, req *pb.RateDeckRequest) (*pb.RateDeckResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("RateDeck: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("RateDeck: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	rating := deck.Rating{
+		DeckID: deckID,
+		UserID: userID,
+		Stars:  int(req.Stars),
+		Review: strings.TrimSpace(req.Review),
+	}
+
+	if err := rating.Validate(); err != nil {
+		return nil, statusError(codes.InvalidArgument, err)
+	}
+
+	d, err := s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("RateDeck: failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	if !d.Public {
+		return nil, statusError(codes.PermissionDenied, deck.ErrDeckNotRateable)
+	}
+
+	if d.AuthorID == userID {
+		return nil, statusError(codes.PermissionDenied, deck.ErrOwnDeckRating)
+	}
+
+	out, err := s.Repository.RateDeck(ctx, rating)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("RateDeck: failed to store rating", "error", err, "deckId", deckID.String(), "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.RateDeckResponse{Rating: toGRPCDeckRating(out)}, nil
+}
+
+// GetDeckReviews pages through the reviews of a deck. Reviews of private
+// decks are only shown to their members.
+func (s *Server) GetDeckReviews(ctx context.Context, req *pb.GetDeckReviewsRequest) (*pb.GetDeckReviewsResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("GetDeckReviews: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	d, err := s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("GetDeckReviews: failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	if !d.Public {
+		if err := s.requireRole(ctx, "GetDeckReviews", d, req.UserId, deck.RoleViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.Repository.GetDeckReviews(ctx, deckID, paginationFromProto(req.Pagination))
+	if err != nil {
+		if errors.Cause(err) == deck.ErrInvalidCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
+		slog.Error("GetDeckReviews: failed to get reviews", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.GetDeckReviewsResponse{Connection: reviewsConnectionToProto(res)}, nil
+}
+
+// GetDeckAnalytics returns the statistics of the cards of a deck, for its
+// author to find the cards that need work. Members can't see them, whatever
+// their role, as they tell what learners answered.
+func (s *Server) GetDeckAnalytics(ctx context.Context, req *pb.GetDeckAnalyticsRequest) (*pb.GetDeckAnalyticsResponse, error) {
+	deckID, err := uuid.Parse(req.DeckId)
+	if err != nil {
+		slog.Error("GetDeckAnalytics: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("deck_id")
+	}
+
+	var ar deck.AnalyticsRange
+	if req.From != nil {
+		ar.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		ar.To = req.To.AsTime()
+	}
+
+	if err := ar.Validate(); err != nil {
+		return nil, fieldError(err, "from")
+	}
+
+	d, err := s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("GetDeckAnalytics: failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	if d.AuthorID.String() != req.UserId {
+		slog.Error("GetDeckAnalytics: deck access denied", "deckId", deckID.String(), "authorId", d.AuthorID.String(), "requestUserId", req.UserId)
+		return nil, statusError(codes.PermissionDenied, deck.ErrAuthorOnly)
+	}
+
+	res, err := s.Repository.GetDeckAnalytics(ctx, deckID, ar)
+	if err != nil {
+		slog.Error("GetDeckAnalytics: failed to get analytics", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	out := &pb.GetDeckAnalyticsResponse{Cards: make([]*pb.CardStats, 0, len(res.Cards))}
+	for _, c := range res.Cards {
+		out.Cards = append(out.Cards, toGRPCCardStats(c))
+	}
+
+	return out, nil
+}
+
+// AddToLibrary adds a deck the user can see to their library.
+func (s *Server) AddToLibrary(ctx context.Context, req *pb.AddToLibraryRequest) (*pb.AddToLibraryResponse, error) {
+	deckID, userID, err := parseLibraryRequest("AddToLibrary", req.DeckId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.Repository.GetDeck(ctx, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("AddToLibrary: failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	if !d.Public {
+		if err := s.requireRole(ctx, "AddToLibrary", d, req.UserId, deck.RoleViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err := s.Repository.AddToLibrary(ctx, userID, deckID)
+	if err != nil {
+		if errors.Cause(err) == deck.ErrDeckNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("AddToLibrary: failed to add deck to library", "error", err, "deckId", deckID.String(), "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.AddToLibraryResponse{Entry: toGRPCLibraryEntry(entry)}, nil
+}
+
+func (s *Server) RemoveFromLibrary(ctx context.Context, req *pb.RemoveFromLibraryRequest) (*pb.RemoveFromLibraryResponse, error) {
+	deckID, userID, err := parseLibraryRequest("RemoveFromLibrary", req.DeckId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Repository.RemoveFromLibrary(ctx, userID, deckID); err != nil {
+		if errors.Cause(err) == deck.ErrNotInLibrary {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("RemoveFromLibrary: failed to remove deck from library", "error", err, "deckId", deckID.String(), "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.RemoveFromLibraryResponse{}, nil
+}
+
+func (s *Server) GetLibrary(ctx context.Context, req *pb.GetLibraryRequest) (*pb.GetLibraryResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("GetLibrary: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	order, err := deck.ParseLibraryOrder(req.Order)
+	if err != nil {
+		return nil, statusError(codes.InvalidArgument, err)
+	}
+
+	res, err := s.Repository.GetLibrary(ctx, userID, order, paginationFromProto(req.Pagination))
+	if err != nil {
+		if errors.Cause(err) == deck.ErrInvalidCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
+		slog.Error("GetLibrary: failed to get library", "error", err, "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.GetLibraryResponse{Connection: libraryConnectionToProto(res)}, nil
+}
+
+func (s *Server) GetAuthoredDecks(ctx context.Context, req *pb.GetAuthoredDecksRequest) (*pb.GetAuthoredDecksResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		slog.Error("GetAuthoredDecks: failed to parse user ID", "error", err, "userId", req.UserId, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("user_id")
+	}
+
+	res, err := s.Repository.GetAuthoredDecks(ctx, userID, paginationFromProto(req.Pagination))
+	if err != nil {
+		if errors.Cause(err) == deck.ErrInvalidCursor {
+			return nil, statusError(codes.InvalidArgument, err)
+		}
+		slog.Error("GetAuthoredDecks: failed to get authored decks", "error", err, "userId", userID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
+	}
+
+	return &pb.GetAuthoredDecksResponse{Connection: authoredConnectionToProto(res)}, nil
+}
+
+// parseLibraryRequest parses the deck and user IDs of a request changing
+// a library.
+func parseLibraryRequest(method, rawDeckID, rawUserID string) (uuid.UUID, uuid.UUID, error) {
+	deckID, err := uuid.Parse(rawDeckID)
+	if err != nil {
+		slog.Error(method+": failed to parse deck ID", "error", err, "deckId", rawDeckID, "stack", errors.ErrorStack(err))
+		return uuid.Nil, uuid.Nil, invalidIDError("deck_id")
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		slog.Error(method+": failed to parse user ID", "error", err, "userId", rawUserID, "stack", errors.ErrorStack(err))
+		return uuid.Nil, uuid.Nil, invalidIDError("user_id")
+	}
+
+	return deckID, userID, nil
+}
+
+// parseMemberRequest parses the IDs of a request managing a member, and
+// checks the member isn't the author of the deck, who is always an owner.
+func (s *Server) parseMemberRequest(ctx context.Context, method, rawDeckID, rawMemberID string) (uuid.UUID, uuid.UUID, error) {
+	deckID, err := uuid.Parse(rawDeckID)
 	if err != nil {
-		slog.Error("CreateCard: failed to convert card from gRPC", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		slog.Error(method+": failed to parse deck ID", "error", err, "deckId", rawDeckID, "stack", errors.ErrorStack(err))
+		return uuid.Nil, uuid.Nil, invalidIDError("deck_id")
 	}
 
-	isValid, _ := deck.ValidateCard(card)
-	if !isValid {
-		return &pb.CreateCardResponse{}, deck.ErrCardInvalid
+	memberID, err := uuid.Parse(rawMemberID)
+	if err != nil {
+		slog.Error(method+": failed to parse member ID", "error", err, "memberId", rawMemberID, "stack", errors.ErrorStack(err))
+		return uuid.Nil, uuid.Nil, invalidIDError("member_id")
 	}
 
-	// Check if deck exists
-	_, err = s.Repository.GetDeck(ctx, deckID)
+	d, err := s.Repository.GetDeck(ctx, deckID)
 	if err != nil {
 		if errors.Cause(err) == deck.ErrDeckNotFound {
-			slog.Error("CreateCard: deck not found", "error", err, "deckId", deckID.String())
-			return &pb.CreateCardResponse{}, status.Error(codes.NotFound, errors.Trace(err).Error())
+			return uuid.Nil, uuid.Nil, statusError(codes.NotFound, err)
 		}
-		slog.Error("CreateCard: failed to get deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
-		return &pb.CreateCardResponse{}, errors.Trace(err)
+		slog.Error(method+": failed to get deck from repository", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		return uuid.Nil, uuid.Nil, errors.Trace(err)
 	}
 
-	card.GenerateUUIDs()
-
-	err = s.Repository.StoreCard(ctx, card, deckID)
-	if err != nil {
-		slog.Error("CreateCard: failed to store card", "error", err, "deckId", deckID.String(), "cardId", card.ID.String(), "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+	if d.AuthorID == memberID {
+		return uuid.Nil, uuid.Nil, statusError(codes.InvalidArgument, deck.ErrAuthorIsOwner)
 	}
 
-	return &pb.CreateCardResponse{Card: toGRPCCard(card)}, nil
+	return deckID, memberID, nil
 }
 
-func (s *Server) GetCards(ctx context.Context, req *pb.GetCardsRequest) (*pb.GetCardsResponse, error) {
-	var ids []uuid.UUID
+// memberStatus maps the errors of managing members to a gRPC status,
+// returning nil for unexpected ones.
+func memberStatus(err error) error {
+	switch errors.Cause(err) {
+	case deck.ErrDeckNotFound, deck.ErrMemberNotFound:
+		return statusError(codes.NotFound, err)
+	case deck.ErrMemberAlreadyExists:
+		return statusError(codes.AlreadyExists, err)
+	}
 
-	for _, idStr := range req.CardIds {
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			slog.Error("GetCards: failed to parse card ID", "error", err, "cardId", idStr, "stack", errors.ErrorStack(err))
-			return nil, errors.Trace(err)
-		}
+	return nil
+}
 
-		ids = append(ids, id)
+// authorizeFork parses the deck ID of an upstream request and checks the
+// user can edit the fork and can still see the upstream deck, as its cards
+// are shown and pulled whole, answers included.
+func (s *Server) authorizeFork(ctx context.Context, method, rawDeckID, userID string) (uuid.UUID, error) {
+	d, _, err := s.authorizeDeck(ctx, method, rawDeckID, userID, deck.RoleEditor)
+	if err != nil {
+		return uuid.Nil, err
 	}
+	deckID := d.ID
 
-	cards, err := s.Repository.GetCards(ctx, ids)
+	if d.UpstreamDeckID == uuid.Nil {
+		return deckID, nil
+	}
+
+	upstream, err := s.Repository.GetDecks(ctx, []uuid.UUID{d.UpstreamDeckID})
 	if err != nil {
-		slog.Error("GetCards: failed to get cards from repository", "error", err, "cardCount", len(ids), "stack", errors.ErrorStack(err))
-		return &pb.GetCardsResponse{}, errors.Trace(err)
+		slog.Error(method+": failed to get upstream deck from repository", "error", err, "deckId", d.UpstreamDeckID.String(), "stack", errors.ErrorStack(err))
+		return uuid.Nil, errors.Trace(err)
 	}
 
-	out := make(map[string]*pb.Card, len(cards))
+	visible, err := s.visibleDecks(ctx, method, upstream, userID, false)
+	if err != nil {
+		return uuid.Nil, err
+	}
 
-	for id, c := range cards {
-		out[id.String()] = toGRPCCard(c)
+	// A deleted upstream deck only leaves removals to pull
+	if _, ok := upstream[d.UpstreamDeckID]; ok {
+		if _, ok := visible[d.UpstreamDeckID]; !ok {
+			return uuid.Nil, statusError(codes.PermissionDenied, deck.ErrNotAllowed)
+		}
 	}
 
-	return &pb.GetCardsResponse{Cards: out}, nil
+	return deckID, nil
 }
 
-func paginationFromProto(p *pb.Pagination) pagination.Pagination {
-	return pagination.Pagination{
-		Before: pagination.Cursor(p.Before),
-		After:  pagination.Cursor(p.After),
-		First:  int(p.First),
-		Last:   int(p.Last),
+// forkStatus maps the errors of forking a deck and syncing a fork to a gRPC
+// status, returning nil for unexpected ones. Upstream cards that aren't
+// valid can't be copied until they are fixed upstream.
+func forkStatus(err error) error {
+	cause := errors.Cause(err)
+
+	switch {
+	case cause == deck.ErrDeckNotFound:
+		return statusError(codes.NotFound, err)
+	case cause == deck.ErrNotAFork, cause == deck.ErrNoUpstreamChanges:
+		return statusError(codes.FailedPrecondition, err)
+	case slices.Contains(cardValidationErrors, cause):
+		return statusError(codes.FailedPrecondition, cause)
 	}
+
+	return nil
 }
 
-func connectionToProto(conn deck.PopularDecksConnection) *pb.PopularDecksConnection {
-	var edges []*pb.PopularDecksConnection_Edge
+// authorizeRevisions parses the IDs of a revisions request and checks the
+// user can edit the deck, as revisions carry the correct answers.
+func (s *Server) authorizeRevisions(ctx context.Context, method, rawDeckID, rawCardID, userID string) (uuid.UUID, uuid.UUID, error) {
+	cardID, err := uuid.Parse(rawCardID)
+	if err != nil {
+		slog.Error(method+": failed to parse card ID", "error", err, "cardId", rawCardID, "stack", errors.ErrorStack(err))
+		return uuid.Nil, uuid.Nil, invalidIDError("card_id")
+	}
 
-	for _, e := range conn.Edges {
-		edges = append(edges, &pb.PopularDecksConnection_Edge{
-			DeckId: e.DeckID.String(),
-			Cursor: string(e.Cursor),
-		})
+	d, _, err := s.authorizeDeck(ctx, method, rawDeckID, userID, deck.RoleEditor)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
 	}
 
-	return &pb.PopularDecksConnection{
-		Edges: edges,
-		PageInfo: &pb.PageInfo{
-			HasPreviousPage: conn.PageInfo.HasPreviousPage,
-			HasNextPage:     conn.PageInfo.HasNextPage,
-			StartCursor:     string(conn.PageInfo.StartCursor),
-			EndCursor:       string(conn.PageInfo.EndCursor),
-		},
+	return d.ID, cardID, nil
+}
+
+// parseOptionalID parses id, returning uuid.Nil for an empty one.
+func parseOptionalID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
 	}
+
+	return uuid.Parse(id)
 }
 
-func (s *Server) CreateDeck(ctx context.Context, req *pb.CreateDeckRequest) (*pb.CreateDeckResponse, error) {
-	d, err := fromGRPCDeck(req.Deck)
+func (s *Server) ImportDeck(ctx context.Context, req *pb.ImportDeckRequest) (*pb.ImportDeckResponse, error) {
+	d, err := fromGRPCDeck(req.Deck, "deck")
 	if err != nil {
-		slog.Error("CreateDeck: failed to convert deck from gRPC", "error", err, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		slog.Error("ImportDeck: failed to convert deck from gRPC", "error", err, "stack", errors.ErrorStack(err))
+		return nil, err
 	}
 
-	isValid, _ := d.Validate()
-	if !isValid {
-		return &pb.CreateDeckResponse{}, deck.ErrDeckInvalid
+	mapping := deck.DefaultColumnMapping()
+	if req.Mapping != nil {
+		mapping = fromGRPCColumnMapping(req.Mapping)
+	}
+
+	var ed deck.ErroredDeck
+	if req.Format == deck.FormatAnki {
+		d, ed, err = deck.ImportAnki(d, bytes.NewReader(req.Data), int64(len(req.Data)))
+	} else {
+		d, ed, err = deck.ImportTabular(d, bytes.NewReader(req.Data), req.Format, mapping)
+	}
+	if err != nil {
+		slog.Error("ImportDeck: failed to read file", "error", err, "format", req.Format, "stack", errors.ErrorStack(err))
+		return nil, statusError(codes.InvalidArgument, err)
+	}
+
+	if len(ed.Errs) > 0 {
+		return nil, statusError(codes.InvalidArgument, deck.ErrDeckInvalid, ed.RowViolations()...)
 	}
 
 	d.GenerateUUIDs()
 
 	if err := s.Repository.StoreDeck(ctx, d); err != nil {
-		slog.Error("CreateDeck: failed to store deck", "error", err, "deckId", d.ID.String(), "stack", errors.ErrorStack(err))
-		return &pb.CreateDeckResponse{}, errors.Trace(err)
+		slog.Error("ImportDeck: failed to store deck", "error", err, "deckId", d.ID.String(), "stack", errors.ErrorStack(err))
+		return nil, errors.Trace(err)
 	}
 
-	return &pb.CreateDeckResponse{Deck: toGRPCDeck(d)}, nil
+	return &pb.ImportDeckResponse{Deck: toGRPCDeck(d)}, nil
 }
 
-func (s *Server) DeleteDeck(ctx context.Context, req *pb.DeleteDeckRequest) (*pb.DeleteDeckResponse, error) {
-	deckID, err := uuid.Parse(req.Id)
+func (s *Server) ExportDeck(ctx context.Context, req *pb.ExportDeckRequest) (*pb.ExportDeckResponse, error) {
+	// The export carries the correct answers, so it is only for editors
+	d, _, err := s.authorizeDeck(ctx, "ExportDeck", req.DeckId, req.UserId, deck.RoleEditor)
 	if err != nil {
-		slog.Error("DeleteDeck: failed to parse deck ID", "error", err, "deckId", req.Id, "stack", errors.ErrorStack(err))
-		return &pb.DeleteDeckResponse{}, errors.Trace(err)
+		return nil, err
 	}
+	deckID := d.ID
 
-	err = s.Repository.DeleteDeck(ctx, deckID)
+	mapping := deck.ExportColumnMapping(maxAnswers(d.Cards))
+	if req.Mapping != nil {
+		mapping = fromGRPCColumnMapping(req.Mapping)
+	}
+
+	var buf bytes.Buffer
+	if req.Format == deck.FormatAnki {
+		err = deck.ExportAnki(&buf, d)
+	} else {
+		err = deck.ExportTabular(&buf, d, req.Format, mapping)
+	}
 	if err != nil {
-		slog.Error("DeleteDeck: failed to delete deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		slog.Error("ExportDeck: failed to write file", "error", err, "deckId", deckID.String(), "format", req.Format, "stack", errors.ErrorStack(err))
+		return nil, statusError(codes.InvalidArgument, err)
 	}
 
-	return &pb.DeleteDeckResponse{}, errors.Trace(err)
+	return &pb.ExportDeckResponse{Data: buf.Bytes()}, nil
 }
 
-func (s *Server) UpdateDeck(ctx context.Context, req *pb.UpdateDeckRequest) (*pb.UpdateDeckResponse, error) {
-	deckID, err := uuid.Parse(req.Id)
-	if err != nil {
-		slog.Error("UpdateDeck: failed to parse deck ID", "error", err, "deckId", req.Id, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
-	}
+const (
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 50
+)
 
-	updates := deck.DeckUpdates{
-		Title:       req.Title,
-		Description: req.Description,
-		IsPublic:    req.IsPublic,
+func (s *Server) AutocompleteTags(ctx context.Context, req *pb.AutocompleteTagsRequest) (*pb.AutocompleteTagsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
 	}
-
-	if !updates.HasUpdates() {
-		err := errors.New("no fields to update")
-		slog.Error("UpdateDeck: no fields provided", "error", err, "deckId", deckID.String())
-		return nil, errors.Trace(err)
+	if limit > maxAutocompleteLimit {
+		limit = maxAutocompleteLimit
 	}
 
-	d, err := s.Repository.UpdateDeck(ctx, deckID, updates)
+	tags, err := s.Repository.AutocompleteTags(ctx, deck.NormalizeTag(req.Prefix), limit)
 	if err != nil {
-		if errors.Cause(err) == deck.ErrDeckNotFound {
-			slog.Error("UpdateDeck: deck not found", "error", err, "deckId", deckID.String())
-			return nil, status.Error(codes.NotFound, errors.Trace(err).Error())
-		}
-		slog.Error("UpdateDeck: failed to update deck", "error", err, "deckId", deckID.String(), "stack", errors.ErrorStack(err))
+		slog.Error("AutocompleteTags: failed to get tags", "error", err, "prefix", req.Prefix, "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
-	return &pb.UpdateDeckResponse{Deck: toGRPCDeck(d)}, nil
+	out := make([]*pb.Tag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, toGRPCTag(t))
+	}
+
+	return &pb.AutocompleteTagsResponse{Tags: out}, nil
 }
 
-func (s *Server) UpdateCard(ctx context.Context, req *pb.UpdateCardRequest) (*pb.UpdateCardResponse, error) {
-	deckID, err := uuid.Parse(req.DeckId)
+func (s *Server) GetTopics(ctx context.Context, req *pb.GetTopicsRequest) (*pb.GetTopicsResponse, error) {
+	topics, err := s.Repository.GetTopics(ctx)
 	if err != nil {
-		slog.Error("UpdateCard: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
+		slog.Error("GetTopics: failed to get topics", "error", err, "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
-	cardID, err := uuid.Parse(req.CardId)
-	if err != nil {
-		slog.Error("UpdateCard: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
-	}
+	return &pb.GetTopicsResponse{Topics: toGRPCTopics(deck.TopicTree(topics))}, nil
+}
 
-	updates := deck.CardUpdates{
-		Title:       req.Title,
-		Explanation: req.Explanation,
-		Kind:        req.Kind,
+func (s *Server) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (*pb.CreateTopicResponse, error) {
+	t := deck.Topic{ID: uuid.New(), Name: strings.TrimSpace(req.Name)}
+	if t.Name == "" {
+		return nil, fieldError(deck.ErrNoTopicName, "name")
 	}
 
-	if !updates.HasUpdates() {
-		err := errors.New("no fields to update")
-		slog.Error("UpdateCard: no fields provided", "error", err, "cardId", cardID.String())
-		return nil, errors.Trace(err)
-	}
+	if req.ParentId != "" {
+		parentID, err := uuid.Parse(req.ParentId)
+		if err != nil {
+			slog.Error("CreateTopic: failed to parse parent ID", "error", err, "parentId", req.ParentId, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError("parent_id")
+		}
 
-	// Validate kind if provided
-	if updates.Kind != nil && *updates.Kind != deck.CardKindSingleChoice && *updates.Kind != deck.CardKindFillInTheBlanks {
-		return nil, deck.ErrInvalidKind
+		t.ParentID = parentID
 	}
 
-	c, err := s.Repository.UpdateCard(ctx, deckID, cardID, updates)
-	if err != nil {
-		if errors.Cause(err) == deck.ErrCardNotFound {
-			slog.Error("UpdateCard: card not found", "error", err, "cardId", cardID.String())
-			return nil, status.Error(codes.NotFound, errors.Trace(err).Error())
+	if err := s.Repository.StoreTopic(ctx, t); err != nil {
+		switch errors.Cause(err) {
+		case deck.ErrTopicNotFound:
+			return nil, statusError(codes.NotFound, err)
+		case deck.ErrTopicAlreadyExists:
+			return nil, statusError(codes.AlreadyExists, err)
 		}
-		slog.Error("UpdateCard: failed to update card", "error", err, "cardId", cardID.String(), "stack", errors.ErrorStack(err))
+		slog.Error("CreateTopic: failed to store topic", "error", err, "name", t.Name, "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
-	return &pb.UpdateCardResponse{Card: toGRPCCard(c)}, nil
+	return &pb.CreateTopicResponse{Topic: toGRPCTopic(t)}, nil
 }
 
-func (s *Server) UpdateAnswer(ctx context.Context, req *pb.UpdateAnswerRequest) (*pb.UpdateAnswerResponse, error) {
-	deckID, err := uuid.Parse(req.DeckId)
+func (s *Server) DeleteTopic(ctx context.Context, req *pb.DeleteTopicRequest) (*pb.DeleteTopicResponse, error) {
+	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		slog.Error("UpdateAnswer: failed to parse deck ID", "error", err, "deckId", req.DeckId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
+		slog.Error("DeleteTopic: failed to parse topic ID", "error", err, "topicId", req.Id, "stack", errors.ErrorStack(err))
+		return nil, invalidIDError("id")
 	}
 
-	cardID, err := uuid.Parse(req.CardId)
-	if err != nil {
-		slog.Error("UpdateAnswer: failed to parse card ID", "error", err, "cardId", req.CardId, "stack", errors.ErrorStack(err))
+	if err := s.Repository.DeleteTopic(ctx, id); err != nil {
+		if errors.Cause(err) == deck.ErrTopicNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("DeleteTopic: failed to delete topic", "error", err, "topicId", req.Id, "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
-	answerID, err := uuid.Parse(req.AnswerId)
-	if err != nil {
-		slog.Error("UpdateAnswer: failed to parse answer ID", "error", err, "answerId", req.AnswerId, "stack", errors.ErrorStack(err))
-		return nil, errors.Trace(err)
-	}
+	return &pb.DeleteTopicResponse{}, nil
+}
 
-	updates := deck.AnswerUpdates{
-		Text:      req.Text,
-		IsCorrect: req.IsCorrect,
+func (s *Server) SetTagTopic(ctx context.Context, req *pb.SetTagTopicRequest) (*pb.SetTagTopicResponse, error) {
+	tag := deck.NormalizeTag(req.Tag)
+	if errs := deck.ValidateTags([]string{tag}); len(errs) > 0 {
+		return nil, statusError(codes.InvalidArgument, errs[0], tagViolations(errs)...)
 	}
 
-	if !updates.HasUpdates() {
-		err := errors.New("no fields to update")
-		slog.Error("UpdateAnswer: no fields provided", "error", err, "answerId", answerID.String())
-		return nil, errors.Trace(err)
+	var topicID uuid.UUID
+	if req.TopicId != "" {
+		id, err := uuid.Parse(req.TopicId)
+		if err != nil {
+			slog.Error("SetTagTopic: failed to parse topic ID", "error", err, "topicId", req.TopicId, "stack", errors.ErrorStack(err))
+			return nil, invalidIDError("topic_id")
+		}
+
+		topicID = id
 	}
 
-	a, err := s.Repository.UpdateAnswer(ctx, deckID, cardID, answerID, updates)
+	t, err := s.Repository.SetTagTopic(ctx, tag, topicID)
 	if err != nil {
-		slog.Error("UpdateAnswer: failed to update answer", "error", err, "answerId", answerID.String(), "stack", errors.ErrorStack(err))
+		if errors.Cause(err) == deck.ErrTopicNotFound {
+			return nil, statusError(codes.NotFound, err)
+		}
+		slog.Error("SetTagTopic: failed to set tag topic", "error", err, "tag", tag, "topicId", req.TopicId, "stack", errors.ErrorStack(err))
 		return nil, errors.Trace(err)
 	}
 
-	return &pb.UpdateAnswerResponse{Answer: &pb.Answer{
-		Id:        a.ID.String(),
-		Text:      a.Text,
-		IsCorrect: a.IsCorrect,
-	}}, nil
+	return &pb.SetTagTopicResponse{Tag: toGRPCTag(t)}, nil
+}
+
+func maxAnswers(cards []deck.Card) int {
+	out := 0
+	for _, c := range cards {
+		if len(c.PossibleAnswers) > out {
+			out = len(c.PossibleAnswers)
+		}
+	}
+
+	return out
 }
 
 func toGRPCDecks(decks []deck.Deck) []*pb.Deck {
@@ -383,14 +1974,152 @@ func toGRPCDecks(decks []deck.Deck) []*pb.Deck {
 }
 
 func toGRPCDeck(d deck.Deck) *pb.Deck {
-	return &pb.Deck{
+	out := &pb.Deck{
 		Id:          d.ID.String(),
 		AuthorId:    d.AuthorID.String(),
 		Title:       d.Title,
 		Description: d.Description,
 		IsPublic:    d.Public,
 		Cards:       toGRPCCards(d.Cards),
+		Language:    d.Language,
+		Tags:        d.Tags,
+		Revision:    int32(d.Revision),
+
+		RatingAverage: d.RatingAverage,
+		RatingCount:   int32(d.RatingCount),
+		Version:       int32(d.Version),
+	}
+	if d.UpstreamDeckID != uuid.Nil {
+		out.UpstreamDeckId = d.UpstreamDeckID.String()
+		out.UpstreamRevision = int32(d.UpstreamRevision)
+	}
+
+	return out
+}
+
+func toGRPCTag(t deck.Tag) *pb.Tag {
+	out := &pb.Tag{Name: t.Name, DeckCount: int64(t.DeckCount)}
+	if t.TopicID != uuid.Nil {
+		out.TopicId = t.TopicID.String()
+	}
+
+	return out
+}
+
+func toGRPCTopics(topics []deck.Topic) []*pb.Topic {
+	out := make([]*pb.Topic, 0, len(topics))
+	for _, t := range topics {
+		out = append(out, toGRPCTopic(t))
+	}
+
+	return out
+}
+
+func toGRPCTopic(t deck.Topic) *pb.Topic {
+	out := &pb.Topic{
+		Id:       t.ID.String(),
+		Name:     t.Name,
+		Tags:     t.Tags,
+		Children: toGRPCTopics(t.Children),
+	}
+
+	if t.ParentID != uuid.Nil {
+		out.ParentId = t.ParentID.String()
+	}
+
+	return out
+}
+
+func toGRPCDeckMember(m deck.Member) *pb.DeckMember {
+	return &pb.DeckMember{
+		DeckId:    m.DeckID.String(),
+		UserId:    m.UserID.String(),
+		Role:      m.Role,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
+
+func toGRPCDeckRating(r deck.Rating) *pb.DeckRating {
+	return &pb.DeckRating{
+		DeckId:    r.DeckID.String(),
+		UserId:    r.UserID.String(),
+		Stars:     int32(r.Stars),
+		Review:    r.Review,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func toGRPCLibraryEntry(e deck.LibraryEntry) *pb.LibraryEntry {
+	return &pb.LibraryEntry{
+		DeckId:          e.DeckID.String(),
+		AddedAt:         timestamppb.New(e.AddedAt),
+		LastPracticedAt: timestamppb.New(e.LastPracticedAt),
+		CardCount:       int32(e.CardCount),
+		Mastery:         e.Mastery,
+	}
+}
+
+func toGRPCCardStats(c deck.CardStats) *pb.CardStats {
+	out := &pb.CardStats{
+		CardId:              c.CardID.String(),
+		Attempts:            int64(c.Attempts),
+		FirstTries:          int64(c.FirstTries),
+		FirstTryCorrectRate: c.FirstTryCorrectRate(),
+		AverageLevel:        c.AverageLevel,
+		Answers:             make([]*pb.AnswerStats, 0, len(c.Answers)),
+		Flags:               make([]string, 0, len(c.Flags)),
+	}
+
+	for _, a := range c.Answers {
+		out.Answers = append(out.Answers, &pb.AnswerStats{
+			AnswerId:  a.AnswerID.String(),
+			IsCorrect: a.IsCorrect,
+			Chosen:    int64(a.Chosen),
+		})
+	}
+
+	for _, f := range c.Flags {
+		out.Flags = append(out.Flags, string(f))
+	}
+
+	return out
+}
+
+func toGRPCCardRevision(r deck.CardRevision) *pb.CardRevision {
+	return &pb.CardRevision{
+		CardId:        r.CardID.String(),
+		Revision:      int32(r.Revision),
+		AuthorId:      r.AuthorID.String(),
+		ChangedFields: r.ChangedFields,
+		Card:          toGRPCCard(r.Card),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		LearnerCount:  int64(r.LearnerCount),
+	}
+}
+
+func toGRPCFieldChanges(changes []deck.FieldChange) []*pb.FieldChange {
+	out := make([]*pb.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &pb.FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+
+	return out
+}
+
+func toGRPCUpstreamChange(c deck.UpstreamChange) *pb.UpstreamChange {
+	out := &pb.UpstreamChange{
+		UpstreamCardId: c.UpstreamCardID.String(),
+		Status:         c.Status,
+		Revision:       int32(c.Revision),
+		Changes:        toGRPCFieldChanges(c.Changes),
+		Card:           toGRPCCard(c.Card),
+	}
+	if c.CardID != uuid.Nil {
+		out.CardId = c.CardID.String()
 	}
+
+	return out
 }
 
 func toGRPCCards(cards []deck.Card) []*pb.Card {
@@ -410,6 +2139,8 @@ func toGRPCCard(c deck.Card) *pb.Card {
 		PossibleAnswers: toGRPCAnswers(c.PossibleAnswers),
 		Explanation:     c.Explanation,
 		Kind:            c.Kind,
+		BlankCount:      int32(c.BlankCount),
+		Version:         int32(c.Version),
 	}
 }
 
@@ -421,13 +2152,19 @@ func toGRPCAnswers(answers []deck.Answer) []*pb.Answer {
 			Id:        a.ID.String(),
 			Text:      a.Text,
 			IsCorrect: a.IsCorrect,
+			Blank:     int32(a.Blank),
+			Tolerance: int32(a.Tolerance),
+			Position:  int32(a.Position),
+			Side:      a.Side,
 		})
 	}
 
 	return out
 }
 
-func fromGRPCDeck(d *pb.Deck) (deck.Deck, error) {
+// fromGRPCDeck converts d, reporting malformed IDs by their path under
+// field.
+func fromGRPCDeck(d *pb.Deck, field string) (deck.Deck, error) {
 	if d == nil {
 		return deck.Deck{}, nil
 	}
@@ -440,7 +2177,7 @@ func fromGRPCDeck(d *pb.Deck) (deck.Deck, error) {
 		deckID, err = uuid.Parse(d.Id)
 		if err != nil {
 			slog.Error("fromGRPCDeck: failed to parse deck ID", "error", err, "deckId", d.Id, "stack", errors.ErrorStack(err))
-			return deck.Deck{}, errors.Trace(err)
+			return deck.Deck{}, invalidIDError(field + ".id")
 		}
 
 	}
@@ -448,13 +2185,13 @@ func fromGRPCDeck(d *pb.Deck) (deck.Deck, error) {
 	authorID, err := uuid.Parse(d.AuthorId)
 	if err != nil {
 		slog.Error("fromGRPCDeck: failed to parse author ID", "error", err, "authorId", d.AuthorId, "stack", errors.ErrorStack(err))
-		return deck.Deck{}, errors.Trace(err)
+		return deck.Deck{}, invalidIDError(field + ".author_id")
 	}
 
-	cards, err := fromGRPCCards(d.Cards)
+	cards, err := fromGRPCCards(d.Cards, field+".cards")
 	if err != nil {
 		slog.Error("fromGRPCDeck: failed to convert cards", "error", err, "cardCount", len(d.Cards), "stack", errors.ErrorStack(err))
-		return deck.Deck{}, errors.Trace(err)
+		return deck.Deck{}, err
 	}
 
 	return deck.Deck{
@@ -464,17 +2201,19 @@ func fromGRPCDeck(d *pb.Deck) (deck.Deck, error) {
 		Description: d.Description,
 		Cards:       cards,
 		Public:      d.IsPublic,
+		Language:    d.Language,
+		Tags:        deck.NormalizeTags(d.Tags),
 	}, nil
 }
 
-func fromGRPCCards(cards []*pb.Card) ([]deck.Card, error) {
+func fromGRPCCards(cards []*pb.Card, field string) ([]deck.Card, error) {
 	var out = make([]deck.Card, 0, len(cards))
 
-	for _, c := range cards {
-		converted, err := fromGRPCCard(c)
+	for i, c := range cards {
+		converted, err := fromGRPCCard(c, fmt.Sprintf("%s[%d]", field, i))
 		if err != nil {
 			slog.Error("fromGRPCCards: failed to convert card", "error", err, "cardTitle", c.Title, "stack", errors.ErrorStack(err))
-			return []deck.Card{}, errors.Trace(err)
+			return []deck.Card{}, err
 		}
 
 		out = append(out, converted)
@@ -483,7 +2222,7 @@ func fromGRPCCards(cards []*pb.Card) ([]deck.Card, error) {
 	return out, nil
 }
 
-func fromGRPCCard(c *pb.Card) (deck.Card, error) {
+func fromGRPCCard(c *pb.Card, field string) (deck.Card, error) {
 	var cardID uuid.UUID
 
 	if c.Id != "" {
@@ -492,14 +2231,14 @@ func fromGRPCCard(c *pb.Card) (deck.Card, error) {
 		cardID, err = uuid.Parse(c.Id)
 		if err != nil {
 			slog.Error("fromGRPCCard: failed to parse card ID", "error", err, "cardId", c.Id, "stack", errors.ErrorStack(err))
-			return deck.Card{}, errors.Trace(err)
+			return deck.Card{}, invalidIDError(field + ".id")
 		}
 	}
 
-	answers, err := fromGRPCAnswers(c.PossibleAnswers)
+	answers, err := fromGRPCAnswers(c.PossibleAnswers, field+".possible_answers")
 	if err != nil {
 		slog.Error("fromGRPCCard: failed to convert answers", "error", err, "answerCount", len(c.PossibleAnswers), "stack", errors.ErrorStack(err))
-		return deck.Card{}, errors.Trace(err)
+		return deck.Card{}, err
 	}
 
 	return deck.Card{
@@ -511,10 +2250,10 @@ func fromGRPCCard(c *pb.Card) (deck.Card, error) {
 	}, nil
 }
 
-func fromGRPCAnswers(answers []*pb.Answer) ([]deck.Answer, error) {
+func fromGRPCAnswers(answers []*pb.Answer, field string) ([]deck.Answer, error) {
 	var out = make([]deck.Answer, 0, len(answers))
 
-	for _, a := range answers {
+	for i, a := range answers {
 		var answerID uuid.UUID
 		if a.Id != "" {
 			var err error
@@ -522,7 +2261,7 @@ func fromGRPCAnswers(answers []*pb.Answer) ([]deck.Answer, error) {
 			answerID, err = uuid.Parse(a.Id)
 			if err != nil {
 				slog.Error("fromGRPCAnswers: failed to parse answer ID", "error", err, "answerId", a.Id, "stack", errors.ErrorStack(err))
-				return []deck.Answer{}, errors.Trace(err)
+				return []deck.Answer{}, invalidIDError(fmt.Sprintf("%s[%d].id", field, i))
 			}
 		}
 
@@ -530,8 +2269,96 @@ func fromGRPCAnswers(answers []*pb.Answer) ([]deck.Answer, error) {
 			ID:        answerID,
 			Text:      a.Text,
 			IsCorrect: a.IsCorrect,
+			Blank:     int(a.Blank),
+			Tolerance: int(a.Tolerance),
+			Position:  int(a.Position),
+			Side:      a.Side,
 		})
 	}
 
 	return out, nil
 }
+
+func fromGRPCCardAnswers(answers []*pb.CardAnswer) ([]deck.CardAnswer, error) {
+	var out = make([]deck.CardAnswer, 0, len(answers))
+
+	for i, a := range answers {
+		field := fmt.Sprintf("answers[%d]", i)
+
+		cardID, err := uuid.Parse(a.CardId)
+		if err != nil {
+			slog.Error("fromGRPCCardAnswers: failed to parse card ID", "error", err, "cardId", a.CardId, "stack", errors.ErrorStack(err))
+			return []deck.CardAnswer{}, invalidIDError(field + ".card_id")
+		}
+
+		ca := deck.CardAnswer{CardID: cardID}
+
+		switch r := a.Response.(type) {
+		case *pb.CardAnswer_AnswerId:
+			ca.AnswerID, err = uuid.Parse(r.AnswerId)
+			if err != nil {
+				slog.Error("fromGRPCCardAnswers: failed to parse answer ID", "error", err, "answerId", r.AnswerId, "stack", errors.ErrorStack(err))
+				return []deck.CardAnswer{}, invalidIDError(field + ".answer_id")
+			}
+		case *pb.CardAnswer_Typed:
+			ca.Blanks = r.Typed.GetBlanks()
+		case *pb.CardAnswer_AnswerIds:
+			for _, idStr := range r.AnswerIds.GetIds() {
+				id, err := uuid.Parse(idStr)
+				if err != nil {
+					slog.Error("fromGRPCCardAnswers: failed to parse answer ID", "error", err, "answerId", idStr, "stack", errors.ErrorStack(err))
+					return []deck.CardAnswer{}, invalidIDError(field + ".answer_ids")
+				}
+
+				ca.AnswerIDs = append(ca.AnswerIDs, id)
+			}
+		case *pb.CardAnswer_Pairs:
+			for _, p := range r.Pairs.GetPairs() {
+				left, err := uuid.Parse(p.LeftId)
+				if err != nil {
+					slog.Error("fromGRPCCardAnswers: failed to parse left answer ID", "error", err, "answerId", p.LeftId, "stack", errors.ErrorStack(err))
+					return []deck.CardAnswer{}, invalidIDError(field + ".pairs.left_id")
+				}
+
+				right, err := uuid.Parse(p.RightId)
+				if err != nil {
+					slog.Error("fromGRPCCardAnswers: failed to parse right answer ID", "error", err, "answerId", p.RightId, "stack", errors.ErrorStack(err))
+					return []deck.CardAnswer{}, invalidIDError(field + ".pairs.right_id")
+				}
+
+				ca.Pairs = append(ca.Pairs, deck.AnswerPair{Left: left, Right: right})
+			}
+		}
+
+		out = append(out, ca)
+	}
+
+	return out, nil
+}
+
+func fromGRPCColumnMapping(m *pb.ColumnMapping) deck.ColumnMapping {
+	optional := func(col *int32) int {
+		if col == nil {
+			return -1
+		}
+
+		return int(*col)
+	}
+
+	out := deck.ColumnMapping{
+		Title:       int(m.Title),
+		Correct:     optional(m.Correct),
+		Explanation: optional(m.Explanation),
+		Kind:        optional(m.Kind),
+		Blanks:      optional(m.Blanks),
+		Tolerances:  optional(m.Tolerances),
+		Sides:       optional(m.Sides),
+		HasHeader:   m.HasHeader,
+	}
+
+	for _, col := range m.Answers {
+		out.Answers = append(out.Answers, int(col))
+	}
+
+	return out
+}
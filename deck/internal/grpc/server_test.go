@@ -3,11 +3,20 @@ package grpc
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/google/uuid"
+	"github.com/juju/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/XaviFP/toshokan/common/pagination"
 	pb "github.com/XaviFP/toshokan/deck/api/proto/v1"
@@ -20,7 +29,7 @@ func TestServer_GetDeck(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
-		deck := deck.Deck{
+		d := deck.Deck{
 			ID:          deckID,
 			Title:       "Go Learning",
 			Description: "Polish your Go skills",
@@ -35,7 +44,8 @@ func TestServer_GetDeck(t *testing.T) {
 					Kind: "single_choice",
 				},
 			}}
-		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck, nil)
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, mock.Anything).Return(deck.Member{}, deck.ErrMemberNotFound)
 		req := pb.GetDeckRequest{
 			DeckId: deckID.String(),
 			UserId: uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1").String(),
@@ -43,7 +53,66 @@ func TestServer_GetDeck(t *testing.T) {
 
 		res, err := srv.GetDeck(context.Background(), &req)
 		assert.NoError(t, err)
-		assert.Equal(t, &pb.GetDeckResponse{Deck: toGRPCDeck(deck)}, res)
+		assert.Equal(t, &pb.GetDeckResponse{Deck: toGRPCDeck(d.LearnerView())}, res)
+		assert.False(t, res.Deck.Cards[0].PossibleAnswers[0].IsCorrect)
+	})
+
+	t.Run("author sees answers", func(t *testing.T) {
+		deckID := uuid.MustParse("8c5b7f0e-1d2a-4b3c-9e4f-5a6b7c8d9e0f")
+		authorID := uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01")
+		deck := deck.Deck{
+			ID:          deckID,
+			Title:       "Go Learning",
+			Description: "Polish your Go skills",
+			AuthorID:    authorID,
+			Cards: []deck.Card{
+				{
+					Title:       "What does CSP stand for?",
+					Explanation: "Hoare, 1978",
+					PossibleAnswers: []deck.Answer{
+						{Text: "Communicating Sequential Processes", IsCorrect: true},
+					},
+					Kind: "single_choice",
+				},
+			}}
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck, nil)
+
+		res, err := srv.GetDeck(context.Background(), &pb.GetDeckRequest{DeckId: deckID.String(), UserId: authorID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.GetDeckResponse{Deck: toGRPCDeck(deck), Role: "owner"}, res)
+	})
+
+	t.Run("editor sees answers of a private deck", func(t *testing.T) {
+		deckID := uuid.MustParse("c924f7e0-5c7e-4d3b-9a3e-8f0b4f2a6d11")
+		editorID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+		d := deck.Deck{
+			ID:       deckID,
+			Title:    "Rust Learning",
+			AuthorID: uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01"),
+			Cards: []deck.Card{
+				{
+					Title: "Which keyword declares a mutable binding?",
+					PossibleAnswers: []deck.Answer{
+						{Text: "let mut", IsCorrect: true},
+					},
+					Kind: "single_choice",
+				},
+			}}
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, editorID).Return(deck.Member{DeckID: deckID, UserID: editorID, Role: deck.RoleEditor}, nil)
+
+		res, err := srv.GetDeck(context.Background(), &pb.GetDeckRequest{DeckId: deckID.String(), UserId: editorID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.GetDeckResponse{Deck: toGRPCDeck(d), Role: "editor"}, res)
+	})
+
+	t.Run("private deck of someone else", func(t *testing.T) {
+		deckID := uuid.MustParse("d42a90dd-7a1b-4c2d-8e3f-9a0b1c2d3e4f")
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01")}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, mock.Anything).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.GetDeck(context.Background(), &pb.GetDeckRequest{DeckId: deckID.String(), UserId: "32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
 	})
 
 	t.Run("failure", func(t *testing.T) {
@@ -67,6 +136,7 @@ func TestServer_GetDecks(t *testing.T) {
 			Title:       "Go Learning",
 			Description: "Polish your Go skills",
 			AuthorID:    uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01"),
+			Public:      true,
 			Cards: []deck.Card{
 				{
 					Title: "What does CSP stand for?",
@@ -95,11 +165,49 @@ func TestServer_GetDecks(t *testing.T) {
 
 		res, err := srv.GetDecks(context.Background(), &pb.GetDecksRequest{DeckIds: []string{"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"}})
 		assert.NoError(t, err)
+		assert.Equal(t, &pb.GetDecksResponse{Decks: map[string]*pb.Deck{
+			"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598": toGRPCDeck(d.LearnerView()),
+		}}, res)
+
+		// Only the author gets the answers, and only when asking for them
+		res, err = srv.GetDecks(context.Background(), &pb.GetDecksRequest{
+			DeckIds:     []string{"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"},
+			UserId:      d.AuthorID.String(),
+			WithAnswers: true,
+		})
+		assert.NoError(t, err)
 		assert.Equal(t, &pb.GetDecksResponse{Decks: map[string]*pb.Deck{
 			"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598": toGRPCDeck(d),
 		}}, res)
 	})
 
+	t.Run("private", func(t *testing.T) {
+		d := deck.Deck{
+			ID:       uuid.MustParse("0b1c1ab4-56d5-4bc4-9d57-63b00f2b4c6a"),
+			Title:    "Private",
+			AuthorID: uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01"),
+		}
+		memberID := uuid.MustParse("9d6a3f43-9a8b-4d5c-8f3e-3c6f0f9a7d21")
+		strangerID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: d}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, d.ID, memberID).Return(deck.Member{Role: deck.RoleViewer}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, d.ID, strangerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		// Private decks are left out for those who can't see them
+		for _, userID := range []string{"", strangerID.String()} {
+			res, err := srv.GetDecks(context.Background(), &pb.GetDecksRequest{DeckIds: []string{d.ID.String()}, UserId: userID})
+			assert.NoError(t, err)
+			assert.Empty(t, res.Decks)
+		}
+
+		res, err := srv.GetDecks(context.Background(), &pb.GetDecksRequest{DeckIds: []string{d.ID.String()}, UserId: memberID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.GetDecksResponse{Decks: map[string]*pb.Deck{
+			d.ID.String(): toGRPCDeck(d.LearnerView()),
+		}}, res)
+	})
+
 	t.Run("error", func(t *testing.T) {
 		repoMock.On("GetDecks", mock.Anything, mock.Anything).Return(map[uuid.UUID]deck.Deck{}, assert.AnError)
 
@@ -151,6 +259,32 @@ func TestServer_CreateDeck(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, &pb.CreateDeckResponse{}, res)
 	})
+
+	t.Run("invalid", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		invalid := d
+		invalid.Title = ""
+		invalid.Cards = []deck.Card{d.Cards[0], {Title: "Pick one", Kind: "single_choice", PossibleAnswers: []deck.Answer{{Text: "Wrong"}}}}
+
+		_, err := srv.CreateDeck(context.Background(), &pb.CreateDeckRequest{Deck: toGRPCDeck(invalid)})
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, deck.ErrDeckInvalid.Error(), st.Message())
+
+		require.Len(t, st.Details(), 2)
+		info := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.Equal(t, "INVALID_DECK", info.Reason)
+		assert.Equal(t, errorDomain, info.Domain)
+
+		br := st.Details()[1].(*errdetails.BadRequest)
+		require.Len(t, br.FieldViolations, 2)
+		assert.Equal(t, "title", br.FieldViolations[0].Field)
+		assert.Equal(t, "NO_TITLE", br.FieldViolations[0].Reason)
+		assert.Equal(t, "cards[1].possible_answers", br.FieldViolations[1].Field)
+		assert.Equal(t, "NO_CORRECT_ANSWER", br.FieldViolations[1].Reason)
+		assert.Equal(t, deck.ErrNoCorrectAnswer.Error(), br.FieldViolations[1].Description)
+	})
 }
 
 func TestServer_DeleteDeck(t *testing.T) {
@@ -183,7 +317,7 @@ func TestServer_GetPopularDecks(t *testing.T) {
 	userID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
 
 	t.Run("success", func(t *testing.T) {
-		repoMock.On("GetPopularDecks", mock.Anything, userID, pagination.Pagination{
+		repoMock.On("GetPopularDecks", mock.Anything, userID, deck.PeriodWeek, []string{"jlpt n5"}, pagination.Pagination{
 			First: 2,
 			After: pagination.Cursor("9999"),
 		},
@@ -211,6 +345,8 @@ func TestServer_GetPopularDecks(t *testing.T) {
 				First: 2,
 				After: "9999",
 			},
+			Tags:   []string{"JLPT  N5", "jlpt n5"},
+			Period: "week",
 		})
 		assert.NoError(t, err)
 
@@ -234,13 +370,86 @@ func TestServer_GetPopularDecks(t *testing.T) {
 	})
 
 	t.Run("error", func(t *testing.T) {
-		repoMock.On("GetPopularDecks", mock.Anything, userID, pagination.Pagination{}).Return(
+		repoMock.On("GetPopularDecks", mock.Anything, userID, deck.PeriodAllTime, []string(nil), pagination.Pagination{}).Return(
 			deck.PopularDecksConnection{},
 			assert.AnError,
 		)
 		_, err := srv.GetPopularDecks(context.Background(), &pb.GetPopularDecksRequest{UserId: userID.String(), Pagination: &pb.Pagination{}})
 		assert.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		_, err := srv.GetPopularDecks(context.Background(), &pb.GetPopularDecksRequest{UserId: userID.String(), Pagination: &pb.Pagination{}, Period: "month"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServer_SearchDecks(t *testing.T) {
+	repoMock := &deck.RepositoryMock{}
+	srv := &Server{Repository: repoMock}
+
+	userID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+
+	t.Run("success", func(t *testing.T) {
+		repoMock.On("SearchDecks", mock.Anything, userID, "goroutines", "english", []string{"go"}, pagination.Pagination{First: 2}).Return(deck.SearchDecksConnection{
+			Edges: []deck.SearchDeckEdge{
+				{
+					DeckID: uuid.MustParse("f79aea77-9aa0-4a84-b4c8-d000a27d2c52"),
+					Rank:   0.6,
+					Cursor: pagination.Cursor("9999"),
+				},
+			},
+			PageInfo: pagination.PageInfo{
+				HasNextPage: true,
+				StartCursor: pagination.Cursor("9999"),
+				EndCursor:   pagination.Cursor("9999"),
+			},
+		}, nil)
+
+		res, err := srv.SearchDecks(context.Background(), &pb.SearchDecksRequest{
+			UserId:     userID.String(),
+			Query:      "  goroutines ",
+			Language:   "english",
+			Pagination: &pb.Pagination{First: 2},
+			Tags:       []string{"Go"},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, &pb.SearchDecksResponse{Connection: &pb.SearchDecksConnection{
+			Edges: []*pb.SearchDecksConnection_Edge{
+				{
+					DeckId: "f79aea77-9aa0-4a84-b4c8-d000a27d2c52",
+					Cursor: "9999",
+					Rank:   0.6,
+				},
+			},
+			PageInfo: &pb.PageInfo{
+				HasNextPage: true,
+				StartCursor: "9999",
+				EndCursor:   "9999",
+			},
+		}}, res)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := srv.SearchDecks(context.Background(), &pb.SearchDecksRequest{UserId: userID.String(), Query: " ", Pagination: &pb.Pagination{}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = srv.SearchDecks(context.Background(), &pb.SearchDecksRequest{UserId: userID.String(), Query: "go", Language: "klingon", Pagination: &pb.Pagination{}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		repoMock.On("SearchDecks", mock.Anything, userID, "go", "", []string(nil), pagination.Pagination{After: "nope"}).Return(deck.SearchDecksConnection{}, deck.ErrInvalidCursor)
+
+		_, err = srv.SearchDecks(context.Background(), &pb.SearchDecksRequest{UserId: userID.String(), Query: "go", Pagination: &pb.Pagination{After: "nope"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		repoMock.On("SearchDecks", mock.Anything, userID, "rust", "", []string(nil), pagination.Pagination{}).Return(deck.SearchDecksConnection{}, assert.AnError)
+
+		_, err := srv.SearchDecks(context.Background(), &pb.SearchDecksRequest{UserId: userID.String(), Query: "rust", Pagination: &pb.Pagination{}})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
 }
 
 func TestServer_GetCards(t *testing.T) {
@@ -260,20 +469,64 @@ func TestServer_GetCards(t *testing.T) {
 		cards := map[uuid.UUID]deck.Card{
 			c.ID: c,
 		}
+		d := deck.Deck{ID: uuid.MustParse("0b1c1ab4-56d5-4bc4-9d57-63b00f2b4c6a"), AuthorID: uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01"), Public: true}
 
 		repoMock.On("GetCards", mock.Anything, []uuid.UUID{c.ID}).Return(cards, nil)
+		repoMock.On("GetCardDecks", mock.Anything, []uuid.UUID{c.ID}).Return(map[uuid.UUID]uuid.UUID{c.ID: d.ID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: d}, nil)
 
 		res, err := srv.GetCards(context.Background(), &pb.GetCardsRequest{CardIds: []string{"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"}})
 		assert.NoError(t, err)
 		assert.Equal(t, &pb.GetCardsResponse{Cards: map[string]*pb.Card{
-			"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598": {
-				Id:              c.ID.String(),
-				Title:           c.Title,
-				Explanation:     c.Explanation,
-				PossibleAnswers: toGRPCAnswers(c.PossibleAnswers),
-				Kind:            "single_choice",
-			},
+			"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598": toGRPCCard(c.LearnerView()),
 		}}, res)
+		assert.False(t, res.Cards[c.ID.String()].PossibleAnswers[0].IsCorrect)
+	})
+
+	t.Run("with_answers", func(t *testing.T) {
+		c := deck.Card{
+			ID:              uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33"),
+			Title:           "What does CSP stand for?",
+			PossibleAnswers: []deck.Answer{{Text: "Communicating Sequential Processes", IsCorrect: true}},
+			Kind:            "single_choice",
+		}
+		d := deck.Deck{ID: uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"), AuthorID: uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01")}
+		learnerID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+
+		repoMock.On("GetCards", mock.Anything, []uuid.UUID{c.ID}).Return(map[uuid.UUID]deck.Card{c.ID: c}, nil)
+		repoMock.On("GetCardDecks", mock.Anything, []uuid.UUID{c.ID}).Return(map[uuid.UUID]uuid.UUID{c.ID: d.ID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: d}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, d.ID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		res, err := srv.GetCards(context.Background(), &pb.GetCardsRequest{CardIds: []string{c.ID.String()}, UserId: d.AuthorID.String(), WithAnswers: true})
+		assert.NoError(t, err)
+		assert.True(t, res.Cards[c.ID.String()].PossibleAnswers[0].IsCorrect)
+
+		// The cards of private decks are left out for those who can't see them
+		res, err = srv.GetCards(context.Background(), &pb.GetCardsRequest{CardIds: []string{c.ID.String()}, UserId: learnerID.String(), WithAnswers: true})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Cards)
+	})
+
+	t.Run("public_deck", func(t *testing.T) {
+		c := deck.Card{
+			ID:              uuid.MustParse("c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"),
+			Title:           "What does CSP stand for?",
+			PossibleAnswers: []deck.Answer{{Text: "Communicating Sequential Processes", IsCorrect: true}},
+			Kind:            "single_choice",
+		}
+		d := deck.Deck{ID: uuid.MustParse("e3a5b1f4-0f0e-4b8a-9a51-3f6f3e6a9c11"), AuthorID: uuid.MustParse("f3b59a97-e678-4410-8ed2-f1094a234a01"), Public: true}
+		learnerID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+
+		repoMock.On("GetCards", mock.Anything, []uuid.UUID{c.ID}).Return(map[uuid.UUID]deck.Card{c.ID: c}, nil)
+		repoMock.On("GetCardDecks", mock.Anything, []uuid.UUID{c.ID}).Return(map[uuid.UUID]uuid.UUID{c.ID: d.ID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: d}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, d.ID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		// Asking isn't enough for those who can't edit the deck
+		res, err := srv.GetCards(context.Background(), &pb.GetCardsRequest{CardIds: []string{c.ID.String()}, UserId: learnerID.String(), WithAnswers: true})
+		assert.NoError(t, err)
+		assert.False(t, res.Cards[c.ID.String()].PossibleAnswers[0].IsCorrect)
 	})
 
 	t.Run("error", func(t *testing.T) {
@@ -286,6 +539,344 @@ func TestServer_GetCards(t *testing.T) {
 	repoMock.AssertExpectations(t)
 }
 
+// cardStream collects what StreamDeckCards sends.
+type cardStream struct {
+	grpc.ServerStream
+	sent []*pb.StreamDeckCardsResponse
+}
+
+func (s *cardStream) Context() context.Context { return context.Background() }
+
+func (s *cardStream) Send(res *pb.StreamDeckCardsResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+func TestServer_DeckCards(t *testing.T) {
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	learnerID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+	d := deck.Deck{ID: uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"), AuthorID: authorID, Public: true}
+
+	cards := map[uuid.UUID]deck.Card{}
+	var edges []deck.DeckCardEdge
+	for i, id := range []string{"5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598", "72bdff92-5bc8-4e1d-9217-d0b23e22ff33", "c924f7e0-efd8-4c2d-9c43-8eafb7102ebc"} {
+		c := deck.Card{
+			ID:              uuid.MustParse(id),
+			Title:           "Card",
+			Kind:            "single_choice",
+			Explanation:     "Because",
+			PossibleAnswers: []deck.Answer{{Text: "Yes", IsCorrect: true}},
+		}
+		cards[c.ID] = c
+		edges = append(edges, deck.DeckCardEdge{CardID: c.ID, Cursor: pagination.Cursor(string(rune('a' + i)))})
+	}
+
+	newRepo := func() *deck.RepositoryMock {
+		repoMock := &deck.RepositoryMock{}
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: d}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, d.ID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+		repoMock.On("GetCards", mock.Anything, mock.Anything).Return(cards, nil)
+		return repoMock
+	}
+
+	t.Run("page", func(t *testing.T) {
+		repoMock := newRepo()
+		repoMock.On("GetDeckCardsPage", mock.Anything, d.ID, pagination.Pagination{First: 2, After: "a"}).Return(deck.DeckCardsConnection{
+			Edges:    edges[1:],
+			PageInfo: pagination.PageInfo{HasPreviousPage: true, StartCursor: "b", EndCursor: "c"},
+		}, nil)
+		srv := &Server{Repository: repoMock}
+
+		res, err := srv.GetDeckCards(context.Background(), &pb.GetDeckCardsRequest{
+			DeckId:      d.ID.String(),
+			UserId:      learnerID.String(),
+			Pagination:  &pb.Pagination{First: 2, After: "a"},
+			WithAnswers: true,
+		})
+		assert.NoError(t, err)
+		require.Len(t, res.Connection.Edges, 2)
+		assert.Equal(t, edges[1].CardID.String(), res.Connection.Edges[0].Node.Id)
+		assert.Equal(t, "b", res.Connection.Edges[0].Cursor)
+		assert.Equal(t, "c", res.Connection.PageInfo.EndCursor)
+
+		// Learners don't get to see the solution
+		assert.Empty(t, res.Connection.Edges[0].Node.Explanation)
+		assert.False(t, res.Connection.Edges[0].Node.PossibleAnswers[0].IsCorrect)
+	})
+
+	t.Run("page_size", func(t *testing.T) {
+		repoMock := newRepo()
+		repoMock.On("GetDeckCardsPage", mock.Anything, d.ID, pagination.Pagination{First: 20}).Return(deck.DeckCardsConnection{}, nil).Once()
+		repoMock.On("GetDeckCardsPage", mock.Anything, d.ID, pagination.Pagination{First: 100}).Return(deck.DeckCardsConnection{}, nil).Once()
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.GetDeckCards(context.Background(), &pb.GetDeckCardsRequest{DeckId: d.ID.String(), UserId: learnerID.String()})
+		assert.NoError(t, err)
+
+		_, err = srv.GetDeckCards(context.Background(), &pb.GetDeckCardsRequest{
+			DeckId:     d.ID.String(),
+			UserId:     learnerID.String(),
+			Pagination: &pb.Pagination{First: 10000},
+		})
+		assert.NoError(t, err)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		private := deck.Deck{ID: uuid.MustParse("b3c3e0a2-6f55-4a0e-8a4c-1d1f4a3a2e10"), AuthorID: authorID}
+		empty := deck.Deck{ID: uuid.MustParse("0e0ef5d4-2f6c-4b8b-9a7b-5b1c3e0f7d22"), AuthorID: authorID, Public: true}
+
+		repoMock := &deck.RepositoryMock{}
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID, private.ID, empty.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: d, private.ID: private, empty.ID: empty}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, private.ID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+		repoMock.On("GetDecksFirstCards", mock.Anything, mock.MatchedBy(func(ids []uuid.UUID) bool {
+			return assert.ElementsMatch(t, []uuid.UUID{d.ID, empty.ID}, ids)
+		}), 2).Return(map[uuid.UUID]deck.DeckCardsConnection{
+			d.ID: {Edges: edges[:2], PageInfo: pagination.PageInfo{HasNextPage: true, StartCursor: "a", EndCursor: "b"}},
+		}, nil)
+		repoMock.On("GetCards", mock.Anything, []uuid.UUID{edges[0].CardID, edges[1].CardID}).Return(cards, nil)
+		srv := &Server{Repository: repoMock}
+
+		res, err := srv.GetDecksCards(context.Background(), &pb.GetDecksCardsRequest{
+			DeckIds: []string{d.ID.String(), private.ID.String(), empty.ID.String()},
+			UserId:  learnerID.String(),
+			First:   2,
+		})
+		assert.NoError(t, err)
+
+		// Private decks the learner isn't a member of are left out
+		require.Len(t, res.Connections, 2)
+
+		conn := res.Connections[d.ID.String()]
+		require.Len(t, conn.Edges, 2)
+		assert.Equal(t, edges[0].CardID.String(), conn.Edges[0].Node.Id)
+		assert.Equal(t, "b", conn.PageInfo.EndCursor)
+		assert.True(t, conn.PageInfo.HasNextPage)
+		assert.Empty(t, conn.Edges[0].Node.Explanation)
+
+		assert.Empty(t, res.Connections[empty.ID.String()].Edges)
+
+		_, err = srv.GetDecksCards(context.Background(), &pb.GetDecksCardsRequest{DeckIds: []string{"nope"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("private", func(t *testing.T) {
+		private := d
+		private.Public = false
+
+		repoMock := &deck.RepositoryMock{}
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{d.ID: private}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, d.ID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.GetDeckCards(context.Background(), &pb.GetDeckCardsRequest{DeckId: d.ID.String(), UserId: learnerID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{d.ID}).Return(map[uuid.UUID]deck.Deck{}, nil)
+		srv := &Server{Repository: repoMock}
+
+		err := srv.StreamDeckCards(&pb.StreamDeckCardsRequest{DeckId: d.ID.String()}, &cardStream{})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		repoMock := newRepo()
+		repoMock.On("GetDeckCardsPage", mock.Anything, d.ID, pagination.Pagination{First: 2}).Return(deck.DeckCardsConnection{
+			Edges:    edges[:2],
+			PageInfo: pagination.PageInfo{HasNextPage: true, StartCursor: "a", EndCursor: "b"},
+		}, nil)
+		repoMock.On("GetDeckCardsPage", mock.Anything, d.ID, pagination.Pagination{First: 2, After: "b"}).Return(deck.DeckCardsConnection{
+			Edges:    edges[2:],
+			PageInfo: pagination.PageInfo{StartCursor: "c", EndCursor: "c"},
+		}, nil)
+		srv := &Server{Repository: repoMock}
+
+		stream := &cardStream{}
+		err := srv.StreamDeckCards(&pb.StreamDeckCardsRequest{DeckId: d.ID.String(), UserId: authorID.String(), BatchSize: 2, WithAnswers: true}, stream)
+		assert.NoError(t, err)
+
+		require.Len(t, stream.sent, 2)
+		assert.Len(t, stream.sent[0].Cards, 2)
+		assert.Len(t, stream.sent[1].Cards, 1)
+		assert.Equal(t, edges[2].CardID.String(), stream.sent[1].Cards[0].Id)
+
+		// Authors get the whole card
+		assert.Equal(t, "Because", stream.sent[0].Cards[0].Explanation)
+		repoMock.AssertNumberOfCalls(t, "GetDeckCardsPage", 2)
+	})
+}
+
+func TestServer_GradeAnswers(t *testing.T) {
+	repoMock := &deck.RepositoryMock{}
+	srv := &Server{Repository: repoMock}
+
+	correctID := uuid.MustParse("9a1d5d0e-7f3a-4c1b-9d6e-1f0b6c2a3e41")
+	wrongID := uuid.MustParse("0c7e4b1a-2d3f-4e5a-8b6c-7d8e9f0a1b2c")
+	c := deck.Card{
+		ID:          uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"),
+		Title:       "What does CSP stand for?",
+		Explanation: "Hoare, 1978",
+		PossibleAnswers: []deck.Answer{
+			{ID: correctID, Text: "Communicating Sequential Processes", IsCorrect: true},
+			{ID: wrongID, Text: "Concurrent Shared Processes"},
+		},
+		Kind: "single_choice",
+	}
+
+	goID := uuid.MustParse("4b9e2f3c-1a7d-4c8e-9f0a-2b3c4d5e6f70")
+	googleID := uuid.MustParse("6d1f0e2a-3b4c-4d5e-8f6a-7b8c9d0e1f2a")
+	rustID := uuid.MustParse("8e2a1b3c-4d5e-4f6a-9b7c-8d9e0f1a2b3c")
+	mozillaID := uuid.MustParse("a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d")
+	matching := deck.Card{
+		ID:    uuid.MustParse("c0ffee00-1234-4abc-8def-0123456789ab"),
+		Title: "Match each language with where it was created",
+		Kind:  deck.CardKindMatching,
+		PossibleAnswers: []deck.Answer{
+			{ID: goID, Text: "Go", IsCorrect: true, Position: 0, Side: deck.AnswerSideLeft},
+			{ID: googleID, Text: "Google", IsCorrect: true, Position: 0, Side: deck.AnswerSideRight},
+			{ID: rustID, Text: "Rust", IsCorrect: true, Position: 1, Side: deck.AnswerSideLeft},
+			{ID: mozillaID, Text: "Mozilla", IsCorrect: true, Position: 1, Side: deck.AnswerSideRight},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		repoMock.On("GetCards", mock.Anything, []uuid.UUID{c.ID, matching.ID}).Return(map[uuid.UUID]deck.Card{c.ID: c, matching.ID: matching}, nil).Once()
+
+		res, err := srv.GradeAnswers(context.Background(), &pb.GradeAnswersRequest{Answers: []*pb.CardAnswer{
+			{CardId: c.ID.String(), Response: &pb.CardAnswer_AnswerId{AnswerId: wrongID.String()}},
+			{CardId: c.ID.String(), Response: &pb.CardAnswer_AnswerId{AnswerId: correctID.String()}},
+			{CardId: matching.ID.String(), Response: &pb.CardAnswer_Pairs{Pairs: &pb.MatchedPairs{Pairs: []*pb.MatchedPairs_Pair{
+				{LeftId: rustID.String(), RightId: mozillaID.String()},
+				{LeftId: goID.String(), RightId: googleID.String()},
+			}}}},
+			{CardId: matching.ID.String(), Response: &pb.CardAnswer_Pairs{Pairs: &pb.MatchedPairs{Pairs: []*pb.MatchedPairs_Pair{
+				{LeftId: rustID.String(), RightId: googleID.String()},
+				{LeftId: goID.String(), RightId: mozillaID.String()},
+			}}}},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.GradeAnswersResponse{Grades: []*pb.CardGrade{
+			{CardId: c.ID.String(), IsCorrect: false, Explanation: "Hoare, 1978"},
+			{CardId: c.ID.String(), IsCorrect: true, Explanation: "Hoare, 1978"},
+			{CardId: matching.ID.String(), IsCorrect: true},
+			{CardId: matching.ID.String(), IsCorrect: false},
+		}}, res)
+	})
+
+	t.Run("card not found", func(t *testing.T) {
+		missingID := uuid.MustParse("3d2c1b0a-9f8e-4d7c-8b6a-5f4e3d2c1b0a")
+		repoMock.On("GetCards", mock.Anything, []uuid.UUID{missingID}).Return(map[uuid.UUID]deck.Card{}, nil).Once()
+
+		_, err := srv.GradeAnswers(context.Background(), &pb.GradeAnswersRequest{Answers: []*pb.CardAnswer{
+			{CardId: missingID.String(), Response: &pb.CardAnswer_AnswerId{AnswerId: correctID.String()}},
+		}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid card id", func(t *testing.T) {
+		_, err := srv.GradeAnswers(context.Background(), &pb.GradeAnswersRequest{Answers: []*pb.CardAnswer{
+			{CardId: "not-a-uuid", Response: &pb.CardAnswer_AnswerId{AnswerId: correctID.String()}},
+		}})
+		assert.Error(t, err)
+	})
+
+	repoMock.AssertExpectations(t)
+}
+
+func TestServer_ImportDeck(t *testing.T) {
+	authorID := "2d6c5b4a-3f2e-4d1c-9b8a-7f6e5d4c3b2a"
+	newDeck := &pb.Deck{AuthorId: authorID, Title: "Japanese vocabulary", Description: "JLPT N5 words"}
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		repoMock.On("StoreDeck", mock.Anything, mock.MatchedBy(func(d deck.Deck) bool {
+			return d.Title == "Japanese vocabulary" && len(d.Cards) == 2 && d.ID != uuid.Nil
+		})).Return(nil)
+		srv := &Server{Repository: repoMock}
+
+		res, err := srv.ImportDeck(context.Background(), &pb.ImportDeckRequest{
+			Deck:   newDeck,
+			Data:   []byte("本\tbook\n水\twater\n"),
+			Format: "tsv",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "本", res.Deck.Cards[0].Title)
+		assert.Equal(t, "water", res.Deck.Cards[1].PossibleAnswers[0].Text)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("row errors", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		_, err := srv.ImportDeck(context.Background(), &pb.ImportDeckRequest{
+			Deck:   newDeck,
+			Data:   []byte("本,book\n,water\n"),
+			Format: "csv",
+		})
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		require.Len(t, st.Details(), 2)
+		assert.Equal(t, "INVALID_DECK", st.Details()[0].(*errdetails.ErrorInfo).Reason)
+		violation := st.Details()[1].(*errdetails.BadRequest).FieldViolations[0]
+		assert.Equal(t, "rows[2].title", violation.Field)
+		assert.Equal(t, "NO_TITLE", violation.Reason)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		_, err := srv.ImportDeck(context.Background(), &pb.ImportDeckRequest{Deck: newDeck, Format: "xlsx"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServer_ExportDeck(t *testing.T) {
+	repoMock := &deck.RepositoryMock{}
+	srv := &Server{Repository: repoMock}
+
+	authorID := uuid.MustParse("2d6c5b4a-3f2e-4d1c-9b8a-7f6e5d4c3b2a")
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	d := deck.Deck{
+		ID:          deckID,
+		AuthorID:    authorID,
+		Title:       "Go",
+		Description: "Polish your Go skills",
+		Public:      true,
+		Cards: []deck.Card{{
+			Title: "What does CSP stand for?",
+			Kind:  deck.CardKindSingleChoice,
+			PossibleAnswers: []deck.Answer{
+				{Text: "Communicating Sequential Processes", IsCorrect: true},
+			},
+		}},
+	}
+	repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+	repoMock.On("GetDeckMember", mock.Anything, mock.Anything, mock.Anything).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+	t.Run("success", func(t *testing.T) {
+		res, err := srv.ExportDeck(context.Background(), &pb.ExportDeckRequest{
+			DeckId: deckID.String(),
+			UserId: authorID.String(),
+			Format: "csv",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "title,answer 1,correct,explanation,kind,blanks,tolerances,sides\nWhat does CSP stand for?,Communicating Sequential Processes,1,,single_choice,0,0,\n", string(res.Data))
+	})
+
+	t.Run("not the author", func(t *testing.T) {
+		_, err := srv.ExportDeck(context.Background(), &pb.ExportDeckRequest{
+			DeckId: deckID.String(),
+			UserId: uuid.NewString(),
+			Format: "csv",
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
 func TestServer_UpdateDeck(t *testing.T) {
 	validDeckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
 
@@ -312,6 +903,37 @@ func TestServer_UpdateDeck(t *testing.T) {
 		assert.Equal(t, "Original description", res.Deck.Description)
 	})
 
+	t.Run("success_update_tags", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		tags := []string{"go", "concurrency"}
+		repoMock.On("UpdateDeck", mock.Anything, validDeckID, deck.DeckUpdates{
+			Tags: &tags,
+		}).Return(deck.Deck{ID: validDeckID, Tags: []string{"concurrency", "go"}}, nil)
+
+		res, err := srv.UpdateDeck(context.Background(), &pb.UpdateDeckRequest{
+			Id:   validDeckID.String(),
+			Tags: &pb.TagList{Tags: []string{" Go", "concurrency", "GO"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"concurrency", "go"}, res.Deck.Tags)
+	})
+
+	t.Run("failure_too_many_tags", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.UpdateDeck(context.Background(), &pb.UpdateDeckRequest{
+			Id:   validDeckID.String(),
+			Tags: &pb.TagList{Tags: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repoMock.AssertNotCalled(t, "UpdateDeck")
+	})
+
 	t.Run("failure_no_fields_provided", func(t *testing.T) {
 		repoMock := &deck.RepositoryMock{}
 		srv := &Server{Repository: repoMock}
@@ -339,21 +961,42 @@ func TestServer_UpdateDeck(t *testing.T) {
 		assert.Error(t, err)
 	})
 
-	t.Run("failure_invalid_deck_ID", func(t *testing.T) {
+	t.Run("failure_version_conflict", func(t *testing.T) {
 		repoMock := &deck.RepositoryMock{}
 		srv := &Server{Repository: repoMock}
 
 		newTitle := "Updated Title"
+		expected := 3
+		repoMock.On("UpdateDeck", mock.Anything, validDeckID, deck.DeckUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &expected,
+		}).Return(deck.Deck{}, errors.Trace(deck.ErrVersionConflict))
+
 		_, err := srv.UpdateDeck(context.Background(), &pb.UpdateDeckRequest{
-			Id:    "invalid-uuid",
-			Title: &newTitle,
+			Id:              validDeckID.String(),
+			Title:           &newTitle,
+			ExpectedVersion: proto.Int32(3),
 		})
 
-		assert.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), deck.ErrVersionConflict.Error())
 	})
-}
 
-func TestServer_UpdateCard(t *testing.T) {
+	t.Run("failure_invalid_deck_ID", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		newTitle := "Updated Title"
+		_, err := srv.UpdateDeck(context.Background(), &pb.UpdateDeckRequest{
+			Id:    "invalid-uuid",
+			Title: &newTitle,
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestServer_UpdateCard(t *testing.T) {
 	validDeckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
 	validCardID := uuid.MustParse("1f30a72f-5d7a-48da-a5c2-42efece6972a")
 
@@ -404,8 +1047,13 @@ func TestServer_UpdateCard(t *testing.T) {
 			Kind:   &invalidKind,
 		})
 
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, deck.ErrInvalidKind)
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, deck.ErrInvalidKind.Error(), st.Message())
+
+		require.Len(t, st.Details(), 2)
+		assert.Equal(t, "INVALID_KIND", st.Details()[0].(*errdetails.ErrorInfo).Reason)
+		assert.Equal(t, "kind", st.Details()[1].(*errdetails.BadRequest).FieldViolations[0].Field)
 	})
 
 	t.Run("failure_card_not_found", func(t *testing.T) {
@@ -423,6 +1071,42 @@ func TestServer_UpdateCard(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+	t.Run("failure_kind_does_not_fit_answers", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		newKind := "matching"
+		repoMock.On("UpdateCard", mock.Anything, validDeckID, validCardID, deck.CardUpdates{Kind: &newKind}).Return(deck.Card{}, errors.Trace(deck.ErrInvalidPairs))
+
+		_, err := srv.UpdateCard(context.Background(), &pb.UpdateCardRequest{
+			DeckId: validDeckID.String(),
+			CardId: validCardID.String(),
+			Kind:   &newKind,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		repoMock.AssertExpectations(t)
+	})
+	t.Run("failure_version_conflict", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		newTitle := "Updated Card Title"
+		expected := 2
+		repoMock.On("UpdateCard", mock.Anything, validDeckID, validCardID, deck.CardUpdates{
+			Title:           &newTitle,
+			ExpectedVersion: &expected,
+		}).Return(deck.Card{}, errors.Trace(deck.ErrVersionConflict))
+
+		_, err := srv.UpdateCard(context.Background(), &pb.UpdateCardRequest{
+			DeckId:          validDeckID.String(),
+			CardId:          validCardID.String(),
+			Title:           &newTitle,
+			ExpectedVersion: proto.Int32(2),
+		})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
 }
 
 func TestServer_UpdateAnswer(t *testing.T) {
@@ -494,6 +1178,25 @@ func TestServer_UpdateAnswer(t *testing.T) {
 		assert.Contains(t, err.Error(), "no fields to update")
 	})
 
+	t.Run("failure_invalid_card", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		isCorrect := false
+		repoMock.On("UpdateAnswer", mock.Anything, validDeckID, validCardID, validAnswerID, deck.AnswerUpdates{
+			IsCorrect: &isCorrect,
+		}).Return(deck.Answer{}, errors.Trace(deck.ErrNoCorrectAnswer))
+
+		_, err := srv.UpdateAnswer(context.Background(), &pb.UpdateAnswerRequest{
+			DeckId:    validDeckID.String(),
+			CardId:    validCardID.String(),
+			AnswerId:  validAnswerID.String(),
+			IsCorrect: &isCorrect,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("failure_invalid_answer_ID", func(t *testing.T) {
 		repoMock := &deck.RepositoryMock{}
 		srv := &Server{Repository: repoMock}
@@ -509,3 +1212,984 @@ func TestServer_UpdateAnswer(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestServer_DeleteCard(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("1f30a72f-5d7a-48da-a5c2-42efece6972a")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteCard", mock.Anything, deckID, cardID, authorID).Return(nil)
+
+		_, err := srv.DeleteCard(context.Background(), &pb.DeleteCardRequest{DeckId: deckID.String(), CardId: cardID.String(), UserId: authorID.String()})
+		assert.NoError(t, err)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteCard", mock.Anything, deckID, cardID, uuid.Nil).Return(deck.ErrCardNotFound)
+
+		_, err := srv.DeleteCard(context.Background(), &pb.DeleteCardRequest{DeckId: deckID.String(), CardId: cardID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid_card_id", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		_, err := srv.DeleteCard(context.Background(), &pb.DeleteCardRequest{DeckId: deckID.String(), CardId: "nope"})
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid card id", st.Message())
+
+		require.Len(t, st.Details(), 2)
+		assert.Equal(t, reasonInvalidID, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+		assert.Equal(t, "card_id", st.Details()[1].(*errdetails.BadRequest).FieldViolations[0].Field)
+	})
+}
+
+func TestServer_CreateAnswer(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("1f30a72f-5d7a-48da-a5c2-42efece6972a")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		answerID := uuid.New()
+		repoMock.On("CreateAnswer", mock.Anything, deckID, cardID, mock.MatchedBy(func(a deck.Answer) bool {
+			return a.ID != uuid.Nil && a.Text == "Transpiled" && !a.IsCorrect
+		}), authorID).Return(deck.Card{ID: cardID, Title: "Zig", Kind: deck.CardKindSingleChoice, PossibleAnswers: []deck.Answer{
+			{ID: uuid.New(), Text: "Compiled", IsCorrect: true},
+			{ID: answerID, Text: "Transpiled"},
+		}}, nil)
+
+		res, err := srv.CreateAnswer(context.Background(), &pb.CreateAnswerRequest{
+			DeckId: deckID.String(),
+			CardId: cardID.String(),
+			UserId: authorID.String(),
+			Answer: &pb.Answer{Text: "Transpiled"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Card.PossibleAnswers, 2)
+		assert.Equal(t, answerID.String(), res.Card.PossibleAnswers[1].Id)
+	})
+
+	t.Run("invalid_card", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("CreateAnswer", mock.Anything, deckID, cardID, mock.Anything, authorID).Return(deck.Card{}, errors.Trace(deck.ErrNoTextAnswer))
+
+		_, err := srv.CreateAnswer(context.Background(), &pb.CreateAnswerRequest{
+			DeckId: deckID.String(),
+			CardId: cardID.String(),
+			UserId: authorID.String(),
+			Answer: &pb.Answer{},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, deck.ErrNoTextAnswer.Error(), status.Convert(err).Message())
+	})
+
+	t.Run("missing_answer", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		_, err := srv.CreateAnswer(context.Background(), &pb.CreateAnswerRequest{DeckId: deckID.String(), CardId: cardID.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServer_DeleteAnswer(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("1f30a72f-5d7a-48da-a5c2-42efece6972a")
+	answerID := uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+
+	req := &pb.DeleteAnswerRequest{
+		DeckId:   deckID.String(),
+		CardId:   cardID.String(),
+		AnswerId: answerID.String(),
+		UserId:   authorID.String(),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteAnswer", mock.Anything, deckID, cardID, answerID, authorID).Return(deck.Card{ID: cardID, Title: "Zig", PossibleAnswers: []deck.Answer{
+			{ID: uuid.New(), Text: "Compiled", IsCorrect: true},
+		}}, nil)
+
+		res, err := srv.DeleteAnswer(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Len(t, res.Card.PossibleAnswers, 1)
+	})
+
+	t.Run("last_correct_answer", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteAnswer", mock.Anything, deckID, cardID, answerID, authorID).Return(deck.Card{}, errors.Trace(deck.ErrNoCorrectAnswer))
+
+		_, err := srv.DeleteAnswer(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteAnswer", mock.Anything, deckID, cardID, answerID, authorID).Return(deck.Card{}, errors.Trace(deck.ErrAnswerNotFound))
+
+		_, err := srv.DeleteAnswer(context.Background(), req)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestServer_Topics(t *testing.T) {
+	goID := uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598")
+	jpID := uuid.MustParse("6363e2c6-d89e-4610-92e8-1e1d2fea49ec")
+	n5ID := uuid.MustParse("f79aea77-9aa0-4a84-b4c8-d000a27d2c52")
+
+	t.Run("get_topics", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetTopics", mock.Anything).Return([]deck.Topic{
+			{ID: n5ID, ParentID: jpID, Name: "N5", Tags: []string{"jlpt n5"}},
+			{ID: jpID, Name: "Japanese"},
+			{ID: goID, Name: "Go", Tags: []string{"go"}},
+		}, nil)
+
+		res, err := srv.GetTopics(context.Background(), &pb.GetTopicsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, []*pb.Topic{
+			{Id: goID.String(), Name: "Go", Tags: []string{"go"}, Children: []*pb.Topic{}},
+			{Id: jpID.String(), Name: "Japanese", Children: []*pb.Topic{
+				{Id: n5ID.String(), ParentId: jpID.String(), Name: "N5", Tags: []string{"jlpt n5"}, Children: []*pb.Topic{}},
+			}},
+		}, res.Topics)
+	})
+
+	t.Run("create_topic", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("StoreTopic", mock.Anything, mock.MatchedBy(func(tp deck.Topic) bool {
+			return tp.Name == "N4" && tp.ParentID == jpID && tp.ID != uuid.Nil
+		})).Return(nil).Once()
+
+		res, err := srv.CreateTopic(context.Background(), &pb.CreateTopicRequest{ParentId: jpID.String(), Name: " N4 "})
+		assert.NoError(t, err)
+		assert.Equal(t, "N4", res.Topic.Name)
+		assert.Equal(t, jpID.String(), res.Topic.ParentId)
+
+		repoMock.On("StoreTopic", mock.Anything, mock.Anything).Return(deck.ErrTopicAlreadyExists).Once()
+
+		_, err = srv.CreateTopic(context.Background(), &pb.CreateTopicRequest{ParentId: jpID.String(), Name: "N4"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = srv.CreateTopic(context.Background(), &pb.CreateTopicRequest{Name: "  "})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("delete_topic", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("DeleteTopic", mock.Anything, goID).Return(deck.ErrTopicNotFound)
+
+		_, err := srv.DeleteTopic(context.Background(), &pb.DeleteTopicRequest{Id: goID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("set_tag_topic", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("SetTagTopic", mock.Anything, "jlpt n5", n5ID).Return(deck.Tag{Name: "jlpt n5", TopicID: n5ID, DeckCount: 3}, nil)
+
+		res, err := srv.SetTagTopic(context.Background(), &pb.SetTagTopicRequest{Tag: "JLPT N5", TopicId: n5ID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.Tag{Name: "jlpt n5", TopicId: n5ID.String(), DeckCount: 3}, res.Tag)
+
+		_, err = srv.SetTagTopic(context.Background(), &pb.SetTagTopicRequest{Tag: " "})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("autocomplete_tags", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("AutocompleteTags", mock.Anything, "jlpt", 10).Return([]deck.Tag{{Name: "jlpt n5", DeckCount: 3}}, nil)
+
+		res, err := srv.AutocompleteTags(context.Background(), &pb.AutocompleteTagsRequest{Prefix: "JLPT"})
+		assert.NoError(t, err)
+		assert.Equal(t, []*pb.Tag{{Name: "jlpt n5", DeckCount: 3}}, res.Tags)
+	})
+}
+
+func TestServer_CardRevisions(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	cardID := uuid.MustParse("1f30a72f-5d7a-48da-a5c2-42efece6972a")
+	answerID := uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	first := deck.Card{ID: cardID, Title: "Zig", Kind: deck.CardKindSingleChoice, PossibleAnswers: []deck.Answer{
+		{ID: answerID, Text: "Compiled", IsCorrect: true},
+	}}
+	second := deck.Card{ID: cardID, Title: "Zig language", Kind: deck.CardKindSingleChoice, PossibleAnswers: []deck.Answer{
+		{ID: answerID, Text: "Compiled", IsCorrect: true},
+	}}
+
+	t.Run("list_revisions", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetCardRevisions", mock.Anything, deckID, cardID).Return([]deck.CardRevision{
+			{CardID: cardID, Revision: 2, AuthorID: authorID, ChangedFields: []string{"title"}, Card: second, CreatedAt: createdAt, LearnerCount: 3},
+		}, nil)
+
+		res, err := srv.ListCardRevisions(context.Background(), &pb.ListCardRevisionsRequest{
+			DeckId: deckID.String(),
+			CardId: cardID.String(),
+			UserId: authorID.String(),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Revisions, 1)
+		assert.Equal(t, int32(2), res.Revisions[0].Revision)
+		assert.Equal(t, []string{"title"}, res.Revisions[0].ChangedFields)
+		assert.Equal(t, "Zig language", res.Revisions[0].Card.Title)
+		assert.Equal(t, createdAt, res.Revisions[0].CreatedAt.AsTime())
+		assert.Equal(t, int64(3), res.Revisions[0].LearnerCount)
+	})
+
+	t.Run("list_revisions_not_author", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, mock.Anything, mock.Anything).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.ListCardRevisions(context.Background(), &pb.ListCardRevisionsRequest{
+			DeckId: deckID.String(),
+			CardId: cardID.String(),
+			UserId: uuid.New().String(),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		repoMock.AssertNotCalled(t, "GetCardRevisions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("diff_revisions", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetCardRevision", mock.Anything, deckID, cardID, 1).Return(deck.CardRevision{Revision: 1, Card: first}, nil)
+		repoMock.On("GetCardRevision", mock.Anything, deckID, cardID, 2).Return(deck.CardRevision{Revision: 2, Card: second}, nil)
+		repoMock.On("GetCardRevision", mock.Anything, deckID, cardID, 9).Return(deck.CardRevision{}, deck.ErrRevisionNotFound)
+
+		res, err := srv.DiffCardRevisions(context.Background(), &pb.DiffCardRevisionsRequest{
+			DeckId:       deckID.String(),
+			CardId:       cardID.String(),
+			UserId:       authorID.String(),
+			FromRevision: 1,
+			ToRevision:   2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*pb.FieldChange{{Field: "title", From: "Zig", To: "Zig language"}}, res.Changes)
+
+		_, err = srv.DiffCardRevisions(context.Background(), &pb.DiffCardRevisionsRequest{
+			DeckId:       deckID.String(),
+			CardId:       cardID.String(),
+			UserId:       authorID.String(),
+			FromRevision: 1,
+			ToRevision:   9,
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("restore_revision", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("RestoreCardRevision", mock.Anything, deckID, cardID, 1, authorID).Return(first, nil)
+
+		res, err := srv.RestoreCardRevision(context.Background(), &pb.RestoreCardRevisionRequest{
+			DeckId:   deckID.String(),
+			CardId:   cardID.String(),
+			UserId:   authorID.String(),
+			Revision: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Zig", res.Card.Title)
+	})
+
+	t.Run("restore_without_user", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		_, err := srv.RestoreCardRevision(context.Background(), &pb.RestoreCardRevisionRequest{
+			DeckId:   deckID.String(),
+			CardId:   cardID.String(),
+			Revision: 1,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("update_card_records_author", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		title := "Zig language"
+		repoMock.On("UpdateCard", mock.Anything, deckID, cardID, deck.CardUpdates{Title: &title, AuthorID: authorID}).Return(second, nil)
+
+		_, err := srv.UpdateCard(context.Background(), &pb.UpdateCardRequest{
+			DeckId: deckID.String(),
+			CardId: cardID.String(),
+			Title:  &title,
+			UserId: authorID.String(),
+		})
+		assert.NoError(t, err)
+		repoMock.AssertExpectations(t)
+	})
+}
+
+func TestServer_ForkDeck(t *testing.T) {
+	upstreamID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	forkID := uuid.MustParse("0b6a3f3e-4a57-4a59-9c1b-7d2f0e8a4c11")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	forkerID := uuid.MustParse("9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55")
+	upstreamCardID := uuid.MustParse("1f30a72f-5d7a-48da-a5c2-42efece6972a")
+
+	t.Run("fork", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, upstreamID).Return(deck.Deck{ID: upstreamID, AuthorID: authorID, Public: true, Revision: 4}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, upstreamID, forkerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+		repoMock.On("ForkDeck", mock.Anything, upstreamID, forkerID).Return(deck.Deck{
+			ID:               forkID,
+			AuthorID:         forkerID,
+			Title:            "Programming languages",
+			Revision:         1,
+			UpstreamDeckID:   upstreamID,
+			UpstreamRevision: 4,
+		}, nil)
+
+		res, err := srv.ForkDeck(context.Background(), &pb.ForkDeckRequest{DeckId: upstreamID.String(), UserId: forkerID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, forkID.String(), res.Deck.Id)
+		assert.Equal(t, forkerID.String(), res.Deck.AuthorId)
+		assert.Equal(t, upstreamID.String(), res.Deck.UpstreamDeckId)
+		assert.Equal(t, int32(4), res.Deck.UpstreamRevision)
+	})
+
+	t.Run("fork_own_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, upstreamID).Return(deck.Deck{ID: upstreamID, AuthorID: authorID}, nil)
+		repoMock.On("ForkDeck", mock.Anything, upstreamID, authorID).Return(deck.Deck{ID: forkID, AuthorID: authorID, UpstreamDeckID: upstreamID}, nil)
+
+		_, err := srv.ForkDeck(context.Background(), &pb.ForkDeckRequest{DeckId: upstreamID.String(), UserId: authorID.String()})
+		assert.NoError(t, err)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("fork_private_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, upstreamID).Return(deck.Deck{ID: upstreamID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, mock.Anything, mock.Anything).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.ForkDeck(context.Background(), &pb.ForkDeckRequest{DeckId: upstreamID.String(), UserId: forkerID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		repoMock.AssertNotCalled(t, "ForkDeck", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fork_invalid_card", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, upstreamID).Return(deck.Deck{ID: upstreamID, AuthorID: authorID, Public: true}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, upstreamID, forkerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+		repoMock.On("ForkDeck", mock.Anything, upstreamID, forkerID).Return(deck.Deck{}, errors.Trace(deck.ErrNoCorrectAnswer))
+
+		_, err := srv.ForkDeck(context.Background(), &pb.ForkDeckRequest{DeckId: upstreamID.String(), UserId: forkerID.String()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("fork_invalid_user", func(t *testing.T) {
+		srv := &Server{Repository: &deck.RepositoryMock{}}
+
+		_, err := srv.ForkDeck(context.Background(), &pb.ForkDeckRequest{DeckId: upstreamID.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("get_upstream_changes", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, forkID).Return(deck.Deck{ID: forkID, AuthorID: forkerID, UpstreamDeckID: upstreamID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{upstreamID}).Return(map[uuid.UUID]deck.Deck{upstreamID: {ID: upstreamID, AuthorID: authorID, Public: true}}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, upstreamID, forkerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+		repoMock.On("GetUpstreamChanges", mock.Anything, forkID).Return([]deck.UpstreamChange{
+			{
+				UpstreamCardID: upstreamCardID,
+				Status:         deck.UpstreamCardAdded,
+				Revision:       1,
+				Changes:        []deck.FieldChange{{Field: "title", To: "Zig"}},
+				Card:           deck.Card{ID: upstreamCardID, Title: "Zig"},
+			},
+		}, nil)
+
+		res, err := srv.GetUpstreamChanges(context.Background(), &pb.GetUpstreamChangesRequest{DeckId: forkID.String(), UserId: forkerID.String()})
+		assert.NoError(t, err)
+		assert.Len(t, res.Changes, 1)
+		assert.Equal(t, upstreamCardID.String(), res.Changes[0].UpstreamCardId)
+		assert.Empty(t, res.Changes[0].CardId)
+		assert.Equal(t, "added", res.Changes[0].Status)
+		assert.Equal(t, []*pb.FieldChange{{Field: "title", To: "Zig"}}, res.Changes[0].Changes)
+	})
+
+	t.Run("get_upstream_changes_not_a_fork", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, upstreamID).Return(deck.Deck{ID: upstreamID, AuthorID: authorID}, nil)
+		repoMock.On("GetUpstreamChanges", mock.Anything, upstreamID).Return([]deck.UpstreamChange(nil), deck.ErrNotAFork)
+
+		_, err := srv.GetUpstreamChanges(context.Background(), &pb.GetUpstreamChangesRequest{DeckId: upstreamID.String(), UserId: authorID.String()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("pull_upstream_changes", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, forkID).Return(deck.Deck{ID: forkID, AuthorID: forkerID, UpstreamDeckID: upstreamID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{upstreamID}).Return(map[uuid.UUID]deck.Deck{upstreamID: {ID: upstreamID, AuthorID: authorID}}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, upstreamID, forkerID).Return(deck.Member{DeckID: upstreamID, UserID: forkerID, Role: deck.RoleEditor}, nil)
+		repoMock.On("PullUpstreamChanges", mock.Anything, forkID, []uuid.UUID{upstreamCardID}, forkerID).Return(nil)
+
+		_, err := srv.PullUpstreamChanges(context.Background(), &pb.PullUpstreamChangesRequest{
+			DeckId:          forkID.String(),
+			UserId:          forkerID.String(),
+			UpstreamCardIds: []string{upstreamCardID.String()},
+		})
+		assert.NoError(t, err)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("pull_upstream_changes_not_owner", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, forkID).Return(deck.Deck{ID: forkID, AuthorID: forkerID, UpstreamDeckID: upstreamID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, mock.Anything, mock.Anything).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.PullUpstreamChanges(context.Background(), &pb.PullUpstreamChangesRequest{
+			DeckId:          forkID.String(),
+			UserId:          authorID.String(),
+			UpstreamCardIds: []string{upstreamCardID.String()},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		repoMock.AssertNotCalled(t, "PullUpstreamChanges", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("pull_from_hidden_upstream", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		// The upstream deck was made private after the fork
+		repoMock.On("GetDeck", mock.Anything, forkID).Return(deck.Deck{ID: forkID, AuthorID: forkerID, UpstreamDeckID: upstreamID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{upstreamID}).Return(map[uuid.UUID]deck.Deck{upstreamID: {ID: upstreamID, AuthorID: authorID}}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, upstreamID, forkerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.PullUpstreamChanges(context.Background(), &pb.PullUpstreamChangesRequest{
+			DeckId:          forkID.String(),
+			UserId:          forkerID.String(),
+			UpstreamCardIds: []string{upstreamCardID.String()},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		repoMock.AssertNotCalled(t, "PullUpstreamChanges", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("pull_without_changes", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, forkID).Return(deck.Deck{ID: forkID, AuthorID: forkerID, UpstreamDeckID: upstreamID}, nil)
+		repoMock.On("GetDecks", mock.Anything, []uuid.UUID{upstreamID}).Return(map[uuid.UUID]deck.Deck{}, nil)
+		repoMock.On("PullUpstreamChanges", mock.Anything, forkID, []uuid.UUID{upstreamCardID}, forkerID).Return(deck.ErrNoUpstreamChanges)
+
+		_, err := srv.PullUpstreamChanges(context.Background(), &pb.PullUpstreamChangesRequest{
+			DeckId:          forkID.String(),
+			UserId:          forkerID.String(),
+			UpstreamCardIds: []string{upstreamCardID.String()},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestServer_DeckMembers(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	memberID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	d := deck.Deck{ID: deckID, AuthorID: authorID}
+
+	t.Run("list_members", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeckMembers", mock.Anything, deckID).Return([]deck.Member{
+			{DeckID: deckID, UserID: memberID, Role: deck.RoleEditor, CreatedAt: createdAt},
+		}, nil)
+
+		res, err := srv.ListDeckMembers(context.Background(), &pb.ListDeckMembersRequest{DeckId: deckID.String(), UserId: authorID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, []*pb.DeckMember{
+			{DeckId: deckID.String(), UserId: memberID.String(), Role: deck.RoleEditor, CreatedAt: timestamppb.New(createdAt)},
+		}, res.Members)
+	})
+
+	t.Run("invite", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("StoreDeckMember", mock.Anything, deck.Member{DeckID: deckID, UserID: memberID, Role: deck.RoleViewer}).
+			Return(deck.Member{DeckID: deckID, UserID: memberID, Role: deck.RoleViewer, CreatedAt: createdAt}, nil)
+
+		res, err := srv.InviteDeckMember(context.Background(), &pb.InviteDeckMemberRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: memberID.String(),
+			Role:     deck.RoleViewer,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, deck.RoleViewer, res.Member.Role)
+		assert.Equal(t, createdAt, res.Member.CreatedAt.AsTime())
+	})
+
+	t.Run("invite_existing_member", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("StoreDeckMember", mock.Anything, mock.Anything).Return(deck.Member{}, errors.Trace(deck.ErrMemberAlreadyExists))
+
+		_, err := srv.InviteDeckMember(context.Background(), &pb.InviteDeckMemberRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: memberID.String(),
+			Role:     deck.RoleViewer,
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("invite_invalid_role", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+
+		_, err := srv.InviteDeckMember(context.Background(), &pb.InviteDeckMemberRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: memberID.String(),
+			Role:     "admin",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, deck.ErrInvalidRole.Error(), status.Convert(err).Message())
+		repoMock.AssertNotCalled(t, "StoreDeckMember", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invite_author", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+
+		_, err := srv.InviteDeckMember(context.Background(), &pb.InviteDeckMemberRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: authorID.String(),
+			Role:     deck.RoleViewer,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, deck.ErrAuthorIsOwner.Error(), status.Convert(err).Message())
+	})
+
+	t.Run("change_role", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("UpdateDeckMember", mock.Anything, deckID, memberID, deck.RoleOwner).
+			Return(deck.Member{DeckID: deckID, UserID: memberID, Role: deck.RoleOwner, CreatedAt: createdAt}, nil)
+
+		res, err := srv.ChangeDeckMemberRole(context.Background(), &pb.ChangeDeckMemberRoleRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: memberID.String(),
+			Role:     deck.RoleOwner,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, deck.RoleOwner, res.Member.Role)
+	})
+
+	t.Run("change_role_not_a_member", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("UpdateDeckMember", mock.Anything, deckID, memberID, deck.RoleEditor).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.ChangeDeckMemberRole(context.Background(), &pb.ChangeDeckMemberRoleRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: memberID.String(),
+			Role:     deck.RoleEditor,
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("DeleteDeckMember", mock.Anything, deckID, memberID).Return(nil)
+
+		_, err := srv.RemoveDeckMember(context.Background(), &pb.RemoveDeckMemberRequest{
+			DeckId:   deckID.String(),
+			UserId:   authorID.String(),
+			MemberId: memberID.String(),
+		})
+		assert.NoError(t, err)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("shared_decks", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		p := pagination.Pagination{First: 10}
+		repoMock.On("GetSharedDecks", mock.Anything, memberID, p).Return(deck.SharedDecksConnection{
+			Edges: []deck.SharedDeckEdge{
+				{DeckID: deckID, Role: deck.RoleEditor, Cursor: "cursor"},
+			},
+			PageInfo: pagination.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+		}, nil)
+
+		res, err := srv.GetSharedDecks(context.Background(), &pb.GetSharedDecksRequest{
+			UserId:     memberID.String(),
+			Pagination: &pb.Pagination{First: 10},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Connection.Edges, 1)
+		assert.Equal(t, deckID.String(), res.Connection.Edges[0].DeckId)
+		assert.Equal(t, deck.RoleEditor, res.Connection.Edges[0].Role)
+		assert.Equal(t, "cursor", res.Connection.PageInfo.EndCursor)
+	})
+}
+
+func TestServer_Ratings(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	learnerID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+	ratedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	public := deck.Deck{ID: deckID, AuthorID: authorID, Public: true}
+
+	t.Run("rate", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		rating := deck.Rating{DeckID: deckID, UserID: learnerID, Stars: 4, Review: "Clear and to the point"}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(public, nil)
+		repoMock.On("RateDeck", mock.Anything, rating).Return(deck.Rating{
+			DeckID:    deckID,
+			UserID:    learnerID,
+			Stars:     4,
+			Review:    "Clear and to the point",
+			CreatedAt: ratedAt,
+			UpdatedAt: ratedAt,
+		}, nil)
+
+		res, err := srv.RateDeck(context.Background(), &pb.RateDeckRequest{
+			DeckId: deckID.String(),
+			UserId: learnerID.String(),
+			Stars:  4,
+			Review: "  Clear and to the point ",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.DeckRating{
+			DeckId:    deckID.String(),
+			UserId:    learnerID.String(),
+			Stars:     4,
+			Review:    "Clear and to the point",
+			CreatedAt: timestamppb.New(ratedAt),
+			UpdatedAt: timestamppb.New(ratedAt),
+		}, res.Rating)
+	})
+
+	t.Run("invalid_stars", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.RateDeck(context.Background(), &pb.RateDeckRequest{DeckId: deckID.String(), UserId: learnerID.String(), Stars: 6})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, deck.ErrInvalidStars.Error(), status.Convert(err).Message())
+		repoMock.AssertNotCalled(t, "RateDeck", mock.Anything, mock.Anything)
+	})
+
+	t.Run("private_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+
+		_, err := srv.RateDeck(context.Background(), &pb.RateDeckRequest{DeckId: deckID.String(), UserId: learnerID.String(), Stars: 3})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, deck.ErrDeckNotRateable.Error(), status.Convert(err).Message())
+	})
+
+	t.Run("own_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(public, nil)
+
+		_, err := srv.RateDeck(context.Background(), &pb.RateDeckRequest{DeckId: deckID.String(), UserId: authorID.String(), Stars: 5})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, deck.ErrOwnDeckRating.Error(), status.Convert(err).Message())
+		repoMock.AssertNotCalled(t, "RateDeck", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reviews", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(public, nil)
+		repoMock.On("GetDeckReviews", mock.Anything, deckID, pagination.Pagination{First: 10}).Return(deck.ReviewsConnection{
+			Edges: []deck.ReviewEdge{
+				{Rating: deck.Rating{DeckID: deckID, UserID: learnerID, Stars: 2, Review: "Too short", CreatedAt: ratedAt, UpdatedAt: ratedAt}, Cursor: "cursor"},
+			},
+			PageInfo: pagination.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+		}, nil)
+
+		res, err := srv.GetDeckReviews(context.Background(), &pb.GetDeckReviewsRequest{
+			DeckId:     deckID.String(),
+			Pagination: &pb.Pagination{First: 10},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Connection.Edges, 1)
+		assert.Equal(t, "Too short", res.Connection.Edges[0].Node.Review)
+		assert.Equal(t, "cursor", res.Connection.PageInfo.EndCursor)
+	})
+
+	t.Run("reviews_of_private_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.GetDeckReviews(context.Background(), &pb.GetDeckReviewsRequest{DeckId: deckID.String(), UserId: learnerID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		repoMock.AssertNotCalled(t, "GetDeckReviews", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestServer_DeckAnalytics(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	editorID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+	cardID := uuid.MustParse("5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598")
+	answerID := uuid.MustParse("72bdff92-5bc8-4e1d-9217-d0b23e22ff33")
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	d := deck.Deck{ID: deckID, AuthorID: authorID, Public: true}
+
+	t.Run("author", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+		repoMock.On("GetDeckAnalytics", mock.Anything, deckID, deck.AnalyticsRange{From: from}).Return(deck.DeckAnalytics{
+			DeckID: deckID,
+			Cards: []deck.CardStats{{
+				CardID:          cardID,
+				Attempts:        12,
+				FirstTries:      10,
+				FirstTryCorrect: 2,
+				AverageLevel:    1.5,
+				Answers:         []deck.AnswerStats{{AnswerID: answerID, IsCorrect: true, Chosen: 3}},
+				Flags:           []deck.CardFlag{deck.CardFlagTooHard},
+			}},
+		}, nil)
+
+		res, err := srv.GetDeckAnalytics(context.Background(), &pb.GetDeckAnalyticsRequest{
+			DeckId: deckID.String(),
+			UserId: authorID.String(),
+			From:   timestamppb.New(from),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*pb.CardStats{{
+			CardId:              cardID.String(),
+			Attempts:            12,
+			FirstTries:          10,
+			FirstTryCorrectRate: 0.2,
+			AverageLevel:        1.5,
+			Answers:             []*pb.AnswerStats{{AnswerId: answerID.String(), IsCorrect: true, Chosen: 3}},
+			Flags:               []string{"too_hard"},
+		}}, res.Cards)
+	})
+
+	t.Run("not_the_author", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(d, nil)
+
+		_, err := srv.GetDeckAnalytics(context.Background(), &pb.GetDeckAnalyticsRequest{DeckId: deckID.String(), UserId: editorID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, deck.ErrAuthorOnly.Error(), status.Convert(err).Message())
+		repoMock.AssertNotCalled(t, "GetDeckAnalytics", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_range", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.GetDeckAnalytics(context.Background(), &pb.GetDeckAnalyticsRequest{
+			DeckId: deckID.String(),
+			UserId: authorID.String(),
+			From:   timestamppb.New(from),
+			To:     timestamppb.New(from.AddDate(0, 0, -1)),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, deck.ErrInvalidRange.Error(), status.Convert(err).Message())
+		repoMock.AssertNotCalled(t, "GetDeck", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{}, deck.ErrDeckNotFound)
+
+		_, err := srv.GetDeckAnalytics(context.Background(), &pb.GetDeckAnalyticsRequest{DeckId: deckID.String(), UserId: authorID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestServer_Library(t *testing.T) {
+	deckID := uuid.MustParse("fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72")
+	authorID := uuid.MustParse("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9")
+	learnerID := uuid.MustParse("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1")
+	addedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	practicedAt := time.Date(2024, 5, 3, 18, 30, 0, 0, time.UTC)
+
+	t.Run("add", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID, Public: true}, nil)
+		repoMock.On("AddToLibrary", mock.Anything, learnerID, deckID).Return(deck.LibraryEntry{
+			DeckID:          deckID,
+			AddedAt:         addedAt,
+			LastPracticedAt: addedAt,
+			CardCount:       3,
+		}, nil)
+
+		res, err := srv.AddToLibrary(context.Background(), &pb.AddToLibraryRequest{DeckId: deckID.String(), UserId: learnerID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.LibraryEntry{
+			DeckId:          deckID.String(),
+			AddedAt:         timestamppb.New(addedAt),
+			LastPracticedAt: timestamppb.New(addedAt),
+			CardCount:       3,
+		}, res.Entry)
+	})
+
+	t.Run("add_private_deck", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetDeck", mock.Anything, deckID).Return(deck.Deck{ID: deckID, AuthorID: authorID}, nil)
+		repoMock.On("GetDeckMember", mock.Anything, deckID, learnerID).Return(deck.Member{}, deck.ErrMemberNotFound)
+
+		_, err := srv.AddToLibrary(context.Background(), &pb.AddToLibraryRequest{DeckId: deckID.String(), UserId: learnerID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		repoMock.AssertNotCalled(t, "AddToLibrary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("remove_missing", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("RemoveFromLibrary", mock.Anything, learnerID, deckID).Return(errors.Trace(deck.ErrNotInLibrary))
+
+		_, err := srv.RemoveFromLibrary(context.Background(), &pb.RemoveFromLibraryRequest{DeckId: deckID.String(), UserId: learnerID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetLibrary", mock.Anything, learnerID, deck.LibraryOrderAdded, pagination.Pagination{First: 10}).Return(deck.LibraryConnection{
+			Edges: []deck.LibraryEdge{
+				{Entry: deck.LibraryEntry{DeckID: deckID, AddedAt: addedAt, LastPracticedAt: practicedAt, CardCount: 3, Mastery: 0.4}, Cursor: "cursor"},
+			},
+			PageInfo: pagination.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+		}, nil)
+
+		res, err := srv.GetLibrary(context.Background(), &pb.GetLibraryRequest{
+			UserId:     learnerID.String(),
+			Order:      "added",
+			Pagination: &pb.Pagination{First: 10},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Connection.Edges, 1)
+		assert.Equal(t, 0.4, res.Connection.Edges[0].Node.Mastery)
+		assert.Equal(t, practicedAt, res.Connection.Edges[0].Node.LastPracticedAt.AsTime())
+	})
+
+	t.Run("list_invalid_order", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		_, err := srv.GetLibrary(context.Background(), &pb.GetLibraryRequest{UserId: learnerID.String(), Order: "title"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, deck.ErrInvalidLibraryOrder.Error(), status.Convert(err).Message())
+	})
+
+	t.Run("authored", func(t *testing.T) {
+		repoMock := &deck.RepositoryMock{}
+		srv := &Server{Repository: repoMock}
+
+		repoMock.On("GetAuthoredDecks", mock.Anything, authorID, pagination.Pagination{First: 5}).Return(deck.AuthoredDecksConnection{
+			Edges: []deck.AuthoredDeckEdge{{DeckID: deckID, Cursor: "cursor"}},
+		}, nil)
+
+		res, err := srv.GetAuthoredDecks(context.Background(), &pb.GetAuthoredDecksRequest{
+			UserId:     authorID.String(),
+			Pagination: &pb.Pagination{First: 5},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*pb.AuthoredDecksConnection_Edge{{DeckId: deckID.String(), Cursor: "cursor"}}, res.Connection.Edges)
+	})
+}
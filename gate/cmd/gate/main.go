@@ -67,8 +67,11 @@ func corsMiddleware(allowed []string) gin.HandlerFunc {
 		origin := c.Request.Header.Get("Origin")
 		if _, ok := allowedSet[origin]; ok {
 			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
-			c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match")
+			// Browsers hide the ETag from scripts otherwise, leaving them
+			// nothing to send back in If-Match
+			c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag")
 			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		}
 
@@ -95,7 +98,14 @@ func main() {
 
 	userClient := pbUser.NewUserAPIClient(userGRPCConn)
 
-	deckGRPCConn, err := grpc.Dial(c.decks.GRPCAddress(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	deckGRPCConn, err := grpc.Dial(
+		c.decks.GRPCAddress(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(pbDeck.MaxMessageSize),
+			grpc.MaxCallSendMsgSize(pbDeck.MaxMessageSize),
+		),
+	)
 	if err != nil {
 		logger.Error("Failed to connect to deck service", "error", err)
 		os.Exit(1)
@@ -137,8 +147,9 @@ func main() {
 
 	authorized := router.Group("/")
 	gate.RegisterMiddlewares(authorized, userClient, deckClient)
-	authorized.POST(queryPath, grapher.NewGraphqlHandler(deckClient, userClient, dealerClient))
+	authorized.POST(queryPath, grapher.NewGraphqlHandler(deckClient, userClient, dealerClient, coursesClient))
 	gate.RegisterDeckRoutes(authorized, userClient, deckClient, c.gate.adminConfig)
+	gate.RegisterTagRoutes(authorized, deckClient, c.gate.adminConfig)
 	gate.RegisterUserRoutes(router, c.gate.signupEnabled, userClient, c.gate.adminConfig)
 	gate.RegisterCoursesRoutes(authorized, coursesClient, c.gate.adminConfig)
 
@@ -187,6 +198,11 @@ func loadConfig() globalConfig {
 		CreateDeckAdminOnly:   parseBoolDefault(os.Getenv("ADMIN_ONLY_CREATE_DECK"), true),
 		UpdateCourseAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_UPDATE_COURSE"), true),
 		UpdateLessonAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_UPDATE_LESSON"), true),
+		DeleteCourseAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_DELETE_COURSE"), true),
+		DeleteLessonAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_DELETE_LESSON"), true),
+		CreateModuleAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_CREATE_MODULE"), true),
+		UpdateModuleAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_UPDATE_MODULE"), true),
+		DeleteModuleAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_DELETE_MODULE"), true),
 		UpdateDeckAdminOnly:   parseBoolDefault(os.Getenv("ADMIN_ONLY_UPDATE_DECK"), true),
 		UpdateCardAdminOnly:   parseBoolDefault(os.Getenv("ADMIN_ONLY_UPDATE_CARD"), true),
 		UpdateAnswerAdminOnly: parseBoolDefault(os.Getenv("ADMIN_ONLY_UPDATE_ANSWER"), true),
@@ -20,6 +20,13 @@ type AdminConfig struct {
 	CreateDeckAdminOnly   bool
 	UpdateCourseAdminOnly bool
 	UpdateLessonAdminOnly bool
+	// Deleting a course or lesson and restoring it are guarded alike
+	DeleteCourseAdminOnly bool
+	DeleteLessonAdminOnly bool
+	CreateModuleAdminOnly bool
+	// Reordering the modules of a course is guarded like updating one
+	UpdateModuleAdminOnly bool
+	DeleteModuleAdminOnly bool
 	UpdateDeckAdminOnly   bool
 	UpdateCardAdminOnly   bool
 	UpdateAnswerAdminOnly bool
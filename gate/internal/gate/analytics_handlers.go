@@ -0,0 +1,112 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+type answerStatsResponse struct {
+	AnswerID  string `json:"answer_id"`
+	IsCorrect bool   `json:"is_correct"`
+	Chosen    int64  `json:"chosen"`
+}
+
+type cardStatsResponse struct {
+	CardID              string                `json:"card_id"`
+	Attempts            int64                 `json:"attempts"`
+	FirstTries          int64                 `json:"first_tries"`
+	FirstTryCorrectRate float64               `json:"first_try_correct_rate"`
+	AverageLevel        float64               `json:"average_level"`
+	Answers             []answerStatsResponse `json:"answers"`
+	Flags               []string              `json:"flags"`
+}
+
+func toCardStatsResponse(s *pbDeck.CardStats) cardStatsResponse {
+	out := cardStatsResponse{
+		CardID:              s.GetCardId(),
+		Attempts:            s.GetAttempts(),
+		FirstTries:          s.GetFirstTries(),
+		FirstTryCorrectRate: s.GetFirstTryCorrectRate(),
+		AverageLevel:        s.GetAverageLevel(),
+		Answers:             make([]answerStatsResponse, 0, len(s.GetAnswers())),
+		Flags:               append([]string{}, s.GetFlags()...),
+	}
+
+	for _, a := range s.GetAnswers() {
+		out.Answers = append(out.Answers, answerStatsResponse{
+			AnswerID:  a.GetAnswerId(),
+			IsCorrect: a.GetIsCorrect(),
+			Chosen:    a.GetChosen(),
+		})
+	}
+
+	return out
+}
+
+// GetDeckAnalytics replies with the statistics of the cards of a deck, for
+// its author only. The from and to query parameters, RFC 3339 times, limit
+// them to the answers given in between.
+func GetDeckAnalytics(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	from, err := parseTimeQuery(ctx, "from")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	to, err := parseTimeQuery(ctx, "to")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := decksClient.GetDeckAnalytics(ctx, &pbDeck.GetDeckAnalyticsRequest{
+		DeckId: deckID,
+		UserId: getUserID(ctx),
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("GetDeckAnalytics: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	cards := make([]cardStatsResponse, 0, len(res.GetCards()))
+	for _, c := range res.GetCards() {
+		cards = append(cards, toCardStatsResponse(c))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"cards": cards})
+}
+
+// parseTimeQuery parses the query parameter param as an RFC 3339 time. It
+// returns nil when the parameter is missing.
+func parseTimeQuery(ctx *gin.Context, param string) (*timestamppb.Timestamp, error) {
+	raw := ctx.Query(param)
+	if raw == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, errors.Errorf("%s must be an RFC 3339 time", param)
+	}
+
+	return timestamppb.New(t), nil
+}
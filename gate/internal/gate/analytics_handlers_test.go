@@ -0,0 +1,106 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	analyticsDeckID   = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	analyticsAuthorID = "4e37a600-c29e-4d0f-af44-66f2cd8cc1c9"
+)
+
+func TestGetDeckAnalytics(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetDeckAnalytics", mock.Anything, &pbDeck.GetDeckAnalyticsRequest{
+			DeckId: analyticsDeckID,
+			UserId: analyticsAuthorID,
+			From:   timestamppb.New(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+		}).Return(&pbDeck.GetDeckAnalyticsResponse{Cards: []*pbDeck.CardStats{{
+			CardId:              "72bdff92-5bc8-4e1d-9217-d0b23e22ff33",
+			Attempts:            40,
+			FirstTries:          20,
+			FirstTryCorrectRate: 0.4,
+			AverageLevel:        2.5,
+			Answers: []*pbDeck.AnswerStats{
+				{AnswerId: "7e6926da-82b2-4ae8-99b4-1b803ebf1877", IsCorrect: true, Chosen: 15},
+				{AnswerId: "dfcb1c81-f590-486e-9b7e-a44f0c436933", Chosen: 25},
+			},
+			Flags: []string{"trapping_distractor"},
+		}}}, nil)
+
+		router := setupTestRouterAs(analyticsAuthorID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+analyticsDeckID+"/analytics?from=2024-05-01T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"cards": [{
+			"card_id": "72bdff92-5bc8-4e1d-9217-d0b23e22ff33",
+			"attempts": 40,
+			"first_tries": 20,
+			"first_try_correct_rate": 0.4,
+			"average_level": 2.5,
+			"answers": [
+				{"answer_id": "7e6926da-82b2-4ae8-99b4-1b803ebf1877", "is_correct": true, "chosen": 15},
+				{"answer_id": "dfcb1c81-f590-486e-9b7e-a44f0c436933", "is_correct": false, "chosen": 25}
+			],
+			"flags": ["trapping_distractor"]
+		}]}`, w.Body.String())
+	})
+
+	t.Run("invalid_time", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		router := setupTestRouterAs(analyticsAuthorID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+analyticsDeckID+"/analytics?to=yesterday", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{"error": "to must be an RFC 3339 time"}`, w.Body.String())
+		decksClient.AssertNotCalled(t, "GetDeckAnalytics", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not_the_author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetDeckAnalytics", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author of the deck can do that", "AUTHOR_ONLY"))
+
+		router := setupTestRouterAs("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1", &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+analyticsDeckID+"/analytics", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.JSONEq(t, `{"error": "deck: only the author of the deck can do that", "code": "AUTHOR_ONLY"}`, w.Body.String())
+	})
+
+	t.Run("invalid_range", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetDeckAnalytics", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: the start of the range must be before its end", "INVALID_RANGE",
+			&errdetails.BadRequest_FieldViolation{Field: "from", Reason: "INVALID_RANGE", Description: "deck: the start of the range must be before its end"},
+		))
+
+		router := setupTestRouterAs(analyticsAuthorID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+analyticsDeckID+"/analytics?from=2024-05-02T00:00:00Z&to=2024-05-01T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"INVALID_RANGE"`)
+	})
+}
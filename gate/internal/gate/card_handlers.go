@@ -0,0 +1,237 @@
+package gate
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+func DeleteCard(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, cardID, ok := cardParams(ctx)
+	if !ok {
+		return
+	}
+
+	_, err := decksClient.DeleteCard(ctx, &pbDeck.DeleteCardRequest{
+		DeckId: deckID,
+		CardId: cardID,
+		UserId: getUserID(ctx),
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("DeleteCard: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+func CreateAnswer(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, cardID, ok := cardParams(ctx)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Text      string `json:"text"`
+		IsCorrect bool   `json:"is_correct"`
+		Blank     int32  `json:"blank"`
+		Tolerance int32  `json:"tolerance"`
+		Position  int32  `json:"position"`
+		Side      string `json:"side"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := decksClient.CreateAnswer(ctx, &pbDeck.CreateAnswerRequest{
+		DeckId: deckID,
+		CardId: cardID,
+		UserId: getUserID(ctx),
+		Answer: &pbDeck.Answer{
+			Text:      req.Text,
+			IsCorrect: req.IsCorrect,
+			Blank:     req.Blank,
+			Tolerance: req.Tolerance,
+			Position:  req.Position,
+			Side:      req.Side,
+		},
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("CreateAnswer: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toCardResponse(res.Card))
+}
+
+func DeleteAnswer(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, cardID, ok := cardParams(ctx)
+	if !ok {
+		return
+	}
+
+	answerID := ctx.Param("answerId")
+	if _, err := uuid.Parse(answerID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer id format"})
+		return
+	}
+
+	res, err := decksClient.DeleteAnswer(ctx, &pbDeck.DeleteAnswerRequest{
+		DeckId:   deckID,
+		CardId:   cardID,
+		AnswerId: answerID,
+		UserId:   getUserID(ctx),
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("DeleteAnswer: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "answerId", answerID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toCardResponse(res.Card))
+}
+
+type cardEdgeResponse struct {
+	Node   cardResponse `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
+// GetDeckCards pages through the cards of a deck, in the order they were
+// added, for decks too large to be read whole with GetDeck.
+func GetDeckCards(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.GetDeckCards(ctx, &pbDeck.GetDeckCardsRequest{
+		DeckId: deckID,
+		UserId: getUserID(ctx),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+		// Only editors of the deck get them
+		WithAnswers: true,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("GetDeckCards: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	edges := make([]cardEdgeResponse, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		edges = append(edges, cardEdgeResponse{Node: toCardResponse(e.Node), Cursor: e.Cursor})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
+
+// StreamDeckCards writes every card of a deck as newline delimited JSON, one
+// card per line, passing each batch on as the deck service sends it. Errors
+// after the first batch can't change the status anymore, so they are written
+// as a last line holding an error instead of a card.
+func StreamDeckCards(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	var batchSize int64
+	if raw := ctx.Query("batch_size"); raw != "" {
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || v < 1 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "batch_size must be a positive number"})
+			return
+		}
+		batchSize = v
+	}
+
+	stream, err := decksClient.StreamDeckCards(ctx, &pbDeck.StreamDeckCardsRequest{
+		DeckId:    deckID,
+		UserId:    getUserID(ctx),
+		BatchSize: int32(batchSize),
+		// Only editors of the deck get them
+		WithAnswers: true,
+	})
+	if err != nil {
+		slog.Error("StreamDeckCards: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The service reports a missing deck or a denied access on the first
+	// receive, before anything is written
+	res, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		if !handleStatusError(ctx, err) {
+			slog.Error("StreamDeckCards: failed to receive cards", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.Header("Content-Type", "application/x-ndjson")
+	ctx.Status(http.StatusOK)
+
+	enc := json.NewEncoder(ctx.Writer)
+	for err == nil {
+		for _, c := range res.GetCards() {
+			if err := enc.Encode(toCardResponse(c)); err != nil {
+				slog.Error("StreamDeckCards: failed to write card", "error", err, "deckId", deckID)
+				return
+			}
+		}
+		ctx.Writer.Flush()
+
+		res, err = stream.Recv()
+	}
+
+	if err != io.EOF {
+		slog.Error("StreamDeckCards: stream interrupted", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+		if err := enc.Encode(errorResponse{Error: err.Error()}); err != nil {
+			slog.Error("StreamDeckCards: failed to write error", "error", err, "deckId", deckID)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package gate
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	cardsDeckID   = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	cardsCardID   = "1f30a72f-5d7a-48da-a5c2-42efece6972a"
+	cardsAnswerID = "5ec790fb-3dcc-4ee4-8c6d-daa9e4e11598"
+	cardsUserID   = "4e37a600-c29e-4d0f-af44-66f2cd8cc1c9"
+)
+
+func TestDeleteCard(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteCard", mock.Anything, &pbDeck.DeleteCardRequest{
+			DeckId: cardsDeckID,
+			CardId: cardsCardID,
+			UserId: cardsUserID,
+		}).Return(&pbDeck.DeleteCardResponse{}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+cardsDeckID+"/cards/"+cardsCardID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteCard", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "deck: card not found", "CARD_NOT_FOUND"))
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+cardsDeckID+"/cards/"+cardsCardID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid_card_id", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+cardsDeckID+"/cards/nope", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		decksClient.AssertNotCalled(t, "DeleteCard", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateAnswer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("CreateAnswer", mock.Anything, &pbDeck.CreateAnswerRequest{
+			DeckId: cardsDeckID,
+			CardId: cardsCardID,
+			UserId: cardsUserID,
+			Answer: &pbDeck.Answer{Text: "Transpiled"},
+		}).Return(&pbDeck.CreateAnswerResponse{
+			Card: &pbDeck.Card{Id: cardsCardID, Title: "Zig", Kind: "single_choice", PossibleAnswers: []*pbDeck.Answer{
+				{Id: cardsAnswerID, Text: "Transpiled"},
+			}},
+		}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+cardsDeckID+"/cards/"+cardsCardID+"/answers", strings.NewReader(`{"text": "Transpiled"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"text":"Transpiled"`)
+	})
+
+	t.Run("invalid_card", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("CreateAnswer", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: all answers must have a non-empty text", "NO_ANSWER_TEXT"))
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+cardsDeckID+"/cards/"+cardsCardID+"/answers", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteAnswer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteAnswer", mock.Anything, &pbDeck.DeleteAnswerRequest{
+			DeckId:   cardsDeckID,
+			CardId:   cardsCardID,
+			AnswerId: cardsAnswerID,
+			UserId:   cardsUserID,
+		}).Return(&pbDeck.DeleteAnswerResponse{
+			Card: &pbDeck.Card{Id: cardsCardID, Title: "Zig", Kind: "single_choice"},
+		}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+cardsDeckID+"/cards/"+cardsCardID+"/answers/"+cardsAnswerID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("last_correct_answer", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteAnswer", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: at least one answer must be correct", "NO_CORRECT_ANSWER"))
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+cardsDeckID+"/cards/"+cardsCardID+"/answers/"+cardsAnswerID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("answer_not_found", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteAnswer", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "deck: answer not found", "ANSWER_NOT_FOUND"))
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+cardsDeckID+"/cards/"+cardsCardID+"/answers/"+cardsAnswerID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetDeckCards(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetDeckCards", mock.Anything, &pbDeck.GetDeckCardsRequest{
+			DeckId:      cardsDeckID,
+			UserId:      cardsUserID,
+			Pagination:  &pbDeck.Pagination{First: 2, After: "a"},
+			WithAnswers: true,
+		}).Return(&pbDeck.GetDeckCardsResponse{Connection: &pbDeck.CardsConnection{
+			Edges:    []*pbDeck.CardsConnection_Edge{{Node: &pbDeck.Card{Id: cardsCardID, Title: "Golang"}, Cursor: "b"}},
+			PageInfo: &pbDeck.PageInfo{HasPreviousPage: true, StartCursor: "b", EndCursor: "b"},
+		}}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+cardsDeckID+"/cards?first=2&after=a", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"cursor":"b"`)
+		assert.Contains(t, w.Body.String(), `"title":"Golang"`)
+		assert.Contains(t, w.Body.String(), `"has_previous_page":true`)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("invalid_pagination", func(t *testing.T) {
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, &mockDecksClient{})
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+cardsDeckID+"/cards?first=1000", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+// cardStreamClient replays responses and then err, io.EOF when nil
+type cardStreamClient struct {
+	grpc.ClientStream
+	responses []*pbDeck.StreamDeckCardsResponse
+	err       error
+}
+
+func (c *cardStreamClient) Recv() (*pbDeck.StreamDeckCardsResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+
+	res := c.responses[0]
+	c.responses = c.responses[1:]
+
+	return res, nil
+}
+
+func TestStreamDeckCards(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("StreamDeckCards", mock.Anything, &pbDeck.StreamDeckCardsRequest{DeckId: cardsDeckID, UserId: cardsUserID, BatchSize: 2, WithAnswers: true}).Return(&cardStreamClient{
+			responses: []*pbDeck.StreamDeckCardsResponse{
+				{Cards: []*pbDeck.Card{{Id: "1", Title: "Golang"}, {Id: "2", Title: "Rust"}}},
+				{Cards: []*pbDeck.Card{{Id: "3", Title: "Lua"}}},
+			},
+		}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+cardsDeckID+"/cards/stream?batch_size=2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.Contains(t, lines[2], `"title":"Lua"`)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("StreamDeckCards", mock.Anything, mock.Anything).Return(&cardStreamClient{err: detailedError(t, codes.NotFound, "deck: deck not found", "DECK_NOT_FOUND")}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+cardsDeckID+"/cards/stream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("StreamDeckCards", mock.Anything, mock.Anything).Return(&cardStreamClient{
+			responses: []*pbDeck.StreamDeckCardsResponse{{Cards: []*pbDeck.Card{{Id: "1", Title: "Golang"}}}},
+			err:       status.Error(codes.Unavailable, "connection reset"),
+		}, nil)
+
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+cardsDeckID+"/cards/stream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.Contains(t, lines[1], `"error":`)
+	})
+
+	t.Run("invalid_batch_size", func(t *testing.T) {
+		router := setupTestRouterAs(cardsUserID, &mockUsersClient{}, &mockDecksClient{})
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+cardsDeckID+"/cards/stream?batch_size=0", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
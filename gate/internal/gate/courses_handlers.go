@@ -4,7 +4,6 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -25,7 +24,7 @@ func GetCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 	req := &pb.GetCourseRequest{CourseId: courseID}
 	res, err := client.GetCourse(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetCourse: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
@@ -33,6 +32,7 @@ func GetCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 		return
 	}
 
+	setETag(ctx, res.Course.GetVersion())
 	ctx.JSON(http.StatusOK, toCourseJSON(res.Course))
 }
 
@@ -57,7 +57,7 @@ func GetLessons(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.GetLessons(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetLessons: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
@@ -84,7 +84,7 @@ func GetLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.GetLesson(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetLesson: gRPC call failed", "error", err, "lessonId", lessonID, "stack", errors.ErrorStack(err))
@@ -92,6 +92,7 @@ func GetLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 		return
 	}
 
+	setETag(ctx, res.Lesson.GetVersion())
 	ctx.JSON(http.StatusOK, toLessonJSON(res.Lesson))
 }
 
@@ -123,7 +124,7 @@ func GetFocusedLessons(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.GetFocusedLessons(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetFocusedLessons: gRPC call failed", "error", err, "courseId", courseID, "userId", userID, "stack", errors.ErrorStack(err))
@@ -136,6 +137,7 @@ func GetFocusedLessons(ctx *gin.Context, client pb.CourseAPIClient) {
 		ctx.JSON(http.StatusOK, gin.H{
 			"edges":     convertBodylessLessonWithProgressEdges(res.Lessons.Edges),
 			"page_info": toPageInfoJSON(res.Lessons.PageInfo),
+			"modules":   toModulesWithProgressJSON(res.Modules),
 		})
 		return
 	}
@@ -143,6 +145,7 @@ func GetFocusedLessons(ctx *gin.Context, client pb.CourseAPIClient) {
 	ctx.JSON(http.StatusOK, gin.H{
 		"edges":     convertLessonWithProgressEdges(res.Lessons.Edges),
 		"page_info": toPageInfoJSON(res.Lessons.PageInfo),
+		"modules":   toModulesWithProgressJSON(res.Modules),
 	})
 }
 
@@ -162,7 +165,7 @@ func EnrollCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.EnrollUser(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("EnrollCourse: gRPC call failed", "error", err, "courseId", courseID, "userId", userID, "stack", errors.ErrorStack(err))
@@ -195,7 +198,7 @@ func GetEnrolledCourses(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.GetEnrolledCourses(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetEnrolledCourses: gRPC call failed", "error", err, "userId", userID, "userIdLen", len(userID), "stack", errors.ErrorStack(err))
@@ -209,6 +212,37 @@ func GetEnrolledCourses(ctx *gin.Context, client pb.CourseAPIClient) {
 	})
 }
 
+func ListCourses(ctx *gin.Context, client pb.CourseAPIClient) {
+	query := ctx.Query("q")
+
+	pagination, err := parsePagination(ctx)
+	if err != nil {
+		slog.Error("ListCourses: failed to parse pagination", "error", err, "query", query, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	req := &pb.ListCoursesRequest{
+		Query:      query,
+		Pagination: pagination,
+	}
+
+	res, err := client.ListCourses(ctx, req)
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("ListCourses: gRPC call failed", "error", err, "query", query, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges":     convertCatalogEdges(res.Courses.Edges),
+		"page_info": toPageInfoJSON(res.Courses.PageInfo),
+	})
+}
+
 func GetLessonState(ctx *gin.Context, client pb.CourseAPIClient) {
 	courseID := ctx.Param("courseId")
 	lessonID := ctx.Param("lessonId")
@@ -227,7 +261,7 @@ func GetLessonState(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.GetLessonState(ctx, req)
 	if err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetLessonState: gRPC call failed", "error", err, "courseId", courseID, "lessonId", lessonID, "userId", userID, "stack", errors.ErrorStack(err))
@@ -279,26 +313,37 @@ func AnswerCards(ctx *gin.Context, client pb.CourseAPIClient) {
 
 	res, err := client.AnswerCards(ctx, req)
 	if err != nil {
-		// TODO: Handle these errors properly
-		if strings.Contains(err.Error(), "lesson") || strings.Contains(err.Error(), "deck") || strings.Contains(err.Error(), "card") || strings.Contains(err.Error(), "invalid UUID") {
-			slog.Error("AnswerCards: validation error", "error", err, "courseId", courseID, "lessonId", lessonID, "deckId", deckID, "userId", userID)
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if handleStatusError(ctx, err) {
 			return
 		}
-
 		slog.Error("AnswerCards: gRPC call failed", "error", err, "courseId", courseID, "lessonId", lessonID, "deckId", deckID, "userId", userID, "stack", errors.ErrorStack(err))
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, res)
+	// Emit default values so that incorrect answers carry is_correct: false
+	marshaler := protojson.MarshalOptions{
+		EmitDefaultValues: true,
+		UseProtoNames:     true,
+	}
+
+	jsonBytes, err := marshaler.Marshal(res)
+	if err != nil {
+		slog.Error("AnswerCards: failed to marshal response", "error", err, "courseId", courseID, "lessonId", lessonID, "deckId", deckID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to marshal response"})
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/json", jsonBytes)
 }
 
 func CreateCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 	var req struct {
-		Order       int64  `json:"order"` // missing binding to allow zero
-		Title       string `json:"title" binding:"required"`
-		Description string `json:"description" binding:"required"`
+		Order           int64  `json:"order"` // missing binding to allow zero
+		Title           string `json:"title" binding:"required"`
+		Description     string `json:"description" binding:"required"`
+		GatingMode      string `json:"gating_mode"`
+		UnlockThreshold int32  `json:"unlock_threshold"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -307,13 +352,18 @@ func CreateCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 	}
 
 	createReq := &pb.CreateCourseRequest{
-		Order:       req.Order,
-		Title:       req.Title,
-		Description: req.Description,
+		Order:           req.Order,
+		Title:           req.Title,
+		Description:     req.Description,
+		GatingMode:      req.GatingMode,
+		UnlockThreshold: req.UnlockThreshold,
 	}
 
 	res, err := client.CreateCourse(ctx, createReq)
 	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
 		slog.Error("CreateCourse: gRPC call failed", "error", err, "title", req.Title, "stack", errors.ErrorStack(err))
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -334,6 +384,7 @@ func CreateLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 		Title       string `json:"title" binding:"required"`
 		Description string `json:"description" binding:"required"`
 		Body        string `json:"body"`
+		ModuleID    string `json:"module_id"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -347,17 +398,12 @@ func CreateLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 		Title:       req.Title,
 		Description: req.Description,
 		Body:        req.Body,
+		ModuleId:    req.ModuleID,
 	}
 
 	res, err := client.CreateLesson(ctx, createReq)
 	if err != nil {
-		//  TODO: Handle these errors properly
-		if strings.Contains(err.Error(), "at least one deck in the body") {
-			slog.Error("CreateLesson: validation error - no deck references", "error", err, "courseId", courseID, "title", req.Title)
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-			return
-		}
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("CreateLesson: gRPC call failed", "error", err, "courseId", courseID, "title", req.Title, "stack", errors.ErrorStack(err))
@@ -376,9 +422,14 @@ func UpdateCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 	}
 
 	var req struct {
-		Order       *int64  `json:"order"`
-		Title       *string `json:"title"`
-		Description *string `json:"description"`
+		Order           *int64  `json:"order"`
+		Title           *string `json:"title"`
+		Description     *string `json:"description"`
+		GatingMode      *string `json:"gating_mode"`
+		UnlockThreshold *int32  `json:"unlock_threshold"`
+		// Version is the version the update is based on, unless If-Match
+		// says otherwise
+		Version *int32 `json:"version"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -387,22 +438,29 @@ func UpdateCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 	}
 
 	// TODO: Remove once service errors are handled properly
-	if req.Order == nil && req.Title == nil && req.Description == nil {
+	if req.Order == nil && req.Title == nil && req.Description == nil && req.GatingMode == nil && req.UnlockThreshold == nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at least one field must be provided"})
 		return
 	}
 
+	version, ok := bindExpectedVersion(ctx, req.Version)
+	if !ok {
+		return
+	}
+
 	updateReq := &pb.UpdateCourseRequest{
-		Id:          courseID,
-		Order:       req.Order,
-		Title:       req.Title,
-		Description: req.Description,
+		Id:              courseID,
+		Order:           req.Order,
+		Title:           req.Title,
+		Description:     req.Description,
+		GatingMode:      req.GatingMode,
+		UnlockThreshold: req.UnlockThreshold,
+		ExpectedVersion: version,
 	}
 
 	res, err := client.UpdateCourse(ctx, updateReq)
 	if err != nil {
-		if strings.Contains(err.Error(), "course not found") {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "course not found"})
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("UpdateCourse: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
@@ -410,6 +468,7 @@ func UpdateCourse(ctx *gin.Context, client pb.CourseAPIClient) {
 		return
 	}
 
+	setETag(ctx, res.Course.GetVersion())
 	ctx.JSON(http.StatusOK, toCourseJSON(res.Course))
 }
 
@@ -431,6 +490,12 @@ func UpdateLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 		Title       *string `json:"title"`
 		Description *string `json:"description"`
 		Body        *string `json:"body"`
+		// ModuleID moves the lesson to a module, or out of its module when
+		// empty
+		ModuleID *string `json:"module_id"`
+		// Version is the version the update is based on, unless If-Match
+		// says otherwise
+		Version *int32 `json:"version"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -439,27 +504,29 @@ func UpdateLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 	}
 
 	// TODO: Remove once service errors are handled properly
-	if req.Order == nil && req.Title == nil && req.Description == nil && req.Body == nil {
+	if req.Order == nil && req.Title == nil && req.Description == nil && req.Body == nil && req.ModuleID == nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at least one field must be provided"})
 		return
 	}
 
+	version, ok := bindExpectedVersion(ctx, req.Version)
+	if !ok {
+		return
+	}
+
 	updateReq := &pb.UpdateLessonRequest{
-		Id:          lessonID,
-		Order:       req.Order,
-		Title:       req.Title,
-		Description: req.Description,
-		Body:        req.Body,
+		Id:              lessonID,
+		Order:           req.Order,
+		Title:           req.Title,
+		Description:     req.Description,
+		Body:            req.Body,
+		ModuleId:        req.ModuleID,
+		ExpectedVersion: version,
 	}
 
 	res, err := client.UpdateLesson(ctx, updateReq)
 	if err != nil {
-		if strings.Contains(err.Error(), "lesson not found") {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "lesson not found"})
-			return
-		}
-		if strings.Contains(err.Error(), "at least one deck in the body") || strings.Contains(err.Error(), "deck") {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("UpdateLesson: gRPC call failed", "error", err, "courseId", courseID, "lessonId", lessonID, "stack", errors.ErrorStack(err))
@@ -467,6 +534,7 @@ func UpdateLesson(ctx *gin.Context, client pb.CourseAPIClient) {
 		return
 	}
 
+	setETag(ctx, res.Lesson.GetVersion())
 	ctx.JSON(http.StatusOK, toLessonJSON(res.Lesson))
 }
 
@@ -488,7 +556,7 @@ func SyncState(ctx *gin.Context, client pb.CourseAPIClient) {
 		UserId:   userID,
 		CourseId: courseID,
 	}); err != nil {
-		if isHandledError(ctx, err) {
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("SyncState: gRPC call failed", "error", err, "userId", userID, "courseId", courseID, "stack", errors.ErrorStack(err))
@@ -499,12 +567,397 @@ func SyncState(ctx *gin.Context, client pb.CourseAPIClient) {
 	ctx.JSON(http.StatusOK, gin.H{})
 }
 
+func DeleteCourse(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	res, err := client.DeleteCourse(ctx, &pb.DeleteCourseRequest{Id: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("DeleteCourse: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Course.GetVersion())
+	ctx.JSON(http.StatusOK, toCourseJSON(res.Course))
+}
+
+func RestoreCourse(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	res, err := client.RestoreCourse(ctx, &pb.RestoreCourseRequest{Id: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("RestoreCourse: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Course.GetVersion())
+	ctx.JSON(http.StatusOK, toCourseJSON(res.Course))
+}
+
+func GetCoursePrerequisites(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	res, err := client.GetCoursePrerequisites(ctx, &pb.GetCoursePrerequisitesRequest{CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("GetCoursePrerequisites: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"prerequisites": toCoursesJSON(res.Prerequisites)})
+}
+
+func SetCoursePrerequisites(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	var req struct {
+		PrerequisiteIDs []string `json:"prerequisite_ids" binding:"required"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := client.SetCoursePrerequisites(ctx, &pb.SetCoursePrerequisitesRequest{
+		CourseId:        courseID,
+		PrerequisiteIds: req.PrerequisiteIDs,
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("SetCoursePrerequisites: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"prerequisites": toCoursesJSON(res.Prerequisites)})
+}
+
+func ReorderLessons(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	var req struct {
+		LessonIDs []string `json:"lesson_ids" binding:"required"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := client.ReorderLessons(ctx, &pb.ReorderLessonsRequest{
+		CourseId:  courseID,
+		LessonIds: req.LessonIDs,
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("ReorderLessons: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"lessons": toBodylessLessonsJSON(res.Lessons)})
+}
+
+func DeleteLesson(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	lessonID := ctx.Param("lessonId")
+	if courseID == "" || lessonID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id or lesson id"})
+		return
+	}
+
+	res, err := client.DeleteLesson(ctx, &pb.DeleteLessonRequest{Id: lessonID, CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("DeleteLesson: gRPC call failed", "error", err, "courseId", courseID, "lessonId", lessonID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Lesson.GetVersion())
+	ctx.JSON(http.StatusOK, toLessonJSON(res.Lesson))
+}
+
+func RestoreLesson(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	lessonID := ctx.Param("lessonId")
+	if courseID == "" || lessonID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id or lesson id"})
+		return
+	}
+
+	res, err := client.RestoreLesson(ctx, &pb.RestoreLessonRequest{Id: lessonID, CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("RestoreLesson: gRPC call failed", "error", err, "courseId", courseID, "lessonId", lessonID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Lesson.GetVersion())
+	ctx.JSON(http.StatusOK, toLessonJSON(res.Lesson))
+}
+
+func GetCourseOutline(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	res, err := client.GetCourseOutline(ctx, &pb.GetCourseOutlineRequest{CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("GetCourseOutline: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toCourseOutlineJSON(res.Outline))
+}
+
+func GetModule(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	moduleID := ctx.Param("moduleId")
+	if courseID == "" || moduleID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id or module id"})
+		return
+	}
+
+	res, err := client.GetModule(ctx, &pb.GetModuleRequest{Id: moduleID, CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("GetModule: gRPC call failed", "error", err, "courseId", courseID, "moduleId", moduleID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Module.GetVersion())
+	ctx.JSON(http.StatusOK, toModuleJSON(res.Module))
+}
+
+func CreateModule(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	var req struct {
+		Order       int64  `json:"order"` // missing binding to allow zero
+		Title       string `json:"title" binding:"required"`
+		Description string `json:"description" binding:"required"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := client.CreateModule(ctx, &pb.CreateModuleRequest{
+		CourseId:    courseID,
+		Order:       req.Order,
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("CreateModule: gRPC call failed", "error", err, "courseId", courseID, "title", req.Title, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toModuleJSON(res.Module))
+}
+
+func UpdateModule(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	moduleID := ctx.Param("moduleId")
+	if courseID == "" || moduleID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id or module id"})
+		return
+	}
+
+	var req struct {
+		Order       *int64  `json:"order"`
+		Title       *string `json:"title"`
+		Description *string `json:"description"`
+		// Version is the version the update is based on, unless If-Match
+		// says otherwise
+		Version *int32 `json:"version"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.Order == nil && req.Title == nil && req.Description == nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at least one field must be provided"})
+		return
+	}
+
+	version, ok := bindExpectedVersion(ctx, req.Version)
+	if !ok {
+		return
+	}
+
+	res, err := client.UpdateModule(ctx, &pb.UpdateModuleRequest{
+		Id:              moduleID,
+		CourseId:        courseID,
+		Order:           req.Order,
+		Title:           req.Title,
+		Description:     req.Description,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("UpdateModule: gRPC call failed", "error", err, "courseId", courseID, "moduleId", moduleID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Module.GetVersion())
+	ctx.JSON(http.StatusOK, toModuleJSON(res.Module))
+}
+
+func DeleteModule(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	moduleID := ctx.Param("moduleId")
+	if courseID == "" || moduleID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id or module id"})
+		return
+	}
+
+	res, err := client.DeleteModule(ctx, &pb.DeleteModuleRequest{Id: moduleID, CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("DeleteModule: gRPC call failed", "error", err, "courseId", courseID, "moduleId", moduleID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Module.GetVersion())
+	ctx.JSON(http.StatusOK, toModuleJSON(res.Module))
+}
+
+func RestoreModule(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	moduleID := ctx.Param("moduleId")
+	if courseID == "" || moduleID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id or module id"})
+		return
+	}
+
+	res, err := client.RestoreModule(ctx, &pb.RestoreModuleRequest{Id: moduleID, CourseId: courseID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("RestoreModule: gRPC call failed", "error", err, "courseId", courseID, "moduleId", moduleID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, res.Module.GetVersion())
+	ctx.JSON(http.StatusOK, toModuleJSON(res.Module))
+}
+
+func ReorderModules(ctx *gin.Context, client pb.CourseAPIClient) {
+	courseID := ctx.Param("courseId")
+	if courseID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing course id"})
+		return
+	}
+
+	var req struct {
+		ModuleIDs []string `json:"module_ids" binding:"required"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := client.ReorderModules(ctx, &pb.ReorderModulesRequest{
+		CourseId:  courseID,
+		ModuleIds: req.ModuleIDs,
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("ReorderModules: gRPC call failed", "error", err, "courseId", courseID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	modules := make([]ModuleJSON, len(res.Modules))
+	for i, module := range res.Modules {
+		modules[i] = toModuleJSON(module)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"modules": modules})
+}
+
 func RegisterCoursesRoutes(r *gin.RouterGroup, client pb.CourseAPIClient, adminCfg AdminConfig) {
 	course := r.Group("/courses")
 	{
 		course.POST("", RequireAdmin(adminCfg, adminCfg.CreateCourseAdminOnly), func(ctx *gin.Context) {
 			CreateCourse(ctx, client)
 		})
+		course.GET("", func(ctx *gin.Context) {
+			ListCourses(ctx, client)
+		})
 		course.GET("/enrolled", func(ctx *gin.Context) {
 			GetEnrolledCourses(ctx, client)
 		})
@@ -514,15 +967,51 @@ func RegisterCoursesRoutes(r *gin.RouterGroup, client pb.CourseAPIClient, adminC
 		course.PATCH("/:courseId", RequireAdmin(adminCfg, adminCfg.UpdateCourseAdminOnly), func(ctx *gin.Context) {
 			UpdateCourse(ctx, client)
 		})
+		course.DELETE("/:courseId", RequireAdmin(adminCfg, adminCfg.DeleteCourseAdminOnly), func(ctx *gin.Context) {
+			DeleteCourse(ctx, client)
+		})
+		course.POST("/:courseId/restore", RequireAdmin(adminCfg, adminCfg.DeleteCourseAdminOnly), func(ctx *gin.Context) {
+			RestoreCourse(ctx, client)
+		})
+		course.GET("/:courseId/prerequisites", func(ctx *gin.Context) {
+			GetCoursePrerequisites(ctx, client)
+		})
+		course.PUT("/:courseId/prerequisites", RequireAdmin(adminCfg, adminCfg.UpdateCourseAdminOnly), func(ctx *gin.Context) {
+			SetCoursePrerequisites(ctx, client)
+		})
 		course.POST("/:courseId/enroll", RequireAdmin(adminCfg, adminCfg.EnrollAdminOnly), func(ctx *gin.Context) {
 			EnrollCourse(ctx, client)
 		})
+		course.GET("/:courseId/outline", func(ctx *gin.Context) {
+			GetCourseOutline(ctx, client)
+		})
+		course.POST("/:courseId/modules", RequireAdmin(adminCfg, adminCfg.CreateModuleAdminOnly), func(ctx *gin.Context) {
+			CreateModule(ctx, client)
+		})
+		course.PUT("/:courseId/modules/order", RequireAdmin(adminCfg, adminCfg.UpdateModuleAdminOnly), func(ctx *gin.Context) {
+			ReorderModules(ctx, client)
+		})
+		course.GET("/:courseId/modules/:moduleId", func(ctx *gin.Context) {
+			GetModule(ctx, client)
+		})
+		course.PATCH("/:courseId/modules/:moduleId", RequireAdmin(adminCfg, adminCfg.UpdateModuleAdminOnly), func(ctx *gin.Context) {
+			UpdateModule(ctx, client)
+		})
+		course.DELETE("/:courseId/modules/:moduleId", RequireAdmin(adminCfg, adminCfg.DeleteModuleAdminOnly), func(ctx *gin.Context) {
+			DeleteModule(ctx, client)
+		})
+		course.POST("/:courseId/modules/:moduleId/restore", RequireAdmin(adminCfg, adminCfg.DeleteModuleAdminOnly), func(ctx *gin.Context) {
+			RestoreModule(ctx, client)
+		})
 		course.GET("/:courseId/lessons", func(ctx *gin.Context) {
 			GetLessons(ctx, client)
 		})
 		course.POST("/:courseId/lessons", RequireAdmin(adminCfg, adminCfg.CreateLessonAdminOnly), func(ctx *gin.Context) {
 			CreateLesson(ctx, client)
 		})
+		course.PUT("/:courseId/lessons/order", RequireAdmin(adminCfg, adminCfg.UpdateLessonAdminOnly), func(ctx *gin.Context) {
+			ReorderLessons(ctx, client)
+		})
 		course.GET("/:courseId/lessons/focused", func(ctx *gin.Context) {
 			GetFocusedLessons(ctx, client)
 		})
@@ -535,6 +1024,12 @@ func RegisterCoursesRoutes(r *gin.RouterGroup, client pb.CourseAPIClient, adminC
 		course.PATCH("/:courseId/lessons/:lessonId", RequireAdmin(adminCfg, adminCfg.UpdateLessonAdminOnly), func(ctx *gin.Context) {
 			UpdateLesson(ctx, client)
 		})
+		course.DELETE("/:courseId/lessons/:lessonId", RequireAdmin(adminCfg, adminCfg.DeleteLessonAdminOnly), func(ctx *gin.Context) {
+			DeleteLesson(ctx, client)
+		})
+		course.POST("/:courseId/lessons/:lessonId/restore", RequireAdmin(adminCfg, adminCfg.DeleteLessonAdminOnly), func(ctx *gin.Context) {
+			RestoreLesson(ctx, client)
+		})
 		course.POST("/:courseId/lessons/:lessonId/decks/:deckId/answer", func(ctx *gin.Context) {
 			AnswerCards(ctx, client)
 		})
@@ -546,30 +1041,48 @@ func RegisterCoursesRoutes(r *gin.RouterGroup, client pb.CourseAPIClient, adminC
 
 // CourseJSON is a JSON-serializable version of pb.Course with timestamps as strings
 type CourseJSON struct {
+	ID              string  `json:"id"`
+	Order           int64   `json:"order"`
+	Title           string  `json:"title"`
+	Description     string  `json:"description"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       *string `json:"updated_at,omitempty"`
+	DeletedAt       *string `json:"deleted_at,omitempty"`
+	Version         int32   `json:"version,omitempty"`
+	GatingMode      string  `json:"gating_mode,omitempty"`
+	UnlockThreshold int32   `json:"unlock_threshold,omitempty"`
+}
+
+// LessonJSON is a JSON-serializable version of pb.Lesson with timestamps as strings
+type LessonJSON struct {
 	ID          string  `json:"id"`
+	CourseID    string  `json:"course_id"`
 	Order       int64   `json:"order"`
 	Title       string  `json:"title"`
 	Description string  `json:"description"`
+	Body        string  `json:"body"`
+	ModuleID    string  `json:"module_id,omitempty"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   *string `json:"updated_at,omitempty"`
 	DeletedAt   *string `json:"deleted_at,omitempty"`
+	Version     int32   `json:"version,omitempty"`
 }
 
-// LessonJSON is a JSON-serializable version of pb.Lesson with timestamps as strings
-type LessonJSON struct {
+// BodylessLessonJSON is a JSON-serializable version of pb.Lesson without the body field
+type BodylessLessonJSON struct {
 	ID          string  `json:"id"`
 	CourseID    string  `json:"course_id"`
 	Order       int64   `json:"order"`
 	Title       string  `json:"title"`
 	Description string  `json:"description"`
-	Body        string  `json:"body"`
+	ModuleID    string  `json:"module_id,omitempty"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   *string `json:"updated_at,omitempty"`
 	DeletedAt   *string `json:"deleted_at,omitempty"`
 }
 
-// BodylessLessonJSON is a JSON-serializable version of pb.Lesson without the body field
-type BodylessLessonJSON struct {
+// ModuleJSON is a JSON-serializable version of pb.Module with timestamps as strings
+type ModuleJSON struct {
 	ID          string  `json:"id"`
 	CourseID    string  `json:"course_id"`
 	Order       int64   `json:"order"`
@@ -578,6 +1091,28 @@ type BodylessLessonJSON struct {
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   *string `json:"updated_at,omitempty"`
 	DeletedAt   *string `json:"deleted_at,omitempty"`
+	Version     int32   `json:"version,omitempty"`
+}
+
+// ModuleWithProgressJSON represents a module with the progress of the user for JSON response (flattened)
+type ModuleWithProgressJSON struct {
+	ModuleJSON
+	LessonCount      int32 `json:"lesson_count"`
+	CompletedLessons int32 `json:"completed_lessons"`
+	IsCompleted      bool  `json:"is_completed"`
+}
+
+// ModuleOutlineJSON represents a module of a course outline with its lessons
+type ModuleOutlineJSON struct {
+	ModuleJSON
+	Lessons []BodylessLessonJSON `json:"lessons"`
+}
+
+// CourseOutlineJSON represents the structure of a course for JSON response
+type CourseOutlineJSON struct {
+	Course  CourseJSON           `json:"course"`
+	Modules []ModuleOutlineJSON  `json:"modules"`
+	Lessons []BodylessLessonJSON `json:"lessons"`
 }
 
 // LessonEdgeJSON represents a lesson edge for JSON response
@@ -592,6 +1127,7 @@ type LessonWithProgressJSON struct {
 	LessonJSON
 	IsCompleted bool `json:"is_completed"`
 	IsCurrent   bool `json:"is_current"`
+	IsLocked    bool `json:"is_locked"`
 }
 
 // LessonWithProgressEdgeJSON represents a lesson with progress edge for JSON response
@@ -605,6 +1141,7 @@ type BodylessLessonWithProgressJSON struct {
 	BodylessLessonJSON
 	IsCompleted bool `json:"is_completed"`
 	IsCurrent   bool `json:"is_current"`
+	IsLocked    bool `json:"is_locked"`
 }
 
 // BodylessLessonWithProgressEdgeJSON represents a bodyless lesson with progress edge for JSON response
@@ -625,6 +1162,18 @@ type CourseWithProgressEdgeJSON struct {
 	Cursor string                 `json:"cursor"`
 }
 
+// CatalogCourseJSON represents a course of the catalog for JSON response (flattened)
+type CatalogCourseJSON struct {
+	CourseJSON
+	LessonCount int32 `json:"lesson_count"`
+}
+
+// CatalogEdgeJSON represents a catalog edge for JSON response
+type CatalogEdgeJSON struct {
+	Node   CatalogCourseJSON `json:"node"`
+	Cursor string            `json:"cursor"`
+}
+
 type PageInfoJSON struct {
 	HasPreviousPage bool   `json:"has_previous_page"`
 	HasNextPage     bool   `json:"has_next_page"`
@@ -654,6 +1203,7 @@ func convertLessonWithProgressEdges(edges []*pb.LessonsWithProgressConnection_Ed
 				LessonJSON:  baseLesson,
 				IsCompleted: edge.Node.IsCompleted,
 				IsCurrent:   edge.Node.IsCurrent,
+				IsLocked:    edge.Node.IsLocked,
 			},
 			Cursor: edge.Cursor,
 		}
@@ -671,6 +1221,7 @@ func convertBodylessLessonWithProgressEdges(edges []*pb.LessonsWithProgressConne
 				BodylessLessonJSON: baseLesson,
 				IsCompleted:        edge.Node.IsCompleted,
 				IsCurrent:          edge.Node.IsCurrent,
+				IsLocked:           edge.Node.IsLocked,
 			},
 			Cursor: edge.Cursor,
 		}
@@ -694,6 +1245,20 @@ func convertCourseWithProgressEdges(edges []*pb.CoursesWithProgressConnection_Ed
 	return result
 }
 
+func convertCatalogEdges(edges []*pb.CatalogConnection_Edge) []CatalogEdgeJSON {
+	result := make([]CatalogEdgeJSON, len(edges))
+	for i, edge := range edges {
+		result[i] = CatalogEdgeJSON{
+			Node: CatalogCourseJSON{
+				CourseJSON:  toCourseJSON(edge.Node.Course),
+				LessonCount: edge.Node.LessonCount,
+			},
+			Cursor: edge.Cursor,
+		}
+	}
+	return result
+}
+
 // convertProtoTimestamp converts a protobuf Timestamp to RFC3339 string
 func convertProtoTimestamp(ts *timestamppb.Timestamp) string {
 	if ts == nil {
@@ -727,14 +1292,26 @@ func protoToJSON(ts *timestamppb.Timestamp) *string {
 // toCourseJSON converts pb.Course to CourseJSON with proper timestamp formatting
 func toCourseJSON(course *pb.Course) CourseJSON {
 	return CourseJSON{
-		ID:          course.Id,
-		Order:       course.Order,
-		Title:       course.Title,
-		Description: course.Description,
-		CreatedAt:   convertProtoTimestamp(course.CreatedAt),
-		UpdatedAt:   protoToJSON(course.UpdatedAt),
-		DeletedAt:   protoToJSON(course.DeletedAt),
+		ID:              course.Id,
+		Order:           course.Order,
+		Title:           course.Title,
+		Description:     course.Description,
+		CreatedAt:       convertProtoTimestamp(course.CreatedAt),
+		UpdatedAt:       protoToJSON(course.UpdatedAt),
+		DeletedAt:       protoToJSON(course.DeletedAt),
+		Version:         course.Version,
+		GatingMode:      course.GatingMode,
+		UnlockThreshold: course.UnlockThreshold,
+	}
+}
+
+// toCoursesJSON converts pb.Courses to CourseJSON
+func toCoursesJSON(courses []*pb.Course) []CourseJSON {
+	result := make([]CourseJSON, len(courses))
+	for i, course := range courses {
+		result[i] = toCourseJSON(course)
 	}
+	return result
 }
 
 // toLessonJSON converts pb.Lesson to LessonJSON with proper timestamp formatting
@@ -746,9 +1323,11 @@ func toLessonJSON(lesson *pb.Lesson) LessonJSON {
 		Title:       lesson.Title,
 		Description: lesson.Description,
 		Body:        lesson.Body,
+		ModuleID:    lesson.ModuleId,
 		CreatedAt:   convertProtoTimestamp(lesson.CreatedAt),
 		UpdatedAt:   protoToJSON(lesson.UpdatedAt),
 		DeletedAt:   protoToJSON(lesson.DeletedAt),
+		Version:     lesson.Version,
 	}
 }
 
@@ -760,35 +1339,66 @@ func toBodylessLessonJSON(lesson *pb.Lesson) BodylessLessonJSON {
 		Order:       lesson.Order,
 		Title:       lesson.Title,
 		Description: lesson.Description,
+		ModuleID:    lesson.ModuleId,
 		CreatedAt:   convertProtoTimestamp(lesson.CreatedAt),
 		UpdatedAt:   protoToJSON(lesson.UpdatedAt),
 		DeletedAt:   protoToJSON(lesson.DeletedAt),
 	}
 }
 
-// TODO: Handle these errors properly
-func isHandledError(ctx *gin.Context, err error) bool {
-	if err == nil {
-		return false
+// toModuleJSON converts pb.Module to ModuleJSON with proper timestamp formatting
+func toModuleJSON(module *pb.Module) ModuleJSON {
+	return ModuleJSON{
+		ID:          module.Id,
+		CourseID:    module.CourseId,
+		Order:       module.Order,
+		Title:       module.Title,
+		Description: module.Description,
+		CreatedAt:   convertProtoTimestamp(module.CreatedAt),
+		UpdatedAt:   protoToJSON(module.UpdatedAt),
+		DeletedAt:   protoToJSON(module.DeletedAt),
+		Version:     module.Version,
 	}
+}
 
-	lower := strings.ToLower(err.Error())
-	if strings.Contains(lower, "not found") {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
-		return true
+// toModulesWithProgressJSON converts the modules of focused lessons to JSON format
+func toModulesWithProgressJSON(modules []*pb.ModuleWithProgress) []ModuleWithProgressJSON {
+	result := make([]ModuleWithProgressJSON, len(modules))
+	for i, m := range modules {
+		result[i] = ModuleWithProgressJSON{
+			ModuleJSON:       toModuleJSON(m.Module),
+			LessonCount:      m.LessonCount,
+			CompletedLessons: m.CompletedLessons,
+			IsCompleted:      m.IsCompleted,
+		}
 	}
+	return result
+}
 
-	if strings.Contains(lower, "uuid") {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return true
+// toBodylessLessonsJSON converts pb.Lessons to BodylessLessonJSON
+func toBodylessLessonsJSON(lessons []*pb.Lesson) []BodylessLessonJSON {
+	result := make([]BodylessLessonJSON, len(lessons))
+	for i, lesson := range lessons {
+		result[i] = toBodylessLessonJSON(lesson)
 	}
+	return result
+}
 
-	if strings.Contains(lower, "does not exist") {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
-		return true
+// toCourseOutlineJSON converts pb.CourseOutline to CourseOutlineJSON
+func toCourseOutlineJSON(outline *pb.CourseOutline) CourseOutlineJSON {
+	modules := make([]ModuleOutlineJSON, len(outline.Modules))
+	for i, m := range outline.Modules {
+		modules[i] = ModuleOutlineJSON{
+			ModuleJSON: toModuleJSON(m.Module),
+			Lessons:    toBodylessLessonsJSON(m.Lessons),
+		}
 	}
 
-	return false
+	return CourseOutlineJSON{
+		Course:  toCourseJSON(outline.Course),
+		Modules: modules,
+		Lessons: toBodylessLessonsJSON(outline.Lessons),
+	}
 }
 
 const DefaultPageSize = 20
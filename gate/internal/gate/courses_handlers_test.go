@@ -0,0 +1,520 @@
+package gate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pbCourse "github.com/XaviFP/toshokan/course/api/proto/v1"
+)
+
+const coursesCourseID = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+
+// setupCoursesTestRouter registers the course routes, authenticating every
+// request as userID.
+func setupCoursesTestRouter(userID string, coursesClient pbCourse.CourseAPIClient) *gin.Engine {
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		ctx.Set("userID", userID)
+	})
+	RegisterCoursesRoutes(router.Group("/"), coursesClient, AdminConfig{})
+	return router
+}
+
+func TestListCourses(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("ListCourses", mock.Anything, &pbCourse.ListCoursesRequest{
+			Query:      "go",
+			Pagination: &pbCourse.Pagination{First: 5, After: "a"},
+		}).Return(&pbCourse.ListCoursesResponse{
+			Courses: &pbCourse.CatalogConnection{
+				Edges: []*pbCourse.CatalogConnection_Edge{{
+					Node:   &pbCourse.CatalogCourse{Course: &pbCourse.Course{Id: coursesCourseID, Title: "Go"}, LessonCount: 3},
+					Cursor: "b",
+				}},
+				PageInfo: &pbCourse.PageInfo{HasNextPage: true, StartCursor: "b", EndCursor: "b"},
+			},
+		}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/courses?q=go&first=5&after=a", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Go"`)
+		assert.Contains(t, w.Body.String(), `"lesson_count":3`)
+		assert.Contains(t, w.Body.String(), `"has_next_page":true`)
+		coursesClient.AssertExpectations(t)
+	})
+
+	t.Run("default_page_size", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("ListCourses", mock.Anything, &pbCourse.ListCoursesRequest{
+			Pagination: &pbCourse.Pagination{First: DefaultPageSize},
+		}).Return(&pbCourse.ListCoursesResponse{Courses: &pbCourse.CatalogConnection{PageInfo: &pbCourse.PageInfo{}}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/courses", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		coursesClient.AssertExpectations(t)
+	})
+
+	t.Run("invalid_pagination", func(t *testing.T) {
+		router := setupCoursesTestRouter("", &mockCoursesClient{})
+
+		req := httptest.NewRequest(http.MethodGet, "/courses?first=many", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("ListCourses", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "courses: invalid cursor", "INVALID_CURSOR"))
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/courses?after=nope", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"INVALID_CURSOR"`)
+	})
+}
+
+func TestDeleteLesson(t *testing.T) {
+	const lessonID = "334ddbf8-1acc-405b-86d8-49f0d1ca636c"
+
+	t.Run("success", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("DeleteLesson", mock.Anything, &pbCourse.DeleteLessonRequest{
+			Id:       lessonID,
+			CourseId: coursesCourseID,
+		}).Return(&pbCourse.DeleteLessonResponse{Lesson: &pbCourse.Lesson{Id: lessonID, Version: 3}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/courses/"+coursesCourseID+"/lessons/"+lessonID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+		coursesClient.AssertExpectations(t)
+	})
+
+	t.Run("lesson_of_another_course", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("DeleteLesson", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "courses: lesson not found", "LESSON_NOT_FOUND"))
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/courses/"+coursesCourseID+"/lessons/"+lessonID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"LESSON_NOT_FOUND"`)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("RestoreLesson", mock.Anything, &pbCourse.RestoreLessonRequest{
+			Id:       lessonID,
+			CourseId: coursesCourseID,
+		}).Return(&pbCourse.RestoreLessonResponse{Lesson: &pbCourse.Lesson{Id: lessonID, Version: 4}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/courses/"+coursesCourseID+"/lessons/"+lessonID+"/restore", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+		coursesClient.AssertExpectations(t)
+	})
+}
+
+func TestAnswerCards(t *testing.T) {
+	const (
+		userID   = "5d2b6a3c-0b6e-4f55-9d43-c2b9f0f0a2d1"
+		lessonID = "334ddbf8-1acc-405b-86d8-49f0d1ca636c"
+		deckID   = "72bdff92-5bc8-4e1d-9217-d0b23e22ff33"
+		card1ID  = "7e6926da-82b2-4ae8-99b4-1b803ebf1877"
+		card2ID  = "0d3c1f4e-58a1-4c5e-9f3c-6e0b8b1f2a7d"
+	)
+
+	coursesClient := &mockCoursesClient{}
+	coursesClient.On("AnswerCards", mock.Anything, mock.MatchedBy(func(req *pbCourse.AnswerCardsRequest) bool {
+		return req.UserId == userID && req.CourseId == coursesCourseID && req.LessonId == lessonID &&
+			req.DeckId == deckID && len(req.CardAnswers) == 2
+	})).Return(&pbCourse.AnswerCardsResponse{
+		Success: true,
+		Results: []*pbCourse.CardResult{
+			{CardId: card1ID, IsCorrect: true, Explanation: "Because."},
+			{CardId: card2ID, IsCorrect: false, Explanation: "Not quite."},
+		},
+	}, nil)
+
+	router := setupCoursesTestRouter(userID, coursesClient)
+
+	body := `[{"card_id":"` + card1ID + `","answer_id":"` + card1ID + `"},{"card_id":"` + card2ID + `","typed_blanks":["go"]}]`
+	req := httptest.NewRequest(http.MethodPost, "/courses/"+coursesCourseID+"/lessons/"+lessonID+"/decks/"+deckID+"/answer", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"success": true,
+		"results": [
+			{"card_id": "`+card1ID+`", "is_correct": true, "explanation": "Because."},
+			{"card_id": "`+card2ID+`", "is_correct": false, "explanation": "Not quite."}
+		]
+	}`, w.Body.String())
+	coursesClient.AssertExpectations(t)
+}
+
+func TestModuleHandlers(t *testing.T) {
+	const moduleID = "5b0bb8a1-93a4-4d43-a7d5-2b84c1b2f3a1"
+
+	t.Run("get", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("GetModule", mock.Anything, &pbCourse.GetModuleRequest{
+			Id:       moduleID,
+			CourseId: coursesCourseID,
+		}).Return(&pbCourse.GetModuleResponse{Module: &pbCourse.Module{Id: moduleID, Title: "Basics", Version: 2}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/courses/"+coursesCourseID+"/modules/"+moduleID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+		coursesClient.AssertExpectations(t)
+	})
+
+	t.Run("module_of_another_course", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("GetModule", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "courses: module not found", "MODULE_NOT_FOUND"))
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/courses/"+coursesCourseID+"/modules/"+moduleID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"MODULE_NOT_FOUND"`)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		title := "The basics"
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("UpdateModule", mock.Anything, &pbCourse.UpdateModuleRequest{
+			Id:       moduleID,
+			CourseId: coursesCourseID,
+			Title:    &title,
+		}).Return(&pbCourse.UpdateModuleResponse{Module: &pbCourse.Module{Id: moduleID, Title: title, Version: 3}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodPatch, "/courses/"+coursesCourseID+"/modules/"+moduleID, strings.NewReader(`{"title":"The basics"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		coursesClient.AssertExpectations(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("DeleteModule", mock.Anything, &pbCourse.DeleteModuleRequest{
+			Id:       moduleID,
+			CourseId: coursesCourseID,
+		}).Return(&pbCourse.DeleteModuleResponse{Module: &pbCourse.Module{Id: moduleID, Version: 4}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/courses/"+coursesCourseID+"/modules/"+moduleID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+		coursesClient.AssertExpectations(t)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		coursesClient := &mockCoursesClient{}
+		coursesClient.On("RestoreModule", mock.Anything, &pbCourse.RestoreModuleRequest{
+			Id:       moduleID,
+			CourseId: coursesCourseID,
+		}).Return(&pbCourse.RestoreModuleResponse{Module: &pbCourse.Module{Id: moduleID, Version: 5}}, nil)
+
+		router := setupCoursesTestRouter("", coursesClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/courses/"+coursesCourseID+"/modules/"+moduleID+"/restore", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		coursesClient.AssertExpectations(t)
+	})
+}
+
+type mockCoursesClient struct {
+	mock.Mock
+}
+
+func (m *mockCoursesClient) GetCourse(ctx context.Context, req *pbCourse.GetCourseRequest, opts ...grpc.CallOption) (*pbCourse.GetCourseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetCourseResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetLesson(ctx context.Context, req *pbCourse.GetLessonRequest, opts ...grpc.CallOption) (*pbCourse.GetLessonResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetLessonResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetLessons(ctx context.Context, req *pbCourse.GetLessonsRequest, opts ...grpc.CallOption) (*pbCourse.GetLessonsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetLessonsResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetFocusedLessons(ctx context.Context, req *pbCourse.GetFocusedLessonsRequest, opts ...grpc.CallOption) (*pbCourse.GetFocusedLessonsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetFocusedLessonsResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetEnrolledCourses(ctx context.Context, req *pbCourse.GetEnrolledCoursesRequest, opts ...grpc.CallOption) (*pbCourse.GetEnrolledCoursesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetEnrolledCoursesResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) ListCourses(ctx context.Context, req *pbCourse.ListCoursesRequest, opts ...grpc.CallOption) (*pbCourse.ListCoursesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.ListCoursesResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) EnrollUser(ctx context.Context, req *pbCourse.EnrollUserRequest, opts ...grpc.CallOption) (*pbCourse.EnrollUserResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.EnrollUserResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetUserProgress(ctx context.Context, req *pbCourse.GetUserProgressRequest, opts ...grpc.CallOption) (*pbCourse.GetUserProgressResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetUserProgressResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetLessonState(ctx context.Context, req *pbCourse.GetLessonStateRequest, opts ...grpc.CallOption) (*pbCourse.GetLessonStateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetLessonStateResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) AnswerCards(ctx context.Context, req *pbCourse.AnswerCardsRequest, opts ...grpc.CallOption) (*pbCourse.AnswerCardsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.AnswerCardsResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) CreateCourse(ctx context.Context, req *pbCourse.CreateCourseRequest, opts ...grpc.CallOption) (*pbCourse.CreateCourseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.CreateCourseResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) CreateLesson(ctx context.Context, req *pbCourse.CreateLessonRequest, opts ...grpc.CallOption) (*pbCourse.CreateLessonResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.CreateLessonResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) UpdateCourse(ctx context.Context, req *pbCourse.UpdateCourseRequest, opts ...grpc.CallOption) (*pbCourse.UpdateCourseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.UpdateCourseResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) UpdateLesson(ctx context.Context, req *pbCourse.UpdateLessonRequest, opts ...grpc.CallOption) (*pbCourse.UpdateLessonResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.UpdateLessonResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) DeleteCourse(ctx context.Context, req *pbCourse.DeleteCourseRequest, opts ...grpc.CallOption) (*pbCourse.DeleteCourseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.DeleteCourseResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) RestoreCourse(ctx context.Context, req *pbCourse.RestoreCourseRequest, opts ...grpc.CallOption) (*pbCourse.RestoreCourseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.RestoreCourseResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) DeleteLesson(ctx context.Context, req *pbCourse.DeleteLessonRequest, opts ...grpc.CallOption) (*pbCourse.DeleteLessonResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.DeleteLessonResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) RestoreLesson(ctx context.Context, req *pbCourse.RestoreLessonRequest, opts ...grpc.CallOption) (*pbCourse.RestoreLessonResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.RestoreLessonResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) ReorderLessons(ctx context.Context, req *pbCourse.ReorderLessonsRequest, opts ...grpc.CallOption) (*pbCourse.ReorderLessonsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.ReorderLessonsResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetModule(ctx context.Context, req *pbCourse.GetModuleRequest, opts ...grpc.CallOption) (*pbCourse.GetModuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetModuleResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) CreateModule(ctx context.Context, req *pbCourse.CreateModuleRequest, opts ...grpc.CallOption) (*pbCourse.CreateModuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.CreateModuleResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) UpdateModule(ctx context.Context, req *pbCourse.UpdateModuleRequest, opts ...grpc.CallOption) (*pbCourse.UpdateModuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.UpdateModuleResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) DeleteModule(ctx context.Context, req *pbCourse.DeleteModuleRequest, opts ...grpc.CallOption) (*pbCourse.DeleteModuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.DeleteModuleResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) RestoreModule(ctx context.Context, req *pbCourse.RestoreModuleRequest, opts ...grpc.CallOption) (*pbCourse.RestoreModuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.RestoreModuleResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) ReorderModules(ctx context.Context, req *pbCourse.ReorderModulesRequest, opts ...grpc.CallOption) (*pbCourse.ReorderModulesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.ReorderModulesResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetCourseOutline(ctx context.Context, req *pbCourse.GetCourseOutlineRequest, opts ...grpc.CallOption) (*pbCourse.GetCourseOutlineResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetCourseOutlineResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) GetCoursePrerequisites(ctx context.Context, req *pbCourse.GetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*pbCourse.GetCoursePrerequisitesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.GetCoursePrerequisitesResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) SetCoursePrerequisites(ctx context.Context, req *pbCourse.SetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*pbCourse.SetCoursePrerequisitesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.SetCoursePrerequisitesResponse), args.Error(1)
+}
+
+func (m *mockCoursesClient) SyncState(ctx context.Context, req *pbCourse.SyncStateRequest, opts ...grpc.CallOption) (*pbCourse.SyncStateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbCourse.SyncStateResponse), args.Error(1)
+}
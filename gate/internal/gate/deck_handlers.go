@@ -2,7 +2,10 @@ package gate
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"strings"
 
@@ -32,10 +35,7 @@ func GetDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient pbD
 
 	res, err := decksClient.GetDeck(ctx, req)
 	if err != nil {
-		// TODO: Handle these errors properly
-		if strings.Contains(err.Error(), "deck: deck not found") {
-			slog.Error("GetDeck: deck not found", "error", err, "deckId", deckID)
-			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("GetDeck: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
@@ -43,6 +43,14 @@ func GetDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient pbD
 		return
 	}
 
+	setETag(ctx, res.Deck.GetVersion())
+
+	// Only editors and owners get to see which answers are correct
+	if res.GetRole() != "editor" && res.GetRole() != "owner" {
+		ctx.JSON(http.StatusOK, toLearnerDeckResponse(res.Deck))
+		return
+	}
+
 	ctx.JSON(http.StatusOK, toDeckResponse(res.Deck))
 }
 
@@ -71,11 +79,7 @@ func CreateDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 
 	res, err := decksClient.CreateDeck(ctx, &pbDeck.CreateDeckRequest{Deck: &d})
 	if err != nil {
-		// Check if it's a validation error (invalid deck)
-		// TODO: Handle these errors properly
-		if strings.Contains(err.Error(), "deck: invalid deck") {
-			slog.Error("CreateDeck: validation error", "error", err, "title", d.Title)
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("CreateDeck: gRPC call failed", "error", err, "title", d.Title, "stack", errors.ErrorStack(err))
@@ -99,8 +103,11 @@ func DeleteDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 		return
 	}
 
-	_, err := decksClient.DeleteDeck(ctx, &pbDeck.DeleteDeckRequest{Id: id})
+	_, err := decksClient.DeleteDeck(ctx, &pbDeck.DeleteDeckRequest{Id: id, UserId: getUserID(ctx)})
 	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
 		slog.Error("DeleteDeck: gRPC call failed", "error", err, "deckId", id, "stack", errors.ErrorStack(err))
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -123,9 +130,14 @@ func UpdateDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 	}
 
 	var req struct {
-		Title       *string `json:"title"`
-		Description *string `json:"description"`
-		IsPublic    *bool   `json:"is_public"`
+		Title       *string   `json:"title"`
+		Description *string   `json:"description"`
+		IsPublic    *bool     `json:"is_public"`
+		Language    *string   `json:"language"`
+		Tags        *[]string `json:"tags"`
+		// Version is the version the update is based on, unless If-Match
+		// says otherwise
+		Version *int32 `json:"version"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -133,20 +145,33 @@ func UpdateDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 		return
 	}
 
-	if req.Title == nil && req.Description == nil && req.IsPublic == nil {
+	if req.Title == nil && req.Description == nil && req.IsPublic == nil && req.Language == nil && req.Tags == nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at least one field must be provided"})
 		return
 	}
 
-	res, err := decksClient.UpdateDeck(ctx, &pbDeck.UpdateDeckRequest{
-		Id:          id,
-		Title:       req.Title,
-		Description: req.Description,
-		IsPublic:    req.IsPublic,
-	})
+	version, ok := bindExpectedVersion(ctx, req.Version)
+	if !ok {
+		return
+	}
+
+	updateReq := &pbDeck.UpdateDeckRequest{
+		Id:              id,
+		Title:           req.Title,
+		Description:     req.Description,
+		IsPublic:        req.IsPublic,
+		Language:        req.Language,
+		UserId:          getUserID(ctx),
+		ExpectedVersion: version,
+	}
+
+	if req.Tags != nil {
+		updateReq.Tags = &pbDeck.TagList{Tags: *req.Tags}
+	}
+
+	res, err := decksClient.UpdateDeck(ctx, updateReq)
 	if err != nil {
-		if strings.Contains(err.Error(), "deck: deck not found") {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "deck not found"})
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("UpdateDeck: gRPC call failed", "error", err, "deckId", id, "stack", errors.ErrorStack(err))
@@ -154,9 +179,166 @@ func UpdateDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 		return
 	}
 
+	setETag(ctx, res.Deck.GetVersion())
 	ctx.JSON(http.StatusOK, toDeckResponse(res.Deck))
 }
 
+// maxSearchPageSize caps how many decks a single search page returns
+const maxSearchPageSize = 100
+
+func SearchDecks(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient) {
+	query := strings.TrimSpace(ctx.Query("q"))
+	if query == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing search query"})
+		return
+	}
+
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.SearchDecks(ctx, &pbDeck.SearchDecksRequest{
+		UserId:   getUserID(ctx),
+		Query:    query,
+		Language: ctx.Query("language"),
+		Tags:     ctx.QueryArray("tag"),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("SearchDecks: gRPC call failed", "error", err, "query", query, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids := make([]string, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		ids = append(ids, e.DeckId)
+	}
+
+	decks, err := decksClient.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids, UserId: getUserID(ctx)})
+	if err != nil {
+		slog.Error("SearchDecks: failed to get decks", "error", err, "deckCount", len(ids), "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	edges := make([]searchDeckEdgeResponse, 0, len(ids))
+	for _, e := range res.Connection.GetEdges() {
+		d, ok := decks.Decks[e.DeckId]
+		if !ok {
+			// Deleted between the search and the lookup
+			continue
+		}
+
+		edges = append(edges, searchDeckEdgeResponse{
+			Node:   toLearnerDeckResponse(d),
+			Cursor: e.Cursor,
+			Rank:   e.Rank,
+		})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
+
+type searchDeckEdgeResponse struct {
+	Node   learnerDeckResponse `json:"node"`
+	Cursor string              `json:"cursor"`
+	Rank   float32             `json:"rank"`
+}
+
+// GetPopularDecks ranks the decks the caller can see by their popularity
+// over a period, all time unless `period=week` asks for what's trending.
+func GetPopularDecks(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.GetPopularDecks(ctx, &pbDeck.GetPopularDecksRequest{
+		UserId: getUserID(ctx),
+		Period: ctx.Query("period"),
+		Tags:   ctx.QueryArray("tag"),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("GetPopularDecks: gRPC call failed", "error", err, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids := make([]string, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		ids = append(ids, e.DeckId)
+	}
+
+	decks, err := decksClient.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids, UserId: getUserID(ctx)})
+	if err != nil {
+		slog.Error("GetPopularDecks: failed to get decks", "error", err, "deckCount", len(ids), "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	edges := make([]popularDeckEdgeResponse, 0, len(ids))
+	for _, e := range res.Connection.GetEdges() {
+		d, ok := decks.Decks[e.DeckId]
+		if !ok {
+			// Deleted between the ranking and the lookup
+			continue
+		}
+
+		edges = append(edges, popularDeckEdgeResponse{
+			Node:   toLearnerDeckResponse(d),
+			Cursor: e.Cursor,
+		})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
+
+type popularDeckEdgeResponse struct {
+	Node   learnerDeckResponse `json:"node"`
+	Cursor string              `json:"cursor"`
+}
+
 func UpdateCard(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient) {
 	deckID := ctx.Param("id")
 	cardID := ctx.Param("cardId")
@@ -185,6 +367,9 @@ func UpdateCard(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 		Title       *string `json:"title"`
 		Explanation *string `json:"explanation"`
 		Kind        *string `json:"kind"`
+		// Version is the version the update is based on, unless If-Match
+		// says otherwise
+		Version *int32 `json:"version"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -197,20 +382,22 @@ func UpdateCard(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 		return
 	}
 
+	version, ok := bindExpectedVersion(ctx, req.Version)
+	if !ok {
+		return
+	}
+
 	res, err := decksClient.UpdateCard(ctx, &pbDeck.UpdateCardRequest{
-		DeckId:      deckID,
-		CardId:      cardID,
-		Title:       req.Title,
-		Explanation: req.Explanation,
-		Kind:        req.Kind,
+		DeckId:          deckID,
+		CardId:          cardID,
+		Title:           req.Title,
+		Explanation:     req.Explanation,
+		Kind:            req.Kind,
+		UserId:          getUserID(ctx),
+		ExpectedVersion: version,
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "deck: invalid card") {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "card not found"})
-			return
-		}
-		if strings.Contains(err.Error(), "deck: kind must be") {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid card kind"})
+		if handleStatusError(ctx, err) {
 			return
 		}
 		slog.Error("UpdateCard: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "stack", errors.ErrorStack(err))
@@ -218,6 +405,7 @@ func UpdateCard(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient
 		return
 	}
 
+	setETag(ctx, res.Card.GetVersion())
 	ctx.JSON(http.StatusOK, toCardResponse(res.Card))
 }
 
@@ -258,6 +446,10 @@ func UpdateAnswer(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClien
 	var req struct {
 		Text      *string `json:"text"`
 		IsCorrect *bool   `json:"is_correct"`
+		Blank     *int32  `json:"blank"`
+		Tolerance *int32  `json:"tolerance"`
+		Position  *int32  `json:"position"`
+		Side      *string `json:"side"`
 	}
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -265,7 +457,8 @@ func UpdateAnswer(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClien
 		return
 	}
 
-	if req.Text == nil && req.IsCorrect == nil {
+	if req.Text == nil && req.IsCorrect == nil && req.Blank == nil && req.Tolerance == nil &&
+		req.Position == nil && req.Side == nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "at least one field must be provided"})
 		return
 	}
@@ -276,8 +469,16 @@ func UpdateAnswer(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClien
 		AnswerId:  answerID,
 		Text:      req.Text,
 		IsCorrect: req.IsCorrect,
+		Blank:     req.Blank,
+		Tolerance: req.Tolerance,
+		Position:  req.Position,
+		Side:      req.Side,
+		UserId:    getUserID(ctx),
 	})
 	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
 		slog.Error("UpdateAnswer: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "answerId", answerID, "stack", errors.ErrorStack(err))
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -286,11 +487,208 @@ func UpdateAnswer(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClien
 	ctx.JSON(http.StatusOK, toAnswerResponse(res.Answer))
 }
 
+func ImportDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient) {
+	// Files come either inline in a JSON body or, for binary formats such as
+	// apkg, as a multipart upload with the mapping JSON encoded in a field
+	var req struct {
+		Title       string                `json:"title" form:"title"`
+		Description string                `json:"description" form:"description"`
+		IsPublic    bool                  `json:"is_public" form:"is_public"`
+		Format      string                `json:"format" form:"format" binding:"required"`
+		Data        string                `json:"data" form:"data"`
+		Mapping     *columnMappingRequest `json:"mapping" form:"-"`
+		MappingJSON string                `json:"-" form:"mapping"`
+		File        *multipart.FileHeader `json:"-" form:"file"`
+	}
+
+	if err := ctx.ShouldBind(&req); err != nil {
+		slog.Error("ImportDeck: failed to bind request", "error", err, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.MappingJSON != "" {
+		if err := json.Unmarshal([]byte(req.MappingJSON), &req.Mapping); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mapping"})
+			return
+		}
+	}
+
+	data := []byte(req.Data)
+	if req.File != nil {
+		var err error
+		if data, err = readFormFile(req.File); err != nil {
+			slog.Error("ImportDeck: failed to read uploaded file", "error", err, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "could not read file"})
+			return
+		}
+	}
+
+	if len(data) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing data or file"})
+		return
+	}
+
+	userID := getUserID(ctx)
+	if userID == "" {
+		slog.Error("ImportDeck: missing user ID from context")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	res, err := decksClient.ImportDeck(ctx, &pbDeck.ImportDeckRequest{
+		Deck: &pbDeck.Deck{
+			AuthorId:    userID,
+			Title:       req.Title,
+			Description: req.Description,
+			IsPublic:    req.IsPublic,
+		},
+		Data:    data,
+		Format:  req.Format,
+		Mapping: req.Mapping.toProto(),
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("ImportDeck: gRPC call failed", "error", err, "title", req.Title, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toDeckResponse(res.Deck))
+}
+
+func ExportDeck(ctx *gin.Context, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if deckID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "missing deck id"})
+		return
+	}
+
+	if _, err := uuid.Parse(deckID); err != nil {
+		slog.Error("ExportDeck: failed to parse deck ID", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "csv")
+
+	res, err := decksClient.ExportDeck(ctx, &pbDeck.ExportDeckRequest{
+		DeckId: deckID,
+		UserId: getUserID(ctx),
+		Format: format,
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("ExportDeck: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	switch format {
+	case "tsv":
+		contentType = "text/tab-separated-values; charset=utf-8"
+	case "apkg":
+		contentType = "application/octet-stream"
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="deck-`+deckID+"."+format+`"`)
+	ctx.Data(http.StatusOK, contentType, res.Data)
+}
+
+// maxImportFileSize caps uploaded files, Anki packages with media included.
+// It has to stay below pbDeck.MaxMessageSize for the file to reach the deck
+// service.
+const maxImportFileSize = 64 << 20
+
+func readFormFile(fh *multipart.FileHeader) ([]byte, error) {
+	if fh.Size > maxImportFileSize {
+		return nil, errors.New("file is too big")
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer f.Close()
+
+	out, err := io.ReadAll(io.LimitReader(f, maxImportFileSize))
+	return out, errors.Trace(err)
+}
+
+// columnMappingRequest gives the zero based column of each card field.
+// Optional columns are left out when the file does not have them.
+type columnMappingRequest struct {
+	Title       int32   `json:"title"`
+	Answers     []int32 `json:"answers"`
+	Correct     *int32  `json:"correct"`
+	Explanation *int32  `json:"explanation"`
+	Kind        *int32  `json:"kind"`
+	Blanks      *int32  `json:"blanks"`
+	Tolerances  *int32  `json:"tolerances"`
+	Sides       *int32  `json:"sides"`
+	HasHeader   bool    `json:"has_header"`
+}
+
+func (m *columnMappingRequest) toProto() *pbDeck.ColumnMapping {
+	if m == nil {
+		return nil
+	}
+
+	return &pbDeck.ColumnMapping{
+		Title:       m.Title,
+		Answers:     m.Answers,
+		Correct:     m.Correct,
+		Explanation: m.Explanation,
+		Kind:        m.Kind,
+		Blanks:      m.Blanks,
+		Tolerances:  m.Tolerances,
+		Sides:       m.Sides,
+		HasHeader:   m.HasHeader,
+	}
+}
+
 func RegisterDeckRoutes(r *gin.RouterGroup, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient, adminCfg AdminConfig) {
 	r.POST("/decks", RequireAdmin(adminCfg, adminCfg.CreateDeckAdminOnly), func(ctx *gin.Context) {
 		CreateDeck(ctx, usersClient, decksClient)
 	})
 
+	r.POST("/decks/import", RequireAdmin(adminCfg, adminCfg.CreateDeckAdminOnly), func(ctx *gin.Context) {
+		ImportDeck(ctx, usersClient, decksClient)
+	})
+
+	r.GET("/decks/search", func(ctx *gin.Context) {
+		SearchDecks(ctx, usersClient, decksClient)
+	})
+
+	r.GET("/decks/popular", func(ctx *gin.Context) {
+		GetPopularDecks(ctx, decksClient)
+	})
+
+	r.GET("/decks/shared", func(ctx *gin.Context) {
+		GetSharedDecks(ctx, decksClient)
+	})
+
+	r.GET("/decks/authored", func(ctx *gin.Context) {
+		GetAuthoredDecks(ctx, decksClient)
+	})
+
+	r.GET("/library", func(ctx *gin.Context) {
+		GetLibrary(ctx, decksClient)
+	})
+
+	r.PUT("/library/:id", func(ctx *gin.Context) {
+		AddToLibrary(ctx, decksClient)
+	})
+
+	r.DELETE("/library/:id", func(ctx *gin.Context) {
+		RemoveFromLibrary(ctx, decksClient)
+	})
+
 	r.DELETE("/decks/:id", func(ctx *gin.Context) {
 		DeleteDeck(ctx, usersClient, decksClient)
 	})
@@ -299,6 +697,18 @@ func RegisterDeckRoutes(r *gin.RouterGroup, usersClient pbUser.UserAPIClient, de
 		GetDeck(ctx, usersClient, decksClient)
 	})
 
+	r.GET("/decks/:id/export", func(ctx *gin.Context) {
+		ExportDeck(ctx, usersClient, decksClient)
+	})
+
+	r.GET("/decks/:id/cards", func(ctx *gin.Context) {
+		GetDeckCards(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/cards/stream", func(ctx *gin.Context) {
+		StreamDeckCards(ctx, decksClient)
+	})
+
 	r.PATCH("/decks/:id", RequireAdmin(adminCfg, adminCfg.UpdateDeckAdminOnly), func(ctx *gin.Context) {
 		UpdateDeck(ctx, usersClient, decksClient)
 	})
@@ -310,6 +720,70 @@ func RegisterDeckRoutes(r *gin.RouterGroup, usersClient pbUser.UserAPIClient, de
 	r.PATCH("/decks/:id/cards/:cardId/answers/:answerId", RequireAdmin(adminCfg, adminCfg.UpdateAnswerAdminOnly), func(ctx *gin.Context) {
 		UpdateAnswer(ctx, usersClient, decksClient)
 	})
+
+	r.DELETE("/decks/:id/cards/:cardId", RequireAdmin(adminCfg, adminCfg.UpdateCardAdminOnly), func(ctx *gin.Context) {
+		DeleteCard(ctx, decksClient)
+	})
+
+	r.POST("/decks/:id/cards/:cardId/answers", RequireAdmin(adminCfg, adminCfg.UpdateAnswerAdminOnly), func(ctx *gin.Context) {
+		CreateAnswer(ctx, decksClient)
+	})
+
+	r.DELETE("/decks/:id/cards/:cardId/answers/:answerId", RequireAdmin(adminCfg, adminCfg.UpdateAnswerAdminOnly), func(ctx *gin.Context) {
+		DeleteAnswer(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/cards/:cardId/revisions", func(ctx *gin.Context) {
+		ListCardRevisions(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/cards/:cardId/revisions/diff", func(ctx *gin.Context) {
+		DiffCardRevisions(ctx, decksClient)
+	})
+
+	r.POST("/decks/:id/cards/:cardId/revisions/:revision/restore", RequireAdmin(adminCfg, adminCfg.UpdateCardAdminOnly), func(ctx *gin.Context) {
+		RestoreCardRevision(ctx, decksClient)
+	})
+
+	r.POST("/decks/:id/fork", RequireAdmin(adminCfg, adminCfg.CreateDeckAdminOnly), func(ctx *gin.Context) {
+		ForkDeck(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/upstream", func(ctx *gin.Context) {
+		GetUpstreamChanges(ctx, decksClient)
+	})
+
+	r.POST("/decks/:id/upstream/pull", RequireAdmin(adminCfg, adminCfg.UpdateCardAdminOnly), func(ctx *gin.Context) {
+		PullUpstreamChanges(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/members", func(ctx *gin.Context) {
+		ListDeckMembers(ctx, decksClient)
+	})
+
+	r.POST("/decks/:id/members", func(ctx *gin.Context) {
+		InviteDeckMember(ctx, decksClient)
+	})
+
+	r.PATCH("/decks/:id/members/:userId", func(ctx *gin.Context) {
+		ChangeDeckMemberRole(ctx, decksClient)
+	})
+
+	r.DELETE("/decks/:id/members/:userId", func(ctx *gin.Context) {
+		RemoveDeckMember(ctx, decksClient)
+	})
+
+	r.PUT("/decks/:id/rating", func(ctx *gin.Context) {
+		RateDeck(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/reviews", func(ctx *gin.Context) {
+		GetDeckReviews(ctx, decksClient)
+	})
+
+	r.GET("/decks/:id/analytics", func(ctx *gin.Context) {
+		GetDeckAnalytics(ctx, decksClient)
+	})
 }
 
 func RegisterMiddlewares(r *gin.RouterGroup, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient) {
@@ -381,7 +855,18 @@ type deckResponse struct {
 	AuthorID    string         `json:"author_id"`
 	Title       string         `json:"title"`
 	Description string         `json:"description"`
+	Language    string         `json:"language,omitempty"`
+	Tags        []string       `json:"tags"`
 	Cards       []cardResponse `json:"cards"`
+	Revision    int32          `json:"revision,omitempty"`
+	// Only set on forks
+	UpstreamDeckID   string `json:"upstream_deck_id,omitempty"`
+	UpstreamRevision int32  `json:"upstream_revision,omitempty"`
+
+	RatingAverage float64 `json:"rating_average"`
+	RatingCount   int32   `json:"rating_count"`
+
+	Version int32 `json:"version,omitempty"`
 }
 
 type cardResponse struct {
@@ -391,6 +876,7 @@ type cardResponse struct {
 	PossibleAnswers []answerResponse `json:"possible_answers"`
 	Explanation     string           `json:"explanation"`
 	Kind            string           `json:"kind"`
+	Version         int32            `json:"version,omitempty"`
 }
 
 type answerResponse struct {
@@ -398,6 +884,10 @@ type answerResponse struct {
 	CardID    string `json:"card_id"`
 	Text      string `json:"text"`
 	IsCorrect bool   `json:"is_correct"`
+	Blank     int32  `json:"blank"`
+	Tolerance int32  `json:"tolerance"`
+	Position  int32  `json:"position"`
+	Side      string `json:"side,omitempty"`
 }
 
 func toDeckResponse(d *pbDeck.Deck) deckResponse {
@@ -410,6 +900,17 @@ func toDeckResponse(d *pbDeck.Deck) deckResponse {
 		AuthorID:    d.GetAuthorId(),
 		Title:       d.GetTitle(),
 		Description: d.GetDescription(),
+		Language:    d.GetLanguage(),
+		Tags:        d.GetTags(),
+		Revision:    d.GetRevision(),
+
+		UpstreamDeckID:   d.GetUpstreamDeckId(),
+		UpstreamRevision: d.GetUpstreamRevision(),
+
+		RatingAverage: d.GetRatingAverage(),
+		RatingCount:   d.GetRatingCount(),
+
+		Version: d.GetVersion(),
 	}
 
 	if len(d.Cards) > 0 {
@@ -433,6 +934,7 @@ func toCardResponse(c *pbDeck.Card) cardResponse {
 		Title:       c.GetTitle(),
 		Explanation: c.GetExplanation(),
 		Kind:        c.GetKind(),
+		Version:     c.GetVersion(),
 	}
 
 	if len(c.PossibleAnswers) > 0 {
@@ -455,5 +957,103 @@ func toAnswerResponse(a *pbDeck.Answer) answerResponse {
 		CardID:    a.GetCardId(),
 		Text:      a.GetText(),
 		IsCorrect: a.GetIsCorrect(),
+		Blank:     a.GetBlank(),
+		Tolerance: a.GetTolerance(),
+		Position:  a.GetPosition(),
+		Side:      a.GetSide(),
 	}
 }
+
+// learnerDeckResponse is the deck as shown to learners: it carries no
+// answer correctness nor card explanations.
+type learnerDeckResponse struct {
+	ID          string                `json:"id"`
+	AuthorID    string                `json:"author_id"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	Language    string                `json:"language,omitempty"`
+	Tags        []string              `json:"tags"`
+	Cards       []learnerCardResponse `json:"cards"`
+	Revision    int32                 `json:"revision,omitempty"`
+	// Only set on forks
+	UpstreamDeckID   string `json:"upstream_deck_id,omitempty"`
+	UpstreamRevision int32  `json:"upstream_revision,omitempty"`
+
+	RatingAverage float64 `json:"rating_average"`
+	RatingCount   int32   `json:"rating_count"`
+}
+
+type learnerCardResponse struct {
+	ID              string                  `json:"id"`
+	DeckID          string                  `json:"deck_id"`
+	Title           string                  `json:"title"`
+	PossibleAnswers []learnerAnswerResponse `json:"possible_answers"`
+	Kind            string                  `json:"kind"`
+	BlankCount      int32                   `json:"blank_count,omitempty"`
+}
+
+type learnerAnswerResponse struct {
+	ID     string `json:"id"`
+	CardID string `json:"card_id"`
+	Text   string `json:"text"`
+	Side   string `json:"side,omitempty"`
+}
+
+func toLearnerDeckResponse(d *pbDeck.Deck) learnerDeckResponse {
+	if d == nil {
+		return learnerDeckResponse{}
+	}
+
+	out := learnerDeckResponse{
+		ID:          d.GetId(),
+		AuthorID:    d.GetAuthorId(),
+		Title:       d.GetTitle(),
+		Description: d.GetDescription(),
+		Language:    d.GetLanguage(),
+		Tags:        d.GetTags(),
+		Revision:    d.GetRevision(),
+
+		UpstreamDeckID:   d.GetUpstreamDeckId(),
+		UpstreamRevision: d.GetUpstreamRevision(),
+
+		RatingAverage: d.GetRatingAverage(),
+		RatingCount:   d.GetRatingCount(),
+	}
+
+	if len(d.Cards) > 0 {
+		out.Cards = make([]learnerCardResponse, 0, len(d.Cards))
+		for _, c := range d.Cards {
+			out.Cards = append(out.Cards, toLearnerCardResponse(c))
+		}
+	}
+
+	return out
+}
+
+func toLearnerCardResponse(c *pbDeck.Card) learnerCardResponse {
+	if c == nil {
+		return learnerCardResponse{}
+	}
+
+	out := learnerCardResponse{
+		ID:         c.GetId(),
+		DeckID:     c.GetDeckId(),
+		Title:      c.GetTitle(),
+		Kind:       c.GetKind(),
+		BlankCount: c.GetBlankCount(),
+	}
+
+	if len(c.PossibleAnswers) > 0 {
+		out.PossibleAnswers = make([]learnerAnswerResponse, 0, len(c.PossibleAnswers))
+		for _, a := range c.PossibleAnswers {
+			out.PossibleAnswers = append(out.PossibleAnswers, learnerAnswerResponse{
+				ID:     a.GetId(),
+				CardID: a.GetCardId(),
+				Text:   a.GetText(),
+				Side:   a.GetSide(),
+			})
+		}
+	}
+
+	return out
+}
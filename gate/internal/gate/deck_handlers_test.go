@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,7 +12,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
 	pbUser "github.com/XaviFP/toshokan/user/api/proto/v1"
@@ -29,6 +32,17 @@ func setupTestRouter(usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksA
 	return router
 }
 
+// setupTestRouterAs is like setupTestRouter but authenticates every request as userID
+func setupTestRouterAs(userID string, usersClient pbUser.UserAPIClient, decksClient pbDeck.DecksAPIClient) *gin.Engine {
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		ctx.Set("userID", userID)
+	})
+	adminCfg := AdminConfig{}
+	RegisterDeckRoutes(router.Group("/"), usersClient, decksClient, adminCfg)
+	return router
+}
+
 func TestRouteNotFound(t *testing.T) {
 	decksClient := &mockDecksClient{}
 	usersClient := &mockUsersClient{}
@@ -91,6 +105,38 @@ func TestRouteNotFound(t *testing.T) {
 	})
 }
 
+func TestDeleteDeck(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteDeck", mock.Anything, &pbDeck.DeleteDeckRequest{
+			Id:     "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72",
+			UserId: "4e37a600-c29e-4d0f-af44-66f2cd8cc1c9",
+		}).Return(&pbDeck.DeleteDeckResponse{}, nil)
+
+		router := setupTestRouterAs("4e37a600-c29e-4d0f-af44-66f2cd8cc1c9", &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("failure_not_author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DeleteDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs("7ce8a8c0-3b8a-4d0e-8f6e-1b1d0f6a2c11", &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
 func TestUpdateDeck(t *testing.T) {
 	t.Run("success_update_title", func(t *testing.T) {
 		decksClient := &mockDecksClient{}
@@ -122,6 +168,45 @@ func TestUpdateDeck(t *testing.T) {
 		assert.Equal(t, title, resp.Title)
 	})
 
+	t.Run("success_update_tags", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("UpdateDeck", mock.Anything, mock.MatchedBy(func(req *pbDeck.UpdateDeckRequest) bool {
+			return req.Title == nil && req.Tags != nil && len(req.Tags.Tags) == 0
+		})).Return(&pbDeck.UpdateDeckResponse{
+			Deck: &pbDeck.Deck{Id: "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", Title: "Go"},
+		}, nil)
+
+		router := setupTestRouter(usersClient, decksClient)
+
+		// An empty list clears the tags of the deck
+		body, _ := json.Marshal(map[string]interface{}{"tags": []string{}})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("failure_too_many_tags", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("UpdateDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: a deck can have at most 10 tags", "tags"))
+
+		router := setupTestRouter(usersClient, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"tags": []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("failure_invalid_uuid", func(t *testing.T) {
 		decksClient := &mockDecksClient{}
 		usersClient := &mockUsersClient{}
@@ -171,6 +256,95 @@ func TestUpdateDeck(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("failure_not_author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("UpdateDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs("7ce8a8c0-3b8a-4d0e-8f6e-1b1d0f6a2c11", &mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"title": "Mine now"})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("success_if_match", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("UpdateDeck", mock.Anything, mock.MatchedBy(func(req *pbDeck.UpdateDeckRequest) bool {
+			return req.ExpectedVersion != nil && *req.ExpectedVersion == 3
+		})).Return(&pbDeck.UpdateDeckResponse{
+			Deck: &pbDeck.Deck{Id: "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", Title: "Go", Version: 4},
+		}, nil)
+
+		router := setupTestRouter(&mockUsersClient{}, decksClient)
+
+		// The header wins over the version in the body
+		body, _ := json.Marshal(map[string]interface{}{"title": "Go", "version": 1})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `W/"3"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), `"version":4`)
+	})
+
+	t.Run("success_body_version", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("UpdateDeck", mock.Anything, mock.MatchedBy(func(req *pbDeck.UpdateDeckRequest) bool {
+			return req.ExpectedVersion != nil && *req.ExpectedVersion == 2
+		})).Return(&pbDeck.UpdateDeckResponse{
+			Deck: &pbDeck.Deck{Id: "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", Title: "Go", Version: 3},
+		}, nil)
+
+		router := setupTestRouter(&mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"title": "Go", "version": 2})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("failure_invalid_if_match", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+
+		router := setupTestRouter(&mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"title": "Go"})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"abc"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		decksClient.AssertNotCalled(t, "UpdateDeck")
+	})
+
+	t.Run("failure_version_conflict", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("UpdateDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.FailedPrecondition, "deck: version conflict", "VERSION_CONFLICT"))
+
+		router := setupTestRouter(&mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"title": "Go"})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
 }
 
 func TestUpdateCard(t *testing.T) {
@@ -253,6 +427,39 @@ func TestUpdateCard(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 		assert.Contains(t, w.Body.String(), "at least one field must be provided")
 	})
+
+	t.Run("failure_not_author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("UpdateCard", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs("7ce8a8c0-3b8a-4d0e-8f6e-1b1d0f6a2c11", &mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"title": "Mine now"})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72/cards/72bdff92-5bc8-4e1d-9217-d0b23e22ff33", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("failure_version_conflict", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("UpdateCard", mock.Anything, mock.MatchedBy(func(req *pbDeck.UpdateCardRequest) bool {
+			return req.ExpectedVersion != nil && *req.ExpectedVersion == 5
+		})).Return(nil, detailedError(t, codes.FailedPrecondition, "deck: version conflict", "VERSION_CONFLICT"))
+
+		router := setupTestRouter(&mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"title": "Stale"})
+		req := httptest.NewRequest(http.MethodPatch, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72/cards/72bdff92-5bc8-4e1d-9217-d0b23e22ff33", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"5"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
 }
 
 func TestUpdateAnswer(t *testing.T) {
@@ -352,6 +559,364 @@ func TestUpdateAnswer(t *testing.T) {
 	})
 }
 
+func TestGetDeck(t *testing.T) {
+	authorID := "f3b59a97-e678-4410-8ed2-f1094a234a01"
+	deck := &pbDeck.Deck{
+		Id:          "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72",
+		AuthorId:    authorID,
+		Title:       "Go Learning",
+		Description: "Polish your Go skills",
+		Cards: []*pbDeck.Card{
+			{
+				Id:          "72bdff92-5bc8-4e1d-9217-d0b23e22ff33",
+				Title:       "What does CSP stand for?",
+				Explanation: "Hoare, 1978",
+				Kind:        "single_choice",
+				PossibleAnswers: []*pbDeck.Answer{
+					{Id: "7e6926da-82b2-4ae8-99b4-1b803ebf1877", Text: "Communicating Sequential Processes", IsCorrect: true},
+				},
+			},
+		},
+	}
+
+	t.Run("learner_does_not_see_correctness", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("GetDeck", mock.Anything, mock.Anything).Return(&pbDeck.GetDeckResponse{Deck: deck}, nil)
+
+		router := setupTestRouterAs("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "is_correct")
+		assert.NotContains(t, w.Body.String(), "explanation")
+		assert.Contains(t, w.Body.String(), "Communicating Sequential Processes")
+	})
+
+	t.Run("author_sees_correctness", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("GetDeck", mock.Anything, mock.Anything).Return(&pbDeck.GetDeckResponse{Deck: deck, Role: "owner"}, nil)
+
+		router := setupTestRouterAs(authorID, usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp deckResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.True(t, resp.Cards[0].PossibleAnswers[0].IsCorrect)
+		assert.Equal(t, "Hoare, 1978", resp.Cards[0].Explanation)
+	})
+
+	t.Run("editor_sees_correctness", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("GetDeck", mock.Anything, mock.Anything).Return(&pbDeck.GetDeckResponse{Deck: deck, Role: "editor"}, nil)
+
+		router := setupTestRouterAs("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"is_correct":true`)
+	})
+
+	t.Run("private_deck", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("GetDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestImportDeck(t *testing.T) {
+	authorID := "f3b59a97-e678-4410-8ed2-f1094a234a01"
+
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("ImportDeck", mock.Anything, mock.MatchedBy(func(req *pbDeck.ImportDeckRequest) bool {
+			return req.Deck.AuthorId == authorID &&
+				req.Format == "tsv" &&
+				string(req.Data) == "本\tbook\n" &&
+				req.Mapping.Title == 0 && *req.Mapping.Correct == 2 && req.Mapping.Kind == nil
+		})).Return(&pbDeck.ImportDeckResponse{Deck: &pbDeck.Deck{Id: "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", Title: "Vocabulary"}}, nil)
+
+		router := setupTestRouterAs(authorID, usersClient, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{
+			"title":       "Vocabulary",
+			"description": "JLPT N5 words",
+			"format":      "tsv",
+			"data":        "本\tbook\n",
+			"mapping":     map[string]interface{}{"title": 0, "answers": []int{1}, "correct": 2},
+		})
+		req := httptest.NewRequest(http.MethodPost, "/decks/import", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("multipart_upload", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("ImportDeck", mock.Anything, mock.MatchedBy(func(req *pbDeck.ImportDeckRequest) bool {
+			return req.Format == "apkg" && string(req.Data) == "PK\x03\x04" && req.Deck.Title == ""
+		})).Return(&pbDeck.ImportDeckResponse{Deck: &pbDeck.Deck{Id: "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72", Title: "Japanese::N5"}}, nil)
+
+		router := setupTestRouterAs(authorID, usersClient, decksClient)
+
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		assert.NoError(t, form.WriteField("format", "apkg"))
+		file, err := form.CreateFormFile("file", "japanese.apkg")
+		assert.NoError(t, err)
+		_, err = file.Write([]byte("PK\x03\x04"))
+		assert.NoError(t, err)
+		assert.NoError(t, form.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/import", &body)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Japanese::N5")
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("row_errors", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("ImportDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: invalid deck", "INVALID_DECK",
+			&errdetails.BadRequest_FieldViolation{Field: "rows[2].title", Reason: "NO_TITLE", Description: "deck: title is missing"},
+		))
+
+		router := setupTestRouterAs(authorID, usersClient, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{"format": "csv", "data": "本,book\n,water\n"})
+		req := httptest.NewRequest(http.MethodPost, "/decks/import", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{
+			"error": "deck: invalid deck",
+			"code": "INVALID_DECK",
+			"fields": [{"field": "rows[2].title", "code": "NO_TITLE", "message": "deck: title is missing"}]
+		}`, w.Body.String())
+	})
+}
+
+func TestExportDeck(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("ExportDeck", mock.Anything, mock.MatchedBy(func(req *pbDeck.ExportDeckRequest) bool {
+			return req.Format == "tsv" && req.UserId == "f3b59a97-e678-4410-8ed2-f1094a234a01"
+		})).Return(&pbDeck.ExportDeckResponse{Data: []byte("title\tanswer 1\n")}, nil)
+
+		router := setupTestRouterAs("f3b59a97-e678-4410-8ed2-f1094a234a01", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72/export?format=tsv", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/tab-separated-values; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "title\tanswer 1\n", w.Body.String())
+	})
+
+	t.Run("not_the_author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("ExportDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs("32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72/export", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestSearchDecks(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("SearchDecks", mock.Anything, mock.MatchedBy(func(req *pbDeck.SearchDecksRequest) bool {
+			return req.Query == "greek myths" && req.Language == "english" &&
+				len(req.Tags) == 2 && req.Tags[0] == "mythology" && req.Tags[1] == "greece" &&
+				req.UserId == "f3b59a97-e678-4410-8ed2-f1094a234a01" &&
+				req.Pagination.First == 5 && req.Pagination.After == "abc"
+		})).Return(&pbDeck.SearchDecksResponse{Connection: &pbDeck.SearchDecksConnection{
+			Edges: []*pbDeck.SearchDecksConnection_Edge{
+				{DeckId: "334ddbf8-1acc-405b-86d8-49f0d1ca636c", Cursor: "c1", Rank: 0.5},
+				{DeckId: "6363e2c6-d89e-4610-92e8-1e1d2fea49ec", Cursor: "c2", Rank: 0.25},
+			},
+			PageInfo: &pbDeck.PageInfo{HasNextPage: true, StartCursor: "c1", EndCursor: "c2"},
+		}}, nil)
+
+		decksClient.On("GetDecks", mock.Anything, &pbDeck.GetDecksRequest{
+			DeckIds: []string{"334ddbf8-1acc-405b-86d8-49f0d1ca636c", "6363e2c6-d89e-4610-92e8-1e1d2fea49ec"},
+			UserId:  "f3b59a97-e678-4410-8ed2-f1094a234a01",
+		}).Return(&pbDeck.GetDecksResponse{Decks: map[string]*pbDeck.Deck{
+			"334ddbf8-1acc-405b-86d8-49f0d1ca636c": {Id: "334ddbf8-1acc-405b-86d8-49f0d1ca636c", Title: "Greek Mythology", Language: "english"},
+		}}, nil)
+
+		router := setupTestRouterAs("f3b59a97-e678-4410-8ed2-f1094a234a01", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/search?q=greek+myths&language=english&first=5&after=abc&tag=mythology&tag=greece", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body struct {
+			Edges []struct {
+				Node struct {
+					ID       string `json:"id"`
+					Title    string `json:"title"`
+					Language string `json:"language"`
+				} `json:"node"`
+				Cursor string  `json:"cursor"`
+				Rank   float32 `json:"rank"`
+			} `json:"edges"`
+			PageInfo PageInfoJSON `json:"page_info"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+		// The second deck was deleted after the search ran
+		assert.Len(t, body.Edges, 1)
+		assert.Equal(t, "Greek Mythology", body.Edges[0].Node.Title)
+		assert.Equal(t, "english", body.Edges[0].Node.Language)
+		assert.Equal(t, "c1", body.Edges[0].Cursor)
+		assert.Equal(t, float32(0.5), body.Edges[0].Rank)
+		assert.Equal(t, PageInfoJSON{HasNextPage: true, StartCursor: "c1", EndCursor: "c2"}, body.PageInfo)
+	})
+
+	t.Run("bad_request", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("SearchDecks", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: unsupported search language", "language"))
+
+		router := setupTestRouter(usersClient, decksClient)
+
+		for _, target := range []string{
+			"/decks/search",
+			"/decks/search?q=go&first=1000",
+			"/decks/search?q=go&language=klingon",
+		} {
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, target)
+		}
+	})
+}
+
+func TestGetPopularDecks(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("GetPopularDecks", mock.Anything, mock.MatchedBy(func(req *pbDeck.GetPopularDecksRequest) bool {
+			return req.Period == "week" && len(req.Tags) == 1 && req.Tags[0] == "mythology" &&
+				req.UserId == "f3b59a97-e678-4410-8ed2-f1094a234a01" &&
+				req.Pagination.First == 5 && req.Pagination.After == "abc"
+		})).Return(&pbDeck.GetPopularDecksResponse{Connection: &pbDeck.PopularDecksConnection{
+			Edges: []*pbDeck.PopularDecksConnection_Edge{
+				{DeckId: "334ddbf8-1acc-405b-86d8-49f0d1ca636c", Cursor: "c1"},
+			},
+			PageInfo: &pbDeck.PageInfo{HasNextPage: true, StartCursor: "c1", EndCursor: "c1"},
+		}}, nil)
+
+		decksClient.On("GetDecks", mock.Anything, &pbDeck.GetDecksRequest{
+			DeckIds: []string{"334ddbf8-1acc-405b-86d8-49f0d1ca636c"},
+			UserId:  "f3b59a97-e678-4410-8ed2-f1094a234a01",
+		}).Return(&pbDeck.GetDecksResponse{Decks: map[string]*pbDeck.Deck{
+			"334ddbf8-1acc-405b-86d8-49f0d1ca636c": {Id: "334ddbf8-1acc-405b-86d8-49f0d1ca636c", Title: "Greek Mythology"},
+		}}, nil)
+
+		router := setupTestRouterAs("f3b59a97-e678-4410-8ed2-f1094a234a01", usersClient, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/popular?period=week&first=5&after=abc&tag=mythology", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body struct {
+			Edges []struct {
+				Node struct {
+					Title string `json:"title"`
+				} `json:"node"`
+				Cursor string `json:"cursor"`
+			} `json:"edges"`
+			PageInfo PageInfoJSON `json:"page_info"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+		assert.Len(t, body.Edges, 1)
+		assert.Equal(t, "Greek Mythology", body.Edges[0].Node.Title)
+		assert.Equal(t, "c1", body.Edges[0].Cursor)
+		assert.Equal(t, PageInfoJSON{HasNextPage: true, StartCursor: "c1", EndCursor: "c1"}, body.PageInfo)
+	})
+
+	t.Run("bad_request", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		usersClient := &mockUsersClient{}
+
+		decksClient.On("GetPopularDecks", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: period must be week or all_time", "INVALID_PERIOD"))
+
+		router := setupTestRouter(usersClient, decksClient)
+
+		for _, target := range []string{
+			"/decks/popular?first=1000",
+			"/decks/popular?period=month",
+		} {
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, target)
+		}
+	})
+}
+
 type mockDecksClient struct {
 	mock.Mock
 }
@@ -436,6 +1001,270 @@ func (m *mockDecksClient) UpdateAnswer(ctx context.Context, req *pbDeck.UpdateAn
 	return args.Get(0).(*pbDeck.UpdateAnswerResponse), args.Error(1)
 }
 
+func (m *mockDecksClient) GradeAnswers(ctx context.Context, req *pbDeck.GradeAnswersRequest, opts ...grpc.CallOption) (*pbDeck.GradeAnswersResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GradeAnswersResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetDeckAnalytics(ctx context.Context, req *pbDeck.GetDeckAnalyticsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckAnalyticsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDeckAnalyticsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetDeckCards(ctx context.Context, req *pbDeck.GetDeckCardsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckCardsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDeckCardsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetDecksCards(ctx context.Context, req *pbDeck.GetDecksCardsRequest, opts ...grpc.CallOption) (*pbDeck.GetDecksCardsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDecksCardsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) StreamDeckCards(ctx context.Context, req *pbDeck.StreamDeckCardsRequest, opts ...grpc.CallOption) (pbDeck.DecksAPI_StreamDeckCardsClient, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pbDeck.DecksAPI_StreamDeckCardsClient), args.Error(1)
+}
+
+func (m *mockDecksClient) AddToLibrary(ctx context.Context, req *pbDeck.AddToLibraryRequest, opts ...grpc.CallOption) (*pbDeck.AddToLibraryResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.AddToLibraryResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) RemoveFromLibrary(ctx context.Context, req *pbDeck.RemoveFromLibraryRequest, opts ...grpc.CallOption) (*pbDeck.RemoveFromLibraryResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RemoveFromLibraryResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetLibrary(ctx context.Context, req *pbDeck.GetLibraryRequest, opts ...grpc.CallOption) (*pbDeck.GetLibraryResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetLibraryResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetAuthoredDecks(ctx context.Context, req *pbDeck.GetAuthoredDecksRequest, opts ...grpc.CallOption) (*pbDeck.GetAuthoredDecksResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetAuthoredDecksResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) RateDeck(ctx context.Context, req *pbDeck.RateDeckRequest, opts ...grpc.CallOption) (*pbDeck.RateDeckResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RateDeckResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetDeckReviews(ctx context.Context, req *pbDeck.GetDeckReviewsRequest, opts ...grpc.CallOption) (*pbDeck.GetDeckReviewsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetDeckReviewsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) ListDeckMembers(ctx context.Context, req *pbDeck.ListDeckMembersRequest, opts ...grpc.CallOption) (*pbDeck.ListDeckMembersResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ListDeckMembersResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) InviteDeckMember(ctx context.Context, req *pbDeck.InviteDeckMemberRequest, opts ...grpc.CallOption) (*pbDeck.InviteDeckMemberResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.InviteDeckMemberResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) ChangeDeckMemberRole(ctx context.Context, req *pbDeck.ChangeDeckMemberRoleRequest, opts ...grpc.CallOption) (*pbDeck.ChangeDeckMemberRoleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ChangeDeckMemberRoleResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) RemoveDeckMember(ctx context.Context, req *pbDeck.RemoveDeckMemberRequest, opts ...grpc.CallOption) (*pbDeck.RemoveDeckMemberResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RemoveDeckMemberResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetSharedDecks(ctx context.Context, req *pbDeck.GetSharedDecksRequest, opts ...grpc.CallOption) (*pbDeck.GetSharedDecksResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetSharedDecksResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) DeleteCard(ctx context.Context, req *pbDeck.DeleteCardRequest, opts ...grpc.CallOption) (*pbDeck.DeleteCardResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DeleteCardResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) CreateAnswer(ctx context.Context, req *pbDeck.CreateAnswerRequest, opts ...grpc.CallOption) (*pbDeck.CreateAnswerResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.CreateAnswerResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) DeleteAnswer(ctx context.Context, req *pbDeck.DeleteAnswerRequest, opts ...grpc.CallOption) (*pbDeck.DeleteAnswerResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DeleteAnswerResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) ForkDeck(ctx context.Context, req *pbDeck.ForkDeckRequest, opts ...grpc.CallOption) (*pbDeck.ForkDeckResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ForkDeckResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetUpstreamChanges(ctx context.Context, req *pbDeck.GetUpstreamChangesRequest, opts ...grpc.CallOption) (*pbDeck.GetUpstreamChangesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetUpstreamChangesResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) PullUpstreamChanges(ctx context.Context, req *pbDeck.PullUpstreamChangesRequest, opts ...grpc.CallOption) (*pbDeck.PullUpstreamChangesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.PullUpstreamChangesResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) ListCardRevisions(ctx context.Context, req *pbDeck.ListCardRevisionsRequest, opts ...grpc.CallOption) (*pbDeck.ListCardRevisionsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ListCardRevisionsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) DiffCardRevisions(ctx context.Context, req *pbDeck.DiffCardRevisionsRequest, opts ...grpc.CallOption) (*pbDeck.DiffCardRevisionsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DiffCardRevisionsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) RestoreCardRevision(ctx context.Context, req *pbDeck.RestoreCardRevisionRequest, opts ...grpc.CallOption) (*pbDeck.RestoreCardRevisionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.RestoreCardRevisionResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) AutocompleteTags(ctx context.Context, req *pbDeck.AutocompleteTagsRequest, opts ...grpc.CallOption) (*pbDeck.AutocompleteTagsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.AutocompleteTagsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) GetTopics(ctx context.Context, req *pbDeck.GetTopicsRequest, opts ...grpc.CallOption) (*pbDeck.GetTopicsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.GetTopicsResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) CreateTopic(ctx context.Context, req *pbDeck.CreateTopicRequest, opts ...grpc.CallOption) (*pbDeck.CreateTopicResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.CreateTopicResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) DeleteTopic(ctx context.Context, req *pbDeck.DeleteTopicRequest, opts ...grpc.CallOption) (*pbDeck.DeleteTopicResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.DeleteTopicResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) SetTagTopic(ctx context.Context, req *pbDeck.SetTagTopicRequest, opts ...grpc.CallOption) (*pbDeck.SetTagTopicResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.SetTagTopicResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) SearchDecks(ctx context.Context, req *pbDeck.SearchDecksRequest, opts ...grpc.CallOption) (*pbDeck.SearchDecksResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.SearchDecksResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) ImportDeck(ctx context.Context, req *pbDeck.ImportDeckRequest, opts ...grpc.CallOption) (*pbDeck.ImportDeckResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ImportDeckResponse), args.Error(1)
+}
+
+func (m *mockDecksClient) ExportDeck(ctx context.Context, req *pbDeck.ExportDeckRequest, opts ...grpc.CallOption) (*pbDeck.ExportDeckResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pbDeck.ExportDeckResponse), args.Error(1)
+}
+
 type mockUsersClient struct {
 	mock.Mock
 }
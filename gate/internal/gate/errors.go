@@ -0,0 +1,88 @@
+package gate
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorResponse is the body of the errors of services, whichever service
+// they come from. Code is a stable key clients can tell errors apart by, and
+// Fields lists the problems with each field of the request, if any.
+type errorResponse struct {
+	Error  string               `json:"error"`
+	Code   string               `json:"code,omitempty"`
+	Fields []fieldErrorResponse `json:"fields,omitempty"`
+}
+
+type fieldErrorResponse struct {
+	Field   string `json:"field"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// statusHTTPCodes are the HTTP statuses of the gRPC codes the services reply
+// with. Codes left out are server errors.
+var statusHTTPCodes = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+}
+
+// reasonHTTPCodes are the HTTP statuses of the errors whose reason says more
+// than their gRPC code does.
+var reasonHTTPCodes = map[string]int{
+	"VERSION_CONFLICT": http.StatusPreconditionFailed,
+}
+
+// handleStatusError replies to err with its HTTP status and an
+// errorResponse when err is a gRPC status carrying an ErrorInfo or a
+// BadRequest detail. It reports whether it did.
+func handleStatusError(ctx *gin.Context, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	res := errorResponse{Error: st.Message()}
+	detailed := false
+
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			res.Code = d.Reason
+			detailed = true
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				res.Fields = append(res.Fields, fieldErrorResponse{Field: v.Field, Code: v.Reason, Message: v.Description})
+			}
+			detailed = true
+		}
+	}
+
+	if !detailed {
+		return false
+	}
+
+	httpCode, ok := reasonHTTPCodes[res.Code]
+	if !ok {
+		httpCode, ok = statusHTTPCodes[st.Code()]
+	}
+	if !ok {
+		httpCode = http.StatusInternalServerError
+	}
+
+	ctx.JSON(httpCode, res)
+
+	return true
+}
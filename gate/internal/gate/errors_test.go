@@ -0,0 +1,88 @@
+package gate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// detailedError returns a status error like the ones of the deck service
+func detailedError(t *testing.T, code codes.Code, message, reason string, fields ...*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(code, message).WithDetails(
+		&errdetails.ErrorInfo{Reason: reason, Domain: "deck.toshokan"},
+		&errdetails.BadRequest{FieldViolations: fields},
+	)
+	require.NoError(t, err)
+
+	return st.Err()
+}
+
+func TestHandleStatusError(t *testing.T) {
+	t.Run("field_violations", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("CreateDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: invalid deck", "INVALID_DECK",
+			&errdetails.BadRequest_FieldViolation{Field: "title", Reason: "NO_TITLE", Description: "deck: title is missing"},
+			&errdetails.BadRequest_FieldViolation{Field: "cards[0].possible_answers", Reason: "NO_CORRECT_ANSWER", Description: "deck: at least one answer must be correct"},
+		))
+
+		router := setupTestRouterAs("7ce8a8c0-3b8a-4d0e-8f6e-1b1d0f6a2c11", &mockUsersClient{}, decksClient)
+
+		body, _ := json.Marshal(map[string]interface{}{
+			"description": "Polish your Go skills",
+			"cards":       []map[string]interface{}{{"title": "Pick one", "kind": "single_choice", "possible_answers": []map[string]interface{}{{"text": "Wrong"}}}},
+		})
+		req := httptest.NewRequest(http.MethodPost, "/decks", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var res errorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, errorResponse{
+			Error: "deck: invalid deck",
+			Code:  "INVALID_DECK",
+			Fields: []fieldErrorResponse{
+				{Field: "title", Code: "NO_TITLE", Message: "deck: title is missing"},
+				{Field: "cards[0].possible_answers", Code: "NO_CORRECT_ANSWER", Message: "deck: at least one answer must be correct"},
+			},
+		}, res)
+	})
+
+	t.Run("reason_overrides_code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+
+		assert.True(t, handleStatusError(ctx, detailedError(t, codes.FailedPrecondition, "deck: version conflict", "VERSION_CONFLICT")))
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		assert.JSONEq(t, `{"error":"deck: version conflict","code":"VERSION_CONFLICT"}`, w.Body.String())
+	})
+
+	t.Run("failed_precondition", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+
+		assert.True(t, handleStatusError(ctx, detailedError(t, codes.FailedPrecondition, "courses: module still has lessons", "MODULE_NOT_EMPTY")))
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("without_details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+
+		assert.False(t, handleStatusError(ctx, status.Error(codes.NotFound, "courses: course not found")))
+		assert.False(t, handleStatusError(ctx, errors.New("boom")))
+	})
+}
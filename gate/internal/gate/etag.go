@@ -0,0 +1,65 @@
+package gate
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/juju/errors"
+)
+
+var errInvalidIfMatch = errors.New("If-Match must be a single version, like \"3\"")
+
+// setETag sets the ETag header to version, the version of the entity in the
+// response. Versions 0 come from services that don't track them and get no
+// header.
+func setETag(ctx *gin.Context, version int32) {
+	if version == 0 {
+		return
+	}
+
+	ctx.Header("ETag", strconv.Quote(strconv.Itoa(int(version))))
+}
+
+// expectedVersion returns the version an update is based on, taken from the
+// If-Match header or, without one, from the version in the body. A nil
+// version means the update applies whatever the current version is.
+func expectedVersion(ctx *gin.Context, bodyVersion *int32) (*int32, error) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" {
+		return bodyVersion, nil
+	}
+
+	if header == "*" {
+		return nil, nil
+	}
+
+	// Versions are compared as they are, so weak tags are as good as strong
+	// ones
+	tag := strings.TrimPrefix(header, "W/")
+	if unquoted, err := strconv.Unquote(tag); err == nil {
+		tag = unquoted
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 32)
+	if err != nil || version < 1 {
+		return nil, errInvalidIfMatch
+	}
+
+	v := int32(version)
+
+	return &v, nil
+}
+
+// bindExpectedVersion is expectedVersion that answers 400 on an invalid
+// If-Match header. It returns false when it did.
+func bindExpectedVersion(ctx *gin.Context, bodyVersion *int32) (*int32, bool) {
+	version, err := expectedVersion(ctx, bodyVersion)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	return version, true
+}
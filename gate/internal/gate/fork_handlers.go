@@ -0,0 +1,120 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+func ForkDeck(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	res, err := decksClient.ForkDeck(ctx, &pbDeck.ForkDeckRequest{DeckId: deckID, UserId: getUserID(ctx)})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("ForkDeck: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toDeckResponse(res.Deck))
+}
+
+func GetUpstreamChanges(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	res, err := decksClient.GetUpstreamChanges(ctx, &pbDeck.GetUpstreamChangesRequest{DeckId: deckID, UserId: getUserID(ctx)})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("GetUpstreamChanges: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	changes := make([]upstreamChangeResponse, 0, len(res.Changes))
+	for _, c := range res.Changes {
+		changes = append(changes, toUpstreamChangeResponse(c))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"changes": changes})
+}
+
+func PullUpstreamChanges(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	var req struct {
+		CardIDs []string `json:"card_ids" binding:"required,min=1"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	for _, id := range req.CardIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid card id format"})
+			return
+		}
+	}
+
+	_, err := decksClient.PullUpstreamChanges(ctx, &pbDeck.PullUpstreamChangesRequest{
+		DeckId:          deckID,
+		UserId:          getUserID(ctx),
+		UpstreamCardIds: req.CardIDs,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("PullUpstreamChanges: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+type upstreamChangeResponse struct {
+	UpstreamCardID string                `json:"upstream_card_id"`
+	CardID         string                `json:"card_id,omitempty"`
+	Status         string                `json:"status"`
+	Revision       int32                 `json:"revision"`
+	Changes        []fieldChangeResponse `json:"changes"`
+	Card           cardResponse          `json:"card"`
+}
+
+func toUpstreamChangeResponse(c *pbDeck.UpstreamChange) upstreamChangeResponse {
+	out := upstreamChangeResponse{
+		UpstreamCardID: c.GetUpstreamCardId(),
+		CardID:         c.GetCardId(),
+		Status:         c.GetStatus(),
+		Revision:       c.GetRevision(),
+		Changes:        make([]fieldChangeResponse, 0, len(c.GetChanges())),
+		Card:           toCardResponse(c.GetCard()),
+	}
+
+	for _, fc := range c.GetChanges() {
+		out.Changes = append(out.Changes, fieldChangeResponse{Field: fc.GetField(), From: fc.GetFrom(), To: fc.GetTo()})
+	}
+
+	return out
+}
@@ -0,0 +1,174 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	forkUpstreamID = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	forkDeckID     = "0b6a3f3e-4a57-4a59-9c1b-7d2f0e8a4c11"
+	forkCardID     = "1f30a72f-5d7a-48da-a5c2-42efece6972a"
+	forkUserID     = "9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55"
+)
+
+func TestForkDeck(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ForkDeck", mock.Anything, &pbDeck.ForkDeckRequest{DeckId: forkUpstreamID, UserId: forkUserID}).Return(&pbDeck.ForkDeckResponse{Deck: &pbDeck.Deck{
+			Id:               forkDeckID,
+			AuthorId:         forkUserID,
+			Title:            "Programming languages",
+			Description:      "Compiled or interpreted?",
+			Revision:         1,
+			UpstreamDeckId:   forkUpstreamID,
+			UpstreamRevision: 4,
+		}}, nil)
+
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+forkUpstreamID+"/fork", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{
+			"id": "0b6a3f3e-4a57-4a59-9c1b-7d2f0e8a4c11",
+			"author_id": "9c1d4e4f-2f6a-4a5e-8f0e-3a7c2b9d1e55",
+			"title": "Programming languages",
+			"description": "Compiled or interpreted?",
+			"tags": null,
+			"cards": null,
+			"revision": 1,
+			"upstream_deck_id": "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72",
+			"upstream_revision": 4,
+			"rating_average": 0,
+			"rating_count": 0
+		}`, w.Body.String())
+	})
+
+	t.Run("private_deck", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ForkDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+forkUpstreamID+"/fork", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("invalid_deck_id", func(t *testing.T) {
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, &mockDecksClient{})
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/not-a-uuid/fork", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetUpstreamChanges(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetUpstreamChanges", mock.Anything, &pbDeck.GetUpstreamChangesRequest{DeckId: forkDeckID, UserId: forkUserID}).Return(&pbDeck.GetUpstreamChangesResponse{Changes: []*pbDeck.UpstreamChange{{
+			UpstreamCardId: forkCardID,
+			Status:         "added",
+			Revision:       1,
+			Changes:        []*pbDeck.FieldChange{{Field: "title", To: "Zig"}},
+			Card:           &pbDeck.Card{Id: forkCardID, Title: "Zig", Kind: "single_choice"},
+		}}}, nil)
+
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+forkDeckID+"/upstream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"changes": [{
+			"upstream_card_id": "1f30a72f-5d7a-48da-a5c2-42efece6972a",
+			"status": "added",
+			"revision": 1,
+			"changes": [{"field": "title", "from": "", "to": "Zig"}],
+			"card": {
+				"id": "1f30a72f-5d7a-48da-a5c2-42efece6972a",
+				"deck_id": "",
+				"title": "Zig",
+				"explanation": "",
+				"kind": "single_choice",
+				"possible_answers": null
+			}
+		}]}`, w.Body.String())
+	})
+
+	t.Run("not_a_fork", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetUpstreamChanges", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.FailedPrecondition, "deck: deck is not a fork", "NOT_A_FORK"))
+
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+forkDeckID+"/upstream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestPullUpstreamChanges(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("PullUpstreamChanges", mock.Anything, &pbDeck.PullUpstreamChangesRequest{
+			DeckId:          forkDeckID,
+			UserId:          forkUserID,
+			UpstreamCardIds: []string{forkCardID},
+		}).Return(&pbDeck.PullUpstreamChangesResponse{}, nil)
+
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+forkDeckID+"/upstream/pull", strings.NewReader(`{"card_ids": ["`+forkCardID+`"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("no_cards", func(t *testing.T) {
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, &mockDecksClient{})
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+forkDeckID+"/upstream/pull", strings.NewReader(`{"card_ids": []}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("nothing_to_pull", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("PullUpstreamChanges", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.FailedPrecondition, "deck: card has no upstream changes to pull", "NO_UPSTREAM_CHANGES"))
+
+		router := setupTestRouterAs(forkUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+forkDeckID+"/upstream/pull", strings.NewReader(`{"card_ids": ["`+forkCardID+`"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
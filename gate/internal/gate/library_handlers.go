@@ -0,0 +1,215 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+type libraryEntryResponse struct {
+	Deck            learnerDeckResponse `json:"deck"`
+	AddedAt         time.Time           `json:"added_at"`
+	LastPracticedAt time.Time           `json:"last_practiced_at"`
+	CardCount       int32               `json:"card_count"`
+	Mastery         float64             `json:"mastery"`
+}
+
+type libraryEdgeResponse struct {
+	Node   libraryEntryResponse `json:"node"`
+	Cursor string               `json:"cursor"`
+}
+
+type authoredDeckEdgeResponse struct {
+	Node   deckResponse `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
+func toLibraryEntryResponse(e *pbDeck.LibraryEntry, d *pbDeck.Deck) libraryEntryResponse {
+	return libraryEntryResponse{
+		Deck:            toLearnerDeckResponse(d),
+		AddedAt:         e.GetAddedAt().AsTime(),
+		LastPracticedAt: e.GetLastPracticedAt().AsTime(),
+		CardCount:       e.GetCardCount(),
+		Mastery:         e.GetMastery(),
+	}
+}
+
+func AddToLibrary(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	res, err := decksClient.AddToLibrary(ctx, &pbDeck.AddToLibraryRequest{DeckId: deckID, UserId: getUserID(ctx)})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("AddToLibrary: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	d, err := decksClient.GetDeck(ctx, &pbDeck.GetDeckRequest{DeckId: deckID, UserId: getUserID(ctx)})
+	if err != nil {
+		slog.Error("AddToLibrary: failed to get deck", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toLibraryEntryResponse(res.Entry, d.Deck))
+}
+
+func RemoveFromLibrary(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	_, err := decksClient.RemoveFromLibrary(ctx, &pbDeck.RemoveFromLibraryRequest{DeckId: deckID, UserId: getUserID(ctx)})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("RemoveFromLibrary: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+// GetLibrary lists the decks in the library of the user, along with their
+// progress on each. Decks are returned as learners see them.
+func GetLibrary(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.GetLibrary(ctx, &pbDeck.GetLibraryRequest{
+		UserId: getUserID(ctx),
+		Order:  ctx.Query("order"),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("GetLibrary: gRPC call failed", "error", err, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ids := make([]string, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		ids = append(ids, e.Node.GetDeckId())
+	}
+
+	decks, err := decksClient.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids, UserId: getUserID(ctx)})
+	if err != nil {
+		slog.Error("GetLibrary: failed to get decks", "error", err, "deckCount", len(ids), "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	edges := make([]libraryEdgeResponse, 0, len(ids))
+	for _, e := range res.Connection.GetEdges() {
+		d, ok := decks.Decks[e.Node.GetDeckId()]
+		if !ok {
+			// Deleted between the listing and the lookup
+			continue
+		}
+
+		edges = append(edges, libraryEdgeResponse{
+			Node:   toLibraryEntryResponse(e.Node, d),
+			Cursor: e.Cursor,
+		})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
+
+// GetAuthoredDecks lists the decks the user authored, the newest first.
+func GetAuthoredDecks(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.GetAuthoredDecks(ctx, &pbDeck.GetAuthoredDecksRequest{
+		UserId: getUserID(ctx),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("GetAuthoredDecks: gRPC call failed", "error", err, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ids := make([]string, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		ids = append(ids, e.DeckId)
+	}
+
+	decks, err := decksClient.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids, UserId: getUserID(ctx), WithAnswers: true})
+	if err != nil {
+		slog.Error("GetAuthoredDecks: failed to get decks", "error", err, "deckCount", len(ids), "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	edges := make([]authoredDeckEdgeResponse, 0, len(ids))
+	for _, e := range res.Connection.GetEdges() {
+		d, ok := decks.Decks[e.DeckId]
+		if !ok {
+			continue
+		}
+
+		edges = append(edges, authoredDeckEdgeResponse{
+			Node:   toDeckResponse(d),
+			Cursor: e.Cursor,
+		})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
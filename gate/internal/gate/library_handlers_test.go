@@ -0,0 +1,134 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	libraryDeckID = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	libraryUserID = "32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1"
+)
+
+func TestAddToLibrary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		addedAt := timestamppb.New(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+
+		decksClient := &mockDecksClient{}
+		decksClient.On("AddToLibrary", mock.Anything, &pbDeck.AddToLibraryRequest{DeckId: libraryDeckID, UserId: libraryUserID}).
+			Return(&pbDeck.AddToLibraryResponse{Entry: &pbDeck.LibraryEntry{DeckId: libraryDeckID, AddedAt: addedAt, LastPracticedAt: addedAt, CardCount: 3}}, nil)
+		decksClient.On("GetDeck", mock.Anything, &pbDeck.GetDeckRequest{DeckId: libraryDeckID, UserId: libraryUserID}).
+			Return(&pbDeck.GetDeckResponse{Deck: &pbDeck.Deck{Id: libraryDeckID, Title: "Programming languages"}}, nil)
+
+		router := setupTestRouterAs(libraryUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPut, "/library/"+libraryDeckID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Programming languages"`)
+		assert.Contains(t, w.Body.String(), `"card_count":3`)
+	})
+
+	t.Run("private_deck", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("AddToLibrary", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs(libraryUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPut, "/library/"+libraryDeckID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestRemoveFromLibrary(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("RemoveFromLibrary", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "deck: deck is not in the library", "NOT_IN_LIBRARY"))
+
+	router := setupTestRouterAs(libraryUserID, &mockUsersClient{}, decksClient)
+
+	req := httptest.NewRequest(http.MethodDelete, "/library/"+libraryDeckID, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetLibrary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetLibrary", mock.Anything, &pbDeck.GetLibraryRequest{
+			UserId:     libraryUserID,
+			Order:      "added",
+			Pagination: &pbDeck.Pagination{First: 10},
+		}).Return(&pbDeck.GetLibraryResponse{Connection: &pbDeck.LibraryConnection{
+			Edges: []*pbDeck.LibraryConnection_Edge{
+				{Node: &pbDeck.LibraryEntry{DeckId: libraryDeckID, CardCount: 3, Mastery: 0.5}, Cursor: "cursor"},
+			},
+			PageInfo: &pbDeck.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+		}}, nil)
+		decksClient.On("GetDecks", mock.Anything, &pbDeck.GetDecksRequest{DeckIds: []string{libraryDeckID}, UserId: libraryUserID}).Return(&pbDeck.GetDecksResponse{
+			Decks: map[string]*pbDeck.Deck{libraryDeckID: {Id: libraryDeckID, Title: "Programming languages"}},
+		}, nil)
+
+		router := setupTestRouterAs(libraryUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/library?order=added&first=10", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Programming languages"`)
+		assert.Contains(t, w.Body.String(), `"mastery":0.5`)
+		assert.Contains(t, w.Body.String(), `"end_cursor":"cursor"`)
+	})
+
+	t.Run("invalid_order", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetLibrary", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: order must be last_practiced or added", "INVALID_LIBRARY_ORDER"))
+
+		router := setupTestRouterAs(libraryUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/library?order=title", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetAuthoredDecks(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("GetAuthoredDecks", mock.Anything, &pbDeck.GetAuthoredDecksRequest{
+		UserId:     libraryUserID,
+		Pagination: &pbDeck.Pagination{First: 10},
+	}).Return(&pbDeck.GetAuthoredDecksResponse{Connection: &pbDeck.AuthoredDecksConnection{
+		Edges:    []*pbDeck.AuthoredDecksConnection_Edge{{DeckId: libraryDeckID, Cursor: "cursor"}},
+		PageInfo: &pbDeck.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+	}}, nil)
+	decksClient.On("GetDecks", mock.Anything, &pbDeck.GetDecksRequest{DeckIds: []string{libraryDeckID}, UserId: libraryUserID, WithAnswers: true}).Return(&pbDeck.GetDecksResponse{
+		Decks: map[string]*pbDeck.Deck{libraryDeckID: {Id: libraryDeckID, Title: "Programming languages", AuthorId: libraryUserID}},
+	}, nil)
+
+	router := setupTestRouterAs(libraryUserID, &mockUsersClient{}, decksClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/decks/authored?first=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"author_id":"`+libraryUserID+`"`)
+}
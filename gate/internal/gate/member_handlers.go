@@ -0,0 +1,234 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+type deckMemberResponse struct {
+	DeckID    string    `json:"deck_id"`
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toDeckMemberResponse(m *pbDeck.DeckMember) deckMemberResponse {
+	return deckMemberResponse{
+		DeckID:    m.GetDeckId(),
+		UserID:    m.GetUserId(),
+		Role:      m.GetRole(),
+		CreatedAt: m.GetCreatedAt().AsTime(),
+	}
+}
+
+func ListDeckMembers(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	res, err := decksClient.ListDeckMembers(ctx, &pbDeck.ListDeckMembersRequest{DeckId: deckID, UserId: getUserID(ctx)})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("ListDeckMembers: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	members := make([]deckMemberResponse, 0, len(res.Members))
+	for _, m := range res.Members {
+		members = append(members, toDeckMemberResponse(m))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"members": members})
+}
+
+func InviteDeckMember(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	var req struct {
+		UserID string `json:"user_id" binding:"required"`
+		Role   string `json:"role" binding:"required"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := uuid.Parse(req.UserID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id format"})
+		return
+	}
+
+	res, err := decksClient.InviteDeckMember(ctx, &pbDeck.InviteDeckMemberRequest{
+		DeckId:   deckID,
+		UserId:   getUserID(ctx),
+		MemberId: req.UserID,
+		Role:     req.Role,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("InviteDeckMember: gRPC call failed", "error", err, "deckId", deckID, "memberId", req.UserID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toDeckMemberResponse(res.Member))
+}
+
+func ChangeDeckMemberRole(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, memberID, ok := memberParams(ctx)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Role string `json:"role" binding:"required"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := decksClient.ChangeDeckMemberRole(ctx, &pbDeck.ChangeDeckMemberRoleRequest{
+		DeckId:   deckID,
+		UserId:   getUserID(ctx),
+		MemberId: memberID,
+		Role:     req.Role,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("ChangeDeckMemberRole: gRPC call failed", "error", err, "deckId", deckID, "memberId", memberID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toDeckMemberResponse(res.Member))
+}
+
+func RemoveDeckMember(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, memberID, ok := memberParams(ctx)
+	if !ok {
+		return
+	}
+
+	_, err := decksClient.RemoveDeckMember(ctx, &pbDeck.RemoveDeckMemberRequest{
+		DeckId:   deckID,
+		UserId:   getUserID(ctx),
+		MemberId: memberID,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("RemoveDeckMember: gRPC call failed", "error", err, "deckId", deckID, "memberId", memberID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+func GetSharedDecks(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.GetSharedDecks(ctx, &pbDeck.GetSharedDecksRequest{
+		UserId: getUserID(ctx),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+	})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("GetSharedDecks: gRPC call failed", "error", err, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids := make([]string, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		ids = append(ids, e.DeckId)
+	}
+
+	decks, err := decksClient.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids, UserId: getUserID(ctx)})
+	if err != nil {
+		slog.Error("GetSharedDecks: failed to get decks", "error", err, "deckCount", len(ids), "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	edges := make([]sharedDeckEdgeResponse, 0, len(ids))
+	for _, e := range res.Connection.GetEdges() {
+		d, ok := decks.Decks[e.DeckId]
+		if !ok {
+			// Deleted between the listing and the lookup
+			continue
+		}
+
+		edges = append(edges, sharedDeckEdgeResponse{
+			Node:   toLearnerDeckResponse(d),
+			Role:   e.Role,
+			Cursor: e.Cursor,
+		})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
+
+type sharedDeckEdgeResponse struct {
+	Node   learnerDeckResponse `json:"node"`
+	Role   string              `json:"role"`
+	Cursor string              `json:"cursor"`
+}
+
+// memberParams reads and validates the deck and member IDs of the path.
+func memberParams(ctx *gin.Context) (string, string, bool) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return "", "", false
+	}
+
+	memberID := ctx.Param("userId")
+	if _, err := uuid.Parse(memberID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id format"})
+		return "", "", false
+	}
+
+	return deckID, memberID, true
+}
@@ -0,0 +1,216 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	membersDeckID   = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	membersOwnerID  = "4e37a600-c29e-4d0f-af44-66f2cd8cc1c9"
+	membersMemberID = "32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1"
+)
+
+func TestListDeckMembers(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ListDeckMembers", mock.Anything, &pbDeck.ListDeckMembersRequest{
+			DeckId: membersDeckID,
+			UserId: membersOwnerID,
+		}).Return(&pbDeck.ListDeckMembersResponse{Members: []*pbDeck.DeckMember{
+			{DeckId: membersDeckID, UserId: membersMemberID, Role: "editor", CreatedAt: timestamppb.New(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))},
+		}}, nil)
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+membersDeckID+"/members", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"user_id":"`+membersMemberID+`"`)
+		assert.Contains(t, w.Body.String(), `"role":"editor"`)
+	})
+
+	t.Run("not_a_member", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ListDeckMembers", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs(membersMemberID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+membersDeckID+"/members", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestInviteDeckMember(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("InviteDeckMember", mock.Anything, &pbDeck.InviteDeckMemberRequest{
+			DeckId:   membersDeckID,
+			UserId:   membersOwnerID,
+			MemberId: membersMemberID,
+			Role:     "viewer",
+		}).Return(&pbDeck.InviteDeckMemberResponse{Member: &pbDeck.DeckMember{DeckId: membersDeckID, UserId: membersMemberID, Role: "viewer"}}, nil)
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+membersDeckID+"/members", strings.NewReader(`{"user_id": "`+membersMemberID+`", "role": "viewer"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("already_a_member", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("InviteDeckMember", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.AlreadyExists, "deck: user is already a member of the deck", "MEMBER_ALREADY_EXISTS"))
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+membersDeckID+"/members", strings.NewReader(`{"user_id": "`+membersMemberID+`", "role": "viewer"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("invalid_role", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("InviteDeckMember", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: role must be viewer, editor or owner", "INVALID_ROLE"))
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+membersDeckID+"/members", strings.NewReader(`{"user_id": "`+membersMemberID+`", "role": "admin"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid_user_id", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+membersDeckID+"/members", strings.NewReader(`{"user_id": "nope", "role": "viewer"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		decksClient.AssertNotCalled(t, "InviteDeckMember", mock.Anything, mock.Anything)
+	})
+}
+
+func TestChangeDeckMemberRole(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ChangeDeckMemberRole", mock.Anything, &pbDeck.ChangeDeckMemberRoleRequest{
+			DeckId:   membersDeckID,
+			UserId:   membersOwnerID,
+			MemberId: membersMemberID,
+			Role:     "owner",
+		}).Return(&pbDeck.ChangeDeckMemberRoleResponse{Member: &pbDeck.DeckMember{DeckId: membersDeckID, UserId: membersMemberID, Role: "owner"}}, nil)
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPatch, "/decks/"+membersDeckID+"/members/"+membersMemberID, strings.NewReader(`{"role": "owner"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"role":"owner"`)
+	})
+
+	t.Run("not_a_member", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ChangeDeckMemberRole", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "deck: member not found", "MEMBER_NOT_FOUND"))
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPatch, "/decks/"+membersDeckID+"/members/"+membersMemberID, strings.NewReader(`{"role": "owner"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestRemoveDeckMember(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("RemoveDeckMember", mock.Anything, &pbDeck.RemoveDeckMemberRequest{
+			DeckId:   membersDeckID,
+			UserId:   membersOwnerID,
+			MemberId: membersMemberID,
+		}).Return(&pbDeck.RemoveDeckMemberResponse{}, nil)
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+membersDeckID+"/members/"+membersMemberID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		decksClient.AssertExpectations(t)
+	})
+
+	t.Run("author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("RemoveDeckMember", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: the author of a deck is always an owner of it", "AUTHOR_IS_OWNER"))
+
+		router := setupTestRouterAs(membersOwnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodDelete, "/decks/"+membersDeckID+"/members/"+membersOwnerID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetSharedDecks(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("GetSharedDecks", mock.Anything, &pbDeck.GetSharedDecksRequest{
+		UserId:     membersMemberID,
+		Pagination: &pbDeck.Pagination{First: 10},
+	}).Return(&pbDeck.GetSharedDecksResponse{Connection: &pbDeck.SharedDecksConnection{
+		Edges: []*pbDeck.SharedDecksConnection_Edge{
+			{DeckId: membersDeckID, Role: "editor", Cursor: "cursor"},
+		},
+		PageInfo: &pbDeck.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+	}}, nil)
+	decksClient.On("GetDecks", mock.Anything, &pbDeck.GetDecksRequest{DeckIds: []string{membersDeckID}, UserId: membersMemberID}).Return(&pbDeck.GetDecksResponse{
+		Decks: map[string]*pbDeck.Deck{membersDeckID: {Id: membersDeckID, Title: "Programming languages"}},
+	}, nil)
+
+	router := setupTestRouterAs(membersMemberID, &mockUsersClient{}, decksClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/decks/shared?first=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Programming languages"`)
+	assert.Contains(t, w.Body.String(), `"role":"editor"`)
+	assert.Contains(t, w.Body.String(), `"end_cursor":"cursor"`)
+}
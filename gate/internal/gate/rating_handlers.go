@@ -0,0 +1,126 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+type deckRatingResponse struct {
+	DeckID    string    `json:"deck_id"`
+	UserID    string    `json:"user_id"`
+	Stars     int32     `json:"stars"`
+	Review    string    `json:"review"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func toDeckRatingResponse(r *pbDeck.DeckRating) deckRatingResponse {
+	return deckRatingResponse{
+		DeckID:    r.GetDeckId(),
+		UserID:    r.GetUserId(),
+		Stars:     r.GetStars(),
+		Review:    r.GetReview(),
+		CreatedAt: r.GetCreatedAt().AsTime(),
+		UpdatedAt: r.GetUpdatedAt().AsTime(),
+	}
+}
+
+type reviewEdgeResponse struct {
+	Node   deckRatingResponse `json:"node"`
+	Cursor string             `json:"cursor"`
+}
+
+// RateDeck stores the rating of the user for a deck, replacing the one they
+// gave before.
+func RateDeck(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	var req struct {
+		Stars  int32  `json:"stars" binding:"required"`
+		Review string `json:"review"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := decksClient.RateDeck(ctx, &pbDeck.RateDeckRequest{
+		DeckId: deckID,
+		UserId: getUserID(ctx),
+		Stars:  req.Stars,
+		Review: req.Review,
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("RateDeck: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toDeckRatingResponse(res.Rating))
+}
+
+func GetDeckReviews(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return
+	}
+
+	p, err := parsePagination(ctx)
+	if err != nil || p.First < 0 || p.Last < 0 || p.First > maxSearchPageSize || p.Last > maxSearchPageSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid pagination parameters"})
+		return
+	}
+
+	res, err := decksClient.GetDeckReviews(ctx, &pbDeck.GetDeckReviewsRequest{
+		DeckId: deckID,
+		UserId: getUserID(ctx),
+		Pagination: &pbDeck.Pagination{
+			First:  p.First,
+			Last:   p.Last,
+			After:  p.After,
+			Before: p.Before,
+		},
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("GetDeckReviews: gRPC call failed", "error", err, "deckId", deckID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	edges := make([]reviewEdgeResponse, 0, len(res.Connection.GetEdges()))
+	for _, e := range res.Connection.GetEdges() {
+		edges = append(edges, reviewEdgeResponse{
+			Node:   toDeckRatingResponse(e.Node),
+			Cursor: e.Cursor,
+		})
+	}
+
+	pageInfo := res.Connection.GetPageInfo()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"edges": edges,
+		"page_info": PageInfoJSON{
+			HasPreviousPage: pageInfo.GetHasPreviousPage(),
+			HasNextPage:     pageInfo.GetHasNextPage(),
+			StartCursor:     pageInfo.GetStartCursor(),
+			EndCursor:       pageInfo.GetEndCursor(),
+		},
+	})
+}
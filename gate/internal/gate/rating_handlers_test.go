@@ -0,0 +1,133 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	ratingsDeckID    = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	ratingsLearnerID = "32571d06-54fb-4d5a-8c6b-dfdc8a51e1a1"
+)
+
+func TestRateDeck(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ratedAt := timestamppb.New(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+
+		decksClient := &mockDecksClient{}
+		decksClient.On("RateDeck", mock.Anything, &pbDeck.RateDeckRequest{
+			DeckId: ratingsDeckID,
+			UserId: ratingsLearnerID,
+			Stars:  4,
+			Review: "Clear and to the point",
+		}).Return(&pbDeck.RateDeckResponse{Rating: &pbDeck.DeckRating{
+			DeckId:    ratingsDeckID,
+			UserId:    ratingsLearnerID,
+			Stars:     4,
+			Review:    "Clear and to the point",
+			CreatedAt: ratedAt,
+			UpdatedAt: ratedAt,
+		}}, nil)
+
+		router := setupTestRouterAs(ratingsLearnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPut, "/decks/"+ratingsDeckID+"/rating", strings.NewReader(`{"stars": 4, "review": "Clear and to the point"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"stars":4`)
+		assert.Contains(t, w.Body.String(), `"review":"Clear and to the point"`)
+	})
+
+	t.Run("missing_stars", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		router := setupTestRouterAs(ratingsLearnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPut, "/decks/"+ratingsDeckID+"/rating", strings.NewReader(`{"review": "No stars"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		decksClient.AssertNotCalled(t, "RateDeck", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid_stars", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("RateDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.InvalidArgument, "deck: stars must be between 1 and 5", "INVALID_STARS"))
+
+		router := setupTestRouterAs(ratingsLearnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPut, "/decks/"+ratingsDeckID+"/rating", strings.NewReader(`{"stars": 7}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("own_deck", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("RateDeck", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: authors can't rate their own decks", "OWN_DECK_RATING"))
+
+		router := setupTestRouterAs(ratingsLearnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPut, "/decks/"+ratingsDeckID+"/rating", strings.NewReader(`{"stars": 5}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestGetDeckReviews(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetDeckReviews", mock.Anything, &pbDeck.GetDeckReviewsRequest{
+			DeckId:     ratingsDeckID,
+			UserId:     ratingsLearnerID,
+			Pagination: &pbDeck.Pagination{First: 10},
+		}).Return(&pbDeck.GetDeckReviewsResponse{Connection: &pbDeck.ReviewsConnection{
+			Edges: []*pbDeck.ReviewsConnection_Edge{
+				{Node: &pbDeck.DeckRating{DeckId: ratingsDeckID, UserId: ratingsLearnerID, Stars: 2, Review: "Too short"}, Cursor: "cursor"},
+			},
+			PageInfo: &pbDeck.PageInfo{StartCursor: "cursor", EndCursor: "cursor"},
+		}}, nil)
+
+		router := setupTestRouterAs(ratingsLearnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+ratingsDeckID+"/reviews?first=10", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"review":"Too short"`)
+		assert.Contains(t, w.Body.String(), `"end_cursor":"cursor"`)
+	})
+
+	t.Run("deck_not_found", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("GetDeckReviews", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "deck: deck not found", "DECK_NOT_FOUND"))
+
+		router := setupTestRouterAs(ratingsLearnerID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+ratingsDeckID+"/reviews", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
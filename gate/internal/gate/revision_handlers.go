@@ -0,0 +1,161 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+func ListCardRevisions(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, cardID, ok := cardParams(ctx)
+	if !ok {
+		return
+	}
+
+	res, err := decksClient.ListCardRevisions(ctx, &pbDeck.ListCardRevisionsRequest{
+		DeckId: deckID,
+		CardId: cardID,
+		UserId: getUserID(ctx),
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("ListCardRevisions: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	revisions := make([]cardRevisionResponse, 0, len(res.Revisions))
+	for _, r := range res.Revisions {
+		revisions = append(revisions, toCardRevisionResponse(r))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+func DiffCardRevisions(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, cardID, ok := cardParams(ctx)
+	if !ok {
+		return
+	}
+
+	from, err := strconv.ParseInt(ctx.Query("from"), 10, 32)
+	if err != nil || from < 1 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid from revision"})
+		return
+	}
+
+	to, err := strconv.ParseInt(ctx.Query("to"), 10, 32)
+	if err != nil || to < 1 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid to revision"})
+		return
+	}
+
+	res, err := decksClient.DiffCardRevisions(ctx, &pbDeck.DiffCardRevisionsRequest{
+		DeckId:       deckID,
+		CardId:       cardID,
+		UserId:       getUserID(ctx),
+		FromRevision: int32(from),
+		ToRevision:   int32(to),
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("DiffCardRevisions: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	changes := make([]fieldChangeResponse, 0, len(res.Changes))
+	for _, c := range res.Changes {
+		changes = append(changes, fieldChangeResponse{Field: c.GetField(), From: c.GetFrom(), To: c.GetTo()})
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"changes": changes})
+}
+
+func RestoreCardRevision(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	deckID, cardID, ok := cardParams(ctx)
+	if !ok {
+		return
+	}
+
+	revision, err := strconv.ParseInt(ctx.Param("revision"), 10, 32)
+	if err != nil || revision < 1 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid revision"})
+		return
+	}
+
+	res, err := decksClient.RestoreCardRevision(ctx, &pbDeck.RestoreCardRevisionRequest{
+		DeckId:   deckID,
+		CardId:   cardID,
+		UserId:   getUserID(ctx),
+		Revision: int32(revision),
+	})
+	if err != nil {
+		if !handleStatusError(ctx, err) {
+			slog.Error("RestoreCardRevision: gRPC call failed", "error", err, "deckId", deckID, "cardId", cardID, "revision", revision, "stack", errors.ErrorStack(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toCardResponse(res.Card))
+}
+
+// cardParams reads and checks the deck and card IDs of the route, replying
+// with a 400 when they are not valid.
+func cardParams(ctx *gin.Context) (string, string, bool) {
+	deckID := ctx.Param("id")
+	if _, err := uuid.Parse(deckID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid deck id format"})
+		return "", "", false
+	}
+
+	cardID := ctx.Param("cardId")
+	if _, err := uuid.Parse(cardID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid card id format"})
+		return "", "", false
+	}
+
+	return deckID, cardID, true
+}
+
+type cardRevisionResponse struct {
+	Revision      int32        `json:"revision"`
+	AuthorID      string       `json:"author_id"`
+	ChangedFields []string     `json:"changed_fields"`
+	Card          cardResponse `json:"card"`
+	CreatedAt     time.Time    `json:"created_at"`
+	LearnerCount  int64        `json:"learner_count"`
+}
+
+func toCardRevisionResponse(r *pbDeck.CardRevision) cardRevisionResponse {
+	out := cardRevisionResponse{
+		Revision:      r.GetRevision(),
+		AuthorID:      r.GetAuthorId(),
+		ChangedFields: r.GetChangedFields(),
+		Card:          toCardResponse(r.GetCard()),
+		CreatedAt:     r.GetCreatedAt().AsTime(),
+		LearnerCount:  r.GetLearnerCount(),
+	}
+
+	if out.ChangedFields == nil {
+		out.ChangedFields = []string{}
+	}
+
+	return out
+}
+
+type fieldChangeResponse struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
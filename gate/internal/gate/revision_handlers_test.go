@@ -0,0 +1,148 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+const (
+	revisionsDeckID = "fb9ffe2c-ad66-4766-9b7b-46fd5d9acd72"
+	revisionsCardID = "1f30a72f-5d7a-48da-a5c2-42efece6972a"
+	revisionsUserID = "4e37a600-c29e-4d0f-af44-66f2cd8cc1c9"
+)
+
+func TestListCardRevisions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ListCardRevisions", mock.Anything, &pbDeck.ListCardRevisionsRequest{
+			DeckId: revisionsDeckID,
+			CardId: revisionsCardID,
+			UserId: revisionsUserID,
+		}).Return(&pbDeck.ListCardRevisionsResponse{Revisions: []*pbDeck.CardRevision{{
+			CardId:        revisionsCardID,
+			Revision:      2,
+			AuthorId:      revisionsUserID,
+			ChangedFields: []string{"title"},
+			Card:          &pbDeck.Card{Id: revisionsCardID, Title: "Zig language", Kind: "single_choice"},
+			CreatedAt:     timestamppb.New(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+			LearnerCount:  3,
+		}}}, nil)
+
+		router := setupTestRouterAs(revisionsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+revisionsDeckID+"/cards/"+revisionsCardID+"/revisions", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"revisions": [{
+			"revision": 2,
+			"author_id": "4e37a600-c29e-4d0f-af44-66f2cd8cc1c9",
+			"changed_fields": ["title"],
+			"card": {
+				"id": "1f30a72f-5d7a-48da-a5c2-42efece6972a",
+				"deck_id": "",
+				"title": "Zig language",
+				"explanation": "",
+				"kind": "single_choice",
+				"possible_answers": null
+			},
+			"created_at": "2024-05-01T10:00:00Z",
+			"learner_count": 3
+		}]}`, w.Body.String())
+	})
+
+	t.Run("not_author", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("ListCardRevisions", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.PermissionDenied, "deck: only the author and members with a high enough role can do that", "NOT_ALLOWED"))
+
+		router := setupTestRouterAs(revisionsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+revisionsDeckID+"/cards/"+revisionsCardID+"/revisions", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestDiffCardRevisions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("DiffCardRevisions", mock.Anything, &pbDeck.DiffCardRevisionsRequest{
+			DeckId:       revisionsDeckID,
+			CardId:       revisionsCardID,
+			UserId:       revisionsUserID,
+			FromRevision: 1,
+			ToRevision:   2,
+		}).Return(&pbDeck.DiffCardRevisionsResponse{Changes: []*pbDeck.FieldChange{
+			{Field: "title", From: "Zig", To: "Zig language"},
+		}}, nil)
+
+		router := setupTestRouterAs(revisionsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+revisionsDeckID+"/cards/"+revisionsCardID+"/revisions/diff?from=1&to=2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"changes": [{"field": "title", "from": "Zig", "to": "Zig language"}]}`, w.Body.String())
+	})
+
+	t.Run("missing_revision", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		router := setupTestRouterAs(revisionsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodGet, "/decks/"+revisionsDeckID+"/cards/"+revisionsCardID+"/revisions/diff?from=1", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		decksClient.AssertNotCalled(t, "DiffCardRevisions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRestoreCardRevision(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("RestoreCardRevision", mock.Anything, &pbDeck.RestoreCardRevisionRequest{
+			DeckId:   revisionsDeckID,
+			CardId:   revisionsCardID,
+			UserId:   revisionsUserID,
+			Revision: 1,
+		}).Return(&pbDeck.RestoreCardRevisionResponse{
+			Card: &pbDeck.Card{Id: revisionsCardID, Title: "Zig", Kind: "single_choice"},
+		}, nil)
+
+		router := setupTestRouterAs(revisionsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+revisionsDeckID+"/cards/"+revisionsCardID+"/revisions/1/restore", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Zig"`)
+	})
+
+	t.Run("revision_not_found", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("RestoreCardRevision", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.NotFound, "deck: card revision not found", "REVISION_NOT_FOUND"))
+
+		router := setupTestRouterAs(revisionsUserID, &mockUsersClient{}, decksClient)
+
+		req := httptest.NewRequest(http.MethodPost, "/decks/"+revisionsDeckID+"/cards/"+revisionsCardID+"/revisions/42/restore", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
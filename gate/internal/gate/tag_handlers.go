@@ -0,0 +1,203 @@
+package gate
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+func AutocompleteTags(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	var limit int64
+	if l := ctx.Query("limit"); l != "" {
+		v, err := strconv.ParseInt(l, 10, 32)
+		if err != nil || v < 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = v
+	}
+
+	res, err := decksClient.AutocompleteTags(ctx, &pbDeck.AutocompleteTagsRequest{
+		Prefix: ctx.Query("prefix"),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		slog.Error("AutocompleteTags: gRPC call failed", "error", err, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	tags := make([]tagResponse, 0, len(res.Tags))
+	for _, t := range res.Tags {
+		tags = append(tags, toTagResponse(t))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+func GetTopics(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	res, err := decksClient.GetTopics(ctx, &pbDeck.GetTopicsRequest{})
+	if err != nil {
+		slog.Error("GetTopics: gRPC call failed", "error", err, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"topics": toTopicResponses(res.Topics)})
+}
+
+func CreateTopic(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	var req struct {
+		Name     string `json:"name" binding:"required"`
+		ParentID string `json:"parent_id"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.ParentID != "" {
+		if _, err := uuid.Parse(req.ParentID); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid parent id format"})
+			return
+		}
+	}
+
+	res, err := decksClient.CreateTopic(ctx, &pbDeck.CreateTopicRequest{Name: req.Name, ParentId: req.ParentID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("CreateTopic: gRPC call failed", "error", err, "name", req.Name, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toTopicResponse(res.Topic))
+}
+
+func DeleteTopic(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	id := ctx.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid topic id format"})
+		return
+	}
+
+	_, err := decksClient.DeleteTopic(ctx, &pbDeck.DeleteTopicRequest{Id: id})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("DeleteTopic: gRPC call failed", "error", err, "topicId", id, "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+func SetTagTopic(ctx *gin.Context, decksClient pbDeck.DecksAPIClient) {
+	var req struct {
+		TopicID string `json:"topic_id"`
+	}
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.TopicID != "" {
+		if _, err := uuid.Parse(req.TopicID); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid topic id format"})
+			return
+		}
+	}
+
+	res, err := decksClient.SetTagTopic(ctx, &pbDeck.SetTagTopicRequest{Tag: ctx.Param("name"), TopicId: req.TopicID})
+	if err != nil {
+		if handleStatusError(ctx, err) {
+			return
+		}
+		slog.Error("SetTagTopic: gRPC call failed", "error", err, "tag", ctx.Param("name"), "stack", errors.ErrorStack(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toTagResponse(res.Tag))
+}
+
+func RegisterTagRoutes(r *gin.RouterGroup, decksClient pbDeck.DecksAPIClient, adminCfg AdminConfig) {
+	r.GET("/tags", func(ctx *gin.Context) {
+		AutocompleteTags(ctx, decksClient)
+	})
+
+	r.PUT("/tags/:name/topic", RequireAdmin(adminCfg, true), func(ctx *gin.Context) {
+		SetTagTopic(ctx, decksClient)
+	})
+
+	r.GET("/topics", func(ctx *gin.Context) {
+		GetTopics(ctx, decksClient)
+	})
+
+	r.POST("/topics", RequireAdmin(adminCfg, true), func(ctx *gin.Context) {
+		CreateTopic(ctx, decksClient)
+	})
+
+	r.DELETE("/topics/:id", RequireAdmin(adminCfg, true), func(ctx *gin.Context) {
+		DeleteTopic(ctx, decksClient)
+	})
+}
+
+type tagResponse struct {
+	Name      string `json:"name"`
+	TopicID   string `json:"topic_id,omitempty"`
+	DeckCount int64  `json:"deck_count"`
+}
+
+func toTagResponse(t *pbDeck.Tag) tagResponse {
+	return tagResponse{
+		Name:      t.GetName(),
+		TopicID:   t.GetTopicId(),
+		DeckCount: t.GetDeckCount(),
+	}
+}
+
+type topicResponse struct {
+	ID       string          `json:"id"`
+	ParentID string          `json:"parent_id,omitempty"`
+	Name     string          `json:"name"`
+	Tags     []string        `json:"tags"`
+	Children []topicResponse `json:"children"`
+}
+
+func toTopicResponses(topics []*pbDeck.Topic) []topicResponse {
+	out := make([]topicResponse, 0, len(topics))
+	for _, t := range topics {
+		out = append(out, toTopicResponse(t))
+	}
+
+	return out
+}
+
+func toTopicResponse(t *pbDeck.Topic) topicResponse {
+	out := topicResponse{
+		ID:       t.GetId(),
+		ParentID: t.GetParentId(),
+		Name:     t.GetName(),
+		Tags:     t.GetTags(),
+		Children: toTopicResponses(t.GetChildren()),
+	}
+
+	if out.Tags == nil {
+		out.Tags = []string{}
+	}
+
+	return out
+}
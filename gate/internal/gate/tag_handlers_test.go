@@ -0,0 +1,170 @@
+package gate
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
+)
+
+func setupTagTestRouter(decksClient pbDeck.DecksAPIClient) *gin.Engine {
+	router := gin.New()
+	adminCfg := AdminConfig{HeaderName: "X-Admin-Token", HeaderSecret: "secret123"}
+	RegisterTagRoutes(router.Group("/"), decksClient, adminCfg)
+	return router
+}
+
+func TestAutocompleteTags(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("AutocompleteTags", mock.Anything, &pbDeck.AutocompleteTagsRequest{Prefix: "jl", Limit: 5}).Return(&pbDeck.AutocompleteTagsResponse{
+		Tags: []*pbDeck.Tag{{Name: "jlpt n5", TopicId: "f79aea77-9aa0-4a84-b4c8-d000a27d2c52", DeckCount: 3}},
+	}, nil)
+
+	router := setupTagTestRouter(decksClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/tags?prefix=jl&limit=5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"tags": [{"name": "jlpt n5", "topic_id": "f79aea77-9aa0-4a84-b4c8-d000a27d2c52", "deck_count": 3}]}`, w.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/tags?limit=many", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetTopics(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("GetTopics", mock.Anything, mock.Anything).Return(&pbDeck.GetTopicsResponse{
+		Topics: []*pbDeck.Topic{
+			{Id: "6363e2c6-d89e-4610-92e8-1e1d2fea49ec", Name: "Japanese", Children: []*pbDeck.Topic{
+				{Id: "f79aea77-9aa0-4a84-b4c8-d000a27d2c52", ParentId: "6363e2c6-d89e-4610-92e8-1e1d2fea49ec", Name: "N5", Tags: []string{"jlpt n5"}},
+			}},
+		},
+	}, nil)
+
+	router := setupTagTestRouter(decksClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/topics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"topics": [{
+		"id": "6363e2c6-d89e-4610-92e8-1e1d2fea49ec",
+		"name": "Japanese",
+		"tags": [],
+		"children": [{
+			"id": "f79aea77-9aa0-4a84-b4c8-d000a27d2c52",
+			"parent_id": "6363e2c6-d89e-4610-92e8-1e1d2fea49ec",
+			"name": "N5",
+			"tags": ["jlpt n5"],
+			"children": []
+		}]
+	}]}`, w.Body.String())
+}
+
+func TestCreateTopic(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("CreateTopic", mock.Anything, &pbDeck.CreateTopicRequest{Name: "Japanese"}).Return(&pbDeck.CreateTopicResponse{
+			Topic: &pbDeck.Topic{Id: "6363e2c6-d89e-4610-92e8-1e1d2fea49ec", Name: "Japanese"},
+		}, nil)
+
+		router := setupTagTestRouter(decksClient)
+
+		body, _ := json.Marshal(map[string]any{"name": "Japanese"})
+		req := httptest.NewRequest(http.MethodPost, "/topics", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Admin-Token", "secret123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("admin_only", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		router := setupTagTestRouter(decksClient)
+
+		body, _ := json.Marshal(map[string]any{"name": "Japanese"})
+		req := httptest.NewRequest(http.MethodPost, "/topics", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		decksClient.AssertNotCalled(t, "CreateTopic", mock.Anything, mock.Anything)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		decksClient := &mockDecksClient{}
+		decksClient.On("CreateTopic", mock.Anything, mock.Anything).Return(nil, detailedError(t, codes.AlreadyExists, "deck: a topic with that name already exists under the same parent", "TOPIC_ALREADY_EXISTS"))
+
+		router := setupTagTestRouter(decksClient)
+
+		body, _ := json.Marshal(map[string]any{"name": "Japanese"})
+		req := httptest.NewRequest(http.MethodPost, "/topics", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Admin-Token", "secret123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestSetTagTopic(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("SetTagTopic", mock.Anything, &pbDeck.SetTagTopicRequest{Tag: "jlpt n5", TopicId: "f79aea77-9aa0-4a84-b4c8-d000a27d2c52"}).Return(&pbDeck.SetTagTopicResponse{
+		Tag: &pbDeck.Tag{Name: "jlpt n5", TopicId: "f79aea77-9aa0-4a84-b4c8-d000a27d2c52"},
+	}, nil)
+	decksClient.On("SetTagTopic", mock.Anything, &pbDeck.SetTagTopicRequest{Tag: "go", TopicId: "6363e2c6-d89e-4610-92e8-1e1d2fea49ec"}).Return(nil, detailedError(t, codes.NotFound, "deck: topic not found", "TOPIC_NOT_FOUND"))
+
+	router := setupTagTestRouter(decksClient)
+
+	for target, code := range map[string]int{
+		"f79aea77-9aa0-4a84-b4c8-d000a27d2c52": http.StatusOK,
+		"6363e2c6-d89e-4610-92e8-1e1d2fea49ec": http.StatusNotFound,
+		"not-a-uuid":                           http.StatusBadRequest,
+	} {
+		tag := "jlpt%20n5"
+		if code == http.StatusNotFound {
+			tag = "go"
+		}
+
+		body, _ := json.Marshal(map[string]any{"topic_id": target})
+		req := httptest.NewRequest(http.MethodPut, "/tags/"+tag+"/topic", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Admin-Token", "secret123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, code, w.Code, target)
+	}
+}
+
+func TestDeleteTopic(t *testing.T) {
+	decksClient := &mockDecksClient{}
+	decksClient.On("DeleteTopic", mock.Anything, &pbDeck.DeleteTopicRequest{Id: "6363e2c6-d89e-4610-92e8-1e1d2fea49ec"}).Return(&pbDeck.DeleteTopicResponse{}, nil)
+
+	router := setupTagTestRouter(decksClient)
+
+	req := httptest.NewRequest(http.MethodDelete, "/topics/6363e2c6-d89e-4610-92e8-1e1d2fea49ec", nil)
+	req.Header.Set("X-Admin-Token", "secret123")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -2,6 +2,8 @@ package graph
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +18,43 @@ type DataLoader interface {
 
 type BatchFn func(ctx context.Context, ids []string) (map[string]Result, error)
 
+// UserKey is the key a user loads an ID with. What is loaded depends on who
+// asks for it while loaders are shared by every request, so their caches
+// keep each user's results apart.
+func UserKey(userID, id string) string {
+	return userID + ":" + id
+}
+
+// SplitUserKeys groups the IDs of user keys by the user loading them.
+func SplitUserKeys(keys []string) map[string][]string {
+	out := make(map[string][]string)
+	for _, key := range keys {
+		userID, id, _ := strings.Cut(key, ":")
+		out[userID] = append(out[userID], id)
+	}
+
+	return out
+}
+
+// DeckCardsKey is the ID the first cards of a deck are loaded with, which
+// depends on how many of them are asked for.
+func DeckCardsKey(deckID string, first int) string {
+	return deckID + "/" + strconv.Itoa(first)
+}
+
+// SplitDeckCardsKeys groups the deck IDs of the IDs made by DeckCardsKey by
+// how many of their cards are loaded.
+func SplitDeckCardsKeys(ids []string) map[int][]string {
+	out := make(map[int][]string)
+	for _, id := range ids {
+		deckID, first, _ := strings.Cut(id, "/")
+		n, _ := strconv.Atoi(first)
+		out[n] = append(out[n], deckID)
+	}
+
+	return out
+}
+
 func NewDataLoader(batchFn BatchFn, cacheTTL, batchEvery time.Duration) DataLoader {
 	loader := loader{
 		cacheTTL:   cacheTTL,
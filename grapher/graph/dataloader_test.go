@@ -49,3 +49,31 @@ func newTestBatchFn(counter *int) BatchFn {
 		return out, nil
 	}
 }
+
+func TestSplitUserKeys(t *testing.T) {
+	keys := []string{
+		UserKey("a", "1"),
+		UserKey("b", "1"),
+		UserKey("a", "2"),
+		UserKey("", "3"),
+	}
+
+	assert.Equal(t, map[string][]string{
+		"a": {"1", "2"},
+		"b": {"1"},
+		"":  {"3"},
+	}, SplitUserKeys(keys))
+}
+
+func TestSplitDeckCardsKeys(t *testing.T) {
+	ids := []string{
+		DeckCardsKey("1", 20),
+		DeckCardsKey("2", 5),
+		DeckCardsKey("3", 20),
+	}
+
+	assert.Equal(t, map[int][]string{
+		20: {"1", "3"},
+		5:  {"2"},
+	}, SplitDeckCardsKeys(ids))
+}
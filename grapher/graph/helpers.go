@@ -1,6 +1,9 @@
 package graph
 
 import (
+	"time"
+
+	v1Course "github.com/XaviFP/toshokan/course/api/proto/v1"
 	v1 "github.com/XaviFP/toshokan/deck/api/proto/v1"
 	"github.com/XaviFP/toshokan/grapher/graph/model"
 )
@@ -49,39 +52,90 @@ func cardToModel(in *v1.Card) *model.Card {
 		Title:       in.Title,
 		Answers:     answers,
 		Explanation: &in.Explanation,
+		Kind:        in.Kind,
 	}
 }
 
-func cardsToModel(in []*v1.Card) []*model.Card {
-	var cards []*model.Card
+func deckToModel(in *v1.Deck) *model.Deck {
+	out := &model.Deck{
+		ID:          in.Id,
+		Title:       in.Title,
+		Description: in.Description,
+		Language:    &in.Language,
+		Tags:        in.Tags,
+
+		AverageRating: in.RatingAverage,
+		RatingCount:   int(in.RatingCount),
+	}
 
-	for _, c := range in {
-		var outAnswers []*model.Answer
-		for _, a := range c.PossibleAnswers {
-			outAnswers = append(outAnswers, &model.Answer{
-				ID:        a.Id,
-				Text:      a.Text,
-				IsCorrect: a.IsCorrect,
-			})
+	if in.UpstreamDeckId != "" {
+		revision := int(in.UpstreamRevision)
+		out.UpstreamDeckID = &in.UpstreamDeckId
+		out.UpstreamRevision = &revision
+	}
+
+	return out
+}
+
+func deckReviewToModel(in *v1.DeckRating) *model.DeckReview {
+	return &model.DeckReview{
+		UserID:    in.UserId,
+		Stars:     int(in.Stars),
+		Review:    in.Review,
+		UpdatedAt: in.UpdatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+func libraryEntryToModel(in *v1.LibraryEntry, d *v1.Deck) *model.LibraryEntry {
+	return &model.LibraryEntry{
+		Deck:            deckToModel(d),
+		AddedAt:         in.AddedAt.AsTime().Format(time.RFC3339),
+		LastPracticedAt: in.LastPracticedAt.AsTime().Format(time.RFC3339),
+		CardCount:       int(in.CardCount),
+		Mastery:         in.Mastery,
+	}
+}
+
+func tagToModel(in *v1.Tag) *model.Tag {
+	out := &model.Tag{
+		Name:      in.Name,
+		DeckCount: int(in.DeckCount),
+	}
+
+	if in.TopicId != "" {
+		out.TopicID = &in.TopicId
+	}
+
+	return out
+}
+
+func topicsToModel(in []*v1.Topic) []*model.Topic {
+	topics := make([]*model.Topic, 0, len(in))
+
+	for _, t := range in {
+		tags := t.Tags
+		if tags == nil {
+			tags = []string{}
 		}
 
-		cards = append(cards, &model.Card{
-			ID:          c.Id,
-			Title:       c.Title,
-			Answers:     outAnswers,
-			Explanation: &c.Explanation,
-			Kind:        c.Kind,
+		topics = append(topics, &model.Topic{
+			ID:       t.Id,
+			Name:     t.Name,
+			Tags:     tags,
+			Children: topicsToModel(t.Children),
 		})
 	}
 
-	return cards
+	return topics
 }
 
-func deckToModel(in *v1.Deck) *model.Deck {
-	return &model.Deck{
-		ID:          in.Id,
-		Title:       in.Title,
-		Description: in.Description,
-		Cards:       cardsToModel(in.Cards),
+func catalogCourseToModel(in *v1Course.CatalogCourse) *model.Course {
+	return &model.Course{
+		ID:          in.Course.Id,
+		Order:       int(in.Course.Order),
+		Title:       in.Course.Title,
+		Description: in.Course.Description,
+		LessonCount: int(in.LessonCount),
+		CreatedAt:   in.Course.CreatedAt.AsTime().Format(time.RFC3339),
 	}
 }
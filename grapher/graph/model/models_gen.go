@@ -2,6 +2,13 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type Answer struct {
 	ID        string `json:"id"`
 	Text      string `json:"text"`
@@ -16,12 +23,32 @@ type AnswerCardsResponse struct {
 	AnswerIDs []string `json:"answerIDs"`
 }
 
+type AuthoredDeckEdge struct {
+	Node   *Deck   `json:"node,omitempty"`
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+type AuthoredDecksConnection struct {
+	Edges    []*AuthoredDeckEdge `json:"edges,omitempty"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+}
+
 type Card struct {
 	ID          string    `json:"id"`
 	Title       string    `json:"title"`
 	Answers     []*Answer `json:"answers,omitempty"`
 	Explanation *string   `json:"explanation,omitempty"`
-	Kind        string    `json:"kind,omitempty"`
+	Kind        string    `json:"kind"`
+}
+
+type CardEdge struct {
+	Node   *Card   `json:"node,omitempty"`
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+type CardsConnection struct {
+	Edges    []*CardEdge `json:"edges,omitempty"`
+	PageInfo *PageInfo   `json:"pageInfo"`
 }
 
 type CardsInput struct {
@@ -29,6 +56,25 @@ type CardsInput struct {
 	MaxCards int    `json:"maxCards"`
 }
 
+type Course struct {
+	ID          string `json:"id"`
+	Order       int    `json:"order"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	LessonCount int    `json:"lessonCount"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+type CourseEdge struct {
+	Node   *Course `json:"node,omitempty"`
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+type CoursesConnection struct {
+	Edges    []*CourseEdge `json:"edges,omitempty"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
 type CreateAnswerInput struct {
 	Text      string `json:"text"`
 	IsCorrect bool   `json:"isCorrect"`
@@ -53,6 +99,7 @@ type CreateDeckInput struct {
 	Title       string             `json:"title"`
 	Description string             `json:"description"`
 	IsPublic    bool               `json:"isPublic"`
+	Tags        []string           `json:"tags,omitempty"`
 	Cards       []*CreateCardInput `json:"cards"`
 }
 
@@ -61,16 +108,69 @@ type CreateDeckResponse struct {
 }
 
 type Deck struct {
-	ID          string  `json:"id"`
-	Title       string  `json:"title"`
-	Description string  `json:"description"`
-	Cards       []*Card `json:"cards,omitempty"`
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Language    *string  `json:"language,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// Cards of the deck, oldest first, 20 at a time unless first says otherwise and 100 at most
+	Cards *CardsConnection `json:"cards,omitempty"`
+	// Only set on forks, the deck it was copied from
+	UpstreamDeckID   *string `json:"upstreamDeckID,omitempty"`
+	UpstreamRevision *int    `json:"upstreamRevision,omitempty"`
+	// Mean of the stars learners gave the deck, 0 until someone rates it
+	AverageRating float64 `json:"averageRating"`
+	RatingCount   int     `json:"ratingCount"`
+}
+
+type DeckReview struct {
+	UserID    string `json:"userID"`
+	Stars     int    `json:"stars"`
+	Review    string `json:"review"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type DeckReviewEdge struct {
+	Node   *DeckReview `json:"node,omitempty"`
+	Cursor *string     `json:"cursor,omitempty"`
+}
+
+type DeckReviewsConnection struct {
+	Edges    []*DeckReviewEdge `json:"edges,omitempty"`
+	PageInfo *PageInfo         `json:"pageInfo"`
 }
 
 type DeleteDeckResponse struct {
 	Success *bool `json:"success,omitempty"`
 }
 
+type ForkDeckResponse struct {
+	Deck *Deck `json:"deck,omitempty"`
+}
+
+type LibraryConnection struct {
+	Edges    []*LibraryEdge `json:"edges,omitempty"`
+	PageInfo *PageInfo      `json:"pageInfo"`
+}
+
+type LibraryEdge struct {
+	Node   *LibraryEntry `json:"node,omitempty"`
+	Cursor *string       `json:"cursor,omitempty"`
+}
+
+type LibraryEntry struct {
+	Deck    *Deck  `json:"deck,omitempty"`
+	AddedAt string `json:"addedAt"`
+	// When the user last answered a card of the deck, or when they added it if never
+	LastPracticedAt string `json:"lastPracticedAt"`
+	CardCount       int    `json:"cardCount"`
+	// From 0 to 1, how close the cards of the deck are to the top level
+	Mastery float64 `json:"mastery"`
+}
+
+type Mutation struct {
+}
+
 type PageInfo struct {
 	HasPreviousPage bool    `json:"hasPreviousPage"`
 	HasNextPage     bool    `json:"hasNextPage"`
@@ -94,3 +194,154 @@ type Profile struct {
 	DisplayName *string `json:"displayName,omitempty"`
 	Bio         *string `json:"bio,omitempty"`
 }
+
+type Query struct {
+}
+
+type RateDeckInput struct {
+	DeckID string  `json:"deckID"`
+	Stars  int     `json:"stars"`
+	Review *string `json:"review,omitempty"`
+}
+
+type RateDeckResponse struct {
+	Review *DeckReview `json:"review,omitempty"`
+}
+
+type RemoveFromLibraryResponse struct {
+	Success bool `json:"success"`
+}
+
+type SearchDeckEdge struct {
+	Node   *Deck   `json:"node,omitempty"`
+	Cursor *string `json:"cursor,omitempty"`
+	Rank   float64 `json:"rank"`
+}
+
+type SearchDecksConnection struct {
+	Edges    []*SearchDeckEdge `json:"edges,omitempty"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type Tag struct {
+	Name      string  `json:"name"`
+	TopicID   *string `json:"topicID,omitempty"`
+	DeckCount int     `json:"deckCount"`
+}
+
+type Topic struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Tags     []string `json:"tags"`
+	Children []*Topic `json:"children"`
+}
+
+type LibraryOrder string
+
+const (
+	LibraryOrderLastPracticed LibraryOrder = "LAST_PRACTICED"
+	LibraryOrderAdded         LibraryOrder = "ADDED"
+)
+
+var AllLibraryOrder = []LibraryOrder{
+	LibraryOrderLastPracticed,
+	LibraryOrderAdded,
+}
+
+func (e LibraryOrder) IsValid() bool {
+	switch e {
+	case LibraryOrderLastPracticed, LibraryOrderAdded:
+		return true
+	}
+	return false
+}
+
+func (e LibraryOrder) String() string {
+	return string(e)
+}
+
+func (e *LibraryOrder) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LibraryOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LibraryOrder", str)
+	}
+	return nil
+}
+
+func (e LibraryOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LibraryOrder) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LibraryOrder) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PopularityPeriod string
+
+const (
+	PopularityPeriodWeek    PopularityPeriod = "WEEK"
+	PopularityPeriodAllTime PopularityPeriod = "ALL_TIME"
+)
+
+var AllPopularityPeriod = []PopularityPeriod{
+	PopularityPeriodWeek,
+	PopularityPeriodAllTime,
+}
+
+func (e PopularityPeriod) IsValid() bool {
+	switch e {
+	case PopularityPeriodWeek, PopularityPeriodAllTime:
+		return true
+	}
+	return false
+}
+
+func (e PopularityPeriod) String() string {
+	return string(e)
+}
+
+func (e *PopularityPeriod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PopularityPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PopularityPeriod", str)
+	}
+	return nil
+}
+
+func (e PopularityPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PopularityPeriod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PopularityPeriod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	pbCourse "github.com/XaviFP/toshokan/course/api/proto/v1"
 	pbDealer "github.com/XaviFP/toshokan/dealer/api/proto/v1"
 	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
 	pbUser "github.com/XaviFP/toshokan/user/api/proto/v1"
@@ -17,8 +18,11 @@ type Resolver struct {
 	DeckClient   pbDeck.DecksAPIClient
 	UserClient   pbUser.UserAPIClient
 	DealerClient pbDealer.DealerClient
+	CourseClient pbCourse.CourseAPIClient
 	DeckLoader   DataLoader
 	CardLoader   DataLoader
+	// DeckCardsLoader loads the first cards of decks, by DeckCardsKey
+	DeckCardsLoader DataLoader
 }
 
 func (r *Resolver) getUserID(ctx context.Context) string {
@@ -5,7 +5,9 @@ package graph
 
 import (
 	"context"
+	"strings"
 
+	v1Course "github.com/XaviFP/toshokan/course/api/proto/v1"
 	v1Dealer "github.com/XaviFP/toshokan/dealer/api/proto/v1"
 	v1Deck "github.com/XaviFP/toshokan/deck/api/proto/v1"
 	"github.com/XaviFP/toshokan/grapher/graph/generated"
@@ -13,12 +15,60 @@ import (
 	"github.com/juju/errors"
 )
 
+// Cards is the resolver for the cards field.
+func (r *deckResolver) Cards(ctx context.Context, obj *model.Deck, first *int, after *string) (*model.CardsConnection, error) {
+	n := int(pageSizeFromInput(first, nil, nil, nil).First)
+
+	var conn *v1Deck.CardsConnection
+
+	if after == nil {
+		// The first cards of the decks of a list are read at once
+		c, err := r.DeckCardsLoader.Load(ctx, UserKey(r.getUserID(ctx), DeckCardsKey(obj.ID, n)))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		conn = c.(*v1Deck.CardsConnection)
+	} else {
+		res, err := r.DeckClient.GetDeckCards(ctx, &v1Deck.GetDeckCardsRequest{
+			DeckId:      obj.ID,
+			UserId:      r.getUserID(ctx),
+			Pagination:  paginationFromInput(&n, after, nil, nil),
+			WithAnswers: true,
+		})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		conn = res.Connection
+	}
+
+	edges := make([]*model.CardEdge, 0, len(conn.Edges))
+	for _, e := range conn.Edges {
+		edges = append(edges, &model.CardEdge{
+			Node:   cardToModel(e.Node),
+			Cursor: &e.Cursor,
+		})
+	}
+
+	return &model.CardsConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     &conn.PageInfo.StartCursor,
+			EndCursor:       &conn.PageInfo.EndCursor,
+		},
+	}, nil
+}
+
 // CreateDeck is the resolver for the createDeck field.
 func (r *mutationResolver) CreateDeck(ctx context.Context, input model.CreateDeckInput) (*model.CreateDeckResponse, error) {
 	res, err := r.DeckClient.CreateDeck(ctx, &v1Deck.CreateDeckRequest{
 		Deck: &v1Deck.Deck{
 			Title:       input.Title,
 			Description: input.Description,
+			Tags:        input.Tags,
 			Cards:       cardsFromInput(input.Cards),
 			AuthorId:    r.getUserID(ctx),
 		},
@@ -32,7 +82,7 @@ func (r *mutationResolver) CreateDeck(ctx context.Context, input model.CreateDec
 		ID:          res.Deck.Id,
 		Title:       res.Deck.Title,
 		Description: res.Deck.Description,
-		Cards:       cardsToModel(res.Deck.Cards),
+		Tags:        res.Deck.Tags,
 	}
 
 	return &model.CreateDeckResponse{Deck: out}, errors.Trace(err)
@@ -40,7 +90,7 @@ func (r *mutationResolver) CreateDeck(ctx context.Context, input model.CreateDec
 
 // DeleteDeck is the resolver for the deleteDeck field.
 func (r *mutationResolver) DeleteDeck(ctx context.Context, id string) (*model.DeleteDeckResponse, error) {
-	_, err := r.DeckClient.DeleteDeck(ctx, &v1Deck.DeleteDeckRequest{Id: id})
+	_, err := r.DeckClient.DeleteDeck(ctx, &v1Deck.DeleteDeckRequest{Id: id, UserId: r.getUserID(ctx)})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -49,6 +99,16 @@ func (r *mutationResolver) DeleteDeck(ctx context.Context, id string) (*model.De
 	return &model.DeleteDeckResponse{Success: &success}, nil
 }
 
+// ForkDeck is the resolver for the forkDeck field.
+func (r *mutationResolver) ForkDeck(ctx context.Context, id string) (*model.ForkDeckResponse, error) {
+	res, err := r.DeckClient.ForkDeck(ctx, &v1Deck.ForkDeckRequest{DeckId: id, UserId: r.getUserID(ctx)})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &model.ForkDeckResponse{Deck: deckToModel(res.Deck)}, nil
+}
+
 func (r *mutationResolver) AnswerCards(ctx context.Context, input model.AnswerCardsInput) (*model.AnswerCardsResponse, error) {
 	_, err := r.DealerClient.StoreAnswers(ctx, &v1Dealer.StoreAnswersRequest{
 		UserId:    r.getUserID(ctx),
@@ -61,6 +121,51 @@ func (r *mutationResolver) AnswerCards(ctx context.Context, input model.AnswerCa
 	return &model.AnswerCardsResponse{AnswerIDs: input.AnswerIDs}, nil
 }
 
+// RateDeck is the resolver for the rateDeck field.
+func (r *mutationResolver) RateDeck(ctx context.Context, input model.RateDeckInput) (*model.RateDeckResponse, error) {
+	req := &v1Deck.RateDeckRequest{
+		DeckId: input.DeckID,
+		UserId: r.getUserID(ctx),
+		Stars:  int32(input.Stars),
+	}
+
+	if input.Review != nil {
+		req.Review = *input.Review
+	}
+
+	res, err := r.DeckClient.RateDeck(ctx, req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &model.RateDeckResponse{Review: deckReviewToModel(res.Rating)}, nil
+}
+
+// AddToLibrary is the resolver for the addToLibrary field.
+func (r *mutationResolver) AddToLibrary(ctx context.Context, deckID string) (*model.LibraryEntry, error) {
+	res, err := r.DeckClient.AddToLibrary(ctx, &v1Deck.AddToLibraryRequest{DeckId: deckID, UserId: r.getUserID(ctx)})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	d, err := r.DeckLoader.Load(ctx, UserKey(r.getUserID(ctx), deckID))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return libraryEntryToModel(res.Entry, d.(*v1Deck.Deck)), nil
+}
+
+// RemoveFromLibrary is the resolver for the removeFromLibrary field.
+func (r *mutationResolver) RemoveFromLibrary(ctx context.Context, deckID string) (*model.RemoveFromLibraryResponse, error) {
+	_, err := r.DeckClient.RemoveFromLibrary(ctx, &v1Deck.RemoveFromLibraryRequest{DeckId: deckID, UserId: r.getUserID(ctx)})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &model.RemoveFromLibraryResponse{Success: true}, nil
+}
+
 // CreateDeckCard implements generated.MutationResolver.
 func (r *mutationResolver) CreateDeckCard(ctx context.Context, input model.CreateDeckCardInput) (*model.CreateDeckCardResponse, error) {
 	var answers []*v1Deck.Answer
@@ -74,6 +179,7 @@ func (r *mutationResolver) CreateDeckCard(ctx context.Context, input model.Creat
 			PossibleAnswers: answers,
 			DeckId:          input.DeckID,
 		},
+		UserId: r.getUserID(ctx),
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -84,24 +190,33 @@ func (r *mutationResolver) CreateDeckCard(ctx context.Context, input model.Creat
 
 // Deck is the resolver for the deck field.
 func (r *queryResolver) Deck(ctx context.Context, id string) (*model.Deck, error) {
-	res, err := r.DeckClient.GetDeck(ctx, &v1Deck.GetDeckRequest{DeckId: id, UserId: r.getUserID(ctx)})
+	// Cards are only read if asked for, a page at a time
+	res, err := r.DeckClient.GetDecks(ctx, &v1Deck.GetDecksRequest{DeckIds: []string{id}, UserId: r.getUserID(ctx)})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
-	return &model.Deck{
-		ID:          res.Deck.Id,
-		Title:       res.Deck.Title,
-		Description: res.Deck.Description,
-		Cards:       cardsToModel(res.Deck.Cards),
-	}, nil
+	// Left out when the user can't see it
+	for _, d := range res.Decks {
+		return deckToModel(d), nil
+	}
+
+	return nil, nil
 }
 
 // PopularDecks is the resolver for the popularDecks field.
-func (r *queryResolver) PopularDecks(ctx context.Context, first *int, after *string, last *int, before *string) (*model.PopularDecksConnection, error) {
-	res, err := r.DeckClient.GetPopularDecks(ctx, &v1Deck.GetPopularDecksRequest{
+func (r *queryResolver) PopularDecks(ctx context.Context, period *model.PopularityPeriod, tags []string, first *int, after *string, last *int, before *string) (*model.PopularDecksConnection, error) {
+	req := &v1Deck.GetPopularDecksRequest{
 		UserId:     r.getUserID(ctx),
-		Pagination: paginationFromInput(first, after, last, before)})
+		Tags:       tags,
+		Pagination: paginationFromInput(first, after, last, before),
+	}
+
+	if period != nil {
+		req.Period = strings.ToLower(period.String())
+	}
+
+	res, err := r.DeckClient.GetPopularDecks(ctx, req)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -114,6 +229,31 @@ func (r *queryResolver) PopularDecks(ctx context.Context, first *int, after *str
 	return out, nil
 }
 
+func (r *queryResolver) SearchDecks(ctx context.Context, query string, language *string, tags []string, first *int, after *string, last *int, before *string) (*model.SearchDecksConnection, error) {
+	req := &v1Deck.SearchDecksRequest{
+		UserId:     r.getUserID(ctx),
+		Query:      query,
+		Tags:       tags,
+		Pagination: paginationFromInput(first, after, last, before),
+	}
+
+	if language != nil {
+		req.Language = *language
+	}
+
+	res, err := r.DeckClient.SearchDecks(ctx, req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	out, err := r.searchConnectionToModel(ctx, res.Connection)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return out, nil
+}
+
 func (r *queryResolver) Cards(ctx context.Context, input model.CardsInput) ([]*model.Card, error) {
 	var maxCards int
 	if input.MaxCards > 0 {
@@ -132,7 +272,7 @@ func (r *queryResolver) Cards(ctx context.Context, input model.CardsInput) ([]*m
 	cards := make([]*model.Card, 0, len(res.CardIds))
 
 	for _, cardID := range res.CardIds {
-		c, err := r.CardLoader.Load(ctx, cardID)
+		c, err := r.CardLoader.Load(ctx, UserKey(r.getUserID(ctx), cardID))
 		if err != nil {
 			if errors.Is(err, ErrNoResult) {
 				continue
@@ -148,6 +288,187 @@ func (r *queryResolver) Cards(ctx context.Context, input model.CardsInput) ([]*m
 
 }
 
+// DeckReviews is the resolver for the deckReviews field.
+func (r *queryResolver) DeckReviews(ctx context.Context, deckID string, first *int, after *string, last *int, before *string) (*model.DeckReviewsConnection, error) {
+	res, err := r.DeckClient.GetDeckReviews(ctx, &v1Deck.GetDeckReviewsRequest{
+		DeckId:     deckID,
+		UserId:     r.getUserID(ctx),
+		Pagination: paginationFromInput(first, after, last, before),
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	conn := res.Connection
+
+	edges := make([]*model.DeckReviewEdge, 0, len(conn.Edges))
+	for _, e := range conn.Edges {
+		edges = append(edges, &model.DeckReviewEdge{
+			Node:   deckReviewToModel(e.Node),
+			Cursor: &e.Cursor,
+		})
+	}
+
+	return &model.DeckReviewsConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     &conn.PageInfo.StartCursor,
+			EndCursor:       &conn.PageInfo.EndCursor,
+		},
+	}, nil
+}
+
+// Library is the resolver for the library field.
+func (r *queryResolver) Library(ctx context.Context, order *model.LibraryOrder, first *int, after *string, last *int, before *string) (*model.LibraryConnection, error) {
+	req := &v1Deck.GetLibraryRequest{
+		UserId:     r.getUserID(ctx),
+		Pagination: paginationFromInput(first, after, last, before),
+	}
+
+	if order != nil {
+		req.Order = strings.ToLower(order.String())
+	}
+
+	res, err := r.DeckClient.GetLibrary(ctx, req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	conn := res.Connection
+
+	edges := make([]*model.LibraryEdge, 0, len(conn.Edges))
+	for _, e := range conn.Edges {
+		d, err := r.DeckLoader.Load(ctx, UserKey(r.getUserID(ctx), e.Node.DeckId))
+		if err != nil {
+			if errors.Is(err, ErrNoResult) {
+				continue
+			}
+
+			return nil, errors.Trace(err)
+		}
+
+		edges = append(edges, &model.LibraryEdge{
+			Node:   libraryEntryToModel(e.Node, d.(*v1Deck.Deck)),
+			Cursor: &e.Cursor,
+		})
+	}
+
+	return &model.LibraryConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     &conn.PageInfo.StartCursor,
+			EndCursor:       &conn.PageInfo.EndCursor,
+		},
+	}, nil
+}
+
+// AuthoredDecks is the resolver for the authoredDecks field.
+func (r *queryResolver) AuthoredDecks(ctx context.Context, first *int, after *string, last *int, before *string) (*model.AuthoredDecksConnection, error) {
+	res, err := r.DeckClient.GetAuthoredDecks(ctx, &v1Deck.GetAuthoredDecksRequest{
+		UserId:     r.getUserID(ctx),
+		Pagination: paginationFromInput(first, after, last, before),
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	conn := res.Connection
+
+	edges := make([]*model.AuthoredDeckEdge, 0, len(conn.Edges))
+	for _, e := range conn.Edges {
+		d, err := r.DeckLoader.Load(ctx, UserKey(r.getUserID(ctx), e.DeckId))
+		if err != nil {
+			if errors.Is(err, ErrNoResult) {
+				continue
+			}
+
+			return nil, errors.Trace(err)
+		}
+
+		edges = append(edges, &model.AuthoredDeckEdge{
+			Node:   deckToModel(d.(*v1Deck.Deck)),
+			Cursor: &e.Cursor,
+		})
+	}
+
+	return &model.AuthoredDecksConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     &conn.PageInfo.StartCursor,
+			EndCursor:       &conn.PageInfo.EndCursor,
+		},
+	}, nil
+}
+
+// Courses is the resolver for the courses field.
+func (r *queryResolver) Courses(ctx context.Context, query *string, first *int, after *string, last *int, before *string) (*model.CoursesConnection, error) {
+	p := pageSizeFromInput(first, after, last, before)
+
+	req := &v1Course.ListCoursesRequest{
+		Pagination: &v1Course.Pagination{
+			First:  p.First,
+			After:  p.After,
+			Last:   p.Last,
+			Before: p.Before,
+		},
+	}
+	if query != nil {
+		req.Query = *query
+	}
+
+	res, err := r.CourseClient.ListCourses(ctx, req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	conn := res.Courses
+
+	edges := make([]*model.CourseEdge, 0, len(conn.Edges))
+	for _, e := range conn.Edges {
+		edges = append(edges, &model.CourseEdge{
+			Node:   catalogCourseToModel(e.Node),
+			Cursor: &e.Cursor,
+		})
+	}
+
+	return &model.CoursesConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     &conn.PageInfo.StartCursor,
+			EndCursor:       &conn.PageInfo.EndCursor,
+		},
+	}, nil
+}
+
+// defaultPageSize is how many items of a connection are read when neither
+// first nor last are given, and maxPageSize the most read at once.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageSizeFromInput is paginationFromInput with first set to
+// defaultPageSize when neither first nor last are given, and both of them
+// kept within maxPageSize.
+func pageSizeFromInput(first *int, after *string, last *int, before *string) *v1Deck.Pagination {
+	p := paginationFromInput(first, after, last, before)
+	if p.First <= 0 && p.Last <= 0 {
+		p.First = defaultPageSize
+	}
+	p.First = min(p.First, maxPageSize)
+	p.Last = min(p.Last, maxPageSize)
+
+	return p
+}
+
 func paginationFromInput(first *int, after *string, last *int, before *string) *v1Deck.Pagination {
 	var out v1Deck.Pagination
 	if first != nil {
@@ -173,7 +494,7 @@ func (r *queryResolver) connectionToModel(ctx context.Context, conn *v1Deck.Popu
 	var edges []*model.PopularDeckEdge
 
 	for _, e := range conn.Edges {
-		deck, err := r.DeckLoader.Load(ctx, e.DeckId)
+		deck, err := r.DeckLoader.Load(ctx, UserKey(r.getUserID(ctx), e.DeckId))
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -195,12 +516,79 @@ func (r *queryResolver) connectionToModel(ctx context.Context, conn *v1Deck.Popu
 	}, nil
 }
 
+func (r *queryResolver) searchConnectionToModel(ctx context.Context, conn *v1Deck.SearchDecksConnection) (*model.SearchDecksConnection, error) {
+	var edges []*model.SearchDeckEdge
+
+	for _, e := range conn.Edges {
+		deck, err := r.DeckLoader.Load(ctx, UserKey(r.getUserID(ctx), e.DeckId))
+		if err != nil {
+			// The deck may have been deleted since the search ran
+			if errors.Is(err, ErrNoResult) {
+				continue
+			}
+
+			return nil, errors.Trace(err)
+		}
+
+		edges = append(edges, &model.SearchDeckEdge{
+			Node:   deckToModel(deck.(*v1Deck.Deck)),
+			Cursor: &e.Cursor,
+			Rank:   float64(e.Rank),
+		})
+	}
+
+	return &model.SearchDecksConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+			StartCursor:     &conn.PageInfo.StartCursor,
+			EndCursor:       &conn.PageInfo.EndCursor,
+		},
+	}, nil
+}
+
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context, prefix string, first *int) ([]*model.Tag, error) {
+	req := &v1Deck.AutocompleteTagsRequest{Prefix: prefix}
+	if first != nil {
+		req.Limit = int32(*first)
+	}
+
+	res, err := r.DeckClient.AutocompleteTags(ctx, req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	tags := make([]*model.Tag, 0, len(res.Tags))
+	for _, t := range res.Tags {
+		tags = append(tags, tagToModel(t))
+	}
+
+	return tags, nil
+}
+
+// Topics is the resolver for the topics field.
+func (r *queryResolver) Topics(ctx context.Context) ([]*model.Topic, error) {
+	res, err := r.DeckClient.GetTopics(ctx, &v1Deck.GetTopicsRequest{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return topicsToModel(res.Topics), nil
+}
+
+// Deck returns generated.DeckResolver implementation.
+func (r *Resolver) Deck() generated.DeckResolver { return &deckResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+type deckResolver struct{ *Resolver }
+
 type mutationResolver struct{ *Resolver }
 
 type queryResolver struct{ *Resolver }
@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/juju/errors"
 
+	pbCourse "github.com/XaviFP/toshokan/course/api/proto/v1"
 	pbDealer "github.com/XaviFP/toshokan/dealer/api/proto/v1"
 	pbDeck "github.com/XaviFP/toshokan/deck/api/proto/v1"
 	"github.com/XaviFP/toshokan/grapher/graph"
@@ -21,11 +22,13 @@ func NewGraphqlHandler(
 	deckClient pbDeck.DecksAPIClient,
 	userClient pbUser.UserAPIClient,
 	dealerClient pbDealer.DealerClient,
+	courseClient pbCourse.CourseAPIClient,
 ) gin.HandlerFunc {
 	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{
 		DeckClient:   deckClient,
 		UserClient:   userClient,
 		DealerClient: dealerClient,
+		CourseClient: courseClient,
 		DeckLoader: graph.NewDataLoader(
 			NewDeckBatchFn(deckClient),
 			time.Minute*30,
@@ -36,6 +39,11 @@ func NewGraphqlHandler(
 			time.Minute*30,
 			time.Millisecond*16,
 		),
+		DeckCardsLoader: graph.NewDataLoader(
+			NewDeckCardsBatchFn(deckClient),
+			time.Minute*30,
+			time.Millisecond*16,
+		),
 	}}))
 
 	h.Use(extension.Introspection{})
@@ -53,34 +61,70 @@ func NewPlaygroundHandler(targetPath string) gin.HandlerFunc {
 	}
 }
 
+// NewDeckBatchFn reads, in their learner view, the decks each user of the
+// keys can see.
 func NewDeckBatchFn(client pbDeck.DecksAPIClient) graph.BatchFn {
-	return func(ctx context.Context, ids []string) (map[string]graph.Result, error) {
-		out := make(map[string]graph.Result, len(ids))
-
-		res, err := client.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids})
-		if err != nil {
-			return out, errors.Trace(err)
-		}
-
-		for _, d := range res.Decks {
-			out[d.Id] = graph.Result{Value: d}
+	return func(ctx context.Context, keys []string) (map[string]graph.Result, error) {
+		out := make(map[string]graph.Result, len(keys))
+
+		for userID, ids := range graph.SplitUserKeys(keys) {
+			res, err := client.GetDecks(ctx, &pbDeck.GetDecksRequest{DeckIds: ids, UserId: userID})
+			if err != nil {
+				return out, errors.Trace(err)
+			}
+
+			for _, d := range res.Decks {
+				out[graph.UserKey(userID, d.Id)] = graph.Result{Value: d}
+			}
 		}
 
 		return out, nil
 	}
 }
 
+// NewCardBatchFn reads, in their learner view, the cards each user of the
+// keys can see.
 func NewCardBatchFn(client pbDeck.DecksAPIClient) graph.BatchFn {
-	return func(ctx context.Context, ids []string) (map[string]graph.Result, error) {
-		out := make(map[string]graph.Result, len(ids))
-
-		res, err := client.GetCards(ctx, &pbDeck.GetCardsRequest{CardIds: ids})
-		if err != nil {
-			return out, errors.Trace(err)
+	return func(ctx context.Context, keys []string) (map[string]graph.Result, error) {
+		out := make(map[string]graph.Result, len(keys))
+
+		for userID, ids := range graph.SplitUserKeys(keys) {
+			res, err := client.GetCards(ctx, &pbDeck.GetCardsRequest{CardIds: ids, UserId: userID})
+			if err != nil {
+				return out, errors.Trace(err)
+			}
+
+			for _, c := range res.Cards {
+				out[graph.UserKey(userID, c.Id)] = graph.Result{Value: c}
+			}
 		}
 
-		for _, c := range res.Cards {
-			out[c.Id] = graph.Result{Value: c}
+		return out, nil
+	}
+}
+
+// NewDeckCardsBatchFn reads the first cards of the decks each user of the
+// keys can see, with answers for the decks they can edit.
+func NewDeckCardsBatchFn(client pbDeck.DecksAPIClient) graph.BatchFn {
+	return func(ctx context.Context, keys []string) (map[string]graph.Result, error) {
+		out := make(map[string]graph.Result, len(keys))
+
+		for userID, ids := range graph.SplitUserKeys(keys) {
+			for first, deckIDs := range graph.SplitDeckCardsKeys(ids) {
+				res, err := client.GetDecksCards(ctx, &pbDeck.GetDecksCardsRequest{
+					DeckIds:     deckIDs,
+					UserId:      userID,
+					First:       int32(first),
+					WithAnswers: true,
+				})
+				if err != nil {
+					return out, errors.Trace(err)
+				}
+
+				for deckID, conn := range res.Connections {
+					out[graph.UserKey(userID, graph.DeckCardsKey(deckID, first))] = graph.Result{Value: conn}
+				}
+			}
 		}
 
 		return out, nil